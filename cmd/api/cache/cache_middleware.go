@@ -71,5 +71,5 @@ func (m *CacheMiddleware) cacheResult(ctx context.Context, key string, r *Respon
 }
 
 func (m *CacheMiddleware) isStatusCacheable(e *CacheEntry) bool {
-	return e.StatusCode == http.StatusOK
+	return e.StatusCode == http.StatusOK && e.Header.Get(echo.HeaderCacheControl) != "no-store"
 }
@@ -113,7 +113,7 @@ const docTemplate = `{
         },
         "/addresses/{hash}": {
             "get": {
-                "description": "Returns detailed information about a specific address including its balance, contract status, and activity history",
+                "description": "Returns detailed information about a specific address including its balance, contract status, activity history and active EIP-7702 delegation",
                 "produces": [
                     "application/json"
                 ],
@@ -158,24 +158,699 @@ const docTemplate = `{
                 }
             }
         },
+        "/addresses/{hash}/approvals": {
+            "get": {
+                "description": "Returns token approvals given by the address: ERC-20 allowances, ERC-721 single token approvals and operator approvals (ApprovalForAll).\nBy default only active approvals are returned. Revoked approvals are included if ` + "`" + `include_revoked` + "`" + ` is set.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "address"
+                ],
+                "summary": "Get address token approvals",
+                "operationId": "get-address-approvals",
+                "parameters": [
+                    {
+                        "maxLength": 42,
+                        "minLength": 42,
+                        "type": "string",
+                        "description": "Address hash in hexadecimal format (e.g., 0x742d35Cc6634C0532925a3b844Bc9e7595f0bEb)",
+                        "name": "hash",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Number of approvals to return (default: 10)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Number of approvals to skip (default: 0)",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "default": "desc",
+                        "description": "Sort order by internal id (default: desc)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "maxLength": 42,
+                        "minLength": 42,
+                        "type": "string",
+                        "example": "0x742d35Cc6634C0532925a3b844Bc9e7595f0bEb",
+                        "description": "Filter by token contract address",
+                        "name": "contract",
+                        "in": "query"
+                    },
+                    {
+                        "maxLength": 42,
+                        "minLength": 42,
+                        "type": "string",
+                        "example": "0x742d35Cc6634C0532925a3b844Bc9e7595f0bEb",
+                        "description": "Filter by spender (operator) address",
+                        "name": "spender",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "allowance",
+                            "token_approval",
+                            "approval_for_all"
+                        ],
+                        "type": "string",
+                        "description": "Filter by approval type (comma-separated list)",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "If true, revoked approvals are returned too (default: false)",
+                        "name": "include_revoked",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Opaque cursor for keyset pagination. Base64url-encoded value from the previous response's 'cursor' field. Encodes the id of the last returned record. Cannot be used together with offset (returns 400).",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of approvals",
+                        "schema": {
+                            "$ref": "#/definitions/handler.CursorResponse"
+                        }
+                    },
+                    "204": {
+                        "description": "Address not found"
+                    },
+                    "400": {
+                        "description": "Invalid request parameters",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/addresses/{hash}/balance_history": {
+            "get": {
+                "description": "Returns historical native balance of the address. The result depends on the query parameters:\n- ` + "`" + `height` + "`" + ` or ` + "`" + `time` + "`" + `: balance at the given block height or timestamp (a single object). Zero balance is returned if the address had no balance changes before that point. The current balance is returned if no balance changes are recorded for the address.\n- ` + "`" + `timeframe` + "`" + `: balance at the end of each time bucket in [time_from, time_to). Buckets without balance changes are omitted. At most 1000 buckets are returned starting from time_from.\n- otherwise: paginated list of balance snapshots taken after each block changing the balance.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "address"
+                ],
+                "summary": "Get address balance history",
+                "operationId": "get-address-balance-history",
+                "parameters": [
+                    {
+                        "maxLength": 42,
+                        "minLength": 42,
+                        "type": "string",
+                        "description": "Address hash in hexadecimal format (e.g., 0x742d35Cc6634C0532925a3b844Bc9e7595f0bEb)",
+                        "name": "hash",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "integer",
+                        "example": 12345,
+                        "description": "Block height for the point lookup",
+                        "name": "height",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "example": 1692892095,
+                        "description": "Unix timestamp for the point lookup",
+                        "name": "time",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "hour",
+                            "day",
+                            "week",
+                            "month"
+                        ],
+                        "type": "string",
+                        "description": "Time bucket size of the series",
+                        "name": "timeframe",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "example": 1692892095,
+                        "description": "Filter by timestamp from (Unix timestamp)",
+                        "name": "time_from",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "example": 1692892095,
+                        "description": "Filter by timestamp to (Unix timestamp)",
+                        "name": "time_to",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Number of snapshots to return (default: 10)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Number of snapshots to skip (default: 0)",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "default": "desc",
+                        "description": "Sort order by timestamp (default: desc)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "latest",
+                            "safe",
+                            "finalized"
+                        ],
+                        "type": "string",
+                        "description": "Return only data of safe or finalized blocks, safe includes finalized ones (default: latest)",
+                        "name": "finality",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Opaque cursor for keyset pagination. Base64url-encoded value from the previous response's 'cursor' field. Encodes (timestamp, id) of the last returned record. Cannot be used together with offset (returns 400).",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of balance snapshots, responses.BalanceHistory for the point lookup or an array of responses.BalanceSeriesItem for the series",
+                        "schema": {
+                            "$ref": "#/definitions/handler.CursorResponse"
+                        }
+                    },
+                    "204": {
+                        "description": "Address not found"
+                    },
+                    "400": {
+                        "description": "Invalid request parameters",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    },
+                    "503": {
+                        "description": "Requested finality is not tracked by the indexer",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    }
+                }
+            }
+        },
         "/beacon_withdrawals": {
             "get": {
-                "description": "Returns a paginated list of beacon chain (consensus layer) withdrawals. Withdrawals represent ETH transferred from validators to execution layer addresses. Can be filtered by block height or recipient address.",
+                "description": "Returns a paginated list of beacon chain (consensus layer) withdrawals. Withdrawals represent ETH transferred from validators to execution layer addresses. Can be filtered by block height or recipient address.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "beacon"
+                ],
+                "summary": "List beacon chain withdrawals",
+                "operationId": "list-beacon-withdrawals",
+                "parameters": [
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Number of withdrawals to return (default: 10)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Number of withdrawals to skip (default: 0)",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "default": "asc",
+                        "description": "Sort order by block height (default: asc)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "integer",
+                        "example": 12345,
+                        "description": "Filter by block height",
+                        "name": "height",
+                        "in": "query"
+                    },
+                    {
+                        "maxLength": 42,
+                        "minLength": 42,
+                        "type": "string",
+                        "example": "0x742d35Cc6634C0532925a3b844Bc9e7595f0bEb",
+                        "description": "Filter by recipient address (hexadecimal with 0x prefix)",
+                        "name": "address",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "latest",
+                            "safe",
+                            "finalized"
+                        ],
+                        "type": "string",
+                        "description": "Return only data of safe or finalized blocks, safe includes finalized ones (default: latest)",
+                        "name": "finality",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Opaque cursor for keyset pagination. Base64url-encoded value from the previous response's 'cursor' field. Encodes (timestamp, id) of the last returned record. Cannot be used together with offset (returns 400).",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of beacon withdrawals",
+                        "schema": {
+                            "$ref": "#/definitions/handler.CursorResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request parameters",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    },
+                    "503": {
+                        "description": "Requested finality is not tracked by the indexer",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/blobs": {
+            "get": {
+                "description": "Returns a paginated list of blobs referenced by blob transactions. Can be filtered by block height or transaction sender.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "blobs"
+                ],
+                "summary": "List EIP-4844 blobs",
+                "operationId": "list-blobs",
+                "parameters": [
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Number of blobs to return (default: 10)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Number of blobs to skip (default: 0)",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "default": "desc",
+                        "description": "Sort order (default: desc)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "integer",
+                        "example": 12345,
+                        "description": "Filter by block height",
+                        "name": "height",
+                        "in": "query"
+                    },
+                    {
+                        "maxLength": 42,
+                        "minLength": 42,
+                        "type": "string",
+                        "example": "0x742d35Cc6634C0532925a3b844Bc9e7595f0bEb",
+                        "description": "Filter by transaction sender address",
+                        "name": "sender",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Opaque cursor for keyset pagination. Base64url-encoded value from the previous response's 'cursor' field. Encodes the id of the last returned record. Cannot be used together with offset (returns 400).",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of blobs",
+                        "schema": {
+                            "$ref": "#/definitions/handler.CursorResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request parameters",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/blocks": {
+            "get": {
+                "description": "Returns a paginated list of blocks. Blocks can be sorted by height in ascending or descending order. Optionally includes statistics for each block.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "block"
+                ],
+                "summary": "List blocks",
+                "operationId": "list-block",
+                "parameters": [
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Number of blocks to return (default: 10)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Number of blocks to skip (default: 0)",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "default": "asc",
+                        "description": "Sort order by height (default: asc)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Include statistics for each block (default: false)",
+                        "name": "stats",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "latest",
+                            "safe",
+                            "finalized"
+                        ],
+                        "type": "string",
+                        "description": "Return only data of safe or finalized blocks, safe includes finalized ones (default: latest)",
+                        "name": "finality",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Opaque cursor for keyset pagination. Base64url-encoded value from the previous response's 'cursor' field. Encodes (timestamp, id) of the last returned record. Cannot be used together with offset (returns 400).",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of blocks",
+                        "schema": {
+                            "$ref": "#/definitions/handler.CursorResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request parameters",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    },
+                    "503": {
+                        "description": "Requested finality is not tracked by the indexer",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/blocks/count": {
+            "get": {
+                "description": "Returns the total number of blocks indexed in the blockchain, including the genesis block",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "block"
+                ],
+                "summary": "Get total block count",
+                "operationId": "get-blocks-count",
+                "responses": {
+                    "200": {
+                        "description": "Total number of blocks (including genesis)",
+                        "schema": {
+                            "type": "integer"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/blocks/{height}": {
+            "get": {
+                "description": "Returns detailed information about a specific block at the given height. Optionally includes statistical data such as transaction count, gas usage, and fees.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "block"
+                ],
+                "summary": "Get block by height",
+                "operationId": "get-block",
+                "parameters": [
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "example": 12345,
+                        "description": "Block height (block number)",
+                        "name": "height",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Include block statistics (transaction count, gas usage, fees) (default: false)",
+                        "name": "stats",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Block information",
+                        "schema": {
+                            "$ref": "#/definitions/responses.Block"
+                        }
+                    },
+                    "204": {
+                        "description": "Block not found"
+                    },
+                    "400": {
+                        "description": "Invalid request parameters",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/blocks/{height}/stats": {
+            "get": {
+                "description": "Returns statistical data for a specific block including transaction count, gas used, gas limit, base fee, and total fees",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "beacon"
+                    "block"
                 ],
-                "summary": "List beacon chain withdrawals",
-                "operationId": "list-beacon-withdrawals",
+                "summary": "Get block statistics",
+                "operationId": "get-block-stats",
+                "parameters": [
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "example": 12345,
+                        "description": "Block height (block number)",
+                        "name": "height",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Block statistics",
+                        "schema": {
+                            "$ref": "#/definitions/responses.BlockStats"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid block height",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/blocks/{height}/transactions": {
+            "get": {
+                "description": "Returns a paginated list of all transactions included in the specified block",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "block"
+                ],
+                "summary": "List transactions in block",
+                "operationId": "list-block-transactions",
                 "parameters": [
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "example": 12345,
+                        "description": "Block height (block number)",
+                        "name": "height",
+                        "in": "path",
+                        "required": true
+                    },
                     {
                         "maximum": 100,
                         "minimum": 1,
                         "type": "integer",
                         "default": 10,
-                        "description": "Number of withdrawals to return (default: 10)",
+                        "description": "Number of transactions to return (default: 10)",
                         "name": "limit",
                         "in": "query"
                     },
@@ -183,7 +858,7 @@ const docTemplate = `{
                         "minimum": 0,
                         "type": "integer",
                         "default": 0,
-                        "description": "Number of withdrawals to skip (default: 0)",
+                        "description": "Number of transactions to skip (default: 0)",
                         "name": "offset",
                         "in": "query"
                     },
@@ -194,43 +869,20 @@ const docTemplate = `{
                         ],
                         "type": "string",
                         "default": "asc",
-                        "description": "Sort order by block height (default: asc)",
+                        "description": "Sort order by index (default: asc)",
                         "name": "sort",
                         "in": "query"
-                    },
-                    {
-                        "minimum": 0,
-                        "type": "integer",
-                        "example": 12345,
-                        "description": "Filter by block height",
-                        "name": "height",
-                        "in": "query"
-                    },
-                    {
-                        "maxLength": 42,
-                        "minLength": 42,
-                        "type": "string",
-                        "example": "0x742d35Cc6634C0532925a3b844Bc9e7595f0bEb",
-                        "description": "Filter by recipient address (hexadecimal with 0x prefix)",
-                        "name": "address",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Opaque cursor for keyset pagination. Base64url-encoded value from the previous response's 'cursor' field. Encodes (timestamp, id) of the last returned record. Cannot be used together with offset (returns 400).",
-                        "name": "cursor",
-                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "List of beacon withdrawals",
+                        "description": "List of transactions in the block",
                         "schema": {
                             "$ref": "#/definitions/handler.CursorResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid request parameters",
+                        "description": "Invalid block height",
                         "schema": {
                             "$ref": "#/definitions/handler.Error"
                         }
@@ -244,24 +896,24 @@ const docTemplate = `{
                 }
             }
         },
-        "/blocks": {
+        "/contracts": {
             "get": {
-                "description": "Returns a paginated list of blocks. Blocks can be sorted by height in ascending or descending order. Optionally includes statistics for each block.",
+                "description": "Returns a paginated list of deployed smart contracts. Can be filtered by verification status or deployment transaction.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "block"
+                    "contract"
                 ],
-                "summary": "List blocks",
-                "operationId": "list-block",
+                "summary": "List smart contracts",
+                "operationId": "list-contract",
                 "parameters": [
                     {
                         "maximum": 100,
                         "minimum": 1,
                         "type": "integer",
                         "default": 10,
-                        "description": "Number of blocks to return (default: 10)",
+                        "description": "Number of contracts to return (default: 10)",
                         "name": "limit",
                         "in": "query"
                     },
@@ -269,7 +921,7 @@ const docTemplate = `{
                         "minimum": 0,
                         "type": "integer",
                         "default": 0,
-                        "description": "Number of blocks to skip (default: 0)",
+                        "description": "Number of contracts to skip (default: 0)",
                         "name": "offset",
                         "in": "query"
                     },
@@ -280,27 +932,54 @@ const docTemplate = `{
                         ],
                         "type": "string",
                         "default": "asc",
-                        "description": "Sort order by height (default: asc)",
+                        "description": "Sort order (default: asc)",
                         "name": "sort",
                         "in": "query"
                     },
+                    {
+                        "enum": [
+                            "id",
+                            "height"
+                        ],
+                        "type": "string",
+                        "description": "Field to sort by (default: id)",
+                        "name": "sort_by",
+                        "in": "query"
+                    },
                     {
                         "type": "boolean",
                         "default": false,
-                        "description": "Include statistics for each block (default: false)",
-                        "name": "stats",
+                        "description": "Filter to show only verified contracts (default: false)",
+                        "name": "is_verified",
                         "in": "query"
                     },
                     {
+                        "maxLength": 66,
+                        "minLength": 66,
                         "type": "string",
-                        "description": "Opaque cursor for keyset pagination. Base64url-encoded value from the previous response's 'cursor' field. Encodes (timestamp, id) of the last returned record. Cannot be used together with offset (returns 400).",
+                        "example": "0x1234567890abcdef1234567890abcdef1234567890abcdef1234567890abcdef",
+                        "description": "Filter by deployment transaction hash (hexadecimal with 0x prefix)",
+                        "name": "tx_hash",
+                        "in": "query"
+                    },
+                    {
+                        "maxLength": 42,
+                        "minLength": 42,
+                        "type": "string",
+                        "description": "Filter by deployer address (hexadecimal with 0x prefix)",
+                        "name": "deployer",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Opaque cursor for keyset pagination. Base64url-encoded value from the previous response's 'cursor' field. Encodes the id of the last returned record. Cannot be used together with offset (returns 400). Only supported when sort_by=id (default); returns 400 for other sort_by values.",
                         "name": "cursor",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "List of blocks",
+                        "description": "List of smart contracts",
                         "schema": {
                             "$ref": "#/definitions/handler.CursorResponse"
                         }
@@ -320,74 +999,40 @@ const docTemplate = `{
                 }
             }
         },
-        "/blocks/count": {
-            "get": {
-                "description": "Returns the total number of blocks indexed in the blockchain, including the genesis block",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "block"
-                ],
-                "summary": "Get total block count",
-                "operationId": "get-blocks-count",
-                "responses": {
-                    "200": {
-                        "description": "Total number of blocks (including genesis)",
-                        "schema": {
-                            "type": "integer"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal server error",
-                        "schema": {
-                            "$ref": "#/definitions/handler.Error"
-                        }
-                    }
-                }
-            }
-        },
-        "/blocks/{height}": {
+        "/contracts/{hash}": {
             "get": {
-                "description": "Returns detailed information about a specific block at the given height. Optionally includes statistical data such as transaction count, gas usage, and fees.",
+                "description": "Returns detailed information about a specific smart contract including deployment info, verification status, and metadata",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "block"
+                    "contract"
                 ],
-                "summary": "Get block by height",
-                "operationId": "get-block",
+                "summary": "Get contract by address",
+                "operationId": "get-contract",
                 "parameters": [
                     {
-                        "minimum": 1,
-                        "type": "integer",
-                        "example": 12345,
-                        "description": "Block height (block number)",
-                        "name": "height",
+                        "maxLength": 42,
+                        "minLength": 42,
+                        "type": "string",
+                        "description": "Contract address in hexadecimal format (e.g., 0x742d35Cc6634C0532925a3b844Bc9e7595f0bEb)",
+                        "name": "hash",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "boolean",
-                        "default": false,
-                        "description": "Include block statistics (transaction count, gas usage, fees) (default: false)",
-                        "name": "stats",
-                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Block information",
+                        "description": "Contract information",
                         "schema": {
-                            "$ref": "#/definitions/responses.Block"
+                            "$ref": "#/definitions/responses.Contract"
                         }
                     },
                     "204": {
-                        "description": "Block not found"
+                        "description": "Contract not found"
                     },
                     "400": {
-                        "description": "Invalid request parameters",
+                        "description": "Invalid contract address format",
                         "schema": {
                             "$ref": "#/definitions/handler.Error"
                         }
@@ -401,37 +1046,40 @@ const docTemplate = `{
                 }
             }
         },
-        "/blocks/{height}/stats": {
+        "/contracts/{hash}/code": {
             "get": {
-                "description": "Returns statistical data for a specific block including transaction count, gas used, gas limit, base fee, and total fees",
+                "description": "Returns the deployed bytecode of a specific smart contract.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "block"
+                    "contract"
                 ],
-                "summary": "Get block statistics",
-                "operationId": "get-block-stats",
+                "summary": "Get contract bytecode",
+                "operationId": "get-contract-code",
                 "parameters": [
                     {
-                        "minimum": 1,
-                        "type": "integer",
-                        "example": 12345,
-                        "description": "Block height (block number)",
-                        "name": "height",
+                        "maxLength": 42,
+                        "minLength": 42,
+                        "type": "string",
+                        "description": "Contract address in hexadecimal format (e.g., 0x742d35Cc6634C0532925a3b844Bc9e7595f0bEb)",
+                        "name": "hash",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Block statistics",
+                        "description": "Contract bytecode",
                         "schema": {
-                            "$ref": "#/definitions/responses.BlockStats"
+                            "$ref": "#/definitions/responses.ContractCode"
                         }
                     },
+                    "204": {
+                        "description": "Contract not found or not verified"
+                    },
                     "400": {
-                        "description": "Invalid block height",
+                        "description": "Invalid contract address format",
                         "schema": {
                             "$ref": "#/definitions/handler.Error"
                         }
@@ -445,24 +1093,24 @@ const docTemplate = `{
                 }
             }
         },
-        "/blocks/{height}/transactions": {
+        "/contracts/{hash}/implementations": {
             "get": {
-                "description": "Returns a paginated list of all transactions included in the specified block",
+                "description": "Returns the timeline of EIP-1967 Upgraded, BeaconUpgraded and AdminChanged events emitted by the proxy contract.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "block"
+                    "contract"
                 ],
-                "summary": "List transactions in block",
-                "operationId": "list-block-transactions",
+                "summary": "Get proxy upgrade history",
+                "operationId": "get-contract-implementations",
                 "parameters": [
-                    {
-                        "minimum": 1,
-                        "type": "integer",
-                        "example": 12345,
-                        "description": "Block height (block number)",
-                        "name": "height",
+                    {
+                        "maxLength": 42,
+                        "minLength": 42,
+                        "type": "string",
+                        "description": "Proxy contract address in hexadecimal format (e.g., 0x742d35Cc6634C0532925a3b844Bc9e7595f0bEb)",
+                        "name": "hash",
                         "in": "path",
                         "required": true
                     },
@@ -471,7 +1119,7 @@ const docTemplate = `{
                         "minimum": 1,
                         "type": "integer",
                         "default": 10,
-                        "description": "Number of transactions to return (default: 10)",
+                        "description": "Number of events to return (default: 10)",
                         "name": "limit",
                         "in": "query"
                     },
@@ -479,7 +1127,7 @@ const docTemplate = `{
                         "minimum": 0,
                         "type": "integer",
                         "default": 0,
-                        "description": "Number of transactions to skip (default: 0)",
+                        "description": "Number of events to skip (default: 0)",
                         "name": "offset",
                         "in": "query"
                     },
@@ -489,21 +1137,41 @@ const docTemplate = `{
                             "desc"
                         ],
                         "type": "string",
-                        "default": "asc",
-                        "description": "Sort order by index (default: asc)",
+                        "default": "desc",
+                        "description": "Sort order (default: desc)",
                         "name": "sort",
                         "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "upgraded",
+                            "beacon_upgraded",
+                            "admin_changed"
+                        ],
+                        "type": "string",
+                        "description": "Filter by event type (comma-separated list)",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Opaque cursor for keyset pagination. Base64url-encoded value from the previous response's 'cursor' field. Encodes the id of the last returned record. Cannot be used together with offset (returns 400).",
+                        "name": "cursor",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "List of transactions in the block",
+                        "description": "List of proxy upgrade events",
                         "schema": {
                             "$ref": "#/definitions/handler.CursorResponse"
                         }
                     },
+                    "204": {
+                        "description": "Contract not found"
+                    },
                     "400": {
-                        "description": "Invalid block height",
+                        "description": "Invalid request parameters",
                         "schema": {
                             "$ref": "#/definitions/handler.Error"
                         }
@@ -517,24 +1185,33 @@ const docTemplate = `{
                 }
             }
         },
-        "/contracts": {
+        "/contracts/{hash}/sources": {
             "get": {
-                "description": "Returns a paginated list of deployed smart contracts. Can be filtered by verification status or deployment transaction.",
+                "description": "Returns the verified source code files for a specific smart contract. Only available for verified contracts.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "contract"
                 ],
-                "summary": "List smart contracts",
-                "operationId": "list-contract",
+                "summary": "Get contract source code",
+                "operationId": "get-contract-sources",
                 "parameters": [
+                    {
+                        "maxLength": 42,
+                        "minLength": 42,
+                        "type": "string",
+                        "description": "Contract address in hexadecimal format (e.g., 0x742d35Cc6634C0532925a3b844Bc9e7595f0bEb)",
+                        "name": "hash",
+                        "in": "path",
+                        "required": true
+                    },
                     {
                         "maximum": 100,
                         "minimum": 1,
                         "type": "integer",
                         "default": 10,
-                        "description": "Number of contracts to return (default: 10)",
+                        "description": "Number of source files to return (default: 10)",
                         "name": "limit",
                         "in": "query"
                     },
@@ -542,7 +1219,7 @@ const docTemplate = `{
                         "minimum": 0,
                         "type": "integer",
                         "default": 0,
-                        "description": "Number of contracts to skip (default: 0)",
+                        "description": "Number of source files to skip (default: 0)",
                         "name": "offset",
                         "in": "query"
                     },
@@ -557,56 +1234,25 @@ const docTemplate = `{
                         "name": "sort",
                         "in": "query"
                     },
-                    {
-                        "enum": [
-                            "id",
-                            "height"
-                        ],
-                        "type": "string",
-                        "description": "Field to sort by (default: id)",
-                        "name": "sort_by",
-                        "in": "query"
-                    },
-                    {
-                        "type": "boolean",
-                        "default": false,
-                        "description": "Filter to show only verified contracts (default: false)",
-                        "name": "is_verified",
-                        "in": "query"
-                    },
-                    {
-                        "maxLength": 66,
-                        "minLength": 66,
-                        "type": "string",
-                        "example": "0x1234567890abcdef1234567890abcdef1234567890abcdef1234567890abcdef",
-                        "description": "Filter by deployment transaction hash (hexadecimal with 0x prefix)",
-                        "name": "tx_hash",
-                        "in": "query"
-                    },
-                    {
-                        "maxLength": 42,
-                        "minLength": 42,
-                        "type": "string",
-                        "description": "Filter by deployer address (hexadecimal with 0x prefix)",
-                        "name": "deployer",
-                        "in": "query"
-                    },
                     {
                         "type": "string",
-                        "description": "Opaque cursor for keyset pagination. Base64url-encoded value from the previous response's 'cursor' field. Encodes the id of the last returned record. Cannot be used together with offset (returns 400). Only supported when sort_by=id (default); returns 400 for other sort_by values.",
+                        "description": "Opaque cursor for keyset pagination. Base64url-encoded value from the previous response's 'cursor' field. Encodes the id of the last returned record. Cannot be used together with offset (returns 400).",
                         "name": "cursor",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "List of smart contracts",
+                        "description": "List of source code files",
                         "schema": {
                             "$ref": "#/definitions/handler.CursorResponse"
                         }
                     },
+                    "204": {
+                        "description": "Contract not found or not verified"
+                    },
                     "400": {
-                        "description": "Invalid request parameters",
+                        "description": "Invalid contract address format",
                         "schema": {
                             "$ref": "#/definitions/handler.Error"
                         }
@@ -620,17 +1266,17 @@ const docTemplate = `{
                 }
             }
         },
-        "/contracts/{hash}": {
+        "/contracts/{hash}/verification": {
             "get": {
-                "description": "Returns detailed information about a specific smart contract including deployment info, verification status, and metadata",
+                "description": "Returns status of the latest verification task of the contract with timings, error and compiler output of the failed compilation",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "contract"
+                    "verification"
                 ],
-                "summary": "Get contract by address",
-                "operationId": "get-contract",
+                "summary": "Get the latest verification task of the contract",
+                "operationId": "get-contract-verification",
                 "parameters": [
                     {
                         "maxLength": 42,
@@ -644,16 +1290,16 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Contract information",
+                        "description": "Verification task",
                         "schema": {
-                            "$ref": "#/definitions/responses.Contract"
+                            "$ref": "#/definitions/responses.VerificationTask"
                         }
                     },
                     "204": {
-                        "description": "Contract not found"
+                        "description": "Contract or task not found"
                     },
                     "400": {
-                        "description": "Invalid contract address format",
+                        "description": "Invalid contract address",
                         "schema": {
                             "$ref": "#/definitions/handler.Error"
                         }
@@ -667,40 +1313,71 @@ const docTemplate = `{
                 }
             }
         },
-        "/contracts/{hash}/code": {
+        "/delegations": {
             "get": {
-                "description": "Returns the deployed bytecode of a specific smart contract.",
+                "description": "Returns a paginated list of externally owned accounts with an active EIP-7702 delegation. Can be filtered by delegate contract address.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "contract"
+                    "delegations"
                 ],
-                "summary": "Get contract bytecode",
-                "operationId": "get-contract-code",
+                "summary": "List EIP-7702 delegations",
+                "operationId": "list-delegations",
                 "parameters": [
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Number of delegations to return (default: 10)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Number of delegations to skip (default: 0)",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "default": "desc",
+                        "description": "Sort order by authority id (default: desc)",
+                        "name": "sort",
+                        "in": "query"
+                    },
                     {
                         "maxLength": 42,
                         "minLength": 42,
                         "type": "string",
-                        "description": "Contract address in hexadecimal format (e.g., 0x742d35Cc6634C0532925a3b844Bc9e7595f0bEb)",
-                        "name": "hash",
-                        "in": "path",
-                        "required": true
+                        "example": "0x742d35Cc6634C0532925a3b844Bc9e7595f0bEb",
+                        "description": "Filter by delegate contract address",
+                        "name": "delegate",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Opaque cursor for keyset pagination. Base64url-encoded value from the previous response's 'cursor' field. Encodes the id of the last returned record. Cannot be used together with offset (returns 400).",
+                        "name": "cursor",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Contract bytecode",
+                        "description": "List of delegations",
                         "schema": {
-                            "$ref": "#/definitions/responses.ContractCode"
+                            "$ref": "#/definitions/handler.CursorResponse"
                         }
                     },
-                    "204": {
-                        "description": "Contract not found or not verified"
-                    },
                     "400": {
-                        "description": "Invalid contract address format",
+                        "description": "Invalid request parameters",
                         "schema": {
                             "$ref": "#/definitions/handler.Error"
                         }
@@ -714,23 +1391,23 @@ const docTemplate = `{
                 }
             }
         },
-        "/contracts/{hash}/sources": {
+        "/delegations/{hash}/history": {
             "get": {
-                "description": "Returns the verified source code files for a specific smart contract. Only available for verified contracts.",
+                "description": "Returns a paginated list of EIP-7702 authorizations signed by the address, including invalid ones",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "contract"
+                    "delegations"
                 ],
-                "summary": "Get contract source code",
-                "operationId": "get-contract-sources",
+                "summary": "Get EIP-7702 delegation history of the address",
+                "operationId": "get-delegation-history",
                 "parameters": [
                     {
                         "maxLength": 42,
                         "minLength": 42,
                         "type": "string",
-                        "description": "Contract address in hexadecimal format (e.g., 0x742d35Cc6634C0532925a3b844Bc9e7595f0bEb)",
+                        "description": "Address hash in hexadecimal format (e.g., 0x742d35Cc6634C0532925a3b844Bc9e7595f0bEb)",
                         "name": "hash",
                         "in": "path",
                         "required": true
@@ -740,7 +1417,7 @@ const docTemplate = `{
                         "minimum": 1,
                         "type": "integer",
                         "default": 10,
-                        "description": "Number of source files to return (default: 10)",
+                        "description": "Number of authorizations to return (default: 10)",
                         "name": "limit",
                         "in": "query"
                     },
@@ -748,7 +1425,7 @@ const docTemplate = `{
                         "minimum": 0,
                         "type": "integer",
                         "default": 0,
-                        "description": "Number of source files to skip (default: 0)",
+                        "description": "Number of authorizations to skip (default: 0)",
                         "name": "offset",
                         "in": "query"
                     },
@@ -758,8 +1435,8 @@ const docTemplate = `{
                             "desc"
                         ],
                         "type": "string",
-                        "default": "asc",
-                        "description": "Sort order (default: asc)",
+                        "default": "desc",
+                        "description": "Sort order (default: desc)",
                         "name": "sort",
                         "in": "query"
                     },
@@ -772,16 +1449,16 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "List of source code files",
+                        "description": "List of authorizations",
                         "schema": {
                             "$ref": "#/definitions/handler.CursorResponse"
                         }
                     },
                     "204": {
-                        "description": "Contract not found or not verified"
+                        "description": "Address not found"
                     },
                     "400": {
-                        "description": "Invalid contract address format",
+                        "description": "Invalid request parameters",
                         "schema": {
                             "$ref": "#/definitions/handler.Error"
                         }
@@ -854,7 +1531,7 @@ const docTemplate = `{
         },
         "/logs": {
             "get": {
-                "description": "Returns a paginated list of event logs emitted by smart contracts. Can be filtered by transaction, address, block height, or time range.",
+                "description": "Returns a paginated list of event logs emitted by smart contracts. Can be filtered by transaction, address, block height, topics, or time range.\nEach topic filter accepts a comma-separated list of 32-byte hex values matched with OR semantics at its position, like eth_getLogs.\nLogs decoded by the indexer can be filtered by event name and argument values. Addresses are compared in checksum form, other hex values in lower case.",
                 "produces": [
                     "application/json"
                 ],
@@ -907,6 +1584,31 @@ const docTemplate = `{
                         "name": "height",
                         "in": "query"
                     },
+                    {
+                        "type": "string",
+                        "example": "0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef",
+                        "description": "Filter by first topic (event signature), comma-separated list",
+                        "name": "topic0",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by second topic, comma-separated list",
+                        "name": "topic1",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by third topic, comma-separated list",
+                        "name": "topic2",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by fourth topic, comma-separated list",
+                        "name": "topic3",
+                        "in": "query"
+                    },
                     {
                         "minimum": 1,
                         "type": "integer",
@@ -934,6 +1636,20 @@ const docTemplate = `{
                         "name": "sort",
                         "in": "query"
                     },
+                    {
+                        "type": "string",
+                        "example": "Transfer",
+                        "description": "Filter by decoded event name. Logs are decoded by the indexer if the log decoder is enabled",
+                        "name": "event_name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "from:0x742d35Cc6634C0532925a3b844Bc9e7595f0bEb",
+                        "description": "Filter by decoded event arguments, comma-separated list of name:value pairs matched with AND semantics",
+                        "name": "arg",
+                        "in": "query"
+                    },
                     {
                         "type": "boolean",
                         "default": false,
@@ -941,6 +1657,17 @@ const docTemplate = `{
                         "name": "decode",
                         "in": "query"
                     },
+                    {
+                        "enum": [
+                            "latest",
+                            "safe",
+                            "finalized"
+                        ],
+                        "type": "string",
+                        "description": "Return only data of safe or finalized blocks, safe includes finalized ones (default: latest)",
+                        "name": "finality",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
                         "description": "Opaque cursor for keyset pagination. Base64url-encoded value from the previous response's 'cursor' field. Encodes (timestamp, id) of the last returned record. Cannot be used together with offset (returns 400).",
@@ -966,6 +1693,12 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/handler.Error"
                         }
+                    },
+                    "503": {
+                        "description": "Requested finality is not tracked by the indexer",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
                     }
                 }
             }
@@ -1044,7 +1777,8 @@ const docTemplate = `{
                             "EIP7702",
                             "EIP1967",
                             "custom",
-                            "clone_with_immutable_args"
+                            "clone_with_immutable_args",
+                            "diamond"
                         ],
                         "type": "string",
                         "description": "Filter by proxy pattern (comma-separated list)",
@@ -1063,21 +1797,84 @@ const docTemplate = `{
                         "in": "query"
                     },
                     {
-                        "type": "string",
-                        "description": "Opaque cursor for keyset pagination. Base64url-encoded value from the previous response's 'cursor' field. Encodes the id of the last returned record. Cannot be used together with offset (returns 400). Only supported when sort_by=id (default); returns 400 for other sort_by values.",
-                        "name": "cursor",
+                        "type": "string",
+                        "description": "Opaque cursor for keyset pagination. Base64url-encoded value from the previous response's 'cursor' field. Encodes the id of the last returned record. Cannot be used together with offset (returns 400). Only supported when sort_by=id (default); returns 400 for other sort_by values.",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of proxy contracts",
+                        "schema": {
+                            "$ref": "#/definitions/handler.CursorResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request parameters",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/search": {
+            "get": {
+                "description": "Performs a universal search across the blockchain. Supports searching by: block height (numeric), transaction hash (0x prefixed hex), address (0x prefixed hex), or token name/symbol (text). Returns matching blocks, transactions, addresses, and tokens.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "search"
+                ],
+                "summary": "Universal search",
+                "operationId": "search",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "0x742d35Cc6634C0532925a3b844Bc9e7595f0bEb",
+                        "description": "Search query: block height (e.g., 12345), transaction/block hash (e.g., 0x1234...), address (e.g., 0x742d...), or token name/symbol (e.g., USDC)",
+                        "name": "query",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "example": 10,
+                        "description": "Maximum number of results to return (default: 10, max: 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "example": 0,
+                        "description": "Number of results to skip for pagination (default: 0)",
+                        "name": "offset",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "List of proxy contracts",
+                        "description": "Search results (can include blocks, transactions, addresses, tokens)",
                         "schema": {
-                            "$ref": "#/definitions/handler.CursorResponse"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/responses.SearchItem"
+                            }
                         }
                     },
+                    "204": {
+                        "description": "No results found"
+                    },
                     "400": {
-                        "description": "Invalid request parameters",
+                        "description": "Invalid search query",
                         "schema": {
                             "$ref": "#/definitions/handler.Error"
                         }
@@ -1091,59 +1888,86 @@ const docTemplate = `{
                 }
             }
         },
-        "/search": {
+        "/signatures": {
             "get": {
-                "description": "Performs a universal search across the blockchain. Supports searching by: block height (numeric), transaction hash (0x prefixed hex), address (0x prefixed hex), or token name/symbol (text). Returns matching blocks, transactions, addresses, and tokens.",
+                "description": "Returns text signatures known for the 4-byte function selector or the 32-byte event topic. Several signatures mean a selector collision. The registry is filled from ABIs of verified contracts, known interfaces and the imported signatures dump.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "search"
+                    "signatures"
                 ],
-                "summary": "Universal search",
-                "operationId": "search",
+                "summary": "Lookup signatures",
+                "operationId": "list-signatures",
                 "parameters": [
                     {
                         "type": "string",
-                        "example": "0x742d35Cc6634C0532925a3b844Bc9e7595f0bEb",
-                        "description": "Search query: block height (e.g., 12345), transaction/block hash (e.g., 0x1234...), address (e.g., 0x742d...), or token name/symbol (e.g., USDC)",
-                        "name": "query",
+                        "example": "0xa9059cbb",
+                        "description": "Function selector (4 bytes) or event topic (32 bytes)",
+                        "name": "selector",
                         "in": "query",
                         "required": true
                     },
                     {
+                        "maximum": 100,
+                        "minimum": 1,
                         "type": "integer",
-                        "example": 10,
-                        "description": "Maximum number of results to return (default: 10, max: 100)",
+                        "default": 10,
+                        "description": "Number of signatures to return (default: 10)",
                         "name": "limit",
                         "in": "query"
                     },
                     {
+                        "minimum": 0,
                         "type": "integer",
-                        "example": 0,
-                        "description": "Number of results to skip for pagination (default: 0)",
+                        "default": 0,
+                        "description": "Number of signatures to skip (default: 0)",
                         "name": "offset",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Search results (can include blocks, transactions, addresses, tokens)",
+                        "description": "List of signatures",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/responses.SearchItem"
+                                "$ref": "#/definitions/responses.Signature"
                             }
                         }
                     },
-                    "204": {
-                        "description": "No results found"
-                    },
                     "400": {
-                        "description": "Invalid search query",
+                        "description": "Invalid request parameters",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/handler.Error"
                         }
+                    }
+                }
+            }
+        },
+        "/stats/blob_fee": {
+            "get": {
+                "description": "Returns EIP-4844 blob statistics for the last 24 hours: count of blobs, used blob gas, burned blob fee and average blob gas price.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "stats"
+                ],
+                "summary": "Get blob fee statistics",
+                "operationId": "blob-fee-stats",
+                "responses": {
+                    "200": {
+                        "description": "Blob fee statistics",
+                        "schema": {
+                            "$ref": "#/definitions/responses.BlobStats"
+                        }
                     },
                     "500": {
                         "description": "Internal server error",
@@ -1245,6 +2069,17 @@ const docTemplate = `{
                         "description": "Filter by token ID",
                         "name": "token_id",
                         "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "latest",
+                            "safe",
+                            "finalized"
+                        ],
+                        "type": "string",
+                        "description": "Return only data of safe or finalized blocks, safe includes finalized ones (default: latest)",
+                        "name": "finality",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -1265,6 +2100,134 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/handler.Error"
                         }
+                    },
+                    "503": {
+                        "description": "Requested finality is not tracked by the indexer",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/token_balances/history": {
+            "get": {
+                "description": "Returns the history of token balance changes. Every item is a snapshot of the holder balance after the block which changed it.\nIf ` + "`" + `height` + "`" + ` is set the endpoint returns the single snapshot valid at that height (balance at height N) instead of the list. In this case ` + "`" + `address` + "`" + ` and ` + "`" + `contract` + "`" + ` are required and ` + "`" + `token_id` + "`" + ` defaults to 0.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "token"
+                ],
+                "summary": "Token balance history",
+                "operationId": "token-balance-history",
+                "parameters": [
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Number of snapshots to return (default: 10)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Number of snapshots to skip (default: 0)",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "default": "desc",
+                        "description": "Sort order by timestamp (default: desc)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "maxLength": 42,
+                        "minLength": 42,
+                        "type": "string",
+                        "example": "0x742d35Cc6634C0532925a3b844Bc9e7595f0bEb",
+                        "description": "Filter by holder address",
+                        "name": "address",
+                        "in": "query"
+                    },
+                    {
+                        "maxLength": 42,
+                        "minLength": 42,
+                        "type": "string",
+                        "example": "0x742d35Cc6634C0532925a3b844Bc9e7595f0bEb",
+                        "description": "Filter by token contract address",
+                        "name": "contract",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "0",
+                        "description": "Filter by token ID",
+                        "name": "token_id",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "integer",
+                        "example": 12345,
+                        "description": "Return token balance at the block height",
+                        "name": "height",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "latest",
+                            "safe",
+                            "finalized"
+                        ],
+                        "type": "string",
+                        "description": "Return only data of safe or finalized blocks, safe includes finalized ones (default: latest)",
+                        "name": "finality",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Opaque cursor for keyset pagination. Base64url-encoded value from the previous response's 'cursor' field. Encodes (timestamp, id) of the last returned record. Cannot be used together with offset (returns 400).",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of token balance snapshots or responses.TokenBalanceHistory if height is set",
+                        "schema": {
+                            "$ref": "#/definitions/handler.CursorResponse"
+                        }
+                    },
+                    "204": {
+                        "description": "Address or contract not found"
+                    },
+                    "400": {
+                        "description": "Invalid request parameters",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    },
+                    "503": {
+                        "description": "Requested finality is not tracked by the indexer",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
                     }
                 }
             }
@@ -1531,6 +2494,17 @@ const docTemplate = `{
                         "name": "decode",
                         "in": "query"
                     },
+                    {
+                        "enum": [
+                            "latest",
+                            "safe",
+                            "finalized"
+                        ],
+                        "type": "string",
+                        "description": "Return only data of safe or finalized blocks, safe includes finalized ones (default: latest)",
+                        "name": "finality",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
                         "description": "Opaque cursor for keyset pagination. Base64url-encoded value from the previous response's 'cursor' field. Encodes (timestamp, id) of the last returned record. Cannot be used together with offset (returns 400).",
@@ -1556,6 +2530,12 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/handler.Error"
                         }
+                    },
+                    "503": {
+                        "description": "Requested finality is not tracked by the indexer",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
                     }
                 }
             }
@@ -1679,6 +2659,17 @@ const docTemplate = `{
                         "name": "token_id",
                         "in": "query"
                     },
+                    {
+                        "enum": [
+                            "latest",
+                            "safe",
+                            "finalized"
+                        ],
+                        "type": "string",
+                        "description": "Return only data of safe or finalized blocks, safe includes finalized ones (default: latest)",
+                        "name": "finality",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
                         "description": "Opaque cursor for keyset pagination. Base64url-encoded value from the previous response's 'cursor' field. Encodes (timestamp, id) of the last returned record. Cannot be used together with offset (returns 400).",
@@ -1704,6 +2695,12 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/handler.Error"
                         }
+                    },
+                    "503": {
+                        "description": "Requested finality is not tracked by the indexer",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
                     }
                 }
             }
@@ -1823,6 +2820,7 @@ const docTemplate = `{
                         "enum": [
                             "TxTypeUnknown",
                             "TxTypeLegacy",
+                            "TxTypeAccessList",
                             "TxTypeDynamicFee",
                             "TxTypeBlob",
                             "TxTypeSetCode"
@@ -1876,6 +2874,17 @@ const docTemplate = `{
                         "name": "decode",
                         "in": "query"
                     },
+                    {
+                        "enum": [
+                            "latest",
+                            "safe",
+                            "finalized"
+                        ],
+                        "type": "string",
+                        "description": "Return only data of safe or finalized blocks, safe includes finalized ones (default: latest)",
+                        "name": "finality",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
                         "description": "Opaque cursor for keyset pagination. Base64url-encoded value from the previous response's 'cursor' field. Encodes (timestamp, id) of the last returned record. Cannot be used together with offset (returns 400).",
@@ -1901,6 +2910,12 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/handler.Error"
                         }
+                    },
+                    "503": {
+                        "description": "Requested finality is not tracked by the indexer",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
                     }
                 }
             }
@@ -2184,7 +3199,7 @@ const docTemplate = `{
         },
         "/verification/code": {
             "post": {
-                "description": "Creates a task to verify the specified contract with source code files. Multiple .sol files can be uploaded.",
+                "description": "Creates a task to verify the specified contract with source code files. Multiple .sol files can be uploaded.\nAlternatively a single solc standard JSON input produced by Hardhat or Foundry can be uploaded. Compiler settings are taken from the input then.",
                 "consumes": [
                     "multipart/form-data"
                 ],
@@ -2206,17 +3221,32 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Contract name in Solidity source",
+                        "description": "Contract name in Solidity source or Vyper file name without extension. Fully-qualified name (path:Contract) for standard JSON input.",
                         "name": "contract_name",
                         "in": "formData",
                         "required": true
                     },
                     {
                         "type": "file",
-                        "description": "Source code files (.sol). Multiple files allowed.",
+                        "description": "Source code files (.sol for Solidity, .vy and .vyi for Vyper). Multiple files allowed.",
                         "name": "source_code",
-                        "in": "formData",
-                        "required": true
+                        "in": "formData"
+                    },
+                    {
+                        "type": "file",
+                        "description": "Solc or Vyper standard JSON input. Can't be used together with source code files and compiler settings.",
+                        "name": "standard_json",
+                        "in": "formData"
+                    },
+                    {
+                        "enum": [
+                            "solidity",
+                            "vyper"
+                        ],
+                        "type": "string",
+                        "description": "Source code language. Solidity by default.",
+                        "name": "language",
+                        "in": "formData"
                     },
                     {
                         "type": "string",
@@ -2282,28 +3312,116 @@ const docTemplate = `{
                         "name": "evm_version",
                         "in": "formData"
                     },
-                    {
-                        "type": "boolean",
-                        "description": "Compile via Yul IR pipeline",
-                        "name": "via_ir",
-                        "in": "formData"
+                    {
+                        "type": "boolean",
+                        "description": "Compile via Yul IR pipeline",
+                        "name": "via_ir",
+                        "in": "formData"
+                    },
+                    {
+                        "enum": [
+                            "gas",
+                            "codesize",
+                            "none"
+                        ],
+                        "type": "string",
+                        "description": "Vyper optimization mode",
+                        "name": "optimization_mode",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "JSON object of Solidity library addresses by short or fully-qualified library names. Addresses of missing libraries are detected from the onchain bytecode.",
+                        "name": "libraries",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.verificationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
                     }
+                }
+            }
+        },
+        "/verification/compilers": {
+            "get": {
+                "description": "Returns solc versions installed to the verifier and vyper versions pinned by the verifier from the newest to the oldest. Solc versions are omitted if they are downloaded on demand.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "verification"
                 ],
+                "summary": "List of installed compilers",
+                "operationId": "list-verification-compilers",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.verificationResponse"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/responses.Compiler"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/verification/tasks/{id}": {
+            "get": {
+                "description": "Returns status of the verification task with timings, error and compiler output of the failed compilation",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "verification"
+                ],
+                "summary": "Get verification task by ID",
+                "operationId": "get-verification-task",
+                "parameters": [
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "example": 1,
+                        "description": "Verification task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Verification task",
+                        "schema": {
+                            "$ref": "#/definitions/responses.VerificationTask"
                         }
                     },
+                    "204": {
+                        "description": "Task not found"
+                    },
                     "400": {
-                        "description": "Bad Request",
+                        "description": "Invalid task ID",
                         "schema": {
                             "$ref": "#/definitions/handler.Error"
                         }
                     },
                     "500": {
-                        "description": "Internal Server Error",
+                        "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/handler.Error"
                         }
@@ -2351,6 +3469,28 @@ const docTemplate = `{
             "properties": {
                 "result": {
                     "type": "string"
+                },
+                "task_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "responses.AccessListItem": {
+            "description": "EIP-2930 access list entry",
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string",
+                    "example": "0x0000000000000000000000000000000000000001"
+                },
+                "storage_keys": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "0x0000000000000000000000000000000000000000000000000000000000000001"
+                    ]
                 }
             }
         },
@@ -2361,6 +3501,9 @@ const docTemplate = `{
                 "balance": {
                     "$ref": "#/definitions/responses.Balance"
                 },
+                "delegation": {
+                    "$ref": "#/definitions/responses.Delegation"
+                },
                 "deployed_contracts": {
                     "type": "integer",
                     "example": 7
@@ -2395,6 +3538,36 @@ const docTemplate = `{
                 }
             }
         },
+        "responses.Authorization": {
+            "description": "EIP-7702 authorization tuple",
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string",
+                    "example": "0x0000000000000000000000000000000000000001"
+                },
+                "chain_id": {
+                    "type": "string",
+                    "example": "1"
+                },
+                "nonce": {
+                    "type": "string",
+                    "example": "1"
+                },
+                "r": {
+                    "type": "string",
+                    "example": "0x0000000000000000000000000000000000000000000000000000000000000001"
+                },
+                "s": {
+                    "type": "string",
+                    "example": "0x0000000000000000000000000000000000000000000000000000000000000001"
+                },
+                "y_parity": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
         "responses.Balance": {
             "description": "Balance of address information",
             "type": "object",
@@ -2405,18 +3578,61 @@ const docTemplate = `{
                 }
             }
         },
+        "responses.BlobStats": {
+            "description": "Aggregated EIP-4844 blob statistics",
+            "type": "object",
+            "properties": {
+                "avg_blob_gas_price": {
+                    "type": "string",
+                    "example": "1"
+                },
+                "blob_fee": {
+                    "type": "string",
+                    "example": "786432"
+                },
+                "blob_gas_used": {
+                    "type": "string",
+                    "example": "786432"
+                },
+                "blobs_count": {
+                    "type": "integer",
+                    "example": 6
+                }
+            }
+        },
         "responses.Block": {
             "description": "Noble block information",
             "type": "object",
             "properties": {
+                "blob_gas_price": {
+                    "type": "string",
+                    "example": "1"
+                },
+                "blob_gas_used": {
+                    "type": "string",
+                    "example": "262144"
+                },
                 "difficulty": {
                     "type": "string",
                     "example": "0x0"
                 },
+                "excess_blob_gas": {
+                    "type": "string",
+                    "example": "0"
+                },
                 "extra_data": {
                     "type": "string",
                     "example": "0x726574682f76312e372e302f6c696e7578"
                 },
+                "finality": {
+                    "type": "string",
+                    "enum": [
+                        "latest",
+                        "safe",
+                        "finalized"
+                    ],
+                    "example": "finalized"
+                },
                 "gas_limit": {
                     "type": "integer",
                     "example": 1000000
@@ -2486,6 +3702,18 @@ const docTemplate = `{
             "description": "Block statistics information",
             "type": "object",
             "properties": {
+                "blob_fee": {
+                    "type": "string",
+                    "example": "786432"
+                },
+                "blob_gas_used": {
+                    "type": "string",
+                    "example": "786432"
+                },
+                "blobs_count": {
+                    "type": "integer",
+                    "example": 6
+                },
                 "block_time": {
                     "type": "integer",
                     "example": 1000
@@ -2504,6 +3732,24 @@ const docTemplate = `{
                 }
             }
         },
+        "responses.Compiler": {
+            "description": "Compiler installed to the verifier",
+            "type": "object",
+            "properties": {
+                "language": {
+                    "type": "string",
+                    "enum": [
+                        "solidity",
+                        "vyper"
+                    ],
+                    "example": "solidity"
+                },
+                "version": {
+                    "type": "string",
+                    "example": "v0.8.20+commit.a1b79de6"
+                }
+            }
+        },
         "responses.Contract": {
             "description": "Noble contract information",
             "type": "object",
@@ -2516,6 +3762,14 @@ const docTemplate = `{
                     "type": "string",
                     "example": "0.1.1"
                 },
+                "constructor_args": {
+                    "type": "string",
+                    "example": "0x00000000000000000000000000000000000000000000000000000000000003e8"
+                },
+                "decoded_constructor_args": {
+                    "type": "object",
+                    "additionalProperties": {}
+                },
                 "deployer": {
                     "type": "string",
                     "example": "0x0000000000000000000000000000000000000003"
@@ -2536,6 +3790,15 @@ const docTemplate = `{
                     "type": "string",
                     "example": "Solidity"
                 },
+                "match_type": {
+                    "type": "string",
+                    "enum": [
+                        "full",
+                        "partial",
+                        "similar"
+                    ],
+                    "example": "full"
+                },
                 "metadata_link": {
                     "type": "string",
                     "example": "https://ipfs.io/ipfs/QmWYtNwHxXxzhrWj7TUcB5tC3m1bSGFXAtEqwegMbk1sjt"
@@ -2576,6 +3839,32 @@ const docTemplate = `{
                 }
             }
         },
+        "responses.Delegation": {
+            "description": "Current EIP-7702 delegation of the externally owned account",
+            "type": "object",
+            "properties": {
+                "authority": {
+                    "type": "string",
+                    "example": "0x0000000000000000000000000000000000000000"
+                },
+                "delegate": {
+                    "type": "string",
+                    "example": "0x0000000000000000000000000000000000000001"
+                },
+                "height": {
+                    "type": "integer",
+                    "example": 100
+                },
+                "last_height": {
+                    "type": "integer",
+                    "example": 120
+                },
+                "tx_hash": {
+                    "type": "string",
+                    "example": "0x0f5c9c35b1c1a4e8b1e96e1a0b5e51b8a4d09a0c1ac35ab2a18b0d7bd36d9c3c"
+                }
+            }
+        },
         "responses.Enums": {
             "description": "Available enum values for various entity types",
             "type": "object",
@@ -2601,6 +3890,17 @@ const docTemplate = `{
                         "prague"
                     ]
                 },
+                "finality": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "latest",
+                        "safe",
+                        "finalized"
+                    ]
+                },
                 "license_type": {
                     "type": "array",
                     "items": {
@@ -2730,10 +4030,33 @@ const docTemplate = `{
                 }
             }
         },
+        "responses.Signature": {
+            "description": "Text signature of the function selector or the event topic",
+            "type": "object",
+            "properties": {
+                "selector": {
+                    "type": "string",
+                    "example": "0xa9059cbb"
+                },
+                "signature": {
+                    "type": "string",
+                    "example": "transfer(address,uint256)"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "function"
+                }
+            }
+        },
         "responses.State": {
             "description": "Blockchain indexer state information",
             "type": "object",
             "properties": {
+                "finalized_height": {
+                    "type": "integer",
+                    "format": "int64",
+                    "example": 68
+                },
                 "hash": {
                     "type": "string",
                     "format": "string",
@@ -2759,6 +4082,11 @@ const docTemplate = `{
                     "format": "string",
                     "example": "indexer"
                 },
+                "safe_height": {
+                    "type": "integer",
+                    "format": "int64",
+                    "example": 90
+                },
                 "synced": {
                     "type": "boolean",
                     "format": "boolean",
@@ -2950,10 +4278,43 @@ const docTemplate = `{
             "description": "Noble transaction information",
             "type": "object",
             "properties": {
+                "access_list": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/responses.AccessListItem"
+                    }
+                },
                 "amount": {
                     "type": "integer",
                     "example": 1000000000000000000
                 },
+                "authorization_list": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/responses.Authorization"
+                    }
+                },
+                "blob_fee": {
+                    "type": "string",
+                    "example": "131072"
+                },
+                "blob_gas_price": {
+                    "type": "string",
+                    "example": "1"
+                },
+                "blob_gas_used": {
+                    "type": "string",
+                    "example": "131072"
+                },
+                "blob_versioned_hashes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "0x01a5b5d5c9c1bcbd1f3e4d2cbb1a3f21b2bd4f21c77c8c5e1f4cbbd5b5d6e7f8"
+                    ]
+                },
                 "cumulative_gas_used": {
                     "type": "integer",
                     "example": 21000
@@ -3009,6 +4370,18 @@ const docTemplate = `{
                     "type": "integer",
                     "example": 1234
                 },
+                "max_fee_per_blob_gas": {
+                    "type": "string",
+                    "example": "1000000"
+                },
+                "max_fee_per_gas": {
+                    "type": "string",
+                    "example": "2000000000"
+                },
+                "max_priority_fee_per_gas": {
+                    "type": "string",
+                    "example": "1000000"
+                },
                 "nonce": {
                     "type": "integer",
                     "example": 1
@@ -3038,6 +4411,7 @@ const docTemplate = `{
                     "enum": [
                         "TxTypeUnknown",
                         "TxTypeLegacy",
+                        "TxTypeAccessList",
                         "TxTypeDynamicFee",
                         "TxTypeBlob",
                         "TxTypeSetCode"
@@ -3170,6 +4544,66 @@ const docTemplate = `{
                     "example": "0x0764012270afacd3b101bcfadaaa9fc3190d04ed90ff22c0ee59781e54858a7d"
                 }
             }
+        },
+        "responses.VerificationTask": {
+            "description": "Contract verification task with its status and compiler output",
+            "type": "object",
+            "properties": {
+                "compiler_output": {
+                    "type": "string",
+                    "example": "Error: Expected ';' but got '}'"
+                },
+                "compiler_version": {
+                    "type": "string",
+                    "example": "v0.8.20+commit.a1b79de6"
+                },
+                "completion_time": {
+                    "type": "string",
+                    "example": "2023-07-04T03:11:02+00:00"
+                },
+                "contract": {
+                    "type": "string",
+                    "example": "0x742d35Cc6634C0532925a3b844Bc9e7595f0bEb0"
+                },
+                "contract_name": {
+                    "type": "string",
+                    "example": "Token"
+                },
+                "creation_time": {
+                    "type": "string",
+                    "example": "2023-07-04T03:10:57+00:00"
+                },
+                "error": {
+                    "type": "string",
+                    "example": "compile contract: compilation failed"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "language": {
+                    "type": "string",
+                    "enum": [
+                        "solidity",
+                        "vyper"
+                    ],
+                    "example": "solidity"
+                },
+                "start_time": {
+                    "type": "string",
+                    "example": "2023-07-04T03:10:58+00:00"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "VerificationStatusNew",
+                        "VerificationStatusPending",
+                        "VerificationStatusFailed",
+                        "VerificationStatusSuccess"
+                    ],
+                    "example": "VerificationStatusSuccess"
+                }
+            }
         }
     }
 }`
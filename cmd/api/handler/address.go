@@ -1,24 +1,43 @@
 package handler
 
 import (
+	"errors"
 	"net/http"
+	"time"
 
 	"github.com/NobleScope/noble-indexer/cmd/api/handler/responses"
 	"github.com/NobleScope/noble-indexer/cmd/api/helpers"
 	"github.com/NobleScope/noble-indexer/internal/storage"
+	internalTypes "github.com/NobleScope/noble-indexer/internal/storage/types"
 	"github.com/NobleScope/noble-indexer/pkg/types"
 	"github.com/labstack/echo/v4"
+	"github.com/shopspring/decimal"
 )
 
 type AddressHandler struct {
-	address storage.IAddress
+	address        storage.IAddress
+	balanceHistory storage.IBalanceHistory
+	allowance      storage.IAllowance
+	delegation     storage.IDelegation
+	state          storage.IState
+	indexerName    string
 }
 
 func NewAddressHandler(
 	address storage.IAddress,
+	balanceHistory storage.IBalanceHistory,
+	allowance storage.IAllowance,
+	delegation storage.IDelegation,
+	state storage.IState,
+	indexerName string,
 ) *AddressHandler {
 	return &AddressHandler{
-		address: address,
+		address:        address,
+		balanceHistory: balanceHistory,
+		allowance:      allowance,
+		delegation:     delegation,
+		state:          state,
+		indexerName:    indexerName,
 	}
 }
 
@@ -112,7 +131,7 @@ type getAddressRequest struct {
 // Get godoc
 //
 //	@Summary		Get address by hash
-//	@Description	Returns detailed information about a specific address including its balance, contract status, and activity history
+//	@Description	Returns detailed information about a specific address including its balance, contract status, activity history and active EIP-7702 delegation
 //	@Tags			address
 //	@ID				get-address
 //	@Param			hash	path	string	true	"Address hash in hexadecimal format (e.g., 0x742d35Cc6634C0532925a3b844Bc9e7595f0bEb)"	minlength(42)	maxlength(42)
@@ -138,5 +157,356 @@ func (handler *AddressHandler) Get(c echo.Context) error {
 		return handleError(c, err, handler.address)
 	}
 
-	return c.JSON(http.StatusOK, responses.NewAddress(address))
+	response := responses.NewAddress(address)
+	if !address.IsContract {
+		delegation, err := handler.delegation.ByAuthorityId(c.Request().Context(), address.Id)
+		switch {
+		case err == nil:
+			if delegation.DelegateId != nil {
+				delegation.Authority = address
+				d := responses.NewDelegation(delegation)
+				response.Delegation = &d
+			}
+		case !handler.delegation.IsNoRows(err):
+			return handleError(c, err, handler.delegation)
+		}
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// maxBalanceSeriesBuckets - max count of time buckets returned by the balance series
+const maxBalanceSeriesBuckets = 1000
+
+var (
+	errHeightWithTime      = errors.New("height and time cannot be used together")
+	errPointWithTimeframe  = errors.New("timeframe cannot be used together with height or time")
+	errTimeframeWithCursor = errors.New("timeframe cannot be used together with cursor")
+)
+
+type balanceHistoryRequest struct {
+	Hash      string            `param:"hash"      validate:"required,address"`
+	Height    *types.Level      `query:"height"    validate:"omitempty,min=0"`
+	Time      int64             `query:"time"      validate:"omitempty,min=1"`
+	Timeframe storage.Timeframe `query:"timeframe" validate:"omitempty,oneof=hour day week month"`
+	Limit     int               `query:"limit"     validate:"omitempty,min=1,max=100"`
+	Offset    int               `query:"offset"    validate:"omitempty,min=0"`
+	Sort      string            `query:"sort"      validate:"omitempty,oneof=asc desc"`
+	Finality  string            `query:"finality"  validate:"omitempty,finality"`
+	Cursor    string            `query:"cursor"    validate:"omitempty"`
+
+	From int64 `example:"1692892095" query:"time_from" swaggertype:"integer" validate:"omitempty,min=1"`
+	To   int64 `example:"1692892095" query:"time_to"   swaggertype:"integer" validate:"omitempty,min=1"`
+}
+
+func (p *balanceHistoryRequest) SetDefault() {
+	if p.Limit == 0 {
+		p.Limit = 10
+	}
+	if p.Sort == "" {
+		p.Sort = desc
+	}
+}
+
+// BalanceHistory godoc
+//
+//	@Summary		Get address balance history
+//	@Description	Returns historical native balance of the address. The result depends on the query parameters:
+//	@Description	- `height` or `time`: balance at the given block height or timestamp (a single object). Zero balance is returned if the address had no balance changes before that point. The current balance is returned if no balance changes are recorded for the address.
+//	@Description	- `timeframe`: balance at the end of each time bucket in [time_from, time_to). Buckets without balance changes are omitted. At most 1000 buckets are returned starting from time_from.
+//	@Description	- otherwise: paginated list of balance snapshots taken after each block changing the balance.
+//	@Tags			address
+//	@ID				get-address-balance-history
+//	@Param			hash		path	string	true	"Address hash in hexadecimal format (e.g., 0x742d35Cc6634C0532925a3b844Bc9e7595f0bEb)"	minlength(42)	maxlength(42)
+//	@Param			height		query	integer	false	"Block height for the point lookup"														minimum(0)	example(12345)
+//	@Param			time		query	integer	false	"Unix timestamp for the point lookup"													minimum(1)	example(1692892095)
+//	@Param			timeframe	query	string	false	"Time bucket size of the series"														Enums(hour, day, week, month)
+//	@Param			time_from	query	integer	false	"Filter by timestamp from (Unix timestamp)"												minimum(1)	example(1692892095)
+//	@Param			time_to		query	integer	false	"Filter by timestamp to (Unix timestamp)"												minimum(1)	example(1692892095)
+//	@Param			limit		query	integer	false	"Number of snapshots to return (default: 10)"											minimum(1)	maximum(100)	default(10)
+//	@Param			offset		query	integer	false	"Number of snapshots to skip (default: 0)"												minimum(0)	default(0)
+//	@Param			sort		query	string	false	"Sort order by timestamp (default: desc)"												Enums(asc, desc)	default(desc)
+//	@Param			finality	query	string	false	"Return only data of safe or finalized blocks, safe includes finalized ones (default: latest)"	Enums(latest, safe, finalized)
+//	@Param			cursor		query	string	false	"Opaque cursor for keyset pagination. Base64url-encoded value from the previous response's 'cursor' field. Encodes (timestamp, id) of the last returned record. Cannot be used together with offset (returns 400)."
+//	@Produce		json
+//	@Success		200	{object}	CursorResponse				"List of balance snapshots, responses.BalanceHistory for the point lookup or an array of responses.BalanceSeriesItem for the series"
+//	@Success		204											"Address not found"
+//	@Failure		400	{object}	Error						"Invalid request parameters"
+//	@Failure		500	{object}	Error						"Internal server error"
+//	@Failure		503	{object}	Error						"Requested finality is not tracked by the indexer"
+//	@Router			/addresses/{hash}/balance_history [get]
+func (handler *AddressHandler) BalanceHistory(c echo.Context) error {
+	req, err := bindAndValidate[balanceHistoryRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	req.SetDefault()
+
+	isPoint := req.Height != nil || req.Time > 0
+	switch {
+	case req.Height != nil && req.Time > 0:
+		return badRequestError(c, errHeightWithTime)
+	case isPoint && req.Timeframe != "":
+		return badRequestError(c, errPointWithTimeframe)
+	case req.Timeframe != "" && req.Cursor != "":
+		return badRequestError(c, errTimeframeWithCursor)
+	}
+
+	hash, err := types.HexFromString(req.Hash)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	address, err := handler.address.ByHash(c.Request().Context(), hash)
+	if err != nil {
+		return handleError(c, err, handler.address)
+	}
+
+	maxHeight, err := finalityHeight(c.Request().Context(), handler.state, handler.indexerName, req.Finality)
+	if err != nil {
+		return handleError(c, err, handler.state)
+	}
+
+	switch {
+	case isPoint:
+		return handler.balanceAt(c, address, maxHeight, req)
+	case req.Timeframe != "":
+		return handler.balanceSeries(c, address.Id, maxHeight, req)
+	}
+
+	filter := storage.BalanceHistoryListFilter{
+		AddressId: address.Id,
+		Limit:     req.Limit,
+		Offset:    req.Offset,
+		Sort:      pgSort(req.Sort),
+		MaxHeight: maxHeight,
+	}
+	if req.From > 0 {
+		filter.TimeFrom = time.Unix(req.From, 0).UTC()
+	}
+	if req.To > 0 {
+		filter.TimeTo = time.Unix(req.To, 0).UTC()
+	}
+
+	if req.Cursor != "" {
+		if req.Offset > 0 {
+			return badRequestError(c, errCursorWithOffset)
+		}
+		cursorTime, cursorID, err := helpers.DecodeTimeIDCursor(req.Cursor)
+		if err != nil {
+			return badRequestError(c, err)
+		}
+		filter.CursorTime = cursorTime
+		filter.CursorID = cursorID
+	}
+
+	history, err := handler.balanceHistory.Filter(c.Request().Context(), filter)
+	if err != nil {
+		return handleError(c, err, handler.balanceHistory)
+	}
+
+	response := make([]responses.BalanceHistory, len(history))
+	for i := range history {
+		response[i] = responses.NewBalanceHistory(history[i])
+	}
+
+	var cursor string
+	if len(history) > 0 {
+		last := history[len(history)-1]
+		cursor = helpers.EncodeTimeIDCursor(last.Time, last.Id)
+	}
+
+	return returnCursorList(c, response, cursor)
+}
+
+func (handler *AddressHandler) balanceAt(c echo.Context, address storage.Address, maxHeight *uint64, req *balanceHistoryRequest) error {
+	var (
+		history storage.BalanceHistory
+		err     error
+	)
+	if req.Height != nil {
+		height := *req.Height
+		if maxHeight != nil {
+			height = min(height, types.Level(*maxHeight))
+		}
+		history, err = handler.balanceHistory.AtHeight(c.Request().Context(), address.Id, height)
+	} else {
+		history, err = handler.balanceHistory.AtTime(c.Request().Context(), address.Id, time.Unix(req.Time, 0).UTC())
+		// the snapshot of the time is above the requested finality, so the balance is the last one of the finality
+		if err == nil && maxHeight != nil && uint64(history.Height) > *maxHeight {
+			history, err = handler.balanceHistory.AtHeight(c.Request().Context(), address.Id, types.Level(*maxHeight))
+		}
+	}
+	if err != nil {
+		if !handler.balanceHistory.IsNoRows(err) {
+			return handleError(c, err, handler.balanceHistory)
+		}
+		history.AddressId = address.Id
+		history.Value = decimal.Zero
+		history.Delta = decimal.Zero
+
+		// balance changes of the address aren't recorded, so the balance hasn't changed since it was indexed
+		snapshots, err := handler.balanceHistory.Filter(c.Request().Context(), storage.BalanceHistoryListFilter{
+			AddressId: address.Id,
+			Limit:     1,
+		})
+		if err != nil {
+			return handleError(c, err, handler.balanceHistory)
+		}
+		if len(snapshots) == 0 && address.Balance != nil {
+			history.Value = address.Balance.Value
+		}
+	}
+
+	return c.JSON(http.StatusOK, responses.NewBalanceHistory(history))
+}
+
+func (handler *AddressHandler) balanceSeries(c echo.Context, addressId uint64, maxHeight *uint64, req *balanceHistoryRequest) error {
+	filter := storage.BalanceSeriesFilter{
+		AddressId: addressId,
+		Timeframe: req.Timeframe,
+		MaxHeight: maxHeight,
+		Limit:     maxBalanceSeriesBuckets,
+	}
+	if req.From > 0 {
+		filter.TimeFrom = time.Unix(req.From, 0).UTC()
+	}
+	if req.To > 0 {
+		filter.TimeTo = time.Unix(req.To, 0).UTC()
+	}
+
+	series, err := handler.balanceHistory.Series(c.Request().Context(), filter)
+	if err != nil {
+		return handleError(c, err, handler.balanceHistory)
+	}
+
+	response := make([]responses.BalanceSeriesItem, len(series))
+	for i := range series {
+		response[i] = responses.NewBalanceSeriesItem(series[i])
+	}
+	return c.JSON(http.StatusOK, response)
+}
+
+type approvalsRequest struct {
+	Hash           string      `param:"hash"            validate:"required,address"`
+	Limit          int         `query:"limit"           validate:"omitempty,min=1,max=100"`
+	Offset         int         `query:"offset"          validate:"omitempty,min=0"`
+	Sort           string      `query:"sort"            validate:"omitempty,oneof=asc desc"`
+	Cursor         string      `query:"cursor"          validate:"omitempty"`
+	Contract       string      `query:"contract"        validate:"omitempty,address"`
+	Spender        string      `query:"spender"         validate:"omitempty,address"`
+	Type           StringArray `query:"type"            validate:"omitempty,dive,approval_type"`
+	IncludeRevoked bool        `query:"include_revoked" validate:"omitempty"`
+}
+
+func (p *approvalsRequest) SetDefault() {
+	if p.Limit == 0 {
+		p.Limit = 10
+	}
+	if p.Sort == "" {
+		p.Sort = desc
+	}
+}
+
+// Approvals godoc
+//
+//	@Summary		Get address token approvals
+//	@Description	Returns token approvals given by the address: ERC-20 allowances, ERC-721 single token approvals and operator approvals (ApprovalForAll).
+//	@Description	By default only active approvals are returned. Revoked approvals are included if `include_revoked` is set.
+//	@Tags			address
+//	@ID				get-address-approvals
+//	@Param			hash			path	string	true	"Address hash in hexadecimal format (e.g., 0x742d35Cc6634C0532925a3b844Bc9e7595f0bEb)"	minlength(42)	maxlength(42)
+//	@Param			limit			query	integer	false	"Number of approvals to return (default: 10)"											minimum(1)	maximum(100)	default(10)
+//	@Param			offset			query	integer	false	"Number of approvals to skip (default: 0)"												minimum(0)	default(0)
+//	@Param			sort			query	string	false	"Sort order by internal id (default: desc)"												Enums(asc, desc)	default(desc)
+//	@Param			contract		query	string	false	"Filter by token contract address"														minlength(42)	maxlength(42)	example(0x742d35Cc6634C0532925a3b844Bc9e7595f0bEb)
+//	@Param			spender			query	string	false	"Filter by spender (operator) address"													minlength(42)	maxlength(42)	example(0x742d35Cc6634C0532925a3b844Bc9e7595f0bEb)
+//	@Param			type			query	string	false	"Filter by approval type (comma-separated list)"										Enums(allowance, token_approval, approval_for_all)
+//	@Param			include_revoked	query	boolean	false	"If true, revoked approvals are returned too (default: false)"							default(false)
+//	@Param			cursor			query	string	false	"Opaque cursor for keyset pagination. Base64url-encoded value from the previous response's 'cursor' field. Encodes the id of the last returned record. Cannot be used together with offset (returns 400)."
+//	@Produce		json
+//	@Success		200	{object}	CursorResponse	"List of approvals"
+//	@Success		204								"Address not found"
+//	@Failure		400	{object}	Error			"Invalid request parameters"
+//	@Failure		500	{object}	Error			"Internal server error"
+//	@Router			/addresses/{hash}/approvals [get]
+func (handler *AddressHandler) Approvals(c echo.Context) error {
+	req, err := bindAndValidate[approvalsRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	req.SetDefault()
+
+	filter := storage.AllowanceListFilter{
+		Limit:          req.Limit,
+		Offset:         req.Offset,
+		Sort:           pgSort(req.Sort),
+		IncludeRevoked: req.IncludeRevoked,
+		Type:           make([]internalTypes.ApprovalType, len(req.Type)),
+	}
+	for i := range req.Type {
+		filter.Type[i] = internalTypes.ApprovalType(req.Type[i])
+	}
+
+	if req.Cursor != "" {
+		if req.Offset > 0 {
+			return badRequestError(c, errCursorWithOffset)
+		}
+		cursorID, err := helpers.DecodeIDCursor(req.Cursor)
+		if err != nil {
+			return badRequestError(c, err)
+		}
+		filter.CursorID = cursorID
+	}
+
+	hash, err := types.HexFromString(req.Hash)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	owner, err := handler.address.ByHash(c.Request().Context(), hash)
+	if err != nil {
+		return handleError(c, err, handler.address)
+	}
+	filter.OwnerId = owner.Id
+
+	if req.Contract != "" {
+		hash, err := types.HexFromString(req.Contract)
+		if err != nil {
+			return badRequestError(c, err)
+		}
+		contract, err := handler.address.ByHash(c.Request().Context(), hash)
+		if err != nil {
+			return handleError(c, err, handler.address)
+		}
+		filter.ContractId = &contract.Id
+	}
+
+	if req.Spender != "" {
+		hash, err := types.HexFromString(req.Spender)
+		if err != nil {
+			return badRequestError(c, err)
+		}
+		spender, err := handler.address.ByHash(c.Request().Context(), hash)
+		if err != nil {
+			return handleError(c, err, handler.address)
+		}
+		filter.SpenderId = &spender.Id
+	}
+
+	allowances, err := handler.allowance.Filter(c.Request().Context(), filter)
+	if err != nil {
+		return handleError(c, err, handler.allowance)
+	}
+
+	response := make([]responses.Allowance, len(allowances))
+	for i := range allowances {
+		response[i] = responses.NewAllowance(allowances[i])
+	}
+
+	var cursor string
+	if len(allowances) > 0 {
+		cursor = helpers.EncodeIDCursor(allowances[len(allowances)-1].Id)
+	}
+
+	return returnCursorList(c, response, cursor)
 }
@@ -8,11 +8,13 @@ import (
 	"net/http/httptest"
 	"net/url"
 	"testing"
+	"time"
 
 	"github.com/NobleScope/noble-indexer/cmd/api/handler/responses"
 	"github.com/NobleScope/noble-indexer/cmd/api/helpers"
 	"github.com/NobleScope/noble-indexer/internal/storage"
 	"github.com/NobleScope/noble-indexer/internal/storage/mock"
+	"github.com/NobleScope/noble-indexer/internal/storage/types"
 	pkgTypes "github.com/NobleScope/noble-indexer/pkg/types"
 	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
 	"github.com/labstack/echo/v4"
@@ -75,10 +77,14 @@ var (
 // AddressHandlerTestSuite -
 type AddressHandlerTestSuite struct {
 	suite.Suite
-	address *mock.MockIAddress
-	echo    *echo.Echo
-	handler *AddressHandler
-	ctrl    *gomock.Controller
+	address        *mock.MockIAddress
+	balanceHistory *mock.MockIBalanceHistory
+	allowance      *mock.MockIAllowance
+	delegation     *mock.MockIDelegation
+	state          *mock.MockIState
+	echo           *echo.Echo
+	handler        *AddressHandler
+	ctrl           *gomock.Controller
 }
 
 // SetupSuite -
@@ -87,7 +93,11 @@ func (s *AddressHandlerTestSuite) SetupSuite() {
 	s.echo.Validator = NewApiValidator()
 	s.ctrl = gomock.NewController(s.T())
 	s.address = mock.NewMockIAddress(s.ctrl)
-	s.handler = NewAddressHandler(s.address)
+	s.balanceHistory = mock.NewMockIBalanceHistory(s.ctrl)
+	s.allowance = mock.NewMockIAllowance(s.ctrl)
+	s.delegation = mock.NewMockIDelegation(s.ctrl)
+	s.state = mock.NewMockIState(s.ctrl)
+	s.handler = NewAddressHandler(s.address, s.balanceHistory, s.allowance, s.delegation, s.state, testIndexerName)
 }
 
 // TearDownSuite -
@@ -307,6 +317,16 @@ func (s *AddressHandlerTestSuite) TestGetSuccess() {
 		Return(testAddress1, nil).
 		Times(1)
 
+	s.delegation.EXPECT().
+		ByAuthorityId(gomock.Any(), testAddress1.Id).
+		Return(storage.Delegation{}, sql.ErrNoRows).
+		Times(1)
+
+	s.delegation.EXPECT().
+		IsNoRows(sql.ErrNoRows).
+		Return(true).
+		Times(1)
+
 	s.Require().NoError(s.handler.Get(c))
 	s.Require().Equal(http.StatusOK, rec.Code)
 
@@ -317,6 +337,81 @@ func (s *AddressHandlerTestSuite) TestGetSuccess() {
 	s.Require().Equal(testAddress1.Hash.Hex(), address.Hash)
 	s.Require().False(address.IsContract)
 	s.Require().EqualValues(50, address.TxCount)
+	s.Require().Nil(address.Delegation)
+}
+
+// TestGetWithDelegation tests that the active EIP-7702 delegation is attached to the address
+func (s *AddressHandlerTestSuite) TestGetWithDelegation() {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/address/:hash")
+	c.SetParamNames("hash")
+	c.SetParamValues(testAddressHex1.Hex())
+
+	s.address.EXPECT().
+		ByHash(gomock.Any(), testAddressHex1).
+		Return(testAddress1, nil).
+		Times(1)
+
+	delegateId := testAddress2.Id
+	s.delegation.EXPECT().
+		ByAuthorityId(gomock.Any(), testAddress1.Id).
+		Return(storage.Delegation{
+			Id:         testAddress1.Id,
+			Height:     100,
+			LastHeight: 150,
+			DelegateId: &delegateId,
+			Delegate:   &storage.Address{Hash: testAddressHex2},
+			Tx:         storage.Tx{Hash: testTxHash},
+		}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.Get(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var address responses.Address
+	err := json.NewDecoder(rec.Body).Decode(&address)
+	s.Require().NoError(err)
+	s.Require().NotNil(address.Delegation)
+	s.Require().Equal(testAddressHex1.Hex(), address.Delegation.Authority)
+	s.Require().NotNil(address.Delegation.Delegate)
+	s.Require().Equal(testAddressHex2.Hex(), *address.Delegation.Delegate)
+	s.Require().EqualValues(100, address.Delegation.Height)
+	s.Require().EqualValues(150, address.Delegation.LastHeight)
+	s.Require().Equal(testTxHash.Hex(), address.Delegation.TxHash)
+}
+
+// TestGetWithClearedDelegation tests that the cleared delegation is not returned
+func (s *AddressHandlerTestSuite) TestGetWithClearedDelegation() {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/address/:hash")
+	c.SetParamNames("hash")
+	c.SetParamValues(testAddressHex1.Hex())
+
+	s.address.EXPECT().
+		ByHash(gomock.Any(), testAddressHex1).
+		Return(testAddress1, nil).
+		Times(1)
+
+	s.delegation.EXPECT().
+		ByAuthorityId(gomock.Any(), testAddress1.Id).
+		Return(storage.Delegation{
+			Id:         testAddress1.Id,
+			Height:     100,
+			LastHeight: 150,
+		}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.Get(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var address responses.Address
+	err := json.NewDecoder(rec.Body).Decode(&address)
+	s.Require().NoError(err)
+	s.Require().Nil(address.Delegation)
 }
 
 // TestGetNoContent tests when address is not found
@@ -458,3 +553,403 @@ func (s *AddressHandlerTestSuite) TestListNoCursorOnNonIdSort() {
 	s.Require().Len(body.Result, 2)
 	s.Require().Empty(body.Cursor)
 }
+
+// ====================================
+// Address Balance History Tests
+// ====================================
+
+var testBalanceHistory = []storage.BalanceHistory{
+	{
+		Id:        2,
+		Height:    150,
+		Time:      testTime,
+		AddressId: 1,
+		Value:     decimal.RequireFromString("700"),
+		Delta:     decimal.RequireFromString("-300"),
+	},
+	{
+		Id:        1,
+		Height:    100,
+		Time:      testTime.Add(-time.Hour),
+		AddressId: 1,
+		Value:     decimal.RequireFromString("1000"),
+		Delta:     decimal.RequireFromString("1000"),
+	},
+}
+
+func (s *AddressHandlerTestSuite) balanceHistoryContext(q url.Values) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/address/:hash/balance_history")
+	c.SetParamNames("hash")
+	c.SetParamValues(testAddressHex1.Hex())
+	return c, rec
+}
+
+// TestBalanceHistoryList tests listing of balance snapshots with default parameters
+func (s *AddressHandlerTestSuite) TestBalanceHistoryList() {
+	c, rec := s.balanceHistoryContext(url.Values{})
+
+	s.address.EXPECT().
+		ByHash(gomock.Any(), testAddressHex1).
+		Return(testAddress1, nil).
+		Times(1)
+
+	s.balanceHistory.EXPECT().
+		Filter(gomock.Any(), storage.BalanceHistoryListFilter{
+			AddressId: 1,
+			Limit:     10,
+			Sort:      sdk.SortOrderDesc,
+		}).
+		Return(testBalanceHistory, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.BalanceHistory(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var body struct {
+		Result []responses.BalanceHistory `json:"result"`
+		Cursor string                     `json:"cursor"`
+	}
+	s.Require().NoError(json.NewDecoder(rec.Body).Decode(&body))
+	s.Require().Len(body.Result, 2)
+	s.Require().EqualValues(150, body.Result[0].Height)
+	s.Require().Equal("700", body.Result[0].Value)
+	s.Require().Equal("-300", body.Result[0].Delta)
+	s.Require().Equal(helpers.EncodeTimeIDCursor(testBalanceHistory[1].Time, 1), body.Cursor)
+}
+
+// TestBalanceHistoryAtHeight tests the point lookup by height
+func (s *AddressHandlerTestSuite) TestBalanceHistoryAtHeight() {
+	q := make(url.Values)
+	q.Set("height", "199")
+	c, rec := s.balanceHistoryContext(q)
+
+	s.address.EXPECT().
+		ByHash(gomock.Any(), testAddressHex1).
+		Return(testAddress1, nil).
+		Times(1)
+
+	s.balanceHistory.EXPECT().
+		AtHeight(gomock.Any(), uint64(1), pkgTypes.Level(199)).
+		Return(testBalanceHistory[0], nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.BalanceHistory(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var balance responses.BalanceHistory
+	s.Require().NoError(json.NewDecoder(rec.Body).Decode(&balance))
+	s.Require().EqualValues(150, balance.Height)
+	s.Require().Equal("700", balance.Value)
+}
+
+// TestBalanceHistoryAtTimeBeforeFirstChange tests that zero balance is returned before the first balance change
+func (s *AddressHandlerTestSuite) TestBalanceHistoryAtTimeBeforeFirstChange() {
+	q := make(url.Values)
+	q.Set("time", "1600000000")
+	c, rec := s.balanceHistoryContext(q)
+
+	s.address.EXPECT().
+		ByHash(gomock.Any(), testAddressHex1).
+		Return(testAddress1, nil).
+		Times(1)
+
+	s.balanceHistory.EXPECT().
+		AtTime(gomock.Any(), uint64(1), time.Unix(1600000000, 0).UTC()).
+		Return(storage.BalanceHistory{}, sql.ErrNoRows).
+		Times(1)
+
+	s.balanceHistory.EXPECT().
+		IsNoRows(sql.ErrNoRows).
+		Return(true).
+		Times(1)
+
+	s.balanceHistory.EXPECT().
+		Filter(gomock.Any(), storage.BalanceHistoryListFilter{
+			AddressId: 1,
+			Limit:     1,
+		}).
+		Return(testBalanceHistory[:1], nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.BalanceHistory(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var balance responses.BalanceHistory
+	s.Require().NoError(json.NewDecoder(rec.Body).Decode(&balance))
+	s.Require().Equal("0", balance.Value)
+}
+
+// TestBalanceHistoryAtHeightWithoutHistory tests that the current balance is returned if balance changes of the address aren't recorded
+func (s *AddressHandlerTestSuite) TestBalanceHistoryAtHeightWithoutHistory() {
+	q := make(url.Values)
+	q.Set("height", "150")
+	c, rec := s.balanceHistoryContext(q)
+
+	s.address.EXPECT().
+		ByHash(gomock.Any(), testAddressHex1).
+		Return(testAddress1, nil).
+		Times(1)
+
+	s.balanceHistory.EXPECT().
+		AtHeight(gomock.Any(), uint64(1), pkgTypes.Level(150)).
+		Return(storage.BalanceHistory{}, sql.ErrNoRows).
+		Times(1)
+
+	s.balanceHistory.EXPECT().
+		IsNoRows(sql.ErrNoRows).
+		Return(true).
+		Times(1)
+
+	s.balanceHistory.EXPECT().
+		Filter(gomock.Any(), storage.BalanceHistoryListFilter{
+			AddressId: 1,
+			Limit:     1,
+		}).
+		Return([]storage.BalanceHistory{}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.BalanceHistory(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var balance responses.BalanceHistory
+	s.Require().NoError(json.NewDecoder(rec.Body).Decode(&balance))
+	s.Require().Equal("1000000000", balance.Value)
+}
+
+// TestBalanceHistorySeries tests the time-bucketed series
+func (s *AddressHandlerTestSuite) TestBalanceHistorySeries() {
+	q := make(url.Values)
+	q.Set("timeframe", "day")
+	q.Set("time_from", "1700000000")
+	c, rec := s.balanceHistoryContext(q)
+
+	s.address.EXPECT().
+		ByHash(gomock.Any(), testAddressHex1).
+		Return(testAddress1, nil).
+		Times(1)
+
+	s.balanceHistory.EXPECT().
+		Series(gomock.Any(), storage.BalanceSeriesFilter{
+			AddressId: 1,
+			Timeframe: storage.TimeframeDay,
+			TimeFrom:  time.Unix(1700000000, 0).UTC(),
+			Limit:     1000,
+		}).
+		Return([]storage.BalanceSeriesItem{
+			{Time: testTime.Truncate(24 * time.Hour), Value: decimal.RequireFromString("700")},
+		}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.BalanceHistory(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var series []responses.BalanceSeriesItem
+	s.Require().NoError(json.NewDecoder(rec.Body).Decode(&series))
+	s.Require().Len(series, 1)
+	s.Require().Equal("700", series[0].Value)
+}
+
+// TestBalanceHistoryInvalidParams tests mutually exclusive and invalid parameters
+func (s *AddressHandlerTestSuite) TestBalanceHistoryInvalidParams() {
+	for _, params := range []map[string]string{
+		{"height": "100", "time": "1700000000"},
+		{"height": "100", "timeframe": "day"},
+		{"timeframe": "year"},
+		{"timeframe": "day", "cursor": helpers.EncodeTimeIDCursor(testTime, 1)},
+	} {
+		q := make(url.Values)
+		for key, value := range params {
+			q.Set(key, value)
+		}
+		c, rec := s.balanceHistoryContext(q)
+
+		s.Require().NoError(s.handler.BalanceHistory(c))
+		s.Require().Equal(http.StatusBadRequest, rec.Code, params)
+	}
+}
+
+// ====================================
+// Approvals Tests
+// ====================================
+
+var testAllowances = []storage.Allowance{
+	{
+		Id:         7,
+		Height:     100,
+		LastHeight: 150,
+		Type:       types.Allowance,
+		ContractId: 3,
+		OwnerId:    1,
+		SpenderId:  2,
+		TokenID:    decimal.Zero,
+		Amount:     decimal.RequireFromString("1000000"),
+		Approved:   true,
+		Owner:      testAddress1,
+		Spender:    testAddress2,
+		Contract:   storage.Contract{Address: testAddress3},
+		Token: &storage.Token{
+			Name:     "Tether USD",
+			Symbol:   "USDT",
+			Decimals: 6,
+			Type:     types.ERC20,
+		},
+	},
+	{
+		Id:         5,
+		Height:     120,
+		LastHeight: 120,
+		Type:       types.ApprovalForAll,
+		ContractId: 3,
+		OwnerId:    1,
+		SpenderId:  2,
+		TokenID:    decimal.Zero,
+		Amount:     decimal.Zero,
+		Approved:   true,
+		Owner:      testAddress1,
+		Spender:    testAddress2,
+		Contract:   storage.Contract{Address: testAddress3},
+	},
+}
+
+func (s *AddressHandlerTestSuite) approvalsContext(q url.Values) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/address/:hash/approvals")
+	c.SetParamNames("hash")
+	c.SetParamValues(testAddressHex1.Hex())
+	return c, rec
+}
+
+// TestApprovalsList tests listing of active approvals with default parameters
+func (s *AddressHandlerTestSuite) TestApprovalsList() {
+	c, rec := s.approvalsContext(url.Values{})
+
+	s.address.EXPECT().
+		ByHash(gomock.Any(), testAddressHex1).
+		Return(testAddress1, nil).
+		Times(1)
+
+	s.allowance.EXPECT().
+		Filter(gomock.Any(), storage.AllowanceListFilter{
+			OwnerId: 1,
+			Limit:   10,
+			Sort:    sdk.SortOrderDesc,
+			Type:    []types.ApprovalType{},
+		}).
+		Return(testAllowances, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.Approvals(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var body struct {
+		Result []responses.Allowance `json:"result"`
+		Cursor string                `json:"cursor"`
+	}
+	s.Require().NoError(json.NewDecoder(rec.Body).Decode(&body))
+	s.Require().Len(body.Result, 2)
+
+	allowance := body.Result[0]
+	s.Require().EqualValues(7, allowance.Id)
+	s.Require().Equal("allowance", allowance.Type)
+	s.Require().Equal(testAddressHex1.Hex(), allowance.Owner)
+	s.Require().Equal(testAddressHex2.Hex(), allowance.Spender)
+	s.Require().Equal(testAddressHex3.Hex(), allowance.Contract)
+	s.Require().Equal("1000000", allowance.Amount)
+	s.Require().True(allowance.Approved)
+	s.Require().NotNil(allowance.Token)
+	s.Require().Equal("USDT", allowance.Token.Symbol)
+	s.Require().Equal(testAddressHex3.Hex(), allowance.Token.Contract)
+
+	s.Require().Equal("approval_for_all", body.Result[1].Type)
+	s.Require().Nil(body.Result[1].Token)
+
+	cursorID, err := helpers.DecodeIDCursor(body.Cursor)
+	s.Require().NoError(err)
+	s.Require().EqualValues(5, cursorID)
+}
+
+// TestApprovalsWithFilters tests filtering by spender, contract, type and revoked approvals
+func (s *AddressHandlerTestSuite) TestApprovalsWithFilters() {
+	q := make(url.Values)
+	q.Set("spender", testAddressHex2.Hex())
+	q.Set("contract", testAddressHex3.Hex())
+	q.Set("type", "allowance,approval_for_all")
+	q.Set("include_revoked", "true")
+	q.Set("cursor", helpers.EncodeIDCursor(10))
+	c, rec := s.approvalsContext(q)
+
+	s.address.EXPECT().
+		ByHash(gomock.Any(), testAddressHex1).
+		Return(testAddress1, nil).
+		Times(1)
+
+	s.address.EXPECT().
+		ByHash(gomock.Any(), testAddressHex2).
+		Return(testAddress2, nil).
+		Times(1)
+
+	s.address.EXPECT().
+		ByHash(gomock.Any(), testAddressHex3).
+		Return(testAddress3, nil).
+		Times(1)
+
+	s.allowance.EXPECT().
+		Filter(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, filter storage.AllowanceListFilter) ([]storage.Allowance, error) {
+			s.Require().EqualValues(1, filter.OwnerId)
+			s.Require().NotNil(filter.SpenderId)
+			s.Require().EqualValues(2, *filter.SpenderId)
+			s.Require().NotNil(filter.ContractId)
+			s.Require().EqualValues(3, *filter.ContractId)
+			s.Require().Equal([]types.ApprovalType{types.Allowance, types.ApprovalForAll}, filter.Type)
+			s.Require().True(filter.IncludeRevoked)
+			s.Require().EqualValues(10, filter.CursorID)
+			return testAllowances[:1], nil
+		}).
+		Times(1)
+
+	s.Require().NoError(s.handler.Approvals(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+}
+
+// TestApprovalsUnknownAddress tests that unknown owner returns no content
+func (s *AddressHandlerTestSuite) TestApprovalsUnknownAddress() {
+	c, rec := s.approvalsContext(url.Values{})
+
+	s.address.EXPECT().
+		ByHash(gomock.Any(), testAddressHex1).
+		Return(storage.Address{}, sql.ErrNoRows).
+		Times(1)
+
+	s.address.EXPECT().
+		IsNoRows(sql.ErrNoRows).
+		Return(true).
+		Times(1)
+
+	s.Require().NoError(s.handler.Approvals(c))
+	s.Require().Equal(http.StatusNoContent, rec.Code)
+}
+
+// TestApprovalsInvalidParams tests invalid parameters
+func (s *AddressHandlerTestSuite) TestApprovalsInvalidParams() {
+	for _, params := range []map[string]string{
+		{"type": "transfer"},
+		{"spender": "0x123"},
+		{"limit": "101"},
+		{"offset": "10", "cursor": helpers.EncodeIDCursor(10)},
+	} {
+		q := make(url.Values)
+		for key, value := range params {
+			q.Set(key, value)
+		}
+		c, rec := s.approvalsContext(q)
+
+		s.Require().NoError(s.handler.Approvals(c))
+		s.Require().Equal(http.StatusBadRequest, rec.Code, params)
+	}
+}
@@ -11,25 +11,32 @@ import (
 type BeaconWithdrawalHandler struct {
 	address           storage.IAddress
 	beaconWithdrawals storage.IBeaconWithdrawal
+	state             storage.IState
+	indexerName       string
 }
 
 func NewBeaconWithdrawalHandler(
 	beaconWithdrawals storage.IBeaconWithdrawal,
 	address storage.IAddress,
+	state storage.IState,
+	indexerName string,
 ) *BeaconWithdrawalHandler {
 	return &BeaconWithdrawalHandler{
 		beaconWithdrawals: beaconWithdrawals,
 		address:           address,
+		state:             state,
+		indexerName:       indexerName,
 	}
 }
 
 type beaconWithdrawalListRequest struct {
-	Limit   int          `query:"limit"   validate:"omitempty,min=1,max=100"`
-	Offset  int          `query:"offset"  validate:"omitempty,min=0"`
-	Sort    string       `query:"sort"    validate:"omitempty,oneof=asc desc"`
-	Height  *types.Level `query:"height"  validate:"omitempty,min=0"`
-	Address string       `query:"address" validate:"omitempty,address"`
-	Cursor  string       `query:"cursor"  validate:"omitempty"`
+	Limit    int          `query:"limit"    validate:"omitempty,min=1,max=100"`
+	Offset   int          `query:"offset"   validate:"omitempty,min=0"`
+	Sort     string       `query:"sort"     validate:"omitempty,oneof=asc desc"`
+	Height   *types.Level `query:"height"   validate:"omitempty,min=0"`
+	Address  string       `query:"address"  validate:"omitempty,address"`
+	Finality string       `query:"finality" validate:"omitempty,finality"`
+	Cursor   string       `query:"cursor"   validate:"omitempty"`
 }
 
 func (p *beaconWithdrawalListRequest) SetDefault() {
@@ -52,11 +59,13 @@ func (p *beaconWithdrawalListRequest) SetDefault() {
 //	@Param			sort	query	string	false	"Sort order by block height (default: asc)"				Enums(asc, desc)	default(asc)
 //	@Param			height	query	integer	false	"Filter by block height"								minimum(0)	example(12345)
 //	@Param			address	query	string	false	"Filter by recipient address (hexadecimal with 0x prefix)"	minlength(42)	maxlength(42)	example(0x742d35Cc6634C0532925a3b844Bc9e7595f0bEb)
+//	@Param			finality	query	string	false	"Return only data of safe or finalized blocks, safe includes finalized ones (default: latest)"	Enums(latest, safe, finalized)
 //	@Param			cursor	query	string	false	"Opaque cursor for keyset pagination. Base64url-encoded value from the previous response's 'cursor' field. Encodes (timestamp, id) of the last returned record. Cannot be used together with offset (returns 400)."
 //	@Produce		json
 //	@Success		200	{object}	CursorResponse	"List of beacon withdrawals"
 //	@Failure		400	{object}	Error						"Invalid request parameters"
 //	@Failure		500	{object}	Error						"Internal server error"
+//	@Failure		503	{object}	Error						"Requested finality is not tracked by the indexer"
 //	@Router			/beacon_withdrawals [get]
 func (handler *BeaconWithdrawalHandler) List(c echo.Context) error {
 	req, err := bindAndValidate[beaconWithdrawalListRequest](c)
@@ -72,6 +81,11 @@ func (handler *BeaconWithdrawalHandler) List(c echo.Context) error {
 		Sort:   pgSort(req.Sort),
 	}
 
+	filter.MaxHeight, err = finalityHeight(c.Request().Context(), handler.state, handler.indexerName, req.Finality)
+	if err != nil {
+		return handleError(c, err, handler.state)
+	}
+
 	if req.Cursor != "" {
 		if req.Offset > 0 {
 			return badRequestError(c, errCursorWithOffset)
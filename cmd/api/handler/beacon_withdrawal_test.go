@@ -12,6 +12,7 @@ import (
 	"github.com/NobleScope/noble-indexer/cmd/api/helpers"
 	"github.com/NobleScope/noble-indexer/internal/storage"
 	"github.com/NobleScope/noble-indexer/internal/storage/mock"
+	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
 	"github.com/labstack/echo/v4"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/suite"
@@ -53,6 +54,7 @@ type BeaconWithdrawalHandlerTestSuite struct {
 	suite.Suite
 	beaconWithdrawals *mock.MockIBeaconWithdrawal
 	address           *mock.MockIAddress
+	state             *mock.MockIState
 	echo              *echo.Echo
 	handler           *BeaconWithdrawalHandler
 	ctrl              *gomock.Controller
@@ -65,7 +67,8 @@ func (s *BeaconWithdrawalHandlerTestSuite) SetupSuite() {
 	s.ctrl = gomock.NewController(s.T())
 	s.beaconWithdrawals = mock.NewMockIBeaconWithdrawal(s.ctrl)
 	s.address = mock.NewMockIAddress(s.ctrl)
-	s.handler = NewBeaconWithdrawalHandler(s.beaconWithdrawals, s.address)
+	s.state = mock.NewMockIState(s.ctrl)
+	s.handler = NewBeaconWithdrawalHandler(s.beaconWithdrawals, s.address, s.state, testIndexerName)
 }
 
 // TearDownSuite -
@@ -177,3 +180,36 @@ func (s *BeaconWithdrawalHandlerTestSuite) TestListEmptyCursorOnEmpty() {
 	s.Require().NoError(err)
 	s.Require().Empty(body.Cursor)
 }
+
+// TestListFinalized tests that withdrawals are limited by the finalized height
+func (s *BeaconWithdrawalHandlerTestSuite) TestListFinalized() {
+	q := make(url.Values)
+	q.Set("finality", "finalized")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/beacon_withdrawals")
+
+	s.state.EXPECT().
+		ByName(gomock.Any(), testIndexerName).
+		Return(storage.State{
+			LastHeight:      120,
+			SafeHeight:      110,
+			FinalizedHeight: 100,
+		}, nil).
+		Times(1)
+
+	maxHeight := uint64(100)
+	s.beaconWithdrawals.EXPECT().
+		Filter(gomock.Any(), storage.BeaconWithdrawalListFilter{
+			Limit:     10,
+			Sort:      sdk.SortOrderAsc,
+			MaxHeight: &maxHeight,
+		}).
+		Return([]*storage.BeaconWithdrawal{&testBeaconWithdrawal1}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.List(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+}
@@ -0,0 +1,125 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/NobleScope/noble-indexer/cmd/api/handler/responses"
+	"github.com/NobleScope/noble-indexer/cmd/api/helpers"
+	"github.com/NobleScope/noble-indexer/internal/storage"
+	pkgTypes "github.com/NobleScope/noble-indexer/pkg/types"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+)
+
+type BlobHandler struct {
+	blobs     storage.IBlob
+	addresses storage.IAddress
+}
+
+func NewBlobHandler(
+	blobs storage.IBlob,
+	addresses storage.IAddress,
+) *BlobHandler {
+	return &BlobHandler{
+		blobs:     blobs,
+		addresses: addresses,
+	}
+}
+
+type listBlobs struct {
+	Limit  int     `query:"limit"  validate:"omitempty,min=1,max=100"`
+	Offset int     `query:"offset" validate:"omitempty,min=0"`
+	Sort   string  `query:"sort"   validate:"omitempty,oneof=asc desc"`
+	Height *uint64 `query:"height" validate:"omitempty,min=0"`
+	Sender string  `query:"sender" validate:"omitempty,address"`
+	Cursor string  `query:"cursor" validate:"omitempty"`
+}
+
+func (req *listBlobs) ToFilters(
+	ctx context.Context,
+	address storage.IAddress,
+) (storage.BlobListFilter, error) {
+	if req.Limit <= 0 || req.Limit > 100 {
+		req.Limit = 10
+	}
+	if req.Sort == "" {
+		req.Sort = desc
+	}
+	filters := storage.BlobListFilter{
+		Limit:  req.Limit,
+		Offset: req.Offset,
+		Sort:   pgSort(req.Sort),
+		Height: req.Height,
+	}
+
+	if req.Sender != "" {
+		senderHex, err := pkgTypes.HexFromString(req.Sender)
+		if err != nil {
+			return filters, errors.Wrapf(err, "decoding sender address: %s", req.Sender)
+		}
+		senderAddress, err := address.ByHash(ctx, senderHex)
+		if err != nil {
+			return filters, errors.Wrapf(err, "fetching sender address by hash: %x", senderHex)
+		}
+		filters.SenderId = &senderAddress.Id
+	}
+
+	if req.Cursor != "" {
+		if req.Offset > 0 {
+			return filters, errCursorWithOffset
+		}
+		cursorID, err := helpers.DecodeIDCursor(req.Cursor)
+		if err != nil {
+			return filters, err
+		}
+		filters.CursorID = cursorID
+	}
+
+	return filters, nil
+}
+
+// List godoc
+//
+//	@Summary		List EIP-4844 blobs
+//	@Description	Returns a paginated list of blobs referenced by blob transactions. Can be filtered by block height or transaction sender.
+//	@Tags			blobs
+//	@ID				list-blobs
+//	@Param			limit	query	integer	false	"Number of blobs to return (default: 10)"	minimum(1)	maximum(100)	default(10)
+//	@Param			offset	query	integer	false	"Number of blobs to skip (default: 0)"		minimum(0)	default(0)
+//	@Param			sort	query	string	false	"Sort order (default: desc)"				Enums(asc, desc)	default(desc)
+//	@Param			height	query	integer	false	"Filter by block height"					minimum(0)	example(12345)
+//	@Param			sender	query	string	false	"Filter by transaction sender address"		minlength(42)	maxlength(42)	example(0x742d35Cc6634C0532925a3b844Bc9e7595f0bEb)
+//	@Param			cursor	query	string	false	"Opaque cursor for keyset pagination. Base64url-encoded value from the previous response's 'cursor' field. Encodes the id of the last returned record. Cannot be used together with offset (returns 400)."
+//	@Produce		json
+//	@Success		200	{object}	CursorResponse	"List of blobs"
+//	@Failure		400	{object}	Error			"Invalid request parameters"
+//	@Failure		500	{object}	Error			"Internal server error"
+//	@Router			/blobs [get]
+func (handler *BlobHandler) List(c echo.Context) error {
+	req, err := bindAndValidate[listBlobs](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	filters, err := req.ToFilters(c.Request().Context(), handler.addresses)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	blobs, err := handler.blobs.Filter(c.Request().Context(), filters)
+	if err != nil {
+		return handleError(c, err, handler.blobs)
+	}
+
+	response := make([]responses.Blob, len(blobs))
+	for i := range blobs {
+		response[i] = responses.NewBlob(blobs[i])
+	}
+
+	var cursor string
+	if len(blobs) > 0 {
+		cursor = helpers.EncodeIDCursor(blobs[len(blobs)-1].Id)
+	}
+
+	return returnCursorList(c, response, cursor)
+}
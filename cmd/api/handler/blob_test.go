@@ -0,0 +1,167 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/NobleScope/noble-indexer/cmd/api/handler/responses"
+	"github.com/NobleScope/noble-indexer/cmd/api/helpers"
+	"github.com/NobleScope/noble-indexer/internal/storage"
+	"github.com/NobleScope/noble-indexer/internal/storage/mock"
+	pkgTypes "github.com/NobleScope/noble-indexer/pkg/types"
+	"github.com/labstack/echo/v4"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	testBlobGasPrice  = decimal.NewFromInt(7)
+	testVersionedHash = pkgTypes.Hex(pkgTypes.MustDecodeHex("0x01a5b5d5c9c1bcbd1f3e4d2cbb1a3f21b2bd4f21c77c8c5e1f4cbbd5b5d6e7f8"))
+
+	testBlob = storage.Blob{
+		Id:            3,
+		Height:        100,
+		Time:          time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		TxId:          1,
+		SenderId:      testAddress1.Id,
+		Index:         1,
+		VersionedHash: testVersionedHash,
+		Tx:            storage.Tx{Hash: testTxHash, BlobGasPrice: &testBlobGasPrice},
+		Sender:        storage.Address{Hash: testAddressHex1},
+	}
+)
+
+// BlobHandlerTestSuite -
+type BlobHandlerTestSuite struct {
+	suite.Suite
+	blobs     *mock.MockIBlob
+	addresses *mock.MockIAddress
+	echo      *echo.Echo
+	handler   *BlobHandler
+	ctrl      *gomock.Controller
+}
+
+// SetupSuite -
+func (s *BlobHandlerTestSuite) SetupSuite() {
+	s.echo = echo.New()
+	s.echo.Validator = NewApiValidator()
+	s.ctrl = gomock.NewController(s.T())
+	s.blobs = mock.NewMockIBlob(s.ctrl)
+	s.addresses = mock.NewMockIAddress(s.ctrl)
+	s.handler = NewBlobHandler(s.blobs, s.addresses)
+}
+
+// TearDownSuite -
+func (s *BlobHandlerTestSuite) TearDownSuite() {
+	s.ctrl.Finish()
+	s.Require().NoError(s.echo.Shutdown(context.Background()))
+}
+
+func TestSuiteBlobHandler_Run(t *testing.T) {
+	suite.Run(t, new(BlobHandlerTestSuite))
+}
+
+// TestListSuccess tests successful retrieval of blobs list
+func (s *BlobHandlerTestSuite) TestListSuccess() {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/blobs")
+
+	s.blobs.EXPECT().
+		Filter(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, filter storage.BlobListFilter) ([]storage.Blob, error) {
+			s.Require().Equal(10, filter.Limit)
+			s.Require().Nil(filter.Height)
+			s.Require().Nil(filter.SenderId)
+			return []storage.Blob{testBlob}, nil
+		}).
+		Times(1)
+
+	s.Require().NoError(s.handler.List(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var body struct {
+		Result []responses.Blob `json:"result"`
+		Cursor string           `json:"cursor"`
+	}
+	err := json.NewDecoder(rec.Body).Decode(&body)
+	s.Require().NoError(err)
+	s.Require().Len(body.Result, 1)
+	s.Require().EqualValues(100, body.Result[0].Height)
+	s.Require().EqualValues(1, body.Result[0].Index)
+	s.Require().Equal(testTxHash.Hex(), body.Result[0].TxHash)
+	s.Require().Equal(testAddressHex1.Hex(), body.Result[0].Sender)
+	s.Require().Equal(testVersionedHash.Hex(), body.Result[0].VersionedHash)
+	s.Require().NotNil(body.Result[0].BlobGasPrice)
+	s.Require().Equal("7", body.Result[0].BlobGasPrice.String())
+	s.Require().Equal(helpers.EncodeIDCursor(3), body.Cursor)
+}
+
+// TestListWithFilters tests list filtered by height and sender
+func (s *BlobHandlerTestSuite) TestListWithFilters() {
+	q := make(url.Values)
+	q.Set("height", "100")
+	q.Set("sender", testAddressHex1.Hex())
+	q.Set("sort", "asc")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/blobs")
+
+	s.addresses.EXPECT().
+		ByHash(gomock.Any(), testAddressHex1).
+		Return(testAddress1, nil).
+		Times(1)
+
+	s.blobs.EXPECT().
+		Filter(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, filter storage.BlobListFilter) ([]storage.Blob, error) {
+			s.Require().NotNil(filter.Height)
+			s.Require().EqualValues(100, *filter.Height)
+			s.Require().NotNil(filter.SenderId)
+			s.Require().EqualValues(testAddress1.Id, *filter.SenderId)
+			s.Require().EqualValues("asc", filter.Sort)
+			return []storage.Blob{testBlob}, nil
+		}).
+		Times(1)
+
+	s.Require().NoError(s.handler.List(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+}
+
+// TestListCursorWithOffset tests that cursor cannot be used with offset
+func (s *BlobHandlerTestSuite) TestListCursorWithOffset() {
+	q := make(url.Values)
+	q.Set("cursor", helpers.EncodeIDCursor(1))
+	q.Set("offset", "5")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/blobs")
+
+	s.Require().NoError(s.handler.List(c))
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+}
+
+// TestListInvalidSender tests handling of invalid sender address
+func (s *BlobHandlerTestSuite) TestListInvalidSender() {
+	q := make(url.Values)
+	q.Set("sender", "invalid")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/blobs")
+
+	s.Require().NoError(s.handler.List(c))
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+}
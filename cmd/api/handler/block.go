@@ -64,15 +64,17 @@ func (handler *BlockHandler) Get(c echo.Context) error {
 		return handleError(c, err, handler.block)
 	}
 
+	preventCaching(c, block.Finality)
 	return c.JSON(http.StatusOK, responses.NewBlock(block))
 }
 
 type blockListRequest struct {
-	Limit  int    `query:"limit"  validate:"omitempty,min=1,max=100"`
-	Offset int    `query:"offset" validate:"omitempty,min=0"`
-	Sort   string `query:"sort"   validate:"omitempty,oneof=asc desc"`
-	Stats  bool   `query:"stats"  validate:"omitempty"`
-	Cursor string `query:"cursor" validate:"omitempty"`
+	Limit    int    `query:"limit"    validate:"omitempty,min=1,max=100"`
+	Offset   int    `query:"offset"   validate:"omitempty,min=0"`
+	Sort     string `query:"sort"     validate:"omitempty,oneof=asc desc"`
+	Stats    bool   `query:"stats"    validate:"omitempty"`
+	Finality string `query:"finality" validate:"omitempty,finality"`
+	Cursor   string `query:"cursor"   validate:"omitempty"`
 }
 
 func (p *blockListRequest) SetDefault() {
@@ -94,11 +96,13 @@ func (p *blockListRequest) SetDefault() {
 //	@Param			offset	query	integer	false	"Number of blocks to skip (default: 0)"					minimum(0)	default(0)
 //	@Param			sort	query	string	false	"Sort order by height (default: asc)"					Enums(asc, desc)	default(asc)
 //	@Param			stats	query	boolean	false	"Include statistics for each block (default: false)"	default(false)
+//	@Param			finality	query	string	false	"Return only data of safe or finalized blocks, safe includes finalized ones (default: latest)"	Enums(latest, safe, finalized)
 //	@Param			cursor	query	string	false	"Opaque cursor for keyset pagination. Base64url-encoded value from the previous response's 'cursor' field. Encodes (timestamp, id) of the last returned record. Cannot be used together with offset (returns 400)."
 //	@Produce		json
 //	@Success		200	{object}	CursorResponse	"List of blocks"
 //	@Failure		400	{object}	Error			"Invalid request parameters"
 //	@Failure		500	{object}	Error			"Internal server error"
+//	@Failure		503	{object}	Error			"Requested finality is not tracked by the indexer"
 //	@Router			/blocks [get]
 func (handler *BlockHandler) List(c echo.Context) error {
 	req, err := bindAndValidate[blockListRequest](c)
@@ -114,6 +118,11 @@ func (handler *BlockHandler) List(c echo.Context) error {
 		WithStats: req.Stats,
 	}
 
+	filters.MaxHeight, err = finalityHeight(c.Request().Context(), handler.state, handler.indexerName, req.Finality)
+	if err != nil {
+		return handleError(c, err, handler.state)
+	}
+
 	if req.Cursor != "" {
 		if req.Offset > 0 {
 			return badRequestError(c, errCursorWithOffset)
@@ -13,6 +13,7 @@ import (
 	"github.com/NobleScope/noble-indexer/cmd/api/helpers"
 	"github.com/NobleScope/noble-indexer/internal/storage"
 	"github.com/NobleScope/noble-indexer/internal/storage/mock"
+	"github.com/NobleScope/noble-indexer/internal/storage/types"
 	pkgTypes "github.com/NobleScope/noble-indexer/pkg/types"
 	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
 	"github.com/labstack/echo/v4"
@@ -126,6 +127,31 @@ func (s *BlockTestSuite) TestGet() {
 	s.Require().Equal("0x9b6e76e8263c5060b61e396c65baf15dd187386d5607250be0dcc5308f0b49ef", block.StateRoot)
 	s.Require().Equal("0x0764012270afacd3b101bcfadaaa9fc3190d04ed90ff22c0ee59781e54858a7d", block.TransactionsRootHash)
 	s.Require().Nil(block.Stats)
+	s.Require().Equal("no-store", rec.Header().Get(echo.HeaderCacheControl))
+}
+
+func (s *BlockTestSuite) TestGetFinalized() {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/block/:height")
+	c.SetParamNames("height")
+	c.SetParamValues("100")
+
+	block := testBlock
+	block.Finality = types.Finalized
+	s.block.EXPECT().
+		ByHeight(gomock.Any(), pkgTypes.Level(100), false).
+		Return(block, nil)
+
+	s.Require().NoError(s.handler.Get(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+	s.Require().Empty(rec.Header().Get(echo.HeaderCacheControl))
+
+	var response responses.Block
+	err := json.NewDecoder(rec.Body).Decode(&response)
+	s.Require().NoError(err)
+	s.Require().Equal("finalized", response.Finality)
 }
 
 func (s *BlockTestSuite) TestGetWithStats() {
@@ -252,6 +278,80 @@ func (s *BlockTestSuite) TestList() {
 	s.Require().Equal("0x0764012270afacd3b101bcfadaaa9fc3190d04ed90ff22c0ee59781e54858a7d", blocks[0].TransactionsRootHash)
 }
 
+func (s *BlockTestSuite) TestListFinalized() {
+	q := make(url.Values)
+	q.Set("finality", "finalized")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/block")
+
+	s.state.EXPECT().
+		ByName(gomock.Any(), testIndexerName).
+		Return(storage.State{
+			LastHeight:      120,
+			SafeHeight:      110,
+			FinalizedHeight: 100,
+		}, nil).
+		Times(1)
+
+	maxHeight := uint64(100)
+	block := testBlock
+	block.Finality = types.Finalized
+	s.block.EXPECT().
+		Filter(gomock.Any(), storage.BlockListFilter{
+			Limit:     10,
+			Offset:    0,
+			Sort:      sdk.SortOrderAsc,
+			MaxHeight: &maxHeight,
+		}).
+		Return([]storage.Block{block}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.List(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var body struct {
+		Result []responses.Block `json:"result"`
+	}
+	err := json.NewDecoder(rec.Body).Decode(&body)
+	s.Require().NoError(err)
+	s.Require().Len(body.Result, 1)
+	s.Require().Equal("finalized", body.Result[0].Finality)
+}
+
+func (s *BlockTestSuite) TestListFinalityNotTracked() {
+	q := make(url.Values)
+	q.Set("finality", "finalized")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/block")
+
+	s.state.EXPECT().
+		ByName(gomock.Any(), testIndexerName).
+		Return(storage.State{LastHeight: 120}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.List(c))
+	s.Require().Equal(http.StatusServiceUnavailable, rec.Code)
+}
+
+func (s *BlockTestSuite) TestListInvalidFinality() {
+	q := make(url.Values)
+	q.Set("finality", "pending")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/block")
+
+	s.Require().NoError(s.handler.List(c))
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+}
+
 func (s *BlockTestSuite) TestListWithStats() {
 	q := make(url.Values)
 	q.Set("stats", "true")
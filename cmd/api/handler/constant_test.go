@@ -51,10 +51,11 @@ func (s *ConstantTestSuite) TestEnums() {
 	var enums responses.Enums
 	err := json.NewDecoder(rec.Body).Decode(&enums)
 	s.Require().NoError(err)
-	s.Require().Len(enums.TxType, 5)
+	s.Require().Len(enums.TxType, 6)
 	s.Require().Len(enums.TransferType, 4)
 	s.Require().Len(enums.TxStatus, 2)
 	s.Require().Len(enums.TraceType, 6)
 	s.Require().Len(enums.CallType, 4)
 	s.Require().Len(enums.TokenType, 3)
+	s.Require().Len(enums.Finality, 3)
 }
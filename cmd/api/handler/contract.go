@@ -6,15 +6,17 @@ import (
 	"github.com/NobleScope/noble-indexer/cmd/api/handler/responses"
 	"github.com/NobleScope/noble-indexer/cmd/api/helpers"
 	"github.com/NobleScope/noble-indexer/internal/storage"
+	internalTypes "github.com/NobleScope/noble-indexer/internal/storage/types"
 	"github.com/NobleScope/noble-indexer/pkg/types"
 	"github.com/labstack/echo/v4"
 )
 
 type ContractHandler struct {
-	address  storage.IAddress
-	contract storage.IContract
-	tx       storage.ITx
-	source   storage.ISource
+	address       storage.IAddress
+	contract      storage.IContract
+	tx            storage.ITx
+	source        storage.ISource
+	proxyUpgrades storage.IProxyUpgrade
 }
 
 func NewContractHandler(
@@ -22,12 +24,14 @@ func NewContractHandler(
 	address storage.IAddress,
 	tx storage.ITx,
 	source storage.ISource,
+	proxyUpgrades storage.IProxyUpgrade,
 ) *ContractHandler {
 	return &ContractHandler{
-		contract: contract,
-		address:  address,
-		tx:       tx,
-		source:   source,
+		contract:      contract,
+		address:       address,
+		tx:            tx,
+		source:        source,
+		proxyUpgrades: proxyUpgrades,
 	}
 }
 
@@ -296,3 +300,98 @@ func (handler *ContractHandler) GetCode(c echo.Context) error {
 
 	return c.JSON(http.StatusOK, responses.NewContractCode(contract, abi))
 }
+
+type getImplementationsRequest struct {
+	Hash   string      `param:"hash"   validate:"required,address"`
+	Limit  int         `query:"limit"  validate:"omitempty,min=1,max=100"`
+	Offset int         `query:"offset" validate:"omitempty,min=0"`
+	Sort   string      `query:"sort"   validate:"omitempty,oneof=asc desc"`
+	Type   StringArray `query:"type"   validate:"omitempty,dive,proxy_upgrade_type"`
+	Cursor string      `query:"cursor" validate:"omitempty"`
+}
+
+func (p *getImplementationsRequest) SetDefault() {
+	if p.Limit == 0 {
+		p.Limit = 10
+	}
+	if p.Sort == "" {
+		p.Sort = desc
+	}
+}
+
+// Implementations godoc
+//
+//	@Summary		Get proxy upgrade history
+//	@Description	Returns the timeline of EIP-1967 Upgraded, BeaconUpgraded and AdminChanged events emitted by the proxy contract.
+//	@Tags			contract
+//	@ID				get-contract-implementations
+//	@Param			hash	path	string	true	"Proxy contract address in hexadecimal format (e.g., 0x742d35Cc6634C0532925a3b844Bc9e7595f0bEb)"	minlength(42)	maxlength(42)
+//	@Param			limit	query	integer	false	"Number of events to return (default: 10)"														minimum(1)	maximum(100)	default(10)
+//	@Param			offset	query	integer	false	"Number of events to skip (default: 0)"															minimum(0)	default(0)
+//	@Param			sort	query	string	false	"Sort order (default: desc)"																	Enums(asc, desc)	default(desc)
+//	@Param			type	query	string	false	"Filter by event type (comma-separated list)"													Enums(upgraded, beacon_upgraded, admin_changed)
+//	@Param			cursor	query	string	false	"Opaque cursor for keyset pagination. Base64url-encoded value from the previous response's 'cursor' field. Encodes the id of the last returned record. Cannot be used together with offset (returns 400)."
+//	@Produce		json
+//	@Success		200	{object}	CursorResponse	"List of proxy upgrade events"
+//	@Success		204									"Contract not found"
+//	@Failure		400	{object}	Error				"Invalid request parameters"
+//	@Failure		500	{object}	Error				"Internal server error"
+//	@Router			/contracts/{hash}/implementations [get]
+func (handler *ContractHandler) Implementations(c echo.Context) error {
+	req, err := bindAndValidate[getImplementationsRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	req.SetDefault()
+
+	hash, err := types.HexFromString(req.Hash)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	address, err := handler.address.ByHash(c.Request().Context(), hash)
+	if err != nil {
+		return handleError(c, err, handler.address)
+	}
+
+	filter := storage.ProxyUpgradeListFilter{
+		ProxyId: address.Id,
+		Limit:   req.Limit,
+		Offset:  req.Offset,
+		Sort:    pgSort(req.Sort),
+	}
+	if len(req.Type) > 0 {
+		filter.Type = make([]internalTypes.ProxyUpgradeType, len(req.Type))
+		for i := range req.Type {
+			filter.Type[i] = internalTypes.ProxyUpgradeType(req.Type[i])
+		}
+	}
+
+	if req.Cursor != "" {
+		if req.Offset > 0 {
+			return badRequestError(c, errCursorWithOffset)
+		}
+		cursorID, err := helpers.DecodeIDCursor(req.Cursor)
+		if err != nil {
+			return badRequestError(c, err)
+		}
+		filter.CursorID = cursorID
+	}
+
+	upgrades, err := handler.proxyUpgrades.Filter(c.Request().Context(), filter)
+	if err != nil {
+		return handleError(c, err, handler.proxyUpgrades)
+	}
+
+	response := make([]responses.ProxyUpgrade, len(upgrades))
+	for i := range upgrades {
+		response[i] = responses.NewProxyUpgrade(upgrades[i])
+	}
+
+	var cursor string
+	if len(upgrades) > 0 {
+		cursor = helpers.EncodeIDCursor(upgrades[len(upgrades)-1].Id)
+	}
+
+	return returnCursorList(c, response, cursor)
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/NobleScope/noble-indexer/cmd/api/helpers"
 	"github.com/NobleScope/noble-indexer/internal/storage"
 	"github.com/NobleScope/noble-indexer/internal/storage/mock"
+	internalTypes "github.com/NobleScope/noble-indexer/internal/storage/types"
 	pkgTypes "github.com/NobleScope/noble-indexer/pkg/types"
 	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
 	"github.com/labstack/echo/v4"
@@ -25,13 +26,14 @@ import (
 type ContractTestSuite struct {
 	suite.Suite
 
-	echo     *echo.Echo
-	ctrl     *gomock.Controller
-	contract *mock.MockIContract
-	address  *mock.MockIAddress
-	tx       *mock.MockITx
-	source   *mock.MockISource
-	handler  *ContractHandler
+	echo          *echo.Echo
+	ctrl          *gomock.Controller
+	contract      *mock.MockIContract
+	address       *mock.MockIAddress
+	tx            *mock.MockITx
+	source        *mock.MockISource
+	proxyUpgrades *mock.MockIProxyUpgrade
+	handler       *ContractHandler
 }
 
 func (s *ContractTestSuite) SetupTest() {
@@ -43,8 +45,9 @@ func (s *ContractTestSuite) SetupTest() {
 	s.address = mock.NewMockIAddress(s.ctrl)
 	s.tx = mock.NewMockITx(s.ctrl)
 	s.source = mock.NewMockISource(s.ctrl)
+	s.proxyUpgrades = mock.NewMockIProxyUpgrade(s.ctrl)
 
-	s.handler = NewContractHandler(s.contract, s.address, s.tx, s.source)
+	s.handler = NewContractHandler(s.contract, s.address, s.tx, s.source, s.proxyUpgrades)
 }
 
 func (s *ContractTestSuite) TearDownTest() {
@@ -207,6 +210,34 @@ func (s *ContractTestSuite) TestContractGet() {
 	s.Require().Equal(http.StatusOK, rec.Code)
 }
 
+func (s *ContractTestSuite) TestContractGet_ConstructorArgs() {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/contract/:hash")
+	c.SetParamNames("hash")
+	c.SetParamValues(testAddressHex1.Hex())
+
+	contract := testContract
+	contract.ABI = json.RawMessage(`[{"inputs":[{"name":"supply","type":"uint256"}],"stateMutability":"nonpayable","type":"constructor"}]`)
+	contract.ConstructorArgs = pkgTypes.MustDecodeHex("00000000000000000000000000000000000000000000000000000000000003e8")
+	contract.MatchType = internalTypes.Full
+
+	s.contract.EXPECT().
+		ByHash(gomock.Any(), testAddressHex1).
+		Return(contract, nil)
+
+	err := s.handler.Get(c)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var response responses.Contract
+	s.Require().NoError(json.NewDecoder(rec.Body).Decode(&response))
+	s.Require().Equal("full", response.MatchType)
+	s.Require().Equal("0x00000000000000000000000000000000000000000000000000000000000003e8", response.ConstructorArgs)
+	s.Require().Equal(map[string]any{"supply": "1000"}, response.DecodedConstructorArgs)
+}
+
 func (s *ContractTestSuite) TestContractGet_InvalidHash() {
 	req := httptest.NewRequest(http.MethodGet, "/", nil)
 	rec := httptest.NewRecorder()
@@ -279,6 +310,45 @@ func (s *ContractTestSuite) TestContractSources() {
 	s.Require().Len(resp, 1)
 }
 
+func (s *ContractTestSuite) TestContractSources_Libraries() {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/contract/:hash/sources")
+	c.SetParamNames("hash")
+	c.SetParamValues(testAddressHex1.Hex())
+
+	s.contract.EXPECT().
+		ByHash(gomock.Any(), testAddressHex1).
+		Return(testContract, nil)
+
+	s.source.EXPECT().
+		Filter(gomock.Any(), gomock.Any()).
+		Return([]storage.Source{
+			{
+				Id:         1,
+				Name:       "Math.sol",
+				ContractId: testContract.Id,
+				Libraries: map[string]string{
+					"Math": "0x742d35cc6634c0532925a3b844bc9e7595f0beb0",
+				},
+			},
+		}, nil)
+
+	err := s.handler.ContractSources(c)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var body struct {
+		Result []responses.Source `json:"result"`
+	}
+	s.Require().NoError(json.NewDecoder(rec.Body).Decode(&body))
+	s.Require().Len(body.Result, 1)
+	s.Require().Equal(map[string]string{
+		"Math": "0x742d35cc6634c0532925a3b844bc9e7595f0beb0",
+	}, body.Result[0].Libraries)
+}
+
 func (s *ContractTestSuite) TestContractSources_WithLimit() {
 	q := make(url.Values)
 	q.Set("limit", "5")
@@ -779,3 +849,98 @@ func (s *ContractTestSuite) TestContractList_NoCursorOnNonIdSort() {
 	s.Require().Len(body.Result, 1)
 	s.Require().Empty(body.Cursor)
 }
+
+func (s *ContractTestSuite) TestContractImplementations() {
+	implementationId := testAddress2.Id
+	upgrade := storage.ProxyUpgrade{
+		Id:               7,
+		Height:           100,
+		Time:             testTime,
+		Type:             internalTypes.Upgraded,
+		ProxyId:          testAddress1.Id,
+		ImplementationId: &implementationId,
+		Implementation:   &storage.Address{Hash: testAddressHex2},
+		Tx:               storage.Tx{Hash: testTxHash},
+	}
+
+	q := make(url.Values)
+	q.Set("type", "upgraded,beacon_upgraded")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/contracts/:hash/implementations")
+	c.SetParamNames("hash")
+	c.SetParamValues(testAddressHex1.Hex())
+
+	s.address.EXPECT().
+		ByHash(gomock.Any(), testAddressHex1).
+		Return(testAddress1, nil).
+		Times(1)
+
+	s.proxyUpgrades.EXPECT().
+		Filter(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, filter storage.ProxyUpgradeListFilter) ([]storage.ProxyUpgrade, error) {
+			s.Require().EqualValues(testAddress1.Id, filter.ProxyId)
+			s.Require().Equal(10, filter.Limit)
+			s.Require().Equal(sdk.SortOrderDesc, filter.Sort)
+			s.Require().Equal([]internalTypes.ProxyUpgradeType{internalTypes.Upgraded, internalTypes.BeaconUpgraded}, filter.Type)
+			return []storage.ProxyUpgrade{upgrade}, nil
+		}).
+		Times(1)
+
+	s.Require().NoError(s.handler.Implementations(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var body struct {
+		Result []responses.ProxyUpgrade `json:"result"`
+		Cursor string                   `json:"cursor"`
+	}
+	s.Require().NoError(json.NewDecoder(rec.Body).Decode(&body))
+	s.Require().Len(body.Result, 1)
+	s.Require().Equal("upgraded", body.Result[0].Type)
+	s.Require().EqualValues(100, body.Result[0].Height)
+	s.Require().Equal(testTxHash.Hex(), body.Result[0].TxHash)
+	s.Require().NotNil(body.Result[0].Implementation)
+	s.Require().Equal(testAddressHex2.Hex(), *body.Result[0].Implementation)
+	s.Require().Nil(body.Result[0].Beacon)
+	s.Require().Nil(body.Result[0].Admin)
+	s.Require().Equal(helpers.EncodeIDCursor(7), body.Cursor)
+}
+
+func (s *ContractTestSuite) TestContractImplementationsNoContent() {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/contracts/:hash/implementations")
+	c.SetParamNames("hash")
+	c.SetParamValues(testAddressHex3.Hex())
+
+	s.address.EXPECT().
+		ByHash(gomock.Any(), testAddressHex3).
+		Return(storage.Address{}, sql.ErrNoRows).
+		Times(1)
+
+	s.address.EXPECT().
+		IsNoRows(sql.ErrNoRows).
+		Return(true).
+		Times(1)
+
+	s.Require().NoError(s.handler.Implementations(c))
+	s.Require().Equal(http.StatusNoContent, rec.Code)
+}
+
+func (s *ContractTestSuite) TestContractImplementationsInvalidType() {
+	q := make(url.Values)
+	q.Set("type", "unknown")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/contracts/:hash/implementations")
+	c.SetParamNames("hash")
+	c.SetParamValues(testAddressHex1.Hex())
+
+	s.Require().NoError(s.handler.Implementations(c))
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+}
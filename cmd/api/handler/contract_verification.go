@@ -3,13 +3,18 @@ package handler
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"io"
+	"mime/multipart"
 	"net/http"
 	"strings"
 
+	"github.com/NobleScope/noble-indexer/cmd/api/handler/responses"
 	"github.com/NobleScope/noble-indexer/internal/storage"
 	"github.com/NobleScope/noble-indexer/internal/storage/postgres"
 	storageTypes "github.com/NobleScope/noble-indexer/internal/storage/types"
+	"github.com/NobleScope/noble-indexer/pkg/contract_verifier/compilers"
+	"github.com/NobleScope/noble-indexer/pkg/contract_verifier/standard_json"
 	"github.com/NobleScope/noble-indexer/pkg/types"
 	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
 	"github.com/labstack/echo/v4"
@@ -27,22 +32,30 @@ type uploadedSourceFile struct {
 }
 
 type ContractVerificationHandler struct {
-	contract storage.IContract
-	task     storage.IVerificationTask
-	file     storage.IVerificationFile
-	beginTx  func(context.Context) (storage.Transaction, error)
+	contract  storage.IContract
+	task      storage.IVerificationTask
+	file      storage.IVerificationFile
+	compilers *compilers.Registry
+	vyper     *compilers.Registry
+	beginTx   func(context.Context) (storage.Transaction, error)
 }
 
+// NewContractVerificationHandler creates the handler. Compilers may be nil if the verifier downloads solc on demand.
+// Vyper may be nil if the build list of vyper versions isn't available to the API.
 func NewContractVerificationHandler(
 	contract storage.IContract,
 	task storage.IVerificationTask,
 	file storage.IVerificationFile,
 	transactable sdk.Transactable,
+	compilers *compilers.Registry,
+	vyper *compilers.Registry,
 ) *ContractVerificationHandler {
 	return &ContractVerificationHandler{
-		contract: contract,
-		task:     task,
-		file:     file,
+		contract:  contract,
+		task:      task,
+		file:      file,
+		compilers: compilers,
+		vyper:     vyper,
 		beginTx: func(ctx context.Context) (storage.Transaction, error) {
 			return postgres.BeginTransaction(ctx, transactable)
 		},
@@ -51,34 +64,108 @@ func NewContractVerificationHandler(
 
 type verificationRequest struct {
 	ContractAddress     string  `form:"contract_address"     validate:"required,address"`
-	ContractName        string  `form:"contract_name"        validate:"required,contract_name"`
+	ContractName        string  `form:"contract_name"        validate:"required,contract_name|fully_qualified_name"`
 	CompilerVersion     string  `form:"compiler_version"     validate:"required,compiler_version"`
 	LicenseType         string  `form:"license_type"         validate:"required,license_type"`
 	OptimizationEnabled *bool   `form:"optimization_enabled"`
 	OptimizationRuns    *uint   `form:"optimization_runs"`
 	EVMVersion          *string `form:"evm_version"          validate:"omitempty,evm_version"`
 	ViaIR               bool    `form:"via_ir"`
+	Language            string  `form:"language"             validate:"omitempty,language"`
+	OptimizationMode    *string `form:"optimization_mode"    validate:"omitempty,oneof=gas codesize none"`
+	Libraries           string  `form:"libraries"            validate:"omitempty,json"`
+}
+
+var sourceExtensions = map[storageTypes.Language][]string{
+	storageTypes.Solidity: {".sol"},
+	storageTypes.Vyper:    {".vy", ".vyi"},
+}
+
+func hasSourceExtension(language storageTypes.Language, filename string) bool {
+	filename = strings.ToLower(filename)
+	for _, ext := range sourceExtensions[language] {
+		if strings.HasSuffix(filename, ext) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseLibraries parses the JSON object of library addresses by short or fully-qualified library names
+func parseLibraries(raw string) (map[string]string, error) {
+	if raw == "" {
+		return nil, nil
+	}
+
+	var libraries map[string]string
+	if err := json.Unmarshal([]byte(raw), &libraries); err != nil {
+		return nil, errors.Wrap(err, "libraries must be a JSON object of library addresses by library names")
+	}
+	for name, address := range libraries {
+		if !storageTypes.ContractNameRe.MatchString(name) {
+			if _, _, err := standard_json.SplitContractName(name); err != nil {
+				return nil, errors.Errorf("invalid library name: %s", name)
+			}
+		}
+		if !evmAddressRegex.MatchString(address) {
+			return nil, errors.Errorf("invalid address of library %s: %s", name, address)
+		}
+		libraries[name] = strings.ToLower(address)
+	}
+	return libraries, nil
+}
+
+func readUploadedFile(fileHeader *multipart.FileHeader) (uploadedSourceFile, error) {
+	file, err := fileHeader.Open()
+	if err != nil {
+		return uploadedSourceFile{}, errors.Wrapf(err, "failed to open file %s", fileHeader.Filename)
+	}
+
+	content, err := io.ReadAll(io.LimitReader(file, MaxFileSize+1))
+	_ = file.Close()
+	if err != nil {
+		return uploadedSourceFile{}, errors.Wrapf(err, "failed to read file %s", fileHeader.Filename)
+	}
+
+	if len(content) > MaxFileSize {
+		return uploadedSourceFile{}, errors.Errorf("file %s is too large, maximum size is 10 MB", fileHeader.Filename)
+	}
+
+	if len(content) == 0 {
+		return uploadedSourceFile{}, errors.Errorf("file %s is empty", fileHeader.Filename)
+	}
+
+	return uploadedSourceFile{
+		name:    fileHeader.Filename,
+		content: content,
+	}, nil
 }
 
 type verificationResponse struct {
 	Result string `json:"result"`
+	TaskId uint64 `json:"task_id"`
 }
 
 // ContractVerify godoc
 //
 //	@Summary		Creates a task to verify the specified contract
 //	@Description	Creates a task to verify the specified contract with source code files. Multiple .sol files can be uploaded.
+//	@Description	Alternatively a single solc standard JSON input produced by Hardhat or Foundry can be uploaded. Compiler settings are taken from the input then.
 //	@Tags			verification
 //	@ID				contract-verification
 //	@Param			contract_address	formData string true  "Contract address"
-//	@Param			contract_name       formData string true  "Contract name in Solidity source"
-//	@Param			source_code         formData file   true  "Source code files (.sol). Multiple files allowed."
+//	@Param			contract_name       formData string true  "Contract name in Solidity source or Vyper file name without extension. Fully-qualified name (path:Contract) for standard JSON input."
+//	@Param			source_code         formData file   false "Source code files (.sol for Solidity, .vy and .vyi for Vyper). Multiple files allowed."
+//	@Param			standard_json       formData file   false "Solc or Vyper standard JSON input. Can't be used together with source code files and compiler settings."
+//	@Param			language            formData string false "Source code language. Solidity by default."	Enums(solidity, vyper)
 //	@Param			compiler_version    formData string true  "Compiler version"
 //	@Param			license_type		formData string true  "License type"										Enums(none, unlicense, mit, gnu_gpl_v2, gnu_gpl_v3, gnu_lgpl_v2_1, gnu_lgpl_v3, bsd_2_clause, bsd_3_clause, mpl_2_0, osl_3_0, apache_2_0, gnu_agpl_v3, bsl_1_1)
 //	@Param			optimization_enabled formData bool   false "Optimization enabled"
 //	@Param			optimization_runs   formData int    false "Optimization runs"
 //	@Param			evm_version         formData string false "EVM version. Auto-detected if not specified."		Enums(homestead, tangerineWhistle, spuriousDragon, byzantium, constantinople, petersburg, istanbul, berlin, london, paris, shanghai, cancun, prague, osaka)
 //	@Param			via_ir              formData bool   false "Compile via Yul IR pipeline"
+//	@Param			optimization_mode   formData string false "Vyper optimization mode"	Enums(gas, codesize, none)
+//	@Param			libraries           formData string false "JSON object of Solidity library addresses by short or fully-qualified library names. Addresses of missing libraries are detected from the onchain bytecode."
 //	@Accept			multipart/form-data
 //	@Produce		json
 //	@Success		200	{object}	verificationResponse
@@ -96,6 +183,14 @@ func (handler *ContractVerificationHandler) ContractVerify(c echo.Context) error
 		return badRequestError(c, errors.Wrap(err, "invalid license type"))
 	}
 
+	language := storageTypes.Solidity
+	if req.Language != "" {
+		language, err = storageTypes.ParseLanguage(req.Language)
+		if err != nil {
+			return badRequestError(c, errors.Wrap(err, "invalid language"))
+		}
+	}
+
 	var evmVersion *storageTypes.EVMVersion
 	if req.EVMVersion != nil {
 		v, err := storageTypes.ParseEVMVersion(*req.EVMVersion)
@@ -110,53 +205,117 @@ func (handler *ContractVerificationHandler) ContractVerify(c echo.Context) error
 		return badRequestError(c, errors.New("failed to parse multipart form"))
 	}
 
-	fileHeaders := form.File["source_code"]
-	if len(fileHeaders) == 0 {
-		return badRequestError(c, errors.New("at least one source code file is required"))
-	}
-	if len(fileHeaders) > MaxFileCount {
-		return badRequestError(c, errors.Errorf("too many files, maximum is %d", MaxFileCount))
-	}
-
-	sourceFiles := make([]uploadedSourceFile, 0, len(fileHeaders))
-	foundPragma := false
-
-	for _, fileHeader := range fileHeaders {
-		if !strings.HasSuffix(strings.ToLower(fileHeader.Filename), ".sol") {
-			return badRequestError(c, errors.Errorf("only .sol files are allowed: %s", fileHeader.Filename))
+	var (
+		sourceFiles  []uploadedSourceFile
+		standardJSON *standard_json.Input
+	)
+	switch standardJSONHeaders := form.File["standard_json"]; {
+	case len(standardJSONHeaders) > 0:
+		if len(form.File["source_code"]) > 0 {
+			return badRequestError(c, errors.New("source code files can't be uploaded together with standard JSON input"))
+		}
+		if len(standardJSONHeaders) > 1 {
+			return badRequestError(c, errors.New("only one standard JSON input is allowed"))
+		}
+		if req.OptimizationEnabled != nil || req.OptimizationRuns != nil || req.EVMVersion != nil || req.ViaIR || req.OptimizationMode != nil || req.Libraries != "" {
+			return badRequestError(c, errors.New("compiler settings must be specified in the standard JSON input"))
 		}
 
-		file, err := fileHeader.Open()
+		file, err := readUploadedFile(standardJSONHeaders[0])
 		if err != nil {
-			return badRequestError(c, errors.Wrapf(err, "failed to open file %s", fileHeader.Filename))
+			return badRequestError(c, err)
 		}
-
-		content, err := io.ReadAll(io.LimitReader(file, MaxFileSize+1))
-		_ = file.Close()
+		input, err := standard_json.Parse(file.content)
 		if err != nil {
-			return badRequestError(c, errors.Wrapf(err, "failed to read file %s", fileHeader.Filename))
+			return badRequestError(c, err)
+		}
+		if err := input.HasContract(req.ContractName); err != nil {
+			return badRequestError(c, err)
+		}
+		inputLanguage := storageTypes.Solidity
+		if input.Language == standard_json.LanguageVyper {
+			inputLanguage = storageTypes.Vyper
+		}
+		if req.Language != "" && inputLanguage != language {
+			return badRequestError(c, errors.Errorf("language %s doesn't match the standard JSON input language %s", language, input.Language))
+		}
+		language = inputLanguage
+		if input.Settings.EVMVersion != "" {
+			v, err := storageTypes.ParseEVMVersion(input.Settings.EVMVersion)
+			if err != nil {
+				return badRequestError(c, errors.Wrap(err, "invalid EVM version"))
+			}
+			evmVersion = &v
+		}
+		sourceFiles = []uploadedSourceFile{file}
+		standardJSON = &input
+	default:
+		if !storageTypes.ContractNameRe.MatchString(req.ContractName) {
+			return badRequestError(c, errors.New("fully-qualified contract name is allowed only with standard JSON input"))
 		}
 
-		if len(content) > MaxFileSize {
-			return badRequestError(c, errors.Errorf("file %s is too large, maximum size is 10 MB", fileHeader.Filename))
+		fileHeaders := form.File["source_code"]
+		if len(fileHeaders) == 0 {
+			return badRequestError(c, errors.New("at least one source code file is required"))
+		}
+		if len(fileHeaders) > MaxFileCount {
+			return badRequestError(c, errors.Errorf("too many files, maximum is %d", MaxFileCount))
 		}
 
-		if len(content) == 0 {
-			return badRequestError(c, errors.Errorf("file %s is empty", fileHeader.Filename))
+		switch language {
+		case storageTypes.Vyper:
+			if req.OptimizationEnabled != nil || req.OptimizationRuns != nil || req.ViaIR {
+				return badRequestError(c, errors.New("vyper optimization is configured by optimization_mode"))
+			}
+			if req.Libraries != "" {
+				return badRequestError(c, errors.New("libraries are allowed only for solidity"))
+			}
+		default:
+			if req.OptimizationMode != nil {
+				return badRequestError(c, errors.New("optimization_mode is allowed only for vyper"))
+			}
 		}
 
-		if bytes.Contains(content, []byte("pragma solidity")) {
-			foundPragma = true
+		sourceFiles = make([]uploadedSourceFile, 0, len(fileHeaders))
+		foundPragma := false
+
+		for _, fileHeader := range fileHeaders {
+			if !hasSourceExtension(language, fileHeader.Filename) {
+				return badRequestError(c, errors.Errorf("only %s files are allowed: %s", strings.Join(sourceExtensions[language], ", "), fileHeader.Filename))
+			}
+
+			file, err := readUploadedFile(fileHeader)
+			if err != nil {
+				return badRequestError(c, err)
+			}
+
+			if bytes.Contains(file.content, []byte("pragma solidity")) {
+				foundPragma = true
+			}
+
+			sourceFiles = append(sourceFiles, file)
 		}
 
-		sourceFiles = append(sourceFiles, uploadedSourceFile{
-			name:    fileHeader.Filename,
-			content: content,
-		})
+		if language == storageTypes.Solidity && !foundPragma {
+			return badRequestError(c, errors.New("at least one file must contain 'pragma solidity'"))
+		}
+	}
+
+	libraries, err := parseLibraries(req.Libraries)
+	if err != nil {
+		return badRequestError(c, err)
 	}
 
-	if !foundPragma {
-		return badRequestError(c, errors.New("at least one file must contain 'pragma solidity'"))
+	switch {
+	case language == storageTypes.Solidity && handler.compilers != nil:
+		if _, ok := handler.compilers.Find(req.CompilerVersion); !ok {
+			return badRequestError(c, errors.Errorf("compiler %s is not installed, see the list of available compilers", req.CompilerVersion))
+		}
+	case language == storageTypes.Vyper && handler.vyper != nil:
+		// pinned vyper versions are downloaded by the verifier on demand
+		if _, ok := handler.vyper.Pinned(req.CompilerVersion); !ok {
+			return badRequestError(c, errors.Errorf("compiler %s is not installed, see the list of available compilers", req.CompilerVersion))
+		}
 	}
 
 	hash, err := types.HexFromString(req.ContractAddress)
@@ -199,6 +358,29 @@ func (handler *ContractVerificationHandler) ContractVerify(c echo.Context) error
 		OptimizationRuns:    req.OptimizationRuns,
 		EVMVersion:          evmVersion,
 		ViaIR:               req.ViaIR,
+		Language:            language,
+		OptimizationMode:    req.OptimizationMode,
+		Libraries:           libraries,
+	}
+	if standardJSON != nil {
+		newTask.StandardJSON = true
+		newTask.ViaIR = standardJSON.Settings.ViaIR
+		if optimizer := standardJSON.Settings.Optimizer; optimizer != nil {
+			newTask.OptimizationEnabled = &optimizer.Enabled
+			if optimizer.Runs != nil {
+				runs := uint(*optimizer.Runs)
+				newTask.OptimizationRuns = &runs
+			}
+		}
+		if language == storageTypes.Vyper {
+			mode, err := standardJSON.Settings.OptimizationMode()
+			if err != nil {
+				return badRequestError(c, err)
+			}
+			if mode != "" {
+				newTask.OptimizationMode = &mode
+			}
+		}
 	}
 
 	ctx := c.Request().Context()
@@ -230,5 +412,97 @@ func (handler *ContractVerificationHandler) ContractVerify(c echo.Context) error
 		return handleError(c, err, handler.task)
 	}
 
-	return c.JSON(http.StatusOK, verificationResponse{Result: "success"})
+	return c.JSON(http.StatusOK, verificationResponse{Result: "success", TaskId: newTask.Id})
+}
+
+type verificationTaskRequest struct {
+	Id uint64 `param:"id" validate:"required,min=1"`
+}
+
+// Task godoc
+//
+//	@Summary		Get verification task by ID
+//	@Description	Returns status of the verification task with timings, error and compiler output of the failed compilation
+//	@Tags			verification
+//	@ID				get-verification-task
+//	@Param			id	path	integer	true	"Verification task ID"	minimum(1)	example(1)
+//	@Produce		json
+//	@Success		200	{object}	responses.VerificationTask	"Verification task"
+//	@Success		204											"Task not found"
+//	@Failure		400	{object}	Error						"Invalid task ID"
+//	@Failure		500	{object}	Error						"Internal server error"
+//	@Router			/verification/tasks/{id} [get]
+func (handler *ContractVerificationHandler) Task(c echo.Context) error {
+	req, err := bindAndValidate[verificationTaskRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	task, err := handler.task.ById(c.Request().Context(), req.Id)
+	if err != nil {
+		return handleError(c, err, handler.task)
+	}
+
+	return c.JSON(http.StatusOK, responses.NewVerificationTask(task))
+}
+
+// ContractVerification godoc
+//
+//	@Summary		Get the latest verification task of the contract
+//	@Description	Returns status of the latest verification task of the contract with timings, error and compiler output of the failed compilation
+//	@Tags			verification
+//	@ID				get-contract-verification
+//	@Param			hash	path	string	true	"Contract address in hexadecimal format (e.g., 0x742d35Cc6634C0532925a3b844Bc9e7595f0bEb)"	minlength(42)	maxlength(42)
+//	@Produce		json
+//	@Success		200	{object}	responses.VerificationTask	"Verification task"
+//	@Success		204											"Contract or task not found"
+//	@Failure		400	{object}	Error						"Invalid contract address"
+//	@Failure		500	{object}	Error						"Internal server error"
+//	@Router			/contracts/{hash}/verification [get]
+func (handler *ContractVerificationHandler) ContractVerification(c echo.Context) error {
+	req, err := bindAndValidate[getByHashRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	hash, err := types.HexFromString(req.Hash)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	contract, err := handler.contract.ByHash(c.Request().Context(), hash)
+	if err != nil {
+		return handleError(c, err, handler.contract)
+	}
+
+	task, err := handler.task.LatestByContractId(c.Request().Context(), contract.Id)
+	if err != nil {
+		return handleError(c, err, handler.task)
+	}
+
+	return c.JSON(http.StatusOK, responses.NewVerificationTask(task))
+}
+
+// Compilers godoc
+//
+//	@Summary		List of installed compilers
+//	@Description	Returns solc versions installed to the verifier and vyper versions pinned by the verifier from the newest to the oldest. Solc versions are omitted if they are downloaded on demand.
+//	@Tags			verification
+//	@ID				list-verification-compilers
+//	@Produce		json
+//	@Success		200	{array}		responses.Compiler
+//	@Router			/verification/compilers [get]
+func (handler *ContractVerificationHandler) Compilers(c echo.Context) error {
+	response := make([]responses.Compiler, 0)
+	if handler.compilers != nil {
+		for _, build := range handler.compilers.Versions() {
+			response = append(response, responses.NewCompiler(build, storageTypes.Solidity.String()))
+		}
+	}
+	if handler.vyper != nil {
+		for _, build := range handler.vyper.Builds() {
+			response = append(response, responses.NewCompiler(build, storageTypes.Vyper.String()))
+		}
+	}
+	return c.JSON(http.StatusOK, response)
 }
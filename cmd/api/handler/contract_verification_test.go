@@ -8,11 +8,16 @@ import (
 	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
+	"github.com/NobleScope/noble-indexer/cmd/api/handler/responses"
 	"github.com/NobleScope/noble-indexer/internal/storage"
 	"github.com/NobleScope/noble-indexer/internal/storage/mock"
 	"github.com/NobleScope/noble-indexer/internal/storage/types"
+	"github.com/NobleScope/noble-indexer/pkg/contract_verifier/compilers"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/mock/gomock"
@@ -40,7 +45,7 @@ func (s *ContractVerificationTestSuite) SetupTest() {
 	s.file = mock.NewMockIVerificationFile(s.ctrl)
 	s.tx = mock.NewMockTransaction(s.ctrl)
 
-	s.handler = NewContractVerificationHandler(s.contract, s.task, s.file, nil)
+	s.handler = NewContractVerificationHandler(s.contract, s.task, s.file, nil, nil, nil)
 	s.handler.beginTx = func(_ context.Context) (storage.Transaction, error) {
 		return s.tx, nil
 	}
@@ -462,6 +467,7 @@ func (s *ContractVerificationTestSuite) TestContractVerify_Success() {
 			s.Require().Equal("0.8.20", task.CompilerVersion)
 			s.Require().Equal(types.VerificationStatusNew, task.Status)
 			s.Require().Equal(testContract.Id, task.ContractId)
+			s.Require().Equal(types.Solidity, task.Language)
 			task.Id = 1
 			return nil
 		})
@@ -485,6 +491,7 @@ func (s *ContractVerificationTestSuite) TestContractVerify_Success() {
 	var resp verificationResponse
 	s.Require().NoError(json.NewDecoder(rec.Body).Decode(&resp))
 	s.Require().Equal("success", resp.Result)
+	s.Require().EqualValues(1, resp.TaskId)
 }
 
 func (s *ContractVerificationTestSuite) TestContractVerify_SuccessWithOptionalParams() {
@@ -537,6 +544,71 @@ func (s *ContractVerificationTestSuite) TestContractVerify_SuccessWithOptionalPa
 	s.Require().Equal(http.StatusOK, rec.Code)
 }
 
+func (s *ContractVerificationTestSuite) TestContractVerify_Libraries() {
+	fields := s.validFields()
+	fields["libraries"] = `{"Math":"0x742D35CC6634c0532925A3b844BC9E7595F0BEb0","src/Lib.sol:Strings":"0x0000000000000000000000000000000000000001"}`
+
+	req, err := createMultipartRequest(fields, s.validFiles())
+	s.Require().NoError(err)
+
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+
+	s.contract.EXPECT().
+		ByHash(gomock.Any(), testAddressHex3).
+		Return(testContract, nil)
+
+	s.task.EXPECT().
+		ByContractId(gomock.Any(), testContract.Id).
+		Return(nil, sql.ErrNoRows)
+	s.task.EXPECT().
+		IsNoRows(sql.ErrNoRows).
+		Return(true)
+
+	s.tx.EXPECT().
+		AddVerificationTask(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, task *storage.VerificationTask) error {
+			s.Require().Equal(map[string]string{
+				"Math":                "0x742d35cc6634c0532925a3b844bc9e7595f0beb0",
+				"src/Lib.sol:Strings": "0x0000000000000000000000000000000000000001",
+			}, task.Libraries)
+			task.Id = 1
+			return nil
+		})
+
+	s.tx.EXPECT().
+		SaveVerificationFiles(gomock.Any(), gomock.Any()).
+		Return(nil)
+
+	s.tx.EXPECT().Flush(gomock.Any()).Return(nil)
+	s.tx.EXPECT().Close(gomock.Any()).Return(nil)
+
+	err = s.handler.ContractVerify(c)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, rec.Code)
+}
+
+func (s *ContractVerificationTestSuite) TestContractVerify_InvalidLibraries() {
+	for _, libraries := range []string{
+		`["Math"]`,
+		`{"Math":"0x1234"}`,
+		`{"Ma;th":"0x742d35cc6634c0532925a3b844bc9e7595f0beb0"}`,
+	} {
+		fields := s.validFields()
+		fields["libraries"] = libraries
+
+		req, err := createMultipartRequest(fields, s.validFiles())
+		s.Require().NoError(err)
+
+		rec := httptest.NewRecorder()
+		c := s.echo.NewContext(req, rec)
+
+		err = s.handler.ContractVerify(c)
+		s.Require().NoError(err)
+		s.Require().Equal(http.StatusBadRequest, rec.Code, libraries)
+	}
+}
+
 func (s *ContractVerificationTestSuite) TestContractVerify_MultipleFiles() {
 	fields := s.validFields()
 
@@ -639,6 +711,7 @@ func (s *ContractVerificationTestSuite) TestContractVerify_FailedTaskExistsThenN
 	var resp verificationResponse
 	s.Require().NoError(json.NewDecoder(rec.Body).Decode(&resp))
 	s.Require().Equal("success", resp.Result)
+	s.Require().EqualValues(2, resp.TaskId)
 }
 
 // --- File size validation ---
@@ -665,3 +738,713 @@ func (s *ContractVerificationTestSuite) TestContractVerify_FileTooLarge() {
 	s.Require().NoError(json.NewDecoder(rec.Body).Decode(&resp))
 	s.Require().Contains(resp.Message, "too large")
 }
+
+// --- Task status ---
+
+func (s *ContractVerificationTestSuite) TestTask() {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/verification/tasks/:id")
+	c.SetParamNames("id")
+	c.SetParamValues("3")
+
+	creationTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	startTime := creationTime.Add(time.Second)
+	completionTime := creationTime.Add(5 * time.Second)
+	s.task.EXPECT().
+		ById(gomock.Any(), uint64(3)).
+		Return(storage.VerificationTask{
+			Id:              3,
+			Status:          types.VerificationStatusFailed,
+			CreationTime:    creationTime,
+			StartTime:       &startTime,
+			CompletionTime:  &completionTime,
+			ContractId:      testContract.Id,
+			ContractName:    "TestContract",
+			CompilerVersion: "0.8.20",
+			Error:           "compile contract: compilation failed",
+			CompilerOutput:  "Error: Expected ';' but got '}'",
+			Address:         storage.Address{Hash: testAddressHex3},
+		}, nil)
+
+	err := s.handler.Task(c)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var resp responses.VerificationTask
+	s.Require().NoError(json.NewDecoder(rec.Body).Decode(&resp))
+	s.Require().EqualValues(3, resp.Id)
+	s.Require().Equal(testAddressHex3.Hex(), resp.Contract)
+	s.Require().Equal(types.VerificationStatusFailed.String(), resp.Status)
+	s.Require().Equal("TestContract", resp.ContractName)
+	s.Require().NotNil(resp.StartTime)
+	s.Require().True(startTime.Equal(*resp.StartTime))
+	s.Require().NotNil(resp.CompletionTime)
+	s.Require().True(completionTime.Equal(*resp.CompletionTime))
+	s.Require().Equal("compile contract: compilation failed", resp.Error)
+	s.Require().Equal("Error: Expected ';' but got '}'", resp.CompilerOutput)
+}
+
+func (s *ContractVerificationTestSuite) TestTask_NotFound() {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/verification/tasks/:id")
+	c.SetParamNames("id")
+	c.SetParamValues("100")
+
+	s.task.EXPECT().
+		ById(gomock.Any(), uint64(100)).
+		Return(storage.VerificationTask{}, sql.ErrNoRows)
+	s.task.EXPECT().
+		IsNoRows(sql.ErrNoRows).
+		Return(true)
+
+	err := s.handler.Task(c)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusNoContent, rec.Code)
+}
+
+func (s *ContractVerificationTestSuite) TestTask_InvalidId() {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/verification/tasks/:id")
+	c.SetParamNames("id")
+	c.SetParamValues("0")
+
+	err := s.handler.Task(c)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (s *ContractVerificationTestSuite) TestContractVerification() {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/contracts/:hash/verification")
+	c.SetParamNames("hash")
+	c.SetParamValues(testAddressHex3.Hex())
+
+	s.contract.EXPECT().
+		ByHash(gomock.Any(), testAddressHex3).
+		Return(testContract, nil)
+
+	s.task.EXPECT().
+		LatestByContractId(gomock.Any(), testContract.Id).
+		Return(storage.VerificationTask{
+			Id:              5,
+			Status:          types.VerificationStatusPending,
+			ContractId:      testContract.Id,
+			ContractName:    "TestContract",
+			CompilerVersion: "0.8.20",
+			Address:         storage.Address{Hash: testAddressHex3},
+		}, nil)
+
+	err := s.handler.ContractVerification(c)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var resp responses.VerificationTask
+	s.Require().NoError(json.NewDecoder(rec.Body).Decode(&resp))
+	s.Require().EqualValues(5, resp.Id)
+	s.Require().Equal(types.VerificationStatusPending.String(), resp.Status)
+	s.Require().Nil(resp.CompletionTime)
+	s.Require().Empty(resp.Error)
+}
+
+func (s *ContractVerificationTestSuite) TestContractVerification_NoTasks() {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/contracts/:hash/verification")
+	c.SetParamNames("hash")
+	c.SetParamValues(testAddressHex3.Hex())
+
+	s.contract.EXPECT().
+		ByHash(gomock.Any(), testAddressHex3).
+		Return(testContract, nil)
+
+	s.task.EXPECT().
+		LatestByContractId(gomock.Any(), testContract.Id).
+		Return(storage.VerificationTask{}, sql.ErrNoRows)
+	s.task.EXPECT().
+		IsNoRows(sql.ErrNoRows).
+		Return(true)
+
+	err := s.handler.ContractVerification(c)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusNoContent, rec.Code)
+}
+
+// --- Standard JSON input ---
+
+const testStandardJSONInput = `{
+	"language": "Solidity",
+	"sources": {
+		"src/TestContract.sol": {"content": "pragma solidity ^0.8.20;\nimport \"@lib/Base.sol\";\ncontract TestContract is Base {}"},
+		"lib/Base.sol": {"content": "pragma solidity ^0.8.20;\ncontract Base {}"}
+	},
+	"settings": {
+		"remappings": ["@lib/=lib/"],
+		"optimizer": {"enabled": true, "runs": 10000},
+		"evmVersion": "cancun",
+		"viaIR": true
+	}
+}`
+
+func (s *ContractVerificationTestSuite) standardJSONFields() map[string]string {
+	fields := s.validFields()
+	fields["contract_name"] = "src/TestContract.sol:TestContract"
+	return fields
+}
+
+// createStandardJSONRequest builds a multipart/form-data request with the given fields and standard JSON input.
+func createStandardJSONRequest(fields map[string]string, input string, sourceFiles map[string][]byte) (*http.Request, error) {
+	body := new(bytes.Buffer)
+	writer := multipart.NewWriter(body)
+
+	for key, val := range fields {
+		if err := writer.WriteField(key, val); err != nil {
+			return nil, err
+		}
+	}
+
+	part, err := writer.CreateFormFile("standard_json", "input.json")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := part.Write([]byte(input)); err != nil {
+		return nil, err
+	}
+
+	for name, content := range sourceFiles {
+		part, err := writer.CreateFormFile("source_code", name)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := part.Write(content); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/", body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	return req, nil
+}
+
+func (s *ContractVerificationTestSuite) TestContractVerify_StandardJSON() {
+	req, err := createStandardJSONRequest(s.standardJSONFields(), testStandardJSONInput, nil)
+	s.Require().NoError(err)
+
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+
+	s.contract.EXPECT().
+		ByHash(gomock.Any(), testAddressHex3).
+		Return(testContract, nil)
+
+	s.task.EXPECT().
+		ByContractId(gomock.Any(), testContract.Id).
+		Return(nil, nil)
+
+	s.tx.EXPECT().
+		AddVerificationTask(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, task *storage.VerificationTask) error {
+			s.Require().True(task.StandardJSON)
+			s.Require().Equal("src/TestContract.sol:TestContract", task.ContractName)
+			s.Require().NotNil(task.OptimizationEnabled)
+			s.Require().True(*task.OptimizationEnabled)
+			s.Require().NotNil(task.OptimizationRuns)
+			s.Require().EqualValues(10000, *task.OptimizationRuns)
+			s.Require().NotNil(task.EVMVersion)
+			s.Require().Equal(types.Cancun, *task.EVMVersion)
+			s.Require().True(task.ViaIR)
+			task.Id = 3
+			return nil
+		})
+
+	s.tx.EXPECT().
+		SaveVerificationFiles(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, files ...*storage.VerificationFile) error {
+			s.Require().Len(files, 1)
+			s.Require().Equal("input.json", files[0].Name)
+			s.Require().JSONEq(testStandardJSONInput, string(files[0].File))
+			s.Require().Equal(uint64(3), files[0].VerificationTaskId)
+			return nil
+		})
+
+	s.tx.EXPECT().Flush(gomock.Any()).Return(nil)
+	s.tx.EXPECT().Close(gomock.Any()).Return(nil)
+
+	err = s.handler.ContractVerify(c)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var resp verificationResponse
+	s.Require().NoError(json.NewDecoder(rec.Body).Decode(&resp))
+	s.Require().Equal("success", resp.Result)
+	s.Require().EqualValues(3, resp.TaskId)
+}
+
+func (s *ContractVerificationTestSuite) TestContractVerify_StandardJSONWithSourceFiles() {
+	req, err := createStandardJSONRequest(s.standardJSONFields(), testStandardJSONInput, s.validFiles())
+	s.Require().NoError(err)
+
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+
+	err = s.handler.ContractVerify(c)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+
+	var resp Error
+	s.Require().NoError(json.NewDecoder(rec.Body).Decode(&resp))
+	s.Require().Contains(resp.Message, "can't be uploaded together")
+}
+
+func (s *ContractVerificationTestSuite) TestContractVerify_StandardJSONWithCompilerSettings() {
+	fields := s.standardJSONFields()
+	fields["optimization_enabled"] = "true"
+
+	req, err := createStandardJSONRequest(fields, testStandardJSONInput, nil)
+	s.Require().NoError(err)
+
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+
+	err = s.handler.ContractVerify(c)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+
+	var resp Error
+	s.Require().NoError(json.NewDecoder(rec.Body).Decode(&resp))
+	s.Require().Contains(resp.Message, "compiler settings must be specified in the standard JSON input")
+}
+
+func (s *ContractVerificationTestSuite) TestContractVerify_StandardJSONWithLibraries() {
+	fields := s.standardJSONFields()
+	fields["libraries"] = `{"Math":"0x742d35cc6634c0532925a3b844bc9e7595f0beb0"}`
+
+	req, err := createStandardJSONRequest(fields, testStandardJSONInput, nil)
+	s.Require().NoError(err)
+
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+
+	err = s.handler.ContractVerify(c)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+
+	var resp Error
+	s.Require().NoError(json.NewDecoder(rec.Body).Decode(&resp))
+	s.Require().Contains(resp.Message, "compiler settings must be specified in the standard JSON input")
+}
+
+func (s *ContractVerificationTestSuite) TestContractVerify_StandardJSONUnknownSource() {
+	fields := s.standardJSONFields()
+	fields["contract_name"] = "src/Unknown.sol:TestContract"
+
+	req, err := createStandardJSONRequest(fields, testStandardJSONInput, nil)
+	s.Require().NoError(err)
+
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+
+	err = s.handler.ContractVerify(c)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+
+	var resp Error
+	s.Require().NoError(json.NewDecoder(rec.Body).Decode(&resp))
+	s.Require().Contains(resp.Message, "is not found in standard JSON input")
+}
+
+func (s *ContractVerificationTestSuite) TestContractVerify_StandardJSONNotFullyQualifiedName() {
+	req, err := createStandardJSONRequest(s.validFields(), testStandardJSONInput, nil)
+	s.Require().NoError(err)
+
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+
+	err = s.handler.ContractVerify(c)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (s *ContractVerificationTestSuite) TestContractVerify_StandardJSONSourceByUrl() {
+	input := `{"language":"Solidity","sources":{"src/TestContract.sol":{"urls":["/etc/passwd"]}}}`
+
+	req, err := createStandardJSONRequest(s.standardJSONFields(), input, nil)
+	s.Require().NoError(err)
+
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+
+	err = s.handler.ContractVerify(c)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+
+	var resp Error
+	s.Require().NoError(json.NewDecoder(rec.Body).Decode(&resp))
+	s.Require().Contains(resp.Message, "sources must be passed by content")
+}
+
+func (s *ContractVerificationTestSuite) TestContractVerify_FullyQualifiedNameWithSourceFiles() {
+	req, err := createMultipartRequest(s.standardJSONFields(), s.validFiles())
+	s.Require().NoError(err)
+
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+
+	err = s.handler.ContractVerify(c)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+
+	var resp Error
+	s.Require().NoError(json.NewDecoder(rec.Body).Decode(&resp))
+	s.Require().Contains(resp.Message, "allowed only with standard JSON input")
+}
+
+// --- Vyper ---
+
+const testVyperStandardJSONInput = `{
+	"language": "Vyper",
+	"sources": {
+		"contracts/Token.vy": {"content": "# pragma version ^0.4.0\nname: public(String[32])"}
+	},
+	"settings": {
+		"evmVersion": "cancun",
+		"optimize": "codesize"
+	}
+}`
+
+func (s *ContractVerificationTestSuite) vyperFields() map[string]string {
+	fields := s.validFields()
+	fields["contract_name"] = "Token"
+	fields["compiler_version"] = "v0.4.0+commit.e9db8d9f"
+	fields["language"] = "vyper"
+	return fields
+}
+
+func (s *ContractVerificationTestSuite) vyperFiles() map[string][]byte {
+	return map[string][]byte{
+		"Token.vy":   []byte("# pragma version ^0.4.0\nimport IToken\nname: public(String[32])"),
+		"IToken.vyi": []byte("@external\ndef name() -> String[32]: ..."),
+	}
+}
+
+func (s *ContractVerificationTestSuite) TestContractVerify_Vyper() {
+	fields := s.vyperFields()
+	fields["optimization_mode"] = "gas"
+
+	req, err := createMultipartRequest(fields, s.vyperFiles())
+	s.Require().NoError(err)
+
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+
+	s.contract.EXPECT().
+		ByHash(gomock.Any(), testAddressHex3).
+		Return(testContract, nil)
+
+	s.task.EXPECT().
+		ByContractId(gomock.Any(), testContract.Id).
+		Return(nil, nil)
+
+	s.tx.EXPECT().
+		AddVerificationTask(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, task *storage.VerificationTask) error {
+			s.Require().Equal(types.Vyper, task.Language)
+			s.Require().Equal("Token", task.ContractName)
+			s.Require().NotNil(task.OptimizationMode)
+			s.Require().Equal("gas", *task.OptimizationMode)
+			s.Require().False(task.StandardJSON)
+			task.Id = 4
+			return nil
+		})
+
+	s.tx.EXPECT().
+		SaveVerificationFiles(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, files ...*storage.VerificationFile) error {
+			s.Require().Len(files, 2)
+			return nil
+		})
+
+	s.tx.EXPECT().Flush(gomock.Any()).Return(nil)
+	s.tx.EXPECT().Close(gomock.Any()).Return(nil)
+
+	err = s.handler.ContractVerify(c)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, rec.Code)
+}
+
+func (s *ContractVerificationTestSuite) TestContractVerify_VyperSolFile() {
+	req, err := createMultipartRequest(s.vyperFields(), s.validFiles())
+	s.Require().NoError(err)
+
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+
+	err = s.handler.ContractVerify(c)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+
+	var resp Error
+	s.Require().NoError(json.NewDecoder(rec.Body).Decode(&resp))
+	s.Require().Contains(resp.Message, "only .vy, .vyi files are allowed")
+}
+
+func (s *ContractVerificationTestSuite) TestContractVerify_VyperOptimizationRuns() {
+	fields := s.vyperFields()
+	fields["optimization_runs"] = "200"
+
+	req, err := createMultipartRequest(fields, s.vyperFiles())
+	s.Require().NoError(err)
+
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+
+	err = s.handler.ContractVerify(c)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (s *ContractVerificationTestSuite) TestContractVerify_SolidityOptimizationMode() {
+	fields := s.validFields()
+	fields["optimization_mode"] = "gas"
+
+	req, err := createMultipartRequest(fields, s.validFiles())
+	s.Require().NoError(err)
+
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+
+	err = s.handler.ContractVerify(c)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+
+	var resp Error
+	s.Require().NoError(json.NewDecoder(rec.Body).Decode(&resp))
+	s.Require().Contains(resp.Message, "optimization_mode is allowed only for vyper")
+}
+
+func (s *ContractVerificationTestSuite) TestContractVerify_VyperLibraries() {
+	fields := s.vyperFields()
+	fields["libraries"] = `{"Math":"0x742d35cc6634c0532925a3b844bc9e7595f0beb0"}`
+
+	req, err := createMultipartRequest(fields, s.vyperFiles())
+	s.Require().NoError(err)
+
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+
+	err = s.handler.ContractVerify(c)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+
+	var resp Error
+	s.Require().NoError(json.NewDecoder(rec.Body).Decode(&resp))
+	s.Require().Contains(resp.Message, "libraries are allowed only for solidity")
+}
+
+func (s *ContractVerificationTestSuite) TestContractVerify_InvalidLanguage() {
+	fields := s.validFields()
+	fields["language"] = "yul"
+
+	req, err := createMultipartRequest(fields, s.validFiles())
+	s.Require().NoError(err)
+
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+
+	err = s.handler.ContractVerify(c)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (s *ContractVerificationTestSuite) TestContractVerify_VyperStandardJSON() {
+	fields := s.validFields()
+	fields["contract_name"] = "contracts/Token.vy:Token"
+
+	req, err := createStandardJSONRequest(fields, testVyperStandardJSONInput, nil)
+	s.Require().NoError(err)
+
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+
+	s.contract.EXPECT().
+		ByHash(gomock.Any(), testAddressHex3).
+		Return(testContract, nil)
+
+	s.task.EXPECT().
+		ByContractId(gomock.Any(), testContract.Id).
+		Return(nil, nil)
+
+	s.tx.EXPECT().
+		AddVerificationTask(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, task *storage.VerificationTask) error {
+			s.Require().True(task.StandardJSON)
+			s.Require().Equal(types.Vyper, task.Language)
+			s.Require().NotNil(task.OptimizationMode)
+			s.Require().Equal("codesize", *task.OptimizationMode)
+			s.Require().NotNil(task.EVMVersion)
+			s.Require().Equal(types.Cancun, *task.EVMVersion)
+			task.Id = 5
+			return nil
+		})
+
+	s.tx.EXPECT().
+		SaveVerificationFiles(gomock.Any(), gomock.Any()).
+		Return(nil)
+
+	s.tx.EXPECT().Flush(gomock.Any()).Return(nil)
+	s.tx.EXPECT().Close(gomock.Any()).Return(nil)
+
+	err = s.handler.ContractVerify(c)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, rec.Code)
+}
+
+func (s *ContractVerificationTestSuite) TestContractVerify_StandardJSONLanguageMismatch() {
+	fields := s.standardJSONFields()
+	fields["language"] = "vyper"
+
+	req, err := createStandardJSONRequest(fields, testStandardJSONInput, nil)
+	s.Require().NoError(err)
+
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+
+	err = s.handler.ContractVerify(c)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+
+	var resp Error
+	s.Require().NoError(json.NewDecoder(rec.Body).Decode(&resp))
+	s.Require().Contains(resp.Message, "doesn't match the standard JSON input language")
+}
+
+// --- Compilers ---
+
+func (s *ContractVerificationTestSuite) newCompilerRegistry() *compilers.Registry {
+	dir := s.T().TempDir()
+	list := `{"builds":[
+		{"path":"solc-linux-amd64-v0.8.20+commit.a1b79de6","version":"0.8.20","longVersion":"0.8.20+commit.a1b79de6"},
+		{"path":"solc-linux-amd64-v0.8.9+commit.e5eed63a","version":"0.8.9","longVersion":"0.8.9+commit.e5eed63a"}
+	]}`
+	s.Require().NoError(os.WriteFile(filepath.Join(dir, compilers.ListFile), []byte(list), 0644))
+	s.Require().NoError(os.WriteFile(filepath.Join(dir, "solc-linux-amd64-v0.8.20+commit.a1b79de6"), []byte("solc"), 0755))
+
+	registry, err := compilers.NewRegistry(dir)
+	s.Require().NoError(err)
+	return registry
+}
+
+func (s *ContractVerificationTestSuite) TestCompilers() {
+	s.handler.compilers = s.newCompilerRegistry()
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/verification/compilers")
+
+	err := s.handler.Compilers(c)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var response []responses.Compiler
+	s.Require().NoError(json.NewDecoder(rec.Body).Decode(&response))
+	s.Require().Equal([]responses.Compiler{
+		{Version: "v0.8.20+commit.a1b79de6", Language: "solidity"},
+	}, response)
+}
+
+func (s *ContractVerificationTestSuite) newVyperRegistry() *compilers.Registry {
+	dir := s.T().TempDir()
+	list := `{"builds":[
+		{"path":"vyper.0.4.0+commit.e9db8d9f.linux","version":"0.4.0","longVersion":"0.4.0+commit.e9db8d9f"}
+	]}`
+	s.Require().NoError(os.WriteFile(filepath.Join(dir, compilers.ListFile), []byte(list), 0644))
+
+	registry, err := compilers.NewRegistry(dir)
+	s.Require().NoError(err)
+	return registry
+}
+
+func (s *ContractVerificationTestSuite) TestCompilers_Vyper() {
+	s.handler.compilers = s.newCompilerRegistry()
+	s.handler.vyper = s.newVyperRegistry()
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/verification/compilers")
+
+	err := s.handler.Compilers(c)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var response []responses.Compiler
+	s.Require().NoError(json.NewDecoder(rec.Body).Decode(&response))
+	s.Require().Equal([]responses.Compiler{
+		{Version: "v0.8.20+commit.a1b79de6", Language: "solidity"},
+		{Version: "v0.4.0+commit.e9db8d9f", Language: "vyper"},
+	}, response)
+}
+
+func (s *ContractVerificationTestSuite) TestCompilers_NoRegistry() {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/verification/compilers")
+
+	err := s.handler.Compilers(c)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var response []responses.Compiler
+	s.Require().NoError(json.NewDecoder(rec.Body).Decode(&response))
+	s.Require().Empty(response)
+}
+
+func (s *ContractVerificationTestSuite) TestContractVerify_CompilerNotInstalled() {
+	s.handler.compilers = s.newCompilerRegistry()
+
+	fields := s.validFields()
+	fields["compiler_version"] = "0.8.9"
+
+	req, err := createMultipartRequest(fields, s.validFiles())
+	s.Require().NoError(err)
+
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+
+	err = s.handler.ContractVerify(c)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+	s.Require().Contains(rec.Body.String(), "compiler 0.8.9 is not installed")
+}
+
+func (s *ContractVerificationTestSuite) TestContractVerify_VyperNotPinned() {
+	s.handler.vyper = s.newVyperRegistry()
+
+	fields := s.vyperFields()
+	fields["compiler_version"] = "v0.3.10+commit.91361694"
+
+	req, err := createMultipartRequest(fields, s.vyperFiles())
+	s.Require().NoError(err)
+
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+
+	err = s.handler.ContractVerify(c)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+	s.Require().Contains(rec.Body.String(), "compiler v0.3.10+commit.91361694 is not installed")
+}
@@ -0,0 +1,211 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/NobleScope/noble-indexer/cmd/api/handler/responses"
+	"github.com/NobleScope/noble-indexer/cmd/api/helpers"
+	"github.com/NobleScope/noble-indexer/internal/storage"
+	pkgTypes "github.com/NobleScope/noble-indexer/pkg/types"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+)
+
+type DelegationHandler struct {
+	delegations    storage.IDelegation
+	authorizations storage.ITxAuthorization
+	addresses      storage.IAddress
+}
+
+func NewDelegationHandler(
+	delegations storage.IDelegation,
+	authorizations storage.ITxAuthorization,
+	addresses storage.IAddress,
+) *DelegationHandler {
+	return &DelegationHandler{
+		delegations:    delegations,
+		authorizations: authorizations,
+		addresses:      addresses,
+	}
+}
+
+type listDelegations struct {
+	Limit    int    `query:"limit"    validate:"omitempty,min=1,max=100"`
+	Offset   int    `query:"offset"   validate:"omitempty,min=0"`
+	Sort     string `query:"sort"     validate:"omitempty,oneof=asc desc"`
+	Delegate string `query:"delegate" validate:"omitempty,address"`
+	Cursor   string `query:"cursor"   validate:"omitempty"`
+}
+
+func (req *listDelegations) ToFilters(
+	ctx context.Context,
+	address storage.IAddress,
+) (storage.DelegationListFilter, error) {
+	if req.Limit <= 0 || req.Limit > 100 {
+		req.Limit = 10
+	}
+	if req.Sort == "" {
+		req.Sort = desc
+	}
+	filters := storage.DelegationListFilter{
+		Limit:  req.Limit,
+		Offset: req.Offset,
+		Sort:   pgSort(req.Sort),
+	}
+
+	if req.Delegate != "" {
+		delegateHex, err := pkgTypes.HexFromString(req.Delegate)
+		if err != nil {
+			return filters, errors.Wrapf(err, "decoding delegate address: %s", req.Delegate)
+		}
+		delegateAddress, err := address.ByHash(ctx, delegateHex)
+		if err != nil {
+			return filters, errors.Wrapf(err, "fetching delegate address by hash: %x", delegateHex)
+		}
+		filters.DelegateId = &delegateAddress.Id
+	}
+
+	if req.Cursor != "" {
+		if req.Offset > 0 {
+			return filters, errCursorWithOffset
+		}
+		cursorID, err := helpers.DecodeIDCursor(req.Cursor)
+		if err != nil {
+			return filters, err
+		}
+		filters.CursorID = cursorID
+	}
+
+	return filters, nil
+}
+
+// List godoc
+//
+//	@Summary		List EIP-7702 delegations
+//	@Description	Returns a paginated list of externally owned accounts with an active EIP-7702 delegation. Can be filtered by delegate contract address.
+//	@Tags			delegations
+//	@ID				list-delegations
+//	@Param			limit		query	integer	false	"Number of delegations to return (default: 10)"	minimum(1)	maximum(100)	default(10)
+//	@Param			offset		query	integer	false	"Number of delegations to skip (default: 0)"	minimum(0)	default(0)
+//	@Param			sort		query	string	false	"Sort order by authority id (default: desc)"	Enums(asc, desc)	default(desc)
+//	@Param			delegate	query	string	false	"Filter by delegate contract address"			minlength(42)	maxlength(42)	example(0x742d35Cc6634C0532925a3b844Bc9e7595f0bEb)
+//	@Param			cursor		query	string	false	"Opaque cursor for keyset pagination. Base64url-encoded value from the previous response's 'cursor' field. Encodes the id of the last returned record. Cannot be used together with offset (returns 400)."
+//	@Produce		json
+//	@Success		200	{object}	CursorResponse	"List of delegations"
+//	@Failure		400	{object}	Error			"Invalid request parameters"
+//	@Failure		500	{object}	Error			"Internal server error"
+//	@Router			/delegations [get]
+func (handler *DelegationHandler) List(c echo.Context) error {
+	req, err := bindAndValidate[listDelegations](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	filters, err := req.ToFilters(c.Request().Context(), handler.addresses)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	delegations, err := handler.delegations.Filter(c.Request().Context(), filters)
+	if err != nil {
+		return handleError(c, err, handler.delegations)
+	}
+
+	response := make([]responses.Delegation, len(delegations))
+	for i := range delegations {
+		response[i] = responses.NewDelegation(delegations[i])
+	}
+
+	var cursor string
+	if len(delegations) > 0 {
+		cursor = helpers.EncodeIDCursor(delegations[len(delegations)-1].Id)
+	}
+
+	return returnCursorList(c, response, cursor)
+}
+
+type delegationHistoryRequest struct {
+	Hash   string `param:"hash"   validate:"required,address"`
+	Limit  int    `query:"limit"  validate:"omitempty,min=1,max=100"`
+	Offset int    `query:"offset" validate:"omitempty,min=0"`
+	Sort   string `query:"sort"   validate:"omitempty,oneof=asc desc"`
+	Cursor string `query:"cursor" validate:"omitempty"`
+}
+
+func (p *delegationHistoryRequest) SetDefault() {
+	if p.Limit == 0 {
+		p.Limit = 10
+	}
+	if p.Sort == "" {
+		p.Sort = desc
+	}
+}
+
+// History godoc
+//
+//	@Summary		Get EIP-7702 delegation history of the address
+//	@Description	Returns a paginated list of EIP-7702 authorizations signed by the address, including invalid ones
+//	@Tags			delegations
+//	@ID				get-delegation-history
+//	@Param			hash	path	string	true	"Address hash in hexadecimal format (e.g., 0x742d35Cc6634C0532925a3b844Bc9e7595f0bEb)"	minlength(42)	maxlength(42)
+//	@Param			limit	query	integer	false	"Number of authorizations to return (default: 10)"										minimum(1)	maximum(100)	default(10)
+//	@Param			offset	query	integer	false	"Number of authorizations to skip (default: 0)"											minimum(0)	default(0)
+//	@Param			sort	query	string	false	"Sort order (default: desc)"															Enums(asc, desc)	default(desc)
+//	@Param			cursor	query	string	false	"Opaque cursor for keyset pagination. Base64url-encoded value from the previous response's 'cursor' field. Encodes the id of the last returned record. Cannot be used together with offset (returns 400)."
+//	@Produce		json
+//	@Success		200	{object}	CursorResponse	"List of authorizations"
+//	@Success		204								"Address not found"
+//	@Failure		400	{object}	Error			"Invalid request parameters"
+//	@Failure		500	{object}	Error			"Internal server error"
+//	@Router			/delegations/{hash}/history [get]
+func (handler *DelegationHandler) History(c echo.Context) error {
+	req, err := bindAndValidate[delegationHistoryRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	req.SetDefault()
+
+	hash, err := pkgTypes.HexFromString(req.Hash)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	address, err := handler.addresses.ByHash(c.Request().Context(), hash)
+	if err != nil {
+		return handleError(c, err, handler.addresses)
+	}
+
+	filter := storage.TxAuthorizationListFilter{
+		AuthorityId: address.Id,
+		Limit:       req.Limit,
+		Offset:      req.Offset,
+		Sort:        pgSort(req.Sort),
+	}
+	if req.Cursor != "" {
+		if req.Offset > 0 {
+			return badRequestError(c, errCursorWithOffset)
+		}
+		cursorID, err := helpers.DecodeIDCursor(req.Cursor)
+		if err != nil {
+			return badRequestError(c, err)
+		}
+		filter.CursorID = cursorID
+	}
+
+	authorizations, err := handler.authorizations.Filter(c.Request().Context(), filter)
+	if err != nil {
+		return handleError(c, err, handler.authorizations)
+	}
+
+	response := make([]responses.DelegationHistoryItem, len(authorizations))
+	for i := range authorizations {
+		response[i] = responses.NewDelegationHistoryItem(authorizations[i])
+	}
+
+	var cursor string
+	if len(authorizations) > 0 {
+		cursor = helpers.EncodeIDCursor(authorizations[len(authorizations)-1].Id)
+	}
+
+	return returnCursorList(c, response, cursor)
+}
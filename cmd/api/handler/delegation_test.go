@@ -0,0 +1,244 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/NobleScope/noble-indexer/cmd/api/handler/responses"
+	"github.com/NobleScope/noble-indexer/cmd/api/helpers"
+	"github.com/NobleScope/noble-indexer/internal/storage"
+	"github.com/NobleScope/noble-indexer/internal/storage/mock"
+	"github.com/labstack/echo/v4"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	testDelegateId = testAddress2.Id
+
+	testDelegation = storage.Delegation{
+		Id:         testAddress1.Id,
+		Height:     100,
+		LastHeight: 150,
+		DelegateId: &testDelegateId,
+		Authority:  storage.Address{Hash: testAddressHex1},
+		Delegate:   &storage.Address{Hash: testAddressHex2},
+		Tx:         storage.Tx{Hash: testTxHash},
+	}
+
+	testTxAuthorization = storage.TxAuthorization{
+		Id:          5,
+		Height:      150,
+		Time:        time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		Index:       1,
+		ChainId:     decimal.NewFromInt(1),
+		Nonce:       decimal.NewFromInt(3),
+		AuthorityId: &testAddress1.Id,
+		DelegateId:  &testDelegateId,
+		Valid:       true,
+		Delegate:    &storage.Address{Hash: testAddressHex2},
+		Tx:          storage.Tx{Hash: testTxHash},
+	}
+)
+
+// DelegationHandlerTestSuite -
+type DelegationHandlerTestSuite struct {
+	suite.Suite
+	delegations    *mock.MockIDelegation
+	authorizations *mock.MockITxAuthorization
+	addresses      *mock.MockIAddress
+	echo           *echo.Echo
+	handler        *DelegationHandler
+	ctrl           *gomock.Controller
+}
+
+// SetupSuite -
+func (s *DelegationHandlerTestSuite) SetupSuite() {
+	s.echo = echo.New()
+	s.echo.Validator = NewApiValidator()
+	s.ctrl = gomock.NewController(s.T())
+	s.delegations = mock.NewMockIDelegation(s.ctrl)
+	s.authorizations = mock.NewMockITxAuthorization(s.ctrl)
+	s.addresses = mock.NewMockIAddress(s.ctrl)
+	s.handler = NewDelegationHandler(s.delegations, s.authorizations, s.addresses)
+}
+
+// TearDownSuite -
+func (s *DelegationHandlerTestSuite) TearDownSuite() {
+	s.ctrl.Finish()
+	s.Require().NoError(s.echo.Shutdown(context.Background()))
+}
+
+func TestSuiteDelegationHandler_Run(t *testing.T) {
+	suite.Run(t, new(DelegationHandlerTestSuite))
+}
+
+// TestListSuccess tests successful retrieval of delegations list
+func (s *DelegationHandlerTestSuite) TestListSuccess() {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/delegations")
+
+	s.delegations.EXPECT().
+		Filter(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, filter storage.DelegationListFilter) ([]storage.Delegation, error) {
+			s.Require().Equal(10, filter.Limit)
+			s.Require().Nil(filter.DelegateId)
+			return []storage.Delegation{testDelegation}, nil
+		}).
+		Times(1)
+
+	s.Require().NoError(s.handler.List(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var body struct {
+		Result []responses.Delegation `json:"result"`
+		Cursor string                 `json:"cursor"`
+	}
+	err := json.NewDecoder(rec.Body).Decode(&body)
+	s.Require().NoError(err)
+	s.Require().Len(body.Result, 1)
+	s.Require().Equal(testAddressHex1.Hex(), body.Result[0].Authority)
+	s.Require().NotNil(body.Result[0].Delegate)
+	s.Require().Equal(testAddressHex2.Hex(), *body.Result[0].Delegate)
+	s.Require().EqualValues(100, body.Result[0].Height)
+	s.Require().EqualValues(150, body.Result[0].LastHeight)
+	s.Require().Equal(testTxHash.Hex(), body.Result[0].TxHash)
+	s.Require().Equal(helpers.EncodeIDCursor(testAddress1.Id), body.Cursor)
+}
+
+// TestListByDelegate tests list filtered by delegate address
+func (s *DelegationHandlerTestSuite) TestListByDelegate() {
+	q := make(url.Values)
+	q.Set("delegate", testAddressHex2.Hex())
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/delegations")
+
+	s.addresses.EXPECT().
+		ByHash(gomock.Any(), testAddressHex2).
+		Return(testAddress2, nil).
+		Times(1)
+
+	s.delegations.EXPECT().
+		Filter(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, filter storage.DelegationListFilter) ([]storage.Delegation, error) {
+			s.Require().NotNil(filter.DelegateId)
+			s.Require().EqualValues(testAddress2.Id, *filter.DelegateId)
+			return []storage.Delegation{testDelegation}, nil
+		}).
+		Times(1)
+
+	s.Require().NoError(s.handler.List(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+}
+
+// TestListCursorWithOffset tests that cursor cannot be used with offset
+func (s *DelegationHandlerTestSuite) TestListCursorWithOffset() {
+	q := make(url.Values)
+	q.Set("cursor", helpers.EncodeIDCursor(1))
+	q.Set("offset", "5")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/delegations")
+
+	s.Require().NoError(s.handler.List(c))
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+}
+
+// TestListInvalidDelegate tests handling of invalid delegate address
+func (s *DelegationHandlerTestSuite) TestListInvalidDelegate() {
+	q := make(url.Values)
+	q.Set("delegate", "invalid")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/delegations")
+
+	s.Require().NoError(s.handler.List(c))
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+}
+
+// TestHistorySuccess tests successful retrieval of delegation history
+func (s *DelegationHandlerTestSuite) TestHistorySuccess() {
+	q := make(url.Values)
+	q.Set("cursor", helpers.EncodeIDCursor(10))
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/delegations/:hash/history")
+	c.SetParamNames("hash")
+	c.SetParamValues(testAddressHex1.Hex())
+
+	s.addresses.EXPECT().
+		ByHash(gomock.Any(), testAddressHex1).
+		Return(testAddress1, nil).
+		Times(1)
+
+	s.authorizations.EXPECT().
+		Filter(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, filter storage.TxAuthorizationListFilter) ([]storage.TxAuthorization, error) {
+			s.Require().EqualValues(testAddress1.Id, filter.AuthorityId)
+			s.Require().EqualValues(10, filter.CursorID)
+			s.Require().Equal(10, filter.Limit)
+			return []storage.TxAuthorization{testTxAuthorization}, nil
+		}).
+		Times(1)
+
+	s.Require().NoError(s.handler.History(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var body struct {
+		Result []responses.DelegationHistoryItem `json:"result"`
+		Cursor string                            `json:"cursor"`
+	}
+	err := json.NewDecoder(rec.Body).Decode(&body)
+	s.Require().NoError(err)
+	s.Require().Len(body.Result, 1)
+	s.Require().EqualValues(150, body.Result[0].Height)
+	s.Require().EqualValues(1, body.Result[0].Index)
+	s.Require().Equal(testTxHash.Hex(), body.Result[0].TxHash)
+	s.Require().NotNil(body.Result[0].Delegate)
+	s.Require().Equal(testAddressHex2.Hex(), *body.Result[0].Delegate)
+	s.Require().Equal("1", body.Result[0].ChainId)
+	s.Require().Equal("3", body.Result[0].Nonce)
+	s.Require().True(body.Result[0].Valid)
+	s.Require().Equal(helpers.EncodeIDCursor(5), body.Cursor)
+}
+
+// TestHistoryNoContent tests when address is not found
+func (s *DelegationHandlerTestSuite) TestHistoryNoContent() {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/delegations/:hash/history")
+	c.SetParamNames("hash")
+	c.SetParamValues(testAddressHex3.Hex())
+
+	s.addresses.EXPECT().
+		ByHash(gomock.Any(), testAddressHex3).
+		Return(storage.Address{}, sql.ErrNoRows).
+		Times(1)
+
+	s.addresses.EXPECT().
+		IsNoRows(sql.ErrNoRows).
+		Return(true).
+		Times(1)
+
+	s.Require().NoError(s.handler.History(c))
+	s.Require().Equal(http.StatusNoContent, rec.Code)
+}
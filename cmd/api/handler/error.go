@@ -55,6 +55,11 @@ func handleError(c echo.Context, err error, noRows NoRows) error {
 			Message: err.Error(),
 		})
 	}
+	if errors.Is(err, errFinalityNotTracked) {
+		return c.JSON(http.StatusServiceUnavailable, Error{
+			Message: err.Error(),
+		})
+	}
 	if noRows.IsNoRows(err) {
 		return c.NoContent(http.StatusNoContent)
 	}
@@ -0,0 +1,61 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"github.com/NobleScope/noble-indexer/internal/storage"
+	"github.com/NobleScope/noble-indexer/internal/storage/types"
+	pkgTypes "github.com/NobleScope/noble-indexer/pkg/types"
+	"github.com/labstack/echo/v4"
+)
+
+var errFinalityNotTracked = errors.New("safe and finalized blocks are not tracked by the indexer")
+
+// finalityHeight returns the height of the last block with the requested finality. Blocks below it have the same
+// or higher finality. Nil is returned if blocks of any finality are requested. If the indexer doesn't track the
+// requested finality errFinalityNotTracked is returned.
+func finalityHeight(ctx context.Context, state storage.IState, indexerName, finality string) (*uint64, error) {
+	if finality == "" || finality == types.Latest.String() {
+		return nil, nil
+	}
+
+	s, err := state.ByName(ctx, indexerName)
+	if err != nil {
+		return nil, err
+	}
+
+	height := uint64(s.FinalizedHeight)
+	if finality == types.Safe.String() {
+		height = uint64(s.SafeHeight)
+	}
+	if height == 0 {
+		return nil, errFinalityNotTracked
+	}
+	return &height, nil
+}
+
+// heightFinality returns the finality of the block at the height by the last safe and finalized heights of the state
+func heightFinality(ctx context.Context, state storage.IState, indexerName string, height pkgTypes.Level) (types.Finality, error) {
+	s, err := state.ByName(ctx, indexerName)
+	if err != nil {
+		return types.Latest, err
+	}
+
+	switch {
+	case height <= s.FinalizedHeight:
+		return types.Finalized, nil
+	case height <= s.SafeHeight:
+		return types.Safe, nil
+	default:
+		return types.Latest, nil
+	}
+}
+
+// preventCaching forbids the cache middleware to store the response with data of not finalized blocks
+// since it may change on finalization or reorganization
+func preventCaching(c echo.Context, finality types.Finality) {
+	if finality != types.Finalized {
+		c.Response().Header().Set(echo.HeaderCacheControl, "no-store")
+	}
+}
@@ -0,0 +1,40 @@
+package jsonrpc
+
+import "fmt"
+
+// Standard JSON-RPC 2.0 and Ethereum JSON-RPC error codes
+const (
+	CodeParseError     = -32700
+	CodeInvalidRequest = -32600
+	CodeMethodNotFound = -32601
+	CodeInvalidParams  = -32602
+	CodeInternalError  = -32603
+	CodeServerError    = -32000
+	CodeLimitExceeded  = -32005
+)
+
+// Error -
+type Error struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// Error -
+func (e *Error) Error() string {
+	return fmt.Sprintf("code=%d message=%s", e.Code, e.Message)
+}
+
+func newError(code int, format string, args ...any) *Error {
+	return &Error{
+		Code:    code,
+		Message: fmt.Sprintf(format, args...),
+	}
+}
+
+var (
+	errParse          = newError(CodeParseError, "parse error")
+	errInvalidRequest = newError(CodeInvalidRequest, "invalid request")
+	errEmptyBatch     = newError(CodeInvalidRequest, "empty batch")
+	errInternal       = newError(CodeInternalError, "internal error")
+	errUnknownBlock   = newError(CodeServerError, "unknown block")
+)
@@ -0,0 +1,338 @@
+package jsonrpc
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/NobleScope/noble-indexer/internal/storage"
+	pkgTypes "github.com/NobleScope/noble-indexer/pkg/types"
+	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+const (
+	tagLatest    = "latest"
+	tagEarliest  = "earliest"
+	tagPending   = "pending"
+	tagSafe      = "safe"
+	tagFinalized = "finalized"
+)
+
+// heads are heights of the last indexed, safe and finalized blocks
+type heads struct {
+	latest    uint64
+	safe      uint64
+	finalized uint64
+}
+
+func (s *Server) head(ctx context.Context) (heads, error) {
+	state, err := s.state.ByName(ctx, s.indexerName)
+	if err != nil {
+		if s.state.IsNoRows(err) {
+			return heads{}, nil
+		}
+		return heads{}, err
+	}
+	return heads{
+		latest:    uint64(state.LastHeight),
+		safe:      uint64(min(state.SafeHeight, state.LastHeight)),
+		finalized: uint64(min(state.FinalizedHeight, state.LastHeight)),
+	}, nil
+}
+
+// resolveBlockNumber converts block tag or hex quantity to the block height
+func resolveBlockNumber(value string, head heads) (uint64, error) {
+	switch value {
+	case "", tagLatest, tagPending:
+		return head.latest, nil
+	case tagSafe:
+		if head.safe == 0 {
+			return 0, newError(CodeServerError, "safe block not found")
+		}
+		return head.safe, nil
+	case tagFinalized:
+		if head.finalized == 0 {
+			return 0, newError(CodeServerError, "finalized block not found")
+		}
+		return head.finalized, nil
+	case tagEarliest:
+		return 0, nil
+	}
+	height, err := hexutil.DecodeUint64(value)
+	if err != nil {
+		return 0, newError(CodeInvalidParams, "invalid block number %s: %s", value, err.Error())
+	}
+	return height, nil
+}
+
+func decodeParam[T any](params []json.RawMessage, idx int, required bool) (T, error) {
+	var value T
+	if idx >= len(params) {
+		if required {
+			return value, newError(CodeInvalidParams, "missing value for required argument %d", idx)
+		}
+		return value, nil
+	}
+	if err := json.Unmarshal(params[idx], &value); err != nil {
+		return value, newError(CodeInvalidParams, "invalid argument %d: %s", idx, err.Error())
+	}
+	return value, nil
+}
+
+func decodeHashParam(params []json.RawMessage, idx int) (pkgTypes.Hex, error) {
+	value, err := decodeParam[string](params, idx, true)
+	if err != nil {
+		return nil, err
+	}
+	hash, err := hexutil.Decode(value)
+	if err != nil || len(hash) != 32 {
+		return nil, newError(CodeInvalidParams, "invalid argument %d: hex string of 32 bytes expected", idx)
+	}
+	return hash, nil
+}
+
+func (s *Server) chainId(ctx context.Context) (any, error) {
+	state, err := s.state.ByName(ctx, s.indexerName)
+	if err != nil {
+		return nil, err
+	}
+	return hexutil.Uint64(state.ChainId), nil
+}
+
+func (s *Server) blockNumber(ctx context.Context) (any, error) {
+	head, err := s.head(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return hexutil.Uint64(head.latest), nil
+}
+
+func (s *Server) getBlockByNumber(ctx context.Context, params []json.RawMessage) (any, error) {
+	number, err := decodeParam[string](params, 0, true)
+	if err != nil {
+		return nil, err
+	}
+	fullTx, err := decodeParam[bool](params, 1, false)
+	if err != nil {
+		return nil, err
+	}
+
+	head, err := s.head(ctx)
+	if err != nil {
+		return nil, err
+	}
+	height, err := resolveBlockNumber(number, head)
+	if err != nil {
+		return nil, err
+	}
+	if height > head.latest {
+		return nil, nil
+	}
+
+	block, err := s.blocks.ByHeight(ctx, pkgTypes.Level(height), false)
+	if err != nil {
+		if s.blocks.IsNoRows(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return s.block(ctx, block, fullTx)
+}
+
+func (s *Server) getBlockByHash(ctx context.Context, params []json.RawMessage) (any, error) {
+	hash, err := decodeHashParam(params, 0)
+	if err != nil {
+		return nil, err
+	}
+	fullTx, err := decodeParam[bool](params, 1, false)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := s.blocks.ByHash(ctx, hash)
+	if err != nil {
+		if s.blocks.IsNoRows(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return s.block(ctx, block, fullTx)
+}
+
+func (s *Server) block(ctx context.Context, block storage.Block, fullTx bool) (any, error) {
+	txs, err := s.tx.AllByHeight(ctx, block.Height)
+	if err != nil {
+		return nil, err
+	}
+	return NewBlock(block, txs, fullTx), nil
+}
+
+func (s *Server) getTransactionByHash(ctx context.Context, params []json.RawMessage) (any, error) {
+	hash, err := decodeHashParam(params, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.tx.ByHash(ctx, hash, false)
+	if err != nil {
+		if s.tx.IsNoRows(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	block, err := s.blocks.ByHeight(ctx, tx.Height, false)
+	if err != nil {
+		return nil, err
+	}
+	return NewTransaction(tx, block.Hash), nil
+}
+
+func (s *Server) getTransactionReceipt(ctx context.Context, params []json.RawMessage) (any, error) {
+	hash, err := decodeHashParam(params, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.tx.ByHash(ctx, hash, false)
+	if err != nil {
+		if s.tx.IsNoRows(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	block, err := s.blocks.ByHeight(ctx, tx.Height, false)
+	if err != nil {
+		return nil, err
+	}
+
+	var contractAddress pkgTypes.Hex
+	if tx.ToAddressId == nil {
+		contracts, err := s.contracts.ListWithTx(ctx, storage.ContractListFilter{
+			Limit:     1,
+			Sort:      sdk.SortOrderAsc,
+			SortField: "id",
+			TxId:      &tx.Id,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if len(contracts) > 0 {
+			contractAddress = contracts[0].Address.Hash
+		}
+	}
+
+	logs, err := s.logs.ByRange(ctx, storage.LogRangeFilter{
+		HeightFrom: uint64(tx.Height),
+		HeightTo:   uint64(tx.Height),
+		TxId:       &tx.Id,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return NewReceipt(tx, block.Hash, contractAddress, logs), nil
+}
+
+func (s *Server) getLogs(ctx context.Context, params []json.RawMessage) (any, error) {
+	query, err := decodeParam[FilterQuery](params, 0, true)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := storage.LogRangeFilter{
+		Limit: s.maxLogs + 1,
+	}
+
+	if query.BlockHash != nil {
+		if query.FromBlock != nil || query.ToBlock != nil {
+			return nil, newError(CodeInvalidParams, "cannot specify both BlockHash and FromBlock/ToBlock, choose one or the other")
+		}
+		hash, err := hexutil.Decode(*query.BlockHash)
+		if err != nil {
+			return nil, newError(CodeInvalidParams, "invalid block hash: %s", err.Error())
+		}
+		block, err := s.blocks.ByHash(ctx, hash)
+		if err != nil {
+			if s.blocks.IsNoRows(err) {
+				return nil, errUnknownBlock
+			}
+			return nil, err
+		}
+		filter.HeightFrom = uint64(block.Height)
+		filter.HeightTo = uint64(block.Height)
+	} else {
+		head, err := s.head(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		var from, to string
+		if query.FromBlock != nil {
+			from = *query.FromBlock
+		}
+		if query.ToBlock != nil {
+			to = *query.ToBlock
+		}
+		if filter.HeightFrom, err = resolveBlockNumber(from, head); err != nil {
+			return nil, err
+		}
+		if filter.HeightTo, err = resolveBlockNumber(to, head); err != nil {
+			return nil, err
+		}
+		if filter.HeightFrom > filter.HeightTo {
+			return nil, newError(CodeInvalidParams, "invalid block range params")
+		}
+		if filter.HeightFrom > head.latest {
+			return []Log{}, nil
+		}
+		filter.HeightTo = min(filter.HeightTo, head.latest)
+		if filter.HeightTo-filter.HeightFrom+1 > s.maxBlockRange {
+			return nil, newError(CodeLimitExceeded, "block range is too large, max is %d blocks", s.maxBlockRange)
+		}
+	}
+
+	if len(query.Topics) > 4 {
+		return nil, newError(CodeInvalidParams, "too many topics, max is 4")
+	}
+	topics := make([][]pkgTypes.Hex, 4)
+	for i := range topics {
+		if topics[i], err = query.TopicsAt(i); err != nil {
+			return nil, newError(CodeInvalidParams, "invalid topics: %s", err.Error())
+		}
+	}
+	filter.Topic0, filter.Topic1, filter.Topic2, filter.Topic3 = topics[0], topics[1], topics[2], topics[3]
+
+	addresses, err := query.Addresses()
+	if err != nil {
+		return nil, newError(CodeInvalidParams, "invalid address: %s", err.Error())
+	}
+	for i := range addresses {
+		address, err := s.address.ByHash(ctx, addresses[i])
+		if err != nil {
+			if s.address.IsNoRows(err) {
+				continue
+			}
+			return nil, err
+		}
+		filter.AddressIds = append(filter.AddressIds, address.Id)
+	}
+	if len(addresses) > 0 && len(filter.AddressIds) == 0 {
+		return []Log{}, nil
+	}
+
+	logs, err := s.logs.ByRange(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	if len(logs) > s.maxLogs {
+		return nil, newError(CodeLimitExceeded, "query returned more than %d results", s.maxLogs)
+	}
+
+	result := make([]Log, len(logs))
+	for i := range logs {
+		result[i] = NewLog(logs[i])
+	}
+	return result, nil
+}
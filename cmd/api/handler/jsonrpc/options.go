@@ -0,0 +1,27 @@
+package jsonrpc
+
+type ServerOption func(*Server)
+
+func WithMaxBlockRange(blocks uint64) ServerOption {
+	return func(s *Server) {
+		if blocks > 0 {
+			s.maxBlockRange = blocks
+		}
+	}
+}
+
+func WithMaxLogs(limit int) ServerOption {
+	return func(s *Server) {
+		if limit > 0 {
+			s.maxLogs = limit
+		}
+	}
+}
+
+func WithMaxBatchSize(size int) ServerOption {
+	return func(s *Server) {
+		if size > 0 {
+			s.maxBatchSize = size
+		}
+	}
+}
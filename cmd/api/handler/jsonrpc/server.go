@@ -0,0 +1,192 @@
+package jsonrpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/NobleScope/noble-indexer/internal/storage"
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	defaultMaxBlockRange = 10_000
+	defaultMaxLogs       = 10_000
+	defaultMaxBatchSize  = 100
+)
+
+// Server - read-only Ethereum JSON-RPC facade over the indexed database
+type Server struct {
+	blocks    storage.IBlock
+	tx        storage.ITx
+	logs      storage.ILog
+	address   storage.IAddress
+	contracts storage.IContract
+	state     storage.IState
+
+	indexerName   string
+	maxBlockRange uint64
+	maxLogs       int
+	maxBatchSize  int
+}
+
+func NewServer(
+	blocks storage.IBlock,
+	tx storage.ITx,
+	logs storage.ILog,
+	address storage.IAddress,
+	contracts storage.IContract,
+	state storage.IState,
+	indexerName string,
+	opts ...ServerOption,
+) *Server {
+	s := &Server{
+		blocks:        blocks,
+		tx:            tx,
+		logs:          logs,
+		address:       address,
+		contracts:     contracts,
+		state:         state,
+		indexerName:   indexerName,
+		maxBlockRange: defaultMaxBlockRange,
+		maxLogs:       defaultMaxLogs,
+		maxBatchSize:  defaultMaxBatchSize,
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
+}
+
+// Handle - serves single and batch JSON-RPC requests. Errors are returned in the JSON-RPC envelope with HTTP 200.
+// Notifications aren't replied, so HTTP 204 is returned if there is no response left.
+func (s *Server) Handle(c echo.Context) error {
+	body, err := io.ReadAll(c.Request().Body)
+	if err != nil {
+		return c.JSON(http.StatusOK, errorResponse(nil, errParse))
+	}
+
+	body = bytes.TrimSpace(body)
+	if len(body) == 0 {
+		return c.JSON(http.StatusOK, errorResponse(nil, errInvalidRequest))
+	}
+
+	if body[0] != '[' {
+		var req Request
+		if err := json.Unmarshal(body, &req); err != nil {
+			return c.JSON(http.StatusOK, errorResponse(nil, errParse))
+		}
+		response := s.call(c.Request().Context(), req)
+		if req.isNotification() {
+			return c.NoContent(http.StatusNoContent)
+		}
+		return c.JSON(http.StatusOK, response)
+	}
+
+	var batch []json.RawMessage
+	if err := json.Unmarshal(body, &batch); err != nil {
+		return c.JSON(http.StatusOK, errorResponse(nil, errParse))
+	}
+	if len(batch) == 0 {
+		return c.JSON(http.StatusOK, errorResponse(nil, errEmptyBatch))
+	}
+	if len(batch) > s.maxBatchSize {
+		return c.JSON(http.StatusOK, errorResponse(nil, newError(CodeLimitExceeded, "batch is too large, max is %d", s.maxBatchSize)))
+	}
+
+	responses := make([]Response, 0, len(batch))
+	for i := range batch {
+		var req Request
+		if err := json.Unmarshal(batch[i], &req); err != nil {
+			responses = append(responses, errorResponse(nil, errInvalidRequest))
+			continue
+		}
+		response := s.call(c.Request().Context(), req)
+		if req.isNotification() {
+			continue
+		}
+		responses = append(responses, response)
+	}
+	if len(responses) == 0 {
+		return c.NoContent(http.StatusNoContent)
+	}
+	return c.JSON(http.StatusOK, responses)
+}
+
+func (s *Server) call(ctx context.Context, req Request) Response {
+	if req.JsonRpc != version || req.Method == "" {
+		return errorResponse(req.Id, errInvalidRequest)
+	}
+
+	var params []json.RawMessage
+	if len(req.Params) > 0 && string(req.Params) != "null" {
+		if err := json.Unmarshal(req.Params, &params); err != nil {
+			return errorResponse(req.Id, newError(CodeInvalidParams, "params should be an array"))
+		}
+	}
+
+	var (
+		result any
+		err    error
+	)
+	switch req.Method {
+	case "eth_chainId":
+		result, err = s.chainId(ctx)
+	case "eth_blockNumber":
+		result, err = s.blockNumber(ctx)
+	case "eth_getBlockByNumber":
+		result, err = s.getBlockByNumber(ctx, params)
+	case "eth_getBlockByHash":
+		result, err = s.getBlockByHash(ctx, params)
+	case "eth_getTransactionByHash":
+		result, err = s.getTransactionByHash(ctx, params)
+	case "eth_getTransactionReceipt":
+		result, err = s.getTransactionReceipt(ctx, params)
+	case "eth_getLogs":
+		result, err = s.getLogs(ctx, params)
+	default:
+		return errorResponse(req.Id, newError(CodeMethodNotFound, "the method %s does not exist/is not available", req.Method))
+	}
+
+	if err != nil {
+		var rpcErr *Error
+		if errors.As(err, &rpcErr) {
+			return errorResponse(req.Id, rpcErr)
+		}
+		log.Err(err).Str("method", req.Method).Msg("json-rpc call")
+		return errorResponse(req.Id, errInternal)
+	}
+
+	raw, err := json.Marshal(result)
+	if err != nil {
+		log.Err(err).Str("method", req.Method).Msg("json-rpc marshal result")
+		return errorResponse(req.Id, errInternal)
+	}
+
+	return Response{
+		JsonRpc: version,
+		Id:      responseId(req.Id),
+		Result:  raw,
+	}
+}
+
+func errorResponse(id json.RawMessage, err *Error) Response {
+	return Response{
+		JsonRpc: version,
+		Id:      responseId(id),
+		Error:   err,
+	}
+}
+
+func responseId(id json.RawMessage) json.RawMessage {
+	if len(id) == 0 {
+		return json.RawMessage("null")
+	}
+	return id
+}
@@ -0,0 +1,532 @@
+package jsonrpc
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/NobleScope/noble-indexer/internal/storage"
+	"github.com/NobleScope/noble-indexer/internal/storage/mock"
+	"github.com/NobleScope/noble-indexer/internal/storage/types"
+	pkgTypes "github.com/NobleScope/noble-indexer/pkg/types"
+	"github.com/labstack/echo/v4"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+const testIndexerName = "test_indexer"
+
+var (
+	testTime      = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	testBlockHash = pkgTypes.Hex(pkgTypes.MustDecodeHex("0x4f1b2a3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5d6e7f8"))
+	testTxHash    = pkgTypes.Hex(pkgTypes.MustDecodeHex("0x90f5df4e03620cc55d3ea295bf8826f84465065340cb6d0d095166dd2465f283"))
+	testAddress   = pkgTypes.Hex(pkgTypes.MustDecodeHex("0xa63d581a7fdab643c09f0524904b046cdb9ad9d2"))
+	testTopic0    = pkgTypes.Hex(pkgTypes.MustDecodeHex("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"))
+
+	testState = storage.State{
+		Name:       testIndexerName,
+		LastHeight: 1000,
+		ChainId:    1337,
+	}
+
+	testTx = storage.Tx{
+		Id:                10,
+		Height:            100,
+		Time:              testTime,
+		Hash:              testTxHash,
+		Index:             2,
+		Nonce:             5,
+		Gas:               decimal.NewFromInt(21000),
+		GasPrice:          decimal.NewFromInt(1_000_000_000),
+		GasUsed:           decimal.NewFromInt(21000),
+		CumulativeGasUsed: decimal.NewFromInt(42000),
+		EffectiveGasPrice: decimal.NewFromInt(1_000_000_000),
+		Amount:            decimal.NewFromInt(255),
+		Type:              types.TxTypeDynamicFee,
+		Status:            types.TxStatusSuccess,
+		FromAddress: storage.Address{
+			Hash: testAddress,
+		},
+	}
+
+	testLog = storage.Log{
+		Id:        1,
+		Height:    100,
+		Time:      testTime,
+		Index:     3,
+		TxId:      10,
+		Data:      pkgTypes.Hex{0x01},
+		Topics:    []pkgTypes.Hex{testTopic0},
+		AddressId: 7,
+		Address: storage.Address{
+			Hash: testAddress,
+		},
+		Tx: storage.Tx{
+			Hash:  testTxHash,
+			Index: 2,
+		},
+		BlockHash: testBlockHash,
+	}
+)
+
+// ServerTestSuite -
+type ServerTestSuite struct {
+	suite.Suite
+	blocks    *mock.MockIBlock
+	tx        *mock.MockITx
+	logs      *mock.MockILog
+	address   *mock.MockIAddress
+	contracts *mock.MockIContract
+	state     *mock.MockIState
+	echo      *echo.Echo
+	server    *Server
+	ctrl      *gomock.Controller
+}
+
+// SetupTest -
+func (s *ServerTestSuite) SetupTest() {
+	s.echo = echo.New()
+	s.ctrl = gomock.NewController(s.T())
+	s.blocks = mock.NewMockIBlock(s.ctrl)
+	s.tx = mock.NewMockITx(s.ctrl)
+	s.logs = mock.NewMockILog(s.ctrl)
+	s.address = mock.NewMockIAddress(s.ctrl)
+	s.contracts = mock.NewMockIContract(s.ctrl)
+	s.state = mock.NewMockIState(s.ctrl)
+	s.server = NewServer(s.blocks, s.tx, s.logs, s.address, s.contracts, s.state, testIndexerName,
+		WithMaxBlockRange(100),
+		WithMaxLogs(2),
+		WithMaxBatchSize(3),
+	)
+}
+
+// TearDownTest -
+func (s *ServerTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+	s.Require().NoError(s.echo.Shutdown(context.Background()))
+}
+
+func TestSuiteServer_Run(t *testing.T) {
+	suite.Run(t, new(ServerTestSuite))
+}
+
+func (s *ServerTestSuite) send(body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/rpc", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	s.Require().NoError(s.server.Handle(c))
+	return rec
+}
+
+func (s *ServerTestSuite) do(body string) *httptest.ResponseRecorder {
+	rec := s.send(body)
+	s.Require().Equal(http.StatusOK, rec.Code)
+	return rec
+}
+
+func (s *ServerTestSuite) decode(rec *httptest.ResponseRecorder) Response {
+	var response Response
+	s.Require().NoError(json.NewDecoder(rec.Body).Decode(&response))
+	return response
+}
+
+func (s *ServerTestSuite) TestChainId() {
+	s.state.EXPECT().
+		ByName(gomock.Any(), testIndexerName).
+		Return(testState, nil).
+		Times(1)
+
+	response := s.decode(s.do(`{"jsonrpc":"2.0","id":1,"method":"eth_chainId","params":[]}`))
+	s.Require().Nil(response.Error)
+	s.Require().Equal("1", string(response.Id))
+	s.Require().Equal(`"0x539"`, string(response.Result))
+}
+
+func (s *ServerTestSuite) TestBlockNumber() {
+	s.state.EXPECT().
+		ByName(gomock.Any(), testIndexerName).
+		Return(testState, nil).
+		Times(1)
+
+	response := s.decode(s.do(`{"jsonrpc":"2.0","id":"abc","method":"eth_blockNumber"}`))
+	s.Require().Nil(response.Error)
+	s.Require().Equal(`"abc"`, string(response.Id))
+	s.Require().Equal(`"0x3e8"`, string(response.Result))
+}
+
+func (s *ServerTestSuite) TestMethodNotFound() {
+	response := s.decode(s.do(`{"jsonrpc":"2.0","id":1,"method":"eth_sendRawTransaction","params":["0x00"]}`))
+	s.Require().NotNil(response.Error)
+	s.Require().Equal(CodeMethodNotFound, response.Error.Code)
+}
+
+func (s *ServerTestSuite) TestParseError() {
+	response := s.decode(s.do(`{"jsonrpc":"2.0",`))
+	s.Require().NotNil(response.Error)
+	s.Require().Equal(CodeParseError, response.Error.Code)
+	s.Require().Equal("null", string(response.Id))
+}
+
+func (s *ServerTestSuite) TestBatch() {
+	s.state.EXPECT().
+		ByName(gomock.Any(), testIndexerName).
+		Return(testState, nil).
+		Times(2)
+
+	rec := s.do(`[
+		{"jsonrpc":"2.0","id":1,"method":"eth_chainId"},
+		{"jsonrpc":"2.0","id":2,"method":"eth_blockNumber"},
+		{"jsonrpc":"2.0","id":3,"method":"unknown"}
+	]`)
+
+	var responses []Response
+	s.Require().NoError(json.NewDecoder(rec.Body).Decode(&responses))
+	s.Require().Len(responses, 3)
+	s.Require().Equal(`"0x539"`, string(responses[0].Result))
+	s.Require().Equal(`"0x3e8"`, string(responses[1].Result))
+	s.Require().NotNil(responses[2].Error)
+	s.Require().Equal(CodeMethodNotFound, responses[2].Error.Code)
+}
+
+func (s *ServerTestSuite) TestNotification() {
+	s.state.EXPECT().
+		ByName(gomock.Any(), testIndexerName).
+		Return(testState, nil).
+		Times(1)
+
+	rec := s.send(`{"jsonrpc":"2.0","method":"eth_chainId"}`)
+	s.Require().Equal(http.StatusNoContent, rec.Code)
+	s.Require().Zero(rec.Body.Len())
+}
+
+func (s *ServerTestSuite) TestBatchWithNotifications() {
+	s.state.EXPECT().
+		ByName(gomock.Any(), testIndexerName).
+		Return(testState, nil).
+		Times(2)
+
+	rec := s.do(`[
+		{"jsonrpc":"2.0","method":"eth_chainId"},
+		{"jsonrpc":"2.0","id":null,"method":"eth_blockNumber"},
+		{"jsonrpc":"2.0","method":"unknown"}
+	]`)
+
+	var responses []Response
+	s.Require().NoError(json.NewDecoder(rec.Body).Decode(&responses))
+	s.Require().Len(responses, 1)
+	s.Require().Equal("null", string(responses[0].Id))
+	s.Require().Equal(`"0x3e8"`, string(responses[0].Result))
+}
+
+func (s *ServerTestSuite) TestBatchOfNotifications() {
+	s.state.EXPECT().
+		ByName(gomock.Any(), testIndexerName).
+		Return(testState, nil).
+		Times(2)
+
+	rec := s.send(`[
+		{"jsonrpc":"2.0","method":"eth_chainId"},
+		{"jsonrpc":"2.0","method":"eth_blockNumber"}
+	]`)
+	s.Require().Equal(http.StatusNoContent, rec.Code)
+	s.Require().Zero(rec.Body.Len())
+}
+
+func (s *ServerTestSuite) TestBatchTooLarge() {
+	response := s.decode(s.do(`[{"jsonrpc":"2.0","id":1,"method":"eth_chainId"},{"jsonrpc":"2.0","id":2,"method":"eth_chainId"},{"jsonrpc":"2.0","id":3,"method":"eth_chainId"},{"jsonrpc":"2.0","id":4,"method":"eth_chainId"}]`))
+	s.Require().NotNil(response.Error)
+	s.Require().Equal(CodeLimitExceeded, response.Error.Code)
+}
+
+func (s *ServerTestSuite) TestGetBlockByNumber() {
+	s.state.EXPECT().
+		ByName(gomock.Any(), testIndexerName).
+		Return(testState, nil).
+		Times(1)
+
+	s.blocks.EXPECT().
+		ByHeight(gomock.Any(), pkgTypes.Level(100), false).
+		Return(storage.Block{
+			Height:        100,
+			Time:          testTime,
+			Hash:          testBlockHash,
+			GasLimit:      decimal.NewFromInt(30_000_000),
+			GasUsed:       decimal.NewFromInt(21000),
+			BaseFeePerGas: 7,
+		}, nil).
+		Times(1)
+
+	s.tx.EXPECT().
+		AllByHeight(gomock.Any(), pkgTypes.Level(100)).
+		Return([]storage.Tx{testTx}, nil).
+		Times(1)
+
+	response := s.decode(s.do(`{"jsonrpc":"2.0","id":1,"method":"eth_getBlockByNumber","params":["0x64", false]}`))
+	s.Require().Nil(response.Error)
+
+	var block map[string]any
+	s.Require().NoError(json.Unmarshal(response.Result, &block))
+	s.Require().Equal("0x64", block["number"])
+	s.Require().Equal(testBlockHash.Hex(), block["hash"])
+	s.Require().Equal("0x1c9c380", block["gasLimit"])
+	s.Require().Equal("0x7", block["baseFeePerGas"])
+	s.Require().Equal([]any{testTxHash.Hex()}, block["transactions"])
+}
+
+func (s *ServerTestSuite) TestGetBlockByNumberAboveHead() {
+	s.state.EXPECT().
+		ByName(gomock.Any(), testIndexerName).
+		Return(testState, nil).
+		Times(1)
+
+	response := s.decode(s.do(`{"jsonrpc":"2.0","id":1,"method":"eth_getBlockByNumber","params":["0xffff", true]}`))
+	s.Require().Nil(response.Error)
+	s.Require().Equal("null", string(response.Result))
+}
+
+func (s *ServerTestSuite) TestGetBlockByNumberFinalized() {
+	state := testState
+	state.SafeHeight = 110
+	state.FinalizedHeight = 100
+	s.state.EXPECT().
+		ByName(gomock.Any(), testIndexerName).
+		Return(state, nil).
+		Times(1)
+
+	s.blocks.EXPECT().
+		ByHeight(gomock.Any(), pkgTypes.Level(100), false).
+		Return(storage.Block{
+			Height: 100,
+			Time:   testTime,
+			Hash:   testBlockHash,
+		}, nil).
+		Times(1)
+
+	s.tx.EXPECT().
+		AllByHeight(gomock.Any(), pkgTypes.Level(100)).
+		Return([]storage.Tx{}, nil).
+		Times(1)
+
+	response := s.decode(s.do(`{"jsonrpc":"2.0","id":1,"method":"eth_getBlockByNumber","params":["finalized", false]}`))
+	s.Require().Nil(response.Error)
+
+	var block map[string]any
+	s.Require().NoError(json.Unmarshal(response.Result, &block))
+	s.Require().Equal("0x64", block["number"])
+}
+
+func (s *ServerTestSuite) TestGetBlockByNumberFinalizedNotTracked() {
+	s.state.EXPECT().
+		ByName(gomock.Any(), testIndexerName).
+		Return(testState, nil).
+		Times(1)
+
+	response := s.decode(s.do(`{"jsonrpc":"2.0","id":1,"method":"eth_getBlockByNumber","params":["finalized", false]}`))
+	s.Require().NotNil(response.Error)
+	s.Require().Equal(CodeServerError, response.Error.Code)
+	s.Require().Equal("finalized block not found", response.Error.Message)
+}
+
+func (s *ServerTestSuite) TestGetTransactionByHashNotFound() {
+	s.tx.EXPECT().
+		ByHash(gomock.Any(), pkgTypes.Hex(testTxHash), false).
+		Return(storage.Tx{}, sql.ErrNoRows).
+		Times(1)
+	s.tx.EXPECT().
+		IsNoRows(sql.ErrNoRows).
+		Return(true).
+		Times(1)
+
+	response := s.decode(s.do(`{"jsonrpc":"2.0","id":1,"method":"eth_getTransactionByHash","params":["` + testTxHash.Hex() + `"]}`))
+	s.Require().Nil(response.Error)
+	s.Require().Equal("null", string(response.Result))
+}
+
+func (s *ServerTestSuite) TestGetTransactionByHashTypedFields() {
+	maxFee := decimal.NewFromInt(2_000_000_000)
+	maxPriorityFee := decimal.NewFromInt(1_000_000)
+	tx := testTx
+	tx.Type = types.TxTypeAccessList
+	tx.MaxFeePerGas = &maxFee
+	tx.MaxPriorityFeePerGas = &maxPriorityFee
+
+	s.tx.EXPECT().
+		ByHash(gomock.Any(), pkgTypes.Hex(testTxHash), false).
+		Return(tx, nil).
+		Times(1)
+
+	s.blocks.EXPECT().
+		ByHeight(gomock.Any(), pkgTypes.Level(100), false).
+		Return(storage.Block{Height: 100, Hash: testBlockHash}, nil).
+		Times(1)
+
+	response := s.decode(s.do(`{"jsonrpc":"2.0","id":1,"method":"eth_getTransactionByHash","params":["` + testTxHash.Hex() + `"]}`))
+	s.Require().Nil(response.Error)
+
+	var result map[string]any
+	s.Require().NoError(json.Unmarshal(response.Result, &result))
+	s.Require().Equal("0x1", result["type"])
+	s.Require().Equal("0x77359400", result["maxFeePerGas"])
+	s.Require().Equal("0xf4240", result["maxPriorityFeePerGas"])
+	s.Require().NotContains(result, "maxFeePerBlobGas")
+	s.Require().NotContains(result, "blobVersionedHashes")
+}
+
+func (s *ServerTestSuite) TestGetTransactionReceipt() {
+	toId := uint64(7)
+	tx := testTx
+	tx.ToAddressId = &toId
+	tx.ToAddress = &storage.Address{Id: 7, Hash: testAddress}
+
+	s.tx.EXPECT().
+		ByHash(gomock.Any(), pkgTypes.Hex(testTxHash), false).
+		Return(tx, nil).
+		Times(1)
+
+	s.blocks.EXPECT().
+		ByHeight(gomock.Any(), pkgTypes.Level(100), false).
+		Return(storage.Block{Height: 100, Hash: testBlockHash}, nil).
+		Times(1)
+
+	txId := uint64(10)
+	s.logs.EXPECT().
+		ByRange(gomock.Any(), storage.LogRangeFilter{
+			HeightFrom: 100,
+			HeightTo:   100,
+			TxId:       &txId,
+		}).
+		Return([]storage.Log{testLog}, nil).
+		Times(1)
+
+	response := s.decode(s.do(`{"jsonrpc":"2.0","id":1,"method":"eth_getTransactionReceipt","params":["` + testTxHash.Hex() + `"]}`))
+	s.Require().Nil(response.Error)
+
+	var receipt Receipt
+	s.Require().NoError(json.Unmarshal(response.Result, &receipt))
+	s.Require().EqualValues(1, receipt.Status)
+	s.Require().EqualValues(2, receipt.Type)
+	s.Require().EqualValues(2, receipt.TransactionIndex)
+	s.Require().Nil(receipt.ContractAddress)
+	s.Require().Len(receipt.Logs, 1)
+	s.Require().EqualValues(3, receipt.Logs[0].LogIndex)
+	s.Require().Equal(testBlockHash.Hex(), receipt.Logs[0].BlockHash.String())
+}
+
+func (s *ServerTestSuite) TestGetTransactionReceiptBlobFields() {
+	blobGasUsed := decimal.NewFromInt(131072)
+	blobGasPrice := decimal.NewFromInt(3)
+	toId := uint64(7)
+	tx := testTx
+	tx.ToAddressId = &toId
+	tx.ToAddress = &storage.Address{Id: 7, Hash: testAddress}
+	tx.Type = types.TxTypeBlob
+	tx.BlobGasUsed = &blobGasUsed
+	tx.BlobGasPrice = &blobGasPrice
+
+	s.tx.EXPECT().
+		ByHash(gomock.Any(), pkgTypes.Hex(testTxHash), false).
+		Return(tx, nil).
+		Times(1)
+
+	s.blocks.EXPECT().
+		ByHeight(gomock.Any(), pkgTypes.Level(100), false).
+		Return(storage.Block{Height: 100, Hash: testBlockHash}, nil).
+		Times(1)
+
+	s.logs.EXPECT().
+		ByRange(gomock.Any(), gomock.Any()).
+		Return([]storage.Log{}, nil).
+		Times(1)
+
+	response := s.decode(s.do(`{"jsonrpc":"2.0","id":1,"method":"eth_getTransactionReceipt","params":["` + testTxHash.Hex() + `"]}`))
+	s.Require().Nil(response.Error)
+
+	var result map[string]any
+	s.Require().NoError(json.Unmarshal(response.Result, &result))
+	s.Require().Equal("0x3", result["type"])
+	s.Require().Equal("0x20000", result["blobGasUsed"])
+	s.Require().Equal("0x3", result["blobGasPrice"])
+}
+
+func (s *ServerTestSuite) TestGetLogs() {
+	s.state.EXPECT().
+		ByName(gomock.Any(), testIndexerName).
+		Return(testState, nil).
+		Times(1)
+
+	s.address.EXPECT().
+		ByHash(gomock.Any(), pkgTypes.Hex(testAddress)).
+		Return(storage.Address{Id: 7, Hash: testAddress}, nil).
+		Times(1)
+
+	s.logs.EXPECT().
+		ByRange(gomock.Any(), storage.LogRangeFilter{
+			HeightFrom: 50,
+			HeightTo:   120,
+			AddressIds: []uint64{7},
+			Topic0:     []pkgTypes.Hex{testTopic0},
+			Limit:      3,
+		}).
+		Return([]storage.Log{testLog}, nil).
+		Times(1)
+
+	response := s.decode(s.do(`{"jsonrpc":"2.0","id":1,"method":"eth_getLogs","params":[{
+		"fromBlock":"0x32",
+		"toBlock":"0x78",
+		"address":"` + testAddress.Hex() + `",
+		"topics":[["` + testTopic0.Hex() + `"], null]
+	}]}`))
+	s.Require().Nil(response.Error)
+
+	var logs []Log
+	s.Require().NoError(json.Unmarshal(response.Result, &logs))
+	s.Require().Len(logs, 1)
+	s.Require().Equal(testAddress.Hex(), logs[0].Address.String())
+	s.Require().Equal(testTxHash.Hex(), logs[0].TransactionHash.String())
+	s.Require().EqualValues(100, logs[0].BlockNumber)
+}
+
+func (s *ServerTestSuite) TestGetLogsRangeTooLarge() {
+	s.state.EXPECT().
+		ByName(gomock.Any(), testIndexerName).
+		Return(testState, nil).
+		Times(1)
+
+	response := s.decode(s.do(`{"jsonrpc":"2.0","id":1,"method":"eth_getLogs","params":[{"fromBlock":"0x1","toBlock":"latest"}]}`))
+	s.Require().NotNil(response.Error)
+	s.Require().Equal(CodeLimitExceeded, response.Error.Code)
+}
+
+func (s *ServerTestSuite) TestGetLogsInvalidRange() {
+	s.state.EXPECT().
+		ByName(gomock.Any(), testIndexerName).
+		Return(testState, nil).
+		Times(1)
+
+	response := s.decode(s.do(`{"jsonrpc":"2.0","id":1,"method":"eth_getLogs","params":[{"fromBlock":"0x10","toBlock":"0x1"}]}`))
+	s.Require().NotNil(response.Error)
+	s.Require().Equal(CodeInvalidParams, response.Error.Code)
+}
+
+func (s *ServerTestSuite) TestGetLogsTooManyResults() {
+	s.state.EXPECT().
+		ByName(gomock.Any(), testIndexerName).
+		Return(testState, nil).
+		Times(1)
+
+	s.logs.EXPECT().
+		ByRange(gomock.Any(), gomock.Any()).
+		Return([]storage.Log{testLog, testLog, testLog}, nil).
+		Times(1)
+
+	response := s.decode(s.do(`{"jsonrpc":"2.0","id":1,"method":"eth_getLogs","params":[{"fromBlock":"0x3e0"}]}`))
+	s.Require().NotNil(response.Error)
+	s.Require().Equal(CodeLimitExceeded, response.Error.Code)
+}
@@ -0,0 +1,346 @@
+package jsonrpc
+
+import (
+	"encoding/json"
+	"math/big"
+	"strings"
+
+	"github.com/NobleScope/noble-indexer/internal/storage"
+	"github.com/NobleScope/noble-indexer/internal/storage/types"
+	pkgTypes "github.com/NobleScope/noble-indexer/pkg/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/shopspring/decimal"
+)
+
+const version = "2.0"
+
+// Request -
+type Request struct {
+	JsonRpc string          `json:"jsonrpc"`
+	Id      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+}
+
+// isNotification - valid requests without id are notifications which are executed without a response
+func (r Request) isNotification() bool {
+	return len(r.Id) == 0 && r.JsonRpc == version && r.Method != ""
+}
+
+// Response -
+type Response struct {
+	JsonRpc string          `json:"jsonrpc"`
+	Id      json.RawMessage `json:"id"`
+	Result  json.RawMessage `json:"result,omitempty"`
+	Error   *Error          `json:"error,omitempty"`
+}
+
+// Block - block in the eth_getBlockBy* format
+type Block struct {
+	Number           hexutil.Uint64  `json:"number"`
+	Hash             hexutil.Bytes   `json:"hash"`
+	ParentHash       hexutil.Bytes   `json:"parentHash"`
+	Nonce            hexutil.Bytes   `json:"nonce"`
+	MixHash          hexutil.Bytes   `json:"mixHash"`
+	Sha3Uncles       hexutil.Bytes   `json:"sha3Uncles"`
+	LogsBloom        hexutil.Bytes   `json:"logsBloom"`
+	TransactionsRoot hexutil.Bytes   `json:"transactionsRoot"`
+	StateRoot        hexutil.Bytes   `json:"stateRoot"`
+	ReceiptsRoot     hexutil.Bytes   `json:"receiptsRoot"`
+	Miner            hexutil.Bytes   `json:"miner"`
+	Difficulty       *hexutil.Big    `json:"difficulty"`
+	ExtraData        hexutil.Bytes   `json:"extraData"`
+	Size             *hexutil.Big    `json:"size"`
+	GasLimit         *hexutil.Big    `json:"gasLimit"`
+	GasUsed          *hexutil.Big    `json:"gasUsed"`
+	Timestamp        hexutil.Uint64  `json:"timestamp"`
+	BaseFeePerGas    hexutil.Uint64  `json:"baseFeePerGas"`
+	Transactions     []any           `json:"transactions"`
+	Uncles           []hexutil.Bytes `json:"uncles"`
+
+	BlobGasUsed   *hexutil.Big `json:"blobGasUsed,omitempty"`
+	ExcessBlobGas *hexutil.Big `json:"excessBlobGas,omitempty"`
+}
+
+func NewBlock(block storage.Block, txs []storage.Tx, fullTx bool) Block {
+	b := Block{
+		Number:           hexutil.Uint64(block.Height),
+		Hash:             hexutil.Bytes(block.Hash),
+		ParentHash:       hexutil.Bytes(block.ParentHashHash),
+		Nonce:            hexutil.Bytes(block.NonceHash),
+		MixHash:          hexutil.Bytes(block.MixHash),
+		Sha3Uncles:       hexutil.Bytes(block.Sha3UnclesHash),
+		LogsBloom:        hexutil.Bytes(block.LogsBloomHash),
+		TransactionsRoot: hexutil.Bytes(block.TransactionsRootHash),
+		StateRoot:        hexutil.Bytes(block.StateRootHash),
+		ReceiptsRoot:     hexutil.Bytes(block.ReceiptsRootHash),
+		Miner:            hexutil.Bytes(block.Miner.Hash),
+		Difficulty:       bytesToBig(block.DifficultyHash),
+		ExtraData:        hexutil.Bytes(block.ExtraDataHash),
+		Size:             bytesToBig(block.SizeHash),
+		GasLimit:         decimalToBig(block.GasLimit),
+		GasUsed:          decimalToBig(block.GasUsed),
+		Timestamp:        hexutil.Uint64(block.Time.Unix()),
+		BaseFeePerGas:    hexutil.Uint64(block.BaseFeePerGas),
+		Transactions:     make([]any, len(txs)),
+		Uncles:           []hexutil.Bytes{},
+	}
+	if block.BlobGasUsed != nil {
+		b.BlobGasUsed = decimalToBig(*block.BlobGasUsed)
+	}
+	if block.ExcessBlobGas != nil {
+		b.ExcessBlobGas = decimalToBig(*block.ExcessBlobGas)
+	}
+
+	for i := range txs {
+		if fullTx {
+			b.Transactions[i] = NewTransaction(txs[i], block.Hash)
+		} else {
+			b.Transactions[i] = hexutil.Bytes(txs[i].Hash)
+		}
+	}
+	return b
+}
+
+// Transaction - transaction in the eth_getTransactionByHash format
+type Transaction struct {
+	BlockHash        hexutil.Bytes  `json:"blockHash"`
+	BlockNumber      hexutil.Uint64 `json:"blockNumber"`
+	From             hexutil.Bytes  `json:"from"`
+	Gas              *hexutil.Big   `json:"gas"`
+	GasPrice         *hexutil.Big   `json:"gasPrice"`
+	Hash             hexutil.Bytes  `json:"hash"`
+	Input            hexutil.Bytes  `json:"input"`
+	Nonce            hexutil.Uint64 `json:"nonce"`
+	To               *hexutil.Bytes `json:"to"`
+	TransactionIndex hexutil.Uint64 `json:"transactionIndex"`
+	Value            *hexutil.Big   `json:"value"`
+	Type             hexutil.Uint64 `json:"type"`
+
+	MaxFeePerGas         *hexutil.Big    `json:"maxFeePerGas,omitempty"`
+	MaxPriorityFeePerGas *hexutil.Big    `json:"maxPriorityFeePerGas,omitempty"`
+	MaxFeePerBlobGas     *hexutil.Big    `json:"maxFeePerBlobGas,omitempty"`
+	BlobVersionedHashes  []hexutil.Bytes `json:"blobVersionedHashes,omitempty"`
+}
+
+func NewTransaction(tx storage.Tx, blockHash pkgTypes.Hex) Transaction {
+	t := Transaction{
+		BlockHash:        hexutil.Bytes(blockHash),
+		BlockNumber:      hexutil.Uint64(tx.Height),
+		From:             hexutil.Bytes(tx.FromAddress.Hash),
+		Gas:              decimalToBig(tx.Gas),
+		GasPrice:         decimalToBig(tx.GasPrice),
+		Hash:             hexutil.Bytes(tx.Hash),
+		Input:            hexutil.Bytes(tx.Input),
+		Nonce:            hexutil.Uint64(tx.Nonce),
+		TransactionIndex: hexutil.Uint64(tx.Index),
+		Value:            decimalToBig(tx.Amount),
+		Type:             txTypeNumber(tx.Type),
+	}
+	if tx.ToAddressId != nil && tx.ToAddress != nil {
+		to := hexutil.Bytes(tx.ToAddress.Hash)
+		t.To = &to
+	}
+	if tx.MaxFeePerGas != nil {
+		t.MaxFeePerGas = decimalToBig(*tx.MaxFeePerGas)
+	}
+	if tx.MaxPriorityFeePerGas != nil {
+		t.MaxPriorityFeePerGas = decimalToBig(*tx.MaxPriorityFeePerGas)
+	}
+	if tx.MaxFeePerBlobGas != nil {
+		t.MaxFeePerBlobGas = decimalToBig(*tx.MaxFeePerBlobGas)
+	}
+	for i := range tx.BlobVersionedHashes {
+		t.BlobVersionedHashes = append(t.BlobVersionedHashes, hexutil.Bytes(tx.BlobVersionedHashes[i]))
+	}
+	return t
+}
+
+// Receipt - transaction receipt in the eth_getTransactionReceipt format
+type Receipt struct {
+	TransactionHash   hexutil.Bytes  `json:"transactionHash"`
+	TransactionIndex  hexutil.Uint64 `json:"transactionIndex"`
+	BlockHash         hexutil.Bytes  `json:"blockHash"`
+	BlockNumber       hexutil.Uint64 `json:"blockNumber"`
+	From              hexutil.Bytes  `json:"from"`
+	To                *hexutil.Bytes `json:"to"`
+	CumulativeGasUsed *hexutil.Big   `json:"cumulativeGasUsed"`
+	GasUsed           *hexutil.Big   `json:"gasUsed"`
+	EffectiveGasPrice *hexutil.Big   `json:"effectiveGasPrice"`
+	ContractAddress   *hexutil.Bytes `json:"contractAddress"`
+	Logs              []Log          `json:"logs"`
+	LogsBloom         hexutil.Bytes  `json:"logsBloom"`
+	Status            hexutil.Uint64 `json:"status"`
+	Type              hexutil.Uint64 `json:"type"`
+
+	BlobGasUsed  *hexutil.Big `json:"blobGasUsed,omitempty"`
+	BlobGasPrice *hexutil.Big `json:"blobGasPrice,omitempty"`
+}
+
+func NewReceipt(tx storage.Tx, blockHash pkgTypes.Hex, contractAddress pkgTypes.Hex, logs []storage.Log) Receipt {
+	tr := NewTransaction(tx, blockHash)
+	r := Receipt{
+		TransactionHash:   tr.Hash,
+		TransactionIndex:  tr.TransactionIndex,
+		BlockHash:         tr.BlockHash,
+		BlockNumber:       tr.BlockNumber,
+		From:              tr.From,
+		To:                tr.To,
+		CumulativeGasUsed: decimalToBig(tx.CumulativeGasUsed),
+		GasUsed:           decimalToBig(tx.GasUsed),
+		EffectiveGasPrice: decimalToBig(tx.EffectiveGasPrice),
+		Logs:              make([]Log, len(logs)),
+		LogsBloom:         hexutil.Bytes(tx.LogsBloom),
+		Type:              tr.Type,
+	}
+	if tx.Status == types.TxStatusSuccess {
+		r.Status = 1
+	}
+	if tx.BlobGasUsed != nil {
+		r.BlobGasUsed = decimalToBig(*tx.BlobGasUsed)
+	}
+	if tx.BlobGasPrice != nil {
+		r.BlobGasPrice = decimalToBig(*tx.BlobGasPrice)
+	}
+	if len(contractAddress) > 0 {
+		address := hexutil.Bytes(contractAddress)
+		r.ContractAddress = &address
+	}
+	for i := range logs {
+		r.Logs[i] = NewLog(logs[i])
+		r.Logs[i].BlockHash = tr.BlockHash
+		r.Logs[i].TransactionHash = tr.Hash
+		r.Logs[i].TransactionIndex = tr.TransactionIndex
+	}
+	return r
+}
+
+// Log - log in the eth_getLogs format
+type Log struct {
+	Address          hexutil.Bytes   `json:"address"`
+	Topics           []hexutil.Bytes `json:"topics"`
+	Data             hexutil.Bytes   `json:"data"`
+	BlockNumber      hexutil.Uint64  `json:"blockNumber"`
+	TransactionHash  hexutil.Bytes   `json:"transactionHash"`
+	TransactionIndex hexutil.Uint64  `json:"transactionIndex"`
+	BlockHash        hexutil.Bytes   `json:"blockHash"`
+	LogIndex         hexutil.Uint64  `json:"logIndex"`
+	Removed          bool            `json:"removed"`
+}
+
+func NewLog(log storage.Log) Log {
+	l := Log{
+		Address:          hexutil.Bytes(log.Address.Hash),
+		Topics:           make([]hexutil.Bytes, len(log.Topics)),
+		Data:             hexutil.Bytes(log.Data),
+		BlockNumber:      hexutil.Uint64(log.Height),
+		TransactionHash:  hexutil.Bytes(log.Tx.Hash),
+		TransactionIndex: hexutil.Uint64(log.Tx.Index),
+		BlockHash:        hexutil.Bytes(log.BlockHash),
+		LogIndex:         hexutil.Uint64(log.Index),
+		Removed:          log.Removed,
+	}
+	for i := range log.Topics {
+		l.Topics[i] = hexutil.Bytes(log.Topics[i])
+	}
+	if l.Data == nil {
+		l.Data = hexutil.Bytes{}
+	}
+	return l
+}
+
+// FilterQuery - eth_getLogs filter object
+type FilterQuery struct {
+	BlockHash *string           `json:"blockHash"`
+	FromBlock *string           `json:"fromBlock"`
+	ToBlock   *string           `json:"toBlock"`
+	Address   json.RawMessage   `json:"address"`
+	Topics    []json.RawMessage `json:"topics"`
+}
+
+// Addresses - returns the address filter which may be a single value or a list of values
+func (f FilterQuery) Addresses() ([]pkgTypes.Hex, error) {
+	values, err := stringOrArray(f.Address)
+	if err != nil {
+		return nil, err
+	}
+	addresses := make([]pkgTypes.Hex, len(values))
+	for i := range values {
+		if addresses[i], err = hexutil.Decode(values[i]); err != nil {
+			return nil, err
+		}
+		if len(addresses[i]) != 20 {
+			return nil, newError(CodeInvalidParams, "invalid address: %s", values[i])
+		}
+	}
+	return addresses, nil
+}
+
+// TopicsAt - returns the OR-list of topics for the given position. Empty list matches any topic.
+func (f FilterQuery) TopicsAt(idx int) ([]pkgTypes.Hex, error) {
+	if idx >= len(f.Topics) {
+		return nil, nil
+	}
+	values, err := stringOrArray(f.Topics[idx])
+	if err != nil || len(values) == 0 {
+		return nil, err
+	}
+	topics := make([]pkgTypes.Hex, len(values))
+	for i := range values {
+		if topics[i], err = hexutil.Decode(values[i]); err != nil {
+			return nil, err
+		}
+		if len(topics[i]) != 32 {
+			return nil, newError(CodeInvalidParams, "invalid topic: %s", values[i])
+		}
+	}
+	return topics, nil
+}
+
+func stringOrArray(raw json.RawMessage) ([]string, error) {
+	trimmed := strings.TrimSpace(string(raw))
+	if trimmed == "" || trimmed == "null" {
+		return nil, nil
+	}
+	if strings.HasPrefix(trimmed, "[") {
+		var values []*string
+		if err := json.Unmarshal(raw, &values); err != nil {
+			return nil, err
+		}
+		result := make([]string, 0, len(values))
+		for i := range values {
+			if values[i] != nil {
+				result = append(result, *values[i])
+			}
+		}
+		return result, nil
+	}
+	var value string
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return nil, err
+	}
+	return []string{value}, nil
+}
+
+func decimalToBig(d decimal.Decimal) *hexutil.Big {
+	return (*hexutil.Big)(d.BigInt())
+}
+
+func bytesToBig(b []byte) *hexutil.Big {
+	return (*hexutil.Big)(new(big.Int).SetBytes(b))
+}
+
+func txTypeNumber(typ types.TxType) hexutil.Uint64 {
+	switch typ {
+	case types.TxTypeAccessList:
+		return 1
+	case types.TxTypeDynamicFee:
+		return 2
+	case types.TxTypeBlob:
+		return 3
+	case types.TxTypeSetCode:
+		return 4
+	default:
+		return 0
+	}
+}
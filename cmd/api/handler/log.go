@@ -1,42 +1,58 @@
 package handler
 
 import (
+	"strings"
 	"time"
 
 	"github.com/NobleScope/noble-indexer/cmd/api/handler/responses"
 	"github.com/NobleScope/noble-indexer/cmd/api/helpers"
 	"github.com/NobleScope/noble-indexer/internal/storage"
 	"github.com/NobleScope/noble-indexer/pkg/types"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
 )
 
 type LogHandler struct {
-	log     storage.ILog
-	tx      storage.ITx
-	address storage.IAddress
+	log         storage.ILog
+	tx          storage.ITx
+	address     storage.IAddress
+	state       storage.IState
+	indexerName string
 }
 
 func NewLogHandler(
 	log storage.ILog,
 	tx storage.ITx,
 	address storage.IAddress,
+	state storage.IState,
+	indexerName string,
 ) *LogHandler {
 	return &LogHandler{
-		log:     log,
-		tx:      tx,
-		address: address,
+		log:         log,
+		tx:          tx,
+		address:     address,
+		state:       state,
+		indexerName: indexerName,
 	}
 }
 
 type logListRequest struct {
-	Limit   int     `query:"limit"   validate:"omitempty,min=1,max=100"`
-	Offset  int     `query:"offset"  validate:"omitempty,min=0"`
-	Sort    string  `query:"sort"    validate:"omitempty,oneof=asc desc"`
-	Height  *uint64 `query:"height"  validate:"omitempty,min=0"`
-	TxHash  string  `query:"tx_hash" validate:"omitempty,tx_hash"`
-	Address string  `query:"address" validate:"omitempty,address"`
-	Decode  bool    `query:"decode"  validate:"omitempty"`
-	Cursor  string  `query:"cursor"  validate:"omitempty"`
+	Limit    int         `query:"limit"      validate:"omitempty,min=1,max=100"`
+	Offset   int         `query:"offset"     validate:"omitempty,min=0"`
+	Sort     string      `query:"sort"       validate:"omitempty,oneof=asc desc"`
+	Height   *uint64     `query:"height"     validate:"omitempty,min=0"`
+	TxHash   string      `query:"tx_hash"    validate:"omitempty,tx_hash"`
+	Address  string      `query:"address"    validate:"omitempty,address"`
+	Topic0   StringArray `query:"topic0"     validate:"omitempty,max=10,dive,topic"`
+	Topic1   StringArray `query:"topic1"     validate:"omitempty,max=10,dive,topic"`
+	Topic2   StringArray `query:"topic2"     validate:"omitempty,max=10,dive,topic"`
+	Topic3   StringArray `query:"topic3"     validate:"omitempty,max=10,dive,topic"`
+	Event    string      `query:"event_name" validate:"omitempty,max=256"`
+	Args     StringArray `query:"arg"        validate:"omitempty,max=5,dive,event_arg"`
+	Decode   bool        `query:"decode"     validate:"omitempty"`
+	Finality string      `query:"finality"   validate:"omitempty,finality"`
+	Cursor   string      `query:"cursor"     validate:"omitempty"`
 
 	From int64 `example:"1692892095" query:"time_from" swaggertype:"integer" validate:"omitempty,min=1"`
 	To   int64 `example:"1692892095" query:"time_to"   swaggertype:"integer" validate:"omitempty,min=1"`
@@ -54,7 +70,9 @@ func (req *logListRequest) SetDefault() {
 // List godoc
 //
 //	@Summary		List event logs
-//	@Description	Returns a paginated list of event logs emitted by smart contracts. Can be filtered by transaction, address, block height, or time range.
+//	@Description	Returns a paginated list of event logs emitted by smart contracts. Can be filtered by transaction, address, block height, topics, or time range.
+//	@Description	Each topic filter accepts a comma-separated list of 32-byte hex values matched with OR semantics at its position, like eth_getLogs.
+//	@Description	Logs decoded by the indexer can be filtered by event name and argument values. Addresses are compared in checksum form, other hex values in lower case.
 //	@Tags			transactions
 //	@ID				list-transaction-log
 //	@Param			tx_hash			query	string	false	"Filter by transaction hash (hexadecimal with 0x prefix)"	minlength(66)	maxlength(66)	example(0x1234567890abcdef1234567890abcdef1234567890abcdef1234567890abcdef)
@@ -62,15 +80,23 @@ func (req *logListRequest) SetDefault() {
 //	@Param			offset			query	integer	false	"Number of logs to skip (default: 0)"						minimum(0)	default(0)
 //	@Param			address			query	string	false	"Filter by contract address that emitted the log"			minlength(42)	maxlength(42)	example(0x742d35Cc6634C0532925a3b844Bc9e7595f0bEb)
 //	@Param			height			query	integer	false	"Filter by block height"									minimum(1)	example(12345)
+//	@Param			topic0			query	string	false	"Filter by first topic (event signature), comma-separated list"	example(0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef)
+//	@Param			topic1			query	string	false	"Filter by second topic, comma-separated list"
+//	@Param			topic2			query	string	false	"Filter by third topic, comma-separated list"
+//	@Param			topic3			query	string	false	"Filter by fourth topic, comma-separated list"
 //	@Param			time_from		query	integer	false	"Filter by timestamp from (Unix timestamp)"					minimum(1)	example(1692892095)
 //	@Param			time_to			query	integer	false	"Filter by timestamp to (Unix timestamp)"					minimum(1)	example(1692892095)
 //	@Param			sort			query	string	false	"Sort order by timestamp (default: desc)"					Enums(asc, desc)	default(desc)
+//	@Param			event_name		query	string	false	"Filter by decoded event name. Logs are decoded by the indexer if the log decoder is enabled"	example(Transfer)
+//	@Param			arg				query	string	false	"Filter by decoded event arguments, comma-separated list of name:value pairs matched with AND semantics"	example(from:0x742d35Cc6634C0532925a3b844Bc9e7595f0bEb)
 //	@Param			decode			query	boolean	false	"Decode log data and topics using contract ABI"				default(false)
+//	@Param			finality		query	string	false	"Return only data of safe or finalized blocks, safe includes finalized ones (default: latest)"	Enums(latest, safe, finalized)
 //	@Param			cursor			query	string	false	"Opaque cursor for keyset pagination. Base64url-encoded value from the previous response's 'cursor' field. Encodes (timestamp, id) of the last returned record. Cannot be used together with offset (returns 400)."
 //	@Produce		json
 //	@Success		200	{object}	CursorResponse	"List of event logs"
 //	@Failure		400	{object}	Error			"Invalid request parameters"
 //	@Failure		500	{object}	Error			"Internal server error"
+//	@Failure		503	{object}	Error			"Requested finality is not tracked by the indexer"
 //	@Router			/logs [get]
 func (handler *LogHandler) List(c echo.Context) error {
 	req, err := bindAndValidate[logListRequest](c)
@@ -86,6 +112,11 @@ func (handler *LogHandler) List(c echo.Context) error {
 		WithABI: req.Decode,
 	}
 
+	filters.MaxHeight, err = finalityHeight(c.Request().Context(), handler.state, handler.indexerName, req.Finality)
+	if err != nil {
+		return handleError(c, err, handler.state)
+	}
+
 	if req.Cursor != "" {
 		if req.Offset > 0 {
 			return badRequestError(c, errCursorWithOffset)
@@ -130,6 +161,24 @@ func (handler *LogHandler) List(c echo.Context) error {
 		filters.Height = req.Height
 	}
 
+	if filters.Topic0, err = parseTopics(req.Topic0); err != nil {
+		return badRequestError(c, err)
+	}
+	if filters.Topic1, err = parseTopics(req.Topic1); err != nil {
+		return badRequestError(c, err)
+	}
+	if filters.Topic2, err = parseTopics(req.Topic2); err != nil {
+		return badRequestError(c, err)
+	}
+	if filters.Topic3, err = parseTopics(req.Topic3); err != nil {
+		return badRequestError(c, err)
+	}
+
+	filters.EventName = req.Event
+	if filters.EventArgs, err = parseEventArgs(req.Args); err != nil {
+		return badRequestError(c, err)
+	}
+
 	if req.From > 0 {
 		filters.TimeFrom = time.Unix(req.From, 0).UTC()
 	}
@@ -155,3 +204,45 @@ func (handler *LogHandler) List(c echo.Context) error {
 
 	return returnCursorList(c, response, cursor)
 }
+
+func parseTopics(values StringArray) ([]types.Hex, error) {
+	if len(values) == 0 {
+		return nil, nil
+	}
+	topics := make([]types.Hex, len(values))
+	for i := range values {
+		topic, err := types.HexFromString(values[i])
+		if err != nil {
+			return nil, err
+		}
+		topics[i] = topic
+	}
+	return topics, nil
+}
+
+// parseEventArgs parses `name:value` pairs of decoded event arguments.
+// Hex values are converted to the form of stored arguments: checksum addresses and lower-case bytes.
+func parseEventArgs(values StringArray) (map[string]string, error) {
+	if len(values) == 0 {
+		return nil, nil
+	}
+	args := make(map[string]string, len(values))
+	for i := range values {
+		name, value, ok := strings.Cut(values[i], ":")
+		if !ok || name == "" || value == "" {
+			return nil, errors.Errorf("invalid event argument: %s", values[i])
+		}
+		if _, ok := args[name]; ok {
+			return nil, errors.Errorf("duplicate event argument: %s", name)
+		}
+
+		switch {
+		case evmAddressRegex.MatchString(value) && strings.HasPrefix(value, "0x"):
+			value = common.HexToAddress(value).Hex()
+		case strings.HasPrefix(value, "0x"):
+			value = strings.ToLower(value)
+		}
+		args[name] = value
+	}
+	return args, nil
+}
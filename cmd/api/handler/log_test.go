@@ -93,6 +93,7 @@ type LogHandlerTestSuite struct {
 	log     *mock.MockILog
 	tx      *mock.MockITx
 	address *mock.MockIAddress
+	state   *mock.MockIState
 	echo    *echo.Echo
 	handler *LogHandler
 	ctrl    *gomock.Controller
@@ -106,7 +107,8 @@ func (s *LogHandlerTestSuite) SetupSuite() {
 	s.log = mock.NewMockILog(s.ctrl)
 	s.tx = mock.NewMockITx(s.ctrl)
 	s.address = mock.NewMockIAddress(s.ctrl)
-	s.handler = NewLogHandler(s.log, s.tx, s.address)
+	s.state = mock.NewMockIState(s.ctrl)
+	s.handler = NewLogHandler(s.log, s.tx, s.address, s.state, testIndexerName)
 }
 
 // TearDownSuite -
@@ -874,3 +876,122 @@ func (s *LogHandlerTestSuite) TestListEmptyCursorOnEmpty() {
 	s.Require().NoError(err)
 	s.Require().Empty(body.Cursor)
 }
+
+// TestListWithTopics tests filtering logs by topic OR-lists
+func (s *LogHandlerTestSuite) TestListWithTopics() {
+	transfer := "0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"
+	approval := "0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925"
+	owner := "0x000000000000000000000000742d35cc6634c0532925a3b844bc9e7595f0beb0"
+
+	q := make(url.Values)
+	q.Set("topic0", transfer+","+approval)
+	q.Set("topic2", owner)
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/log")
+
+	s.log.EXPECT().
+		Filter(gomock.Any(), storage.LogListFilter{
+			Limit:  10,
+			Offset: 0,
+			Sort:   sdk.SortOrderDesc,
+			Topic0: []pkgTypes.Hex{pkgTypes.MustDecodeHex(transfer), pkgTypes.MustDecodeHex(approval)},
+			Topic2: []pkgTypes.Hex{pkgTypes.MustDecodeHex(owner)},
+		}).
+		Return([]storage.Log{testLog1, testLog2}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.List(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var body struct {
+		Result []responses.Log `json:"result"`
+		Cursor string          `json:"cursor"`
+	}
+	err := json.NewDecoder(rec.Body).Decode(&body)
+	s.Require().NoError(err)
+	s.Require().Len(body.Result, 2)
+}
+
+// TestListInvalidTopic tests handling of invalid topic value
+func (s *LogHandlerTestSuite) TestListInvalidTopic() {
+	q := make(url.Values)
+	q.Set("topic1", "0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef,0x1234")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/log")
+
+	s.Require().NoError(s.handler.List(c))
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+
+	var e Error
+	err := json.NewDecoder(rec.Body).Decode(&e)
+	s.Require().NoError(err)
+	s.Require().NotEmpty(e.Message)
+}
+
+// TestListWithEventFilters tests filtering by decoded event name and arguments
+func (s *LogHandlerTestSuite) TestListWithEventFilters() {
+	q := make(url.Values)
+	q.Set("event_name", "Transfer")
+	q.Set("arg", "from:0x742d35cc6634c0532925a3b844bc9e7595f0beb0,value:1000,id:0xABCDEF")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/log")
+
+	decoded := testLog1
+	decoded.EventName = "Transfer"
+	decoded.EventSignature = "Transfer(address,address,uint256)"
+	decoded.EventArgs = []byte(`{"from":"0x742D35CC6634c0532925A3b844BC9E7595F0BEb0","to":"0x0000000000000000000000000000000000000001","value":"1000"}`)
+
+	s.log.EXPECT().
+		Filter(gomock.Any(), storage.LogListFilter{
+			Limit:     10,
+			Offset:    0,
+			Sort:      sdk.SortOrderDesc,
+			EventName: "Transfer",
+			EventArgs: map[string]string{
+				"from":  "0x742D35CC6634c0532925A3b844BC9E7595F0BEb0",
+				"value": "1000",
+				"id":    "0xabcdef",
+			},
+		}).
+		Return([]storage.Log{decoded}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.List(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var body struct {
+		Result []responses.Log `json:"result"`
+		Cursor string          `json:"cursor"`
+	}
+	err := json.NewDecoder(rec.Body).Decode(&body)
+	s.Require().NoError(err)
+	s.Require().Len(body.Result, 1)
+	s.Require().Equal("Transfer", body.Result[0].EventName)
+	s.Require().Equal("Transfer(address,address,uint256)", body.Result[0].EventSignature)
+	s.Require().JSONEq(string(decoded.EventArgs), string(body.Result[0].EventArgs))
+}
+
+// TestListInvalidEventArg tests handling of event argument without value
+func (s *LogHandlerTestSuite) TestListInvalidEventArg() {
+	for _, arg := range []string{"from", "from:", ":0x01", "from:1,from:2"} {
+		q := make(url.Values)
+		q.Set("arg", arg)
+
+		req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+		rec := httptest.NewRecorder()
+		c := s.echo.NewContext(req, rec)
+		c.SetPath("/log")
+
+		s.Require().NoError(s.handler.List(c))
+		s.Require().Equal(http.StatusBadRequest, rec.Code, arg)
+	}
+}
@@ -117,7 +117,7 @@ func (req *listProxyContracts) ToFilters(
 //	@Param			sort_by			query	string	false	"Field to sort by (default: id)"																									Enums(id, height)
 //	@Param			height			query	integer	false	"Filter by deployment block height"																									minimum(1)	example(12345)
 //	@Param			implementation	query	string	false	"Filter by implementation contract address"																							minlength(42)	maxlength(42)	example(0x742d35Cc6634C0532925a3b844Bc9e7595f0bEb)
-//	@Param			type			query	string	false	"Filter by proxy pattern (comma-separated list)"																					Enums(EIP1167, EIP7760, EIP7702, EIP1967, custom, clone_with_immutable_args)
+//	@Param			type			query	string	false	"Filter by proxy pattern (comma-separated list)"																					Enums(EIP1167, EIP7760, EIP7702, EIP1967, custom, clone_with_immutable_args, diamond)
 //	@Param			status			query	string	false	"Filter by resolution status: new (just detected), resolved (implementation found), error (failed to resolve) (comma-separated)"	Enums(new, resolved, error)
 //	@Param			cursor			query	string	false	"Opaque cursor for keyset pagination. Base64url-encoded value from the previous response's 'cursor' field. Encodes the id of the last returned record. Cannot be used together with offset (returns 400). Only supported when sort_by=id (default); returns 400 for other sort_by values."
 //	@Produce		json
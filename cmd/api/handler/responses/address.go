@@ -1,6 +1,8 @@
 package responses
 
 import (
+	"time"
+
 	"github.com/NobleScope/noble-indexer/internal/storage"
 	pkgTypes "github.com/NobleScope/noble-indexer/pkg/types"
 )
@@ -18,6 +20,7 @@ type Address struct {
 	DeployedContracts int            `example:"7"                                          json:"deployed_contracts" swaggertype:"integer"`
 	Interactions      int            `example:"890"                                        json:"interactions"       swaggertype:"integer"`
 	Balance           Balance        `json:"balance"`
+	Delegation        *Delegation    `json:"delegation,omitempty"`
 }
 
 func NewAddress(addr storage.Address) Address {
@@ -43,3 +46,37 @@ func NewAddress(addr storage.Address) Address {
 type Balance struct {
 	Value string `example:"10000000000" json:"value" swaggertype:"string"`
 }
+
+// BalanceHistory info
+//
+//	@Description	Snapshot of address balance after the block changing it
+type BalanceHistory struct {
+	Height uint64    `example:"100"                       json:"height" swaggertype:"integer"`
+	Time   time.Time `example:"2023-07-04T03:10:57+00:00" json:"time"   swaggertype:"string"`
+	Value  string    `example:"10000000000"               json:"value"  swaggertype:"string"`
+	Delta  string    `example:"-2000000000"               json:"delta"  swaggertype:"string"`
+}
+
+func NewBalanceHistory(history storage.BalanceHistory) BalanceHistory {
+	return BalanceHistory{
+		Height: uint64(history.Height),
+		Time:   history.Time,
+		Value:  history.Value.String(),
+		Delta:  history.Delta.String(),
+	}
+}
+
+// BalanceSeriesItem info
+//
+//	@Description	Address balance at the end of the time bucket
+type BalanceSeriesItem struct {
+	Time  time.Time `example:"2023-07-04T00:00:00+00:00" json:"time"  swaggertype:"string"`
+	Value string    `example:"10000000000"               json:"value" swaggertype:"string"`
+}
+
+func NewBalanceSeriesItem(item storage.BalanceSeriesItem) BalanceSeriesItem {
+	return BalanceSeriesItem{
+		Time:  item.Time,
+		Value: item.Value.String(),
+	}
+}
@@ -0,0 +1,47 @@
+package responses
+
+import (
+	"github.com/NobleScope/noble-indexer/internal/storage"
+)
+
+// Allowance model info
+//
+//	@Description	Token approval given by the owner to the spender
+type Allowance struct {
+	Id         uint64 `example:"321"                                        json:"id"          swaggertype:"integer"`
+	Height     uint64 `example:"100"                                        json:"height"      swaggertype:"integer"`
+	LastHeight uint64 `example:"200"                                        json:"last_height" swaggertype:"integer"`
+	Type       string `example:"allowance"                                  json:"type"        swaggertype:"string"`
+	Owner      string `example:"0x0000000000000000000000000000000000000001" json:"owner"       swaggertype:"string"`
+	Spender    string `example:"0x0000000000000000000000000000000000000002" json:"spender"     swaggertype:"string"`
+	Contract   string `example:"0xdAC17F958D2ee523a2206206994597C13D831ec7" json:"contract"    swaggertype:"string"`
+	TokenId    string `example:"0"                                          json:"token_id"    swaggertype:"string"`
+	Amount     string `example:"123456789"                                  json:"amount"      swaggertype:"string"`
+	Approved   bool   `example:"true"                                       json:"approved"    swaggertype:"boolean"`
+
+	Token *Token `json:"token,omitempty"`
+}
+
+func NewAllowance(allowance storage.Allowance) Allowance {
+	a := Allowance{
+		Id:         allowance.Id,
+		Height:     uint64(allowance.Height),
+		LastHeight: uint64(allowance.LastHeight),
+		Type:       allowance.Type.String(),
+		Owner:      allowance.Owner.Hash.Hex(),
+		Spender:    allowance.Spender.Hash.Hex(),
+		Contract:   allowance.Contract.Address.Hash.Hex(),
+		TokenId:    allowance.TokenID.String(),
+		Amount:     allowance.Amount.String(),
+		Approved:   allowance.Approved,
+	}
+
+	if allowance.Token != nil && allowance.Token.Type != "" {
+		token := NewToken(*allowance.Token)
+		token.Contract = a.Contract
+		token.TokenId = a.TokenId
+		a.Token = &token
+	}
+
+	return a
+}
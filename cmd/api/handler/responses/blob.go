@@ -0,0 +1,56 @@
+package responses
+
+import (
+	"time"
+
+	"github.com/NobleScope/noble-indexer/internal/storage"
+	"github.com/shopspring/decimal"
+)
+
+// Blob model info
+//
+//	@Description	EIP-4844 blob referenced by the blob transaction
+type Blob struct {
+	Height        uint64           `example:"100"                                                                json:"height"                   swaggertype:"integer"`
+	Time          time.Time        `example:"2023-07-04T03:10:57+00:00"                                          json:"time"                     swaggertype:"string"`
+	TxHash        string           `example:"0x0f5c9c35b1c1a4e8b1e96e1a0b5e51b8a4d09a0c1ac35ab2a18b0d7bd36d9c3c" json:"tx_hash"                  swaggertype:"string"`
+	Sender        string           `example:"0x0000000000000000000000000000000000000000"                         json:"sender"                   swaggertype:"string"`
+	Index         int64            `example:"0"                                                                  json:"index"                    swaggertype:"integer"`
+	VersionedHash string           `example:"0x01a5b5d5c9c1bcbd1f3e4d2cbb1a3f21b2bd4f21c77c8c5e1f4cbbd5b5d6e7f8" json:"versioned_hash"           swaggertype:"string"`
+	BlobGasPrice  *decimal.Decimal `example:"1"                                                                  json:"blob_gas_price,omitempty" swaggertype:"string"`
+}
+
+func NewBlob(blob storage.Blob) Blob {
+	return Blob{
+		Height:        uint64(blob.Height),
+		Time:          blob.Time,
+		TxHash:        blob.Tx.Hash.Hex(),
+		Sender:        blob.Sender.Hash.Hex(),
+		Index:         blob.Index,
+		VersionedHash: blob.VersionedHash.Hex(),
+		BlobGasPrice:  blob.Tx.BlobGasPrice,
+	}
+}
+
+// BlobStats model info
+//
+//	@Description	Aggregated EIP-4844 blob statistics
+type BlobStats struct {
+	BlobsCount      int64           `example:"6"      json:"blobs_count"        swaggertype:"integer"`
+	BlobGasUsed     decimal.Decimal `example:"786432" json:"blob_gas_used"      swaggertype:"string"`
+	BlobFee         decimal.Decimal `example:"786432" json:"blob_fee"           swaggertype:"string"`
+	AvgBlobGasPrice decimal.Decimal `example:"1"      json:"avg_blob_gas_price" swaggertype:"string"`
+}
+
+func NewBlobStats(stats storage.BlobStats) BlobStats {
+	result := BlobStats{
+		BlobsCount:      stats.BlobsCount,
+		BlobGasUsed:     stats.BlobGasUsed,
+		BlobFee:         stats.BlobFee,
+		AvgBlobGasPrice: decimal.Zero,
+	}
+	if !stats.BlobGasUsed.IsZero() {
+		result.AvgBlobGasPrice = stats.BlobFee.DivRound(stats.BlobGasUsed, 0)
+	}
+	return result
+}
@@ -12,24 +12,28 @@ import (
 //
 //	@Description	Noble block information
 type Block struct {
-	Height               uint64          `example:"100"                                                                json:"height"                 swaggertype:"integer"`
-	Time                 time.Time       `example:"2023-07-04T03:10:57+00:00"                                          json:"time"                   swaggertype:"string"`
-	GasLimit             decimal.Decimal `example:"1000000"                                                            json:"gas_limit"              swaggertype:"integer"`
-	GasUsed              decimal.Decimal `example:"500000"                                                             json:"gas_used"               swaggertype:"integer"`
-	Hash                 string          `example:"0x0764012270afacd3b101bcfadaaa9fc3190d04ed90ff22c0ee59781e54858a7d" json:"hash"                   swaggertype:"string"`
-	ParentHash           string          `example:"0x0764012270afacd3b101bcfadaaa9fc3190d04ed90ff22c0ee59781e54858a7d" json:"parent_hash"            swaggertype:"string"`
-	Difficulty           string          `example:"0x0"                                                                json:"difficulty"             swaggertype:"string"`
-	ExtraData            string          `example:"0x726574682f76312e372e302f6c696e7578"                               json:"extra_data"             swaggertype:"string"`
-	LogsBloom            string          `example:"0x0000000000000000000020000000000"                                  json:"logs_bloom"             swaggertype:"string"`
-	Miner                string          `example:"0x0000000000000000000000000000000000000000"                         json:"miner"                  swaggertype:"string"`
-	MixHash              string          `example:"0x000000000000000000000000000000000000000000000000000000000033a87e" json:"mix_hash"               swaggertype:"string"`
-	Nonce                uint64          `example:"0"                                                                  json:"nonce"                  swaggertype:"integer"`
-	ReceiptsRoot         string          `example:"0x24e9aae3033f9ff809675831eca331b701440009592a40a6d788756f3be983a2" json:"receipts_root"          swaggertype:"string"`
-	Sha3Uncles           string          `example:"0x1dcc4de8dec75d7aab85b567b6ccd41ad312451b948a7413f0a142fd40d49347" json:"sha3_uncles_hash"       swaggertype:"string"`
-	Size                 uint64          `example:"0"                                                                  json:"size"                   swaggertype:"integer"`
-	StateRoot            string          `example:"0x9b6e76e8263c5060b61e396c65baf15dd187386d5607250be0dcc5308f0b49ef" json:"state_root"             swaggertype:"string"`
-	TransactionsRootHash string          `example:"0x0764012270afacd3b101bcfadaaa9fc3190d04ed90ff22c0ee59781e54858a7d" json:"transactions_root_hash" swaggertype:"string"`
-	Stats                *BlockStats     `json:"stats,omitempty"`
+	Height               uint64           `example:"100"                                                                json:"height"                    swaggertype:"integer"`
+	Time                 time.Time        `example:"2023-07-04T03:10:57+00:00"                                          json:"time"                      swaggertype:"string"`
+	GasLimit             decimal.Decimal  `example:"1000000"                                                            json:"gas_limit"                 swaggertype:"integer"`
+	GasUsed              decimal.Decimal  `example:"500000"                                                             json:"gas_used"                  swaggertype:"integer"`
+	Hash                 string           `example:"0x0764012270afacd3b101bcfadaaa9fc3190d04ed90ff22c0ee59781e54858a7d" json:"hash"                      swaggertype:"string"`
+	ParentHash           string           `example:"0x0764012270afacd3b101bcfadaaa9fc3190d04ed90ff22c0ee59781e54858a7d" json:"parent_hash"               swaggertype:"string"`
+	Difficulty           string           `example:"0x0"                                                                json:"difficulty"                swaggertype:"string"`
+	ExtraData            string           `example:"0x726574682f76312e372e302f6c696e7578"                               json:"extra_data"                swaggertype:"string"`
+	LogsBloom            string           `example:"0x0000000000000000000020000000000"                                  json:"logs_bloom"                swaggertype:"string"`
+	Miner                string           `example:"0x0000000000000000000000000000000000000000"                         json:"miner"                     swaggertype:"string"`
+	MixHash              string           `example:"0x000000000000000000000000000000000000000000000000000000000033a87e" json:"mix_hash"                  swaggertype:"string"`
+	Nonce                uint64           `example:"0"                                                                  json:"nonce"                     swaggertype:"integer"`
+	ReceiptsRoot         string           `example:"0x24e9aae3033f9ff809675831eca331b701440009592a40a6d788756f3be983a2" json:"receipts_root"             swaggertype:"string"`
+	Sha3Uncles           string           `example:"0x1dcc4de8dec75d7aab85b567b6ccd41ad312451b948a7413f0a142fd40d49347" json:"sha3_uncles_hash"          swaggertype:"string"`
+	Size                 uint64           `example:"0"                                                                  json:"size"                      swaggertype:"integer"`
+	StateRoot            string           `example:"0x9b6e76e8263c5060b61e396c65baf15dd187386d5607250be0dcc5308f0b49ef" json:"state_root"                swaggertype:"string"`
+	TransactionsRootHash string           `example:"0x0764012270afacd3b101bcfadaaa9fc3190d04ed90ff22c0ee59781e54858a7d" json:"transactions_root_hash"    swaggertype:"string"`
+	BlobGasUsed          *decimal.Decimal `example:"262144"                                                             json:"blob_gas_used,omitempty"   swaggertype:"string"`
+	ExcessBlobGas        *decimal.Decimal `example:"0"                                                                  json:"excess_blob_gas,omitempty" swaggertype:"string"`
+	BlobGasPrice         *decimal.Decimal `example:"1"                                                                  json:"blob_gas_price,omitempty"  swaggertype:"string"`
+	Finality             string           `enums:"latest,safe,finalized"                                                example:"finalized"              json:"finality"       swaggertype:"string"`
+	Stats                *BlockStats      `json:"stats,omitempty"`
 }
 
 func NewBlock(block storage.Block) Block {
@@ -49,6 +53,10 @@ func NewBlock(block storage.Block) Block {
 		Sha3Uncles:           block.Sha3UnclesHash.Hex(),
 		StateRoot:            block.StateRootHash.Hex(),
 		TransactionsRootHash: block.TransactionsRootHash.Hex(),
+		BlobGasUsed:          block.BlobGasUsed,
+		ExcessBlobGas:        block.ExcessBlobGas,
+		BlobGasPrice:         block.BlobGasPrice,
+		Finality:             block.Finality.String(),
 	}
 
 	size, err := block.SizeHash.Uint64()
@@ -77,6 +85,10 @@ type BlockStats struct {
 	Time      time.Time `example:"2023-07-04T03:10:57+00:00" json:"time"       swaggertype:"string"`
 	TxCount   int64     `example:"12"                        json:"tx_count"   swaggertype:"integer"`
 	BlockTime uint64    `example:"1000"                      json:"block_time" swaggertype:"integer"`
+
+	BlobsCount  int64           `example:"6"      json:"blobs_count"   swaggertype:"integer"`
+	BlobGasUsed decimal.Decimal `example:"786432" json:"blob_gas_used" swaggertype:"string"`
+	BlobFee     decimal.Decimal `example:"786432" json:"blob_fee"      swaggertype:"string"`
 }
 
 func NewBlockStats(stats storage.BlockStats) *BlockStats {
@@ -85,5 +97,9 @@ func NewBlockStats(stats storage.BlockStats) *BlockStats {
 		Time:      stats.Time,
 		TxCount:   stats.TxCount,
 		BlockTime: stats.BlockTime,
+
+		BlobsCount:  stats.BlobsCount,
+		BlobGasUsed: stats.BlobGasUsed,
+		BlobFee:     stats.BlobFee,
 	}
 }
@@ -0,0 +1,20 @@
+package responses
+
+import (
+	"github.com/NobleScope/noble-indexer/pkg/contract_verifier/compilers"
+)
+
+// Compiler model info
+//
+//	@Description	Compiler installed to the verifier
+type Compiler struct {
+	Version  string `example:"v0.8.20+commit.a1b79de6" json:"version"  swaggertype:"string"`
+	Language string `enums:"solidity,vyper"            example:"solidity" json:"language" swaggertype:"string"`
+}
+
+func NewCompiler(build compilers.Build, language string) Compiler {
+	return Compiler{
+		Version:  build.CompilerVersion(),
+		Language: language,
+	}
+}
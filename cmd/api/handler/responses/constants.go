@@ -25,6 +25,7 @@ type Enums struct {
 	VerificationTaskStatus []string `example:"new,pending,success,failed" json:"verification_task_status" swaggertype:"array,string"`
 	EVMVersion             []string `example:"shanghai,cancun,prague"     json:"evm_version"              swaggertype:"array,string"`
 	LicenseType            []string `example:"mit,apache_2_0,gnu_gpl_v3"  json:"license_type"             swaggertype:"array,string"`
+	Finality               []string `example:"latest,safe,finalized"      json:"finality"                 swaggertype:"array,string"`
 }
 
 func NewEnums() Enums {
@@ -41,5 +42,6 @@ func NewEnums() Enums {
 		VerificationTaskStatus: types.VerificationTaskStatusNames(),
 		EVMVersion:             types.EVMVersionNames(),
 		LicenseType:            types.LicenseTypeNames(),
+		Finality:               types.FinalityNames(),
 	}
 }
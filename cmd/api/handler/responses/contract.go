@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 
 	"github.com/NobleScope/noble-indexer/internal/storage"
+	"github.com/NobleScope/noble-indexer/pkg/decoder"
 	pkgTypes "github.com/NobleScope/noble-indexer/pkg/types"
 )
 
@@ -22,8 +23,11 @@ type Contract struct {
 	Language         string `example:"Solidity"                                                            json:"language,omitempty"          swaggertype:"string"`
 	Error            string `example:"Error string"                                                        json:"error,omitempty"             swaggertype:"string"`
 	Deployer         string `example:"0x0000000000000000000000000000000000000003"                          json:"deployer,omitempty"          swaggertype:"string"`
+	MatchType        string `enums:"full,partial,similar"                                                  example:"full"                     json:"match_type,omitempty" swaggertype:"string"`
+	ConstructorArgs  string `example:"0x00000000000000000000000000000000000000000000000000000000000003e8"  json:"constructor_args,omitempty"  swaggertype:"string"`
 
-	Tags []string `json:"tags,omitempty"`
+	DecodedConstructorArgs map[string]any `json:"decoded_constructor_args,omitempty"`
+	Tags                   []string       `json:"tags,omitempty"`
 }
 
 func NewContract(contract storage.Contract) Contract {
@@ -37,6 +41,12 @@ func NewContract(contract storage.Contract) Contract {
 		Language:         contract.Language,
 		Error:            contract.Error,
 		Tags:             contract.Tags,
+		MatchType:        contract.MatchType.String(),
+	}
+
+	if len(contract.ConstructorArgs) > 0 {
+		c.ConstructorArgs = contract.ConstructorArgs.Hex()
+		c.DecodedConstructorArgs = decoder.DecodeConstructorArgs(contract.ConstructorArgs, contract.ABI)
 	}
 
 	if contract.Tx != nil {
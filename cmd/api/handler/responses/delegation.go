@@ -0,0 +1,67 @@
+package responses
+
+import (
+	"time"
+
+	"github.com/NobleScope/noble-indexer/internal/storage"
+)
+
+// Delegation model info
+//
+//	@Description	Current EIP-7702 delegation of the externally owned account
+type Delegation struct {
+	Authority  string  `example:"0x0000000000000000000000000000000000000000"                         json:"authority"   swaggertype:"string"`
+	Delegate   *string `example:"0x0000000000000000000000000000000000000001"                         json:"delegate"    swaggertype:"string"`
+	Height     uint64  `example:"100"                                                                json:"height"      swaggertype:"integer"`
+	LastHeight uint64  `example:"120"                                                                json:"last_height" swaggertype:"integer"`
+	TxHash     string  `example:"0x0f5c9c35b1c1a4e8b1e96e1a0b5e51b8a4d09a0c1ac35ab2a18b0d7bd36d9c3c" json:"tx_hash"     swaggertype:"string"`
+}
+
+func NewDelegation(d storage.Delegation) Delegation {
+	result := Delegation{
+		Authority:  d.Authority.Hash.Hex(),
+		Height:     uint64(d.Height),
+		LastHeight: uint64(d.LastHeight),
+		TxHash:     d.Tx.Hash.Hex(),
+	}
+
+	if d.DelegateId != nil && d.Delegate != nil {
+		delegate := d.Delegate.Hash.Hex()
+		result.Delegate = &delegate
+	}
+
+	return result
+}
+
+// DelegationHistoryItem model info
+//
+//	@Description	EIP-7702 authorization signed by the externally owned account
+type DelegationHistoryItem struct {
+	Height   uint64    `example:"100"                                                                json:"height"   swaggertype:"integer"`
+	Time     time.Time `example:"2023-07-04T03:10:57+00:00"                                          json:"time"     swaggertype:"string"`
+	TxHash   string    `example:"0x0f5c9c35b1c1a4e8b1e96e1a0b5e51b8a4d09a0c1ac35ab2a18b0d7bd36d9c3c" json:"tx_hash"  swaggertype:"string"`
+	Index    int64     `example:"0"                                                                  json:"index"    swaggertype:"integer"`
+	Delegate *string   `example:"0x0000000000000000000000000000000000000001"                         json:"delegate" swaggertype:"string"`
+	ChainId  string    `example:"1"                                                                  json:"chain_id" swaggertype:"string"`
+	Nonce    string    `example:"5"                                                                  json:"nonce"    swaggertype:"string"`
+	Valid    bool      `example:"true"                                                               json:"valid"    swaggertype:"boolean"`
+}
+
+func NewDelegationHistoryItem(auth storage.TxAuthorization) DelegationHistoryItem {
+	result := DelegationHistoryItem{
+		Height:  uint64(auth.Height),
+		Time:    auth.Time,
+		TxHash:  auth.Tx.Hash.Hex(),
+		Index:   auth.Index,
+		ChainId: auth.ChainId.String(),
+		Nonce:   auth.Nonce.String(),
+		Valid:   auth.Valid,
+	}
+
+	if auth.DelegateId != nil && auth.Delegate != nil {
+		delegate := auth.Delegate.Hash.Hex()
+		result.Delegate = &delegate
+	}
+
+	return result
+}
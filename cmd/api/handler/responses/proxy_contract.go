@@ -8,11 +8,12 @@ import (
 //
 //	@Description	Proxy contract information
 type ProxyContract struct {
-	Height         uint64  `example:"100"                                                            json:"height"         swaggertype:"integer"`
-	Contract       string  `example:"0x0000000000000000000000000000000000000000"                     json:"contract"       swaggertype:"string"`
-	Type           string  `enums:"EIP1167,EIP7760,EIP7702,EIP1967,custom,clone_with_immutable_args" example:"EIP1967"     json:"type"           swaggertype:"string"`
-	Status         string  `enums:"new,resolved,error"                                               example:"resolved"    json:"status"         swaggertype:"string"`
-	Implementation *string `example:"0x0000000000000000000000000000000000000001"                     json:"implementation" swaggertype:"string"`
+	Height         uint64  `example:"100"                                                                    json:"height"           swaggertype:"integer"`
+	Contract       string  `example:"0x0000000000000000000000000000000000000000"                             json:"contract"         swaggertype:"string"`
+	Type           string  `enums:"EIP1167,EIP7760,EIP7702,EIP1967,custom,clone_with_immutable_args,diamond" example:"EIP1967"       json:"type"           swaggertype:"string"`
+	Status         string  `enums:"new,resolved,error"                                                       example:"resolved"      json:"status"         swaggertype:"string"`
+	Implementation *string `example:"0x0000000000000000000000000000000000000001"                             json:"implementation"   swaggertype:"string"`
+	Beacon         *string `example:"0x0000000000000000000000000000000000000002"                             json:"beacon,omitempty" swaggertype:"string"`
 }
 
 func NewProxyContract(pc storage.ProxyContract) ProxyContract {
@@ -27,6 +28,10 @@ func NewProxyContract(pc storage.ProxyContract) ProxyContract {
 		impl := pc.Implementation.Address.Hash.Hex()
 		result.Implementation = &impl
 	}
+	if pc.BeaconId != nil && pc.Beacon != nil {
+		beacon := pc.Beacon.Hash.Hex()
+		result.Beacon = &beacon
+	}
 
 	return result
 }
@@ -0,0 +1,42 @@
+package responses
+
+import (
+	"time"
+
+	"github.com/NobleScope/noble-indexer/internal/storage"
+)
+
+// ProxyUpgrade model info
+//
+//	@Description	EIP-1967 upgrade event of the proxy contract
+type ProxyUpgrade struct {
+	Height         uint64    `example:"100"                                                                json:"height"                   swaggertype:"integer"`
+	Time           time.Time `example:"2023-07-04T03:10:57+00:00"                                          json:"time"                     swaggertype:"string"`
+	TxHash         string    `example:"0x0f5c9c35b1c1a4e8b1e96e1a0b5e51b8a4d09a0c1ac35ab2a18b0d7bd36d9c3c" json:"tx_hash"                  swaggertype:"string"`
+	Type           string    `enums:"upgraded,beacon_upgraded,admin_changed"                               example:"upgraded"              json:"type"           swaggertype:"string"`
+	Implementation *string   `example:"0x0000000000000000000000000000000000000001"                         json:"implementation,omitempty" swaggertype:"string"`
+	Beacon         *string   `example:"0x0000000000000000000000000000000000000002"                         json:"beacon,omitempty"         swaggertype:"string"`
+	PreviousAdmin  *string   `example:"0x0000000000000000000000000000000000000003"                         json:"previous_admin,omitempty" swaggertype:"string"`
+	Admin          *string   `example:"0x0000000000000000000000000000000000000004"                         json:"admin,omitempty"          swaggertype:"string"`
+}
+
+func NewProxyUpgrade(upgrade storage.ProxyUpgrade) ProxyUpgrade {
+	return ProxyUpgrade{
+		Height:         uint64(upgrade.Height),
+		Time:           upgrade.Time,
+		TxHash:         upgrade.Tx.Hash.Hex(),
+		Type:           string(upgrade.Type),
+		Implementation: optionalAddressHash(upgrade.ImplementationId, upgrade.Implementation),
+		Beacon:         optionalAddressHash(upgrade.BeaconId, upgrade.Beacon),
+		PreviousAdmin:  optionalAddressHash(upgrade.PreviousAdminId, upgrade.PreviousAdmin),
+		Admin:          optionalAddressHash(upgrade.AdminId, upgrade.Admin),
+	}
+}
+
+func optionalAddressHash(id *uint64, address *storage.Address) *string {
+	if id == nil || address == nil {
+		return nil
+	}
+	hash := address.Hash.Hex()
+	return &hash
+}
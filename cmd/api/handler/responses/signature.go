@@ -0,0 +1,22 @@
+package responses
+
+import (
+	"github.com/NobleScope/noble-indexer/internal/storage"
+)
+
+// Signature model info
+//
+//	@Description	Text signature of the function selector or the event topic
+type Signature struct {
+	Selector  string `example:"0xa9059cbb"                json:"selector"  swaggertype:"string"`
+	Signature string `example:"transfer(address,uint256)" json:"signature" swaggertype:"string"`
+	Type      string `example:"function"                  json:"type"      swaggertype:"string"`
+}
+
+func NewSignature(s storage.Signature) Signature {
+	return Signature{
+		Selector:  s.Selector.Hex(),
+		Signature: s.Signature,
+		Type:      s.Type.String(),
+	}
+}
@@ -13,16 +13,18 @@ type Source struct {
 	License string `example:"License"              json:"license" swaggertype:"string"`
 	Content string `example:"Source content"       json:"content" swaggertype:"string"`
 
-	Urls []string `json:"urls,omitempty"`
+	Urls      []string          `json:"urls,omitempty"`
+	Libraries map[string]string `json:"libraries,omitempty"`
 }
 
 func NewSource(source storage.Source) Source {
 	s := Source{
-		Id:      source.Id,
-		Name:    source.Name,
-		License: source.License,
-		Content: source.Content,
-		Urls:    source.Urls,
+		Id:        source.Id,
+		Name:      source.Name,
+		License:   source.License,
+		Content:   source.Content,
+		Urls:      source.Urls,
+		Libraries: source.Libraries,
 	}
 
 	return s
@@ -105,3 +105,28 @@ func NewTokenBalance(tb storage.TokenBalance) TokenBalance {
 	t.Token.TokenId = tb.TokenID.String()
 	return t
 }
+
+// TokenBalanceHistory model info
+//
+//	@Description	Snapshot of token balance after the block changing it
+type TokenBalanceHistory struct {
+	Height   uint64    `example:"100"                                        json:"height"   swaggertype:"integer"`
+	Time     time.Time `example:"2026-01-01T01:01:01+00:00"                  json:"time"     swaggertype:"string"`
+	Address  string    `example:"0x0000000000000000000000000000000000000001" json:"address"  swaggertype:"string"`
+	Contract string    `example:"0xdAC17F958D2ee523a2206206994597C13D831ec7" json:"contract" swaggertype:"string"`
+	TokenId  string    `example:"0"                                          json:"token_id" swaggertype:"string"`
+	Value    string    `example:"123456789"                                  json:"value"    swaggertype:"string"`
+	Delta    string    `example:"-1000"                                      json:"delta"    swaggertype:"string"`
+}
+
+func NewTokenBalanceHistory(history storage.TokenBalanceHistory) TokenBalanceHistory {
+	return TokenBalanceHistory{
+		Height:   uint64(history.Height),
+		Time:     history.Time,
+		Address:  history.Address.Hash.Hex(),
+		Contract: history.Contract.Address.Hash.Hex(),
+		TokenId:  history.TokenID.String(),
+		Value:    history.Balance.String(),
+		Delta:    history.Delta.String(),
+	}
+}
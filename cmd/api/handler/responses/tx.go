@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/NobleScope/noble-indexer/internal/storage"
+	"github.com/NobleScope/noble-indexer/pkg/decoder"
 	"github.com/NobleScope/noble-indexer/pkg/types"
 	"github.com/shopspring/decimal"
 )
@@ -12,50 +13,72 @@ import (
 //
 //	@Description	Noble transaction information
 type Transaction struct {
-	Height            uint64          `example:"100"                                                                json:"height"              swaggertype:"integer"`
-	Time              time.Time       `example:"2023-07-04T03:10:57+00:00"                                          json:"time"                swaggertype:"string"`
-	Hash              string          `example:"0x0764012270afacd3b101bcfadaaa9fc3190d04ed90ff22c0ee59781e54858a7d" json:"hash"                swaggertype:"string"`
-	Index             int64           `example:"0"                                                                  json:"index"               swaggertype:"integer"`
-	Nonce             int64           `example:"1"                                                                  json:"nonce"               swaggertype:"integer"`
-	Type              string          `enums:"TxTypeUnknown,TxTypeLegacy,TxTypeDynamicFee,TxTypeBlob,TxTypeSetCode" example:"TxTypeDynamicFee" json:"type"           swaggertype:"string"`
-	Status            string          `enums:"TxStatusSuccess,TxStatusRevert"                                       example:"TxStatusSuccess"  json:"status"         swaggertype:"string"`
-	Gas               decimal.Decimal `example:"21000"                                                              json:"gas"                 swaggertype:"integer"`
-	GasPrice          decimal.Decimal `example:"1000000"                                                            json:"gas_price"           swaggertype:"integer"`
-	GasUsed           decimal.Decimal `example:"21000"                                                              json:"gas_used"            swaggertype:"integer"`
-	CumulativeGasUsed decimal.Decimal `example:"21000"                                                              json:"cumulative_gas_used" swaggertype:"integer"`
-	EffectiveGasPrice decimal.Decimal `example:"1000000"                                                            json:"effective_gas_price" swaggertype:"integer"`
-	Fee               decimal.Decimal `example:"21000000000"                                                        json:"fee"                 swaggertype:"string"`
-	Amount            decimal.Decimal `example:"1000000000000000000"                                                json:"amount"              swaggertype:"integer"`
-	FromAddress       string          `example:"0x0000000000000000000000000000000000000000"                         json:"from_address"        swaggertype:"string"`
-	ToAddress         *string         `example:"0x0000000000000000000000000000000000000001"                         json:"to_address"          swaggertype:"string"`
-	Input             string          `example:"0x"                                                                 json:"input"               swaggertype:"string"`
-	LogsBloom         string          `example:"0x00000000000000000000000000000000000000000000"                     json:"logs_bloom"          swaggertype:"string"`
-	LogsCount         int             `example:"1234"                                                               json:"logs_count"          swaggertype:"integer"`
-	TracesCount       int             `example:"1488"                                                               json:"traces_count"        swaggertype:"integer"`
-	Decoded           *DecodedTrace   `json:"decoded,omitempty"                                                     swaggertype:"object"`
+	Height               uint64                `example:"100"                                                                json:"height"                             swaggertype:"integer"`
+	Time                 time.Time             `example:"2023-07-04T03:10:57+00:00"                                          json:"time"                               swaggertype:"string"`
+	Hash                 string                `example:"0x0764012270afacd3b101bcfadaaa9fc3190d04ed90ff22c0ee59781e54858a7d" json:"hash"                               swaggertype:"string"`
+	Index                int64                 `example:"0"                                                                  json:"index"                              swaggertype:"integer"`
+	Nonce                int64                 `example:"1"                                                                  json:"nonce"                              swaggertype:"integer"`
+	Type                 string                `enums:"TxTypeUnknown,TxTypeLegacy,TxTypeAccessList,TxTypeDynamicFee,TxTypeBlob,TxTypeSetCode" example:"TxTypeDynamicFee" json:"type"           swaggertype:"string"`
+	Status               string                `enums:"TxStatusSuccess,TxStatusRevert"                                       example:"TxStatusSuccess"  json:"status"         swaggertype:"string"`
+	Gas                  decimal.Decimal       `example:"21000"                                                              json:"gas"                                swaggertype:"integer"`
+	GasPrice             decimal.Decimal       `example:"1000000"                                                            json:"gas_price"                          swaggertype:"integer"`
+	GasUsed              decimal.Decimal       `example:"21000"                                                              json:"gas_used"                           swaggertype:"integer"`
+	CumulativeGasUsed    decimal.Decimal       `example:"21000"                                                              json:"cumulative_gas_used"                swaggertype:"integer"`
+	EffectiveGasPrice    decimal.Decimal       `example:"1000000"                                                            json:"effective_gas_price"                swaggertype:"integer"`
+	Fee                  decimal.Decimal       `example:"21000000000"                                                        json:"fee"                                swaggertype:"string"`
+	Amount               decimal.Decimal       `example:"1000000000000000000"                                                json:"amount"                             swaggertype:"integer"`
+	FromAddress          string                `example:"0x0000000000000000000000000000000000000000"                         json:"from_address"                       swaggertype:"string"`
+	ToAddress            *string               `example:"0x0000000000000000000000000000000000000001"                         json:"to_address"                         swaggertype:"string"`
+	Input                string                `example:"0x"                                                                 json:"input"                              swaggertype:"string"`
+	MaxFeePerGas         *decimal.Decimal      `example:"2000000000"                                                         json:"max_fee_per_gas,omitempty"          swaggertype:"string"`
+	MaxPriorityFeePerGas *decimal.Decimal      `example:"1000000"                                                            json:"max_priority_fee_per_gas,omitempty" swaggertype:"string"`
+	MaxFeePerBlobGas     *decimal.Decimal      `example:"1000000"                                                            json:"max_fee_per_blob_gas,omitempty"     swaggertype:"string"`
+	BlobVersionedHashes  []string              `example:"0x01a5b5d5c9c1bcbd1f3e4d2cbb1a3f21b2bd4f21c77c8c5e1f4cbbd5b5d6e7f8" json:"blob_versioned_hashes,omitempty"    swaggertype:"array,string"`
+	BlobGasUsed          *decimal.Decimal      `example:"131072"                                                             json:"blob_gas_used,omitempty"            swaggertype:"string"`
+	BlobGasPrice         *decimal.Decimal      `example:"1"                                                                  json:"blob_gas_price,omitempty"           swaggertype:"string"`
+	BlobFee              *decimal.Decimal      `example:"131072"                                                             json:"blob_fee,omitempty"                 swaggertype:"string"`
+	AccessList           []AccessListItem      `json:"access_list,omitempty"`
+	AuthorizationList    []Authorization       `json:"authorization_list,omitempty"`
+	LogsBloom            string                `example:"0x00000000000000000000000000000000000000000000"                     json:"logs_bloom"                         swaggertype:"string"`
+	LogsCount            int                   `example:"1234"                                                               json:"logs_count"                         swaggertype:"integer"`
+	TracesCount          int                   `example:"1488"                                                               json:"traces_count"                       swaggertype:"integer"`
+	Decoded              *decoder.DecodedTrace `json:"decoded,omitempty"                                                     swaggertype:"object"`
 }
 
 func NewTransaction(tx storage.Tx) Transaction {
 	result := Transaction{
-		Height:            uint64(tx.Height),
-		Time:              tx.Time,
-		Hash:              tx.Hash.Hex(),
-		Index:             tx.Index,
-		Nonce:             tx.Nonce,
-		Type:              string(tx.Type),
-		Status:            string(tx.Status),
-		Gas:               tx.Gas,
-		GasPrice:          tx.GasPrice,
-		GasUsed:           tx.GasUsed,
-		CumulativeGasUsed: tx.CumulativeGasUsed,
-		EffectiveGasPrice: tx.EffectiveGasPrice,
-		Fee:               tx.Fee,
-		Amount:            tx.Amount,
-		FromAddress:       tx.FromAddress.Hash.Hex(),
-		Input:             types.Hex(tx.Input).Hex(),
-		LogsBloom:         types.Hex(tx.LogsBloom).Hex(),
-		LogsCount:         tx.LogsCount,
-		TracesCount:       tx.TracesCount,
+		Height:               uint64(tx.Height),
+		Time:                 tx.Time,
+		Hash:                 tx.Hash.Hex(),
+		Index:                tx.Index,
+		Nonce:                tx.Nonce,
+		Type:                 string(tx.Type),
+		Status:               string(tx.Status),
+		Gas:                  tx.Gas,
+		GasPrice:             tx.GasPrice,
+		GasUsed:              tx.GasUsed,
+		CumulativeGasUsed:    tx.CumulativeGasUsed,
+		EffectiveGasPrice:    tx.EffectiveGasPrice,
+		Fee:                  tx.Fee,
+		Amount:               tx.Amount,
+		FromAddress:          tx.FromAddress.Hash.Hex(),
+		Input:                types.Hex(tx.Input).Hex(),
+		MaxFeePerGas:         tx.MaxFeePerGas,
+		MaxPriorityFeePerGas: tx.MaxPriorityFeePerGas,
+		MaxFeePerBlobGas:     tx.MaxFeePerBlobGas,
+		BlobGasUsed:          tx.BlobGasUsed,
+		BlobGasPrice:         tx.BlobGasPrice,
+		BlobFee:              tx.BlobFee,
+		LogsBloom:            types.Hex(tx.LogsBloom).Hex(),
+		LogsCount:            tx.LogsCount,
+		TracesCount:          tx.TracesCount,
+	}
+
+	if len(tx.BlobVersionedHashes) > 0 {
+		result.BlobVersionedHashes = make([]string, len(tx.BlobVersionedHashes))
+		for i := range tx.BlobVersionedHashes {
+			result.BlobVersionedHashes[i] = tx.BlobVersionedHashes[i].Hex()
+		}
 	}
 
 	if tx.ToAddress != nil {
@@ -63,11 +86,54 @@ func NewTransaction(tx storage.Tx) Transaction {
 		result.ToAddress = &toAddr
 	}
 
-	if tx.ToAddressId != nil && tx.ToContractABI != nil {
-		if parsedABI := parseABI(tx.ToContractABI); parsedABI != nil {
-			result.Decoded = decodeTxArgs(parsedABI, tx.Input)
+	if tx.ToAddressId != nil {
+		result.Decoded = decoder.DecodeInput(tx.Input, tx.ToContractABI, tx.ToImplementationABI, tx.ToFacetABI)
+		if result.Decoded == nil {
+			result.Decoded = decoder.DecodeInputBySignatures(tx.Input, tx.ToSignatures)
 		}
 	}
 
 	return result
 }
+
+// AccessListItem model info
+//
+//	@Description	EIP-2930 access list entry
+type AccessListItem struct {
+	Address     string   `example:"0x0000000000000000000000000000000000000001"                         json:"address"      swaggertype:"string"`
+	StorageKeys []string `example:"0x0000000000000000000000000000000000000000000000000000000000000001" json:"storage_keys" swaggertype:"array,string"`
+}
+
+func NewAccessListItem(item storage.TxAccessList) AccessListItem {
+	result := AccessListItem{
+		Address:     item.Address.Hex(),
+		StorageKeys: make([]string, len(item.StorageKeys)),
+	}
+	for i := range item.StorageKeys {
+		result.StorageKeys[i] = item.StorageKeys[i].Hex()
+	}
+	return result
+}
+
+// Authorization model info
+//
+//	@Description	EIP-7702 authorization tuple
+type Authorization struct {
+	ChainId decimal.Decimal `example:"1"                                                                  json:"chain_id" swaggertype:"string"`
+	Address string          `example:"0x0000000000000000000000000000000000000001"                         json:"address"  swaggertype:"string"`
+	Nonce   decimal.Decimal `example:"1"                                                                  json:"nonce"    swaggertype:"string"`
+	YParity int64           `example:"1"                                                                  json:"y_parity" swaggertype:"integer"`
+	R       string          `example:"0x0000000000000000000000000000000000000000000000000000000000000001" json:"r"        swaggertype:"string"`
+	S       string          `example:"0x0000000000000000000000000000000000000000000000000000000000000001" json:"s"        swaggertype:"string"`
+}
+
+func NewAuthorization(auth storage.TxAuthorization) Authorization {
+	return Authorization{
+		ChainId: auth.ChainId,
+		Address: auth.Address.Hex(),
+		Nonce:   auth.Nonce,
+		YParity: auth.YParity,
+		R:       auth.R.Hex(),
+		S:       auth.S.Hex(),
+	}
+}
@@ -0,0 +1,40 @@
+package responses
+
+import (
+	"time"
+
+	"github.com/NobleScope/noble-indexer/internal/storage"
+)
+
+// VerificationTask model info
+//
+//	@Description	Contract verification task with its status and compiler output
+type VerificationTask struct {
+	Id              uint64     `example:"1"                                                                                                json:"id"                           swaggertype:"integer"`
+	Contract        string     `example:"0x742d35Cc6634C0532925a3b844Bc9e7595f0bEb0"                                                       json:"contract"                     swaggertype:"string"`
+	Status          string     `enums:"VerificationStatusNew,VerificationStatusPending,VerificationStatusFailed,VerificationStatusSuccess" example:"VerificationStatusSuccess" json:"status"         swaggertype:"string"`
+	ContractName    string     `example:"Token"                                                                                            json:"contract_name"                swaggertype:"string"`
+	CompilerVersion string     `example:"v0.8.20+commit.a1b79de6"                                                                          json:"compiler_version"             swaggertype:"string"`
+	Language        string     `enums:"solidity,vyper"                                                                                     example:"solidity"                  json:"language"       swaggertype:"string"`
+	CreationTime    time.Time  `example:"2023-07-04T03:10:57+00:00"                                                                        json:"creation_time"                swaggertype:"string"`
+	StartTime       *time.Time `example:"2023-07-04T03:10:58+00:00"                                                                        json:"start_time,omitempty"         swaggertype:"string"`
+	CompletionTime  *time.Time `example:"2023-07-04T03:11:02+00:00"                                                                        json:"completion_time,omitempty"    swaggertype:"string"`
+	Error           string     `example:"compile contract: compilation failed"                                                             json:"error,omitempty"              swaggertype:"string"`
+	CompilerOutput  string     `example:"Error: Expected ';' but got '}'"                                                                  json:"compiler_output,omitempty"    swaggertype:"string"`
+}
+
+func NewVerificationTask(task storage.VerificationTask) VerificationTask {
+	return VerificationTask{
+		Id:              task.Id,
+		Contract:        task.Address.Hash.Hex(),
+		Status:          task.Status.String(),
+		ContractName:    task.ContractName,
+		CompilerVersion: task.CompilerVersion,
+		Language:        task.Language.String(),
+		CreationTime:    task.CreationTime,
+		StartTime:       task.StartTime,
+		CompletionTime:  task.CompletionTime,
+		Error:           task.Error,
+		CompilerOutput:  task.CompilerOutput,
+	}
+}
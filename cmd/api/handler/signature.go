@@ -0,0 +1,83 @@
+package handler
+
+import (
+	"github.com/NobleScope/noble-indexer/cmd/api/handler/responses"
+	"github.com/NobleScope/noble-indexer/internal/storage"
+	pkgTypes "github.com/NobleScope/noble-indexer/pkg/types"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+)
+
+type SignatureHandler struct {
+	signatures storage.ISignature
+}
+
+func NewSignatureHandler(
+	signatures storage.ISignature,
+) *SignatureHandler {
+	return &SignatureHandler{
+		signatures: signatures,
+	}
+}
+
+type listSignatures struct {
+	Limit    int    `query:"limit"    validate:"omitempty,min=1,max=100"`
+	Offset   int    `query:"offset"   validate:"omitempty,min=0"`
+	Selector string `query:"selector" validate:"required,selector"`
+}
+
+func (req *listSignatures) ToFilters() (storage.SignatureListFilter, error) {
+	if req.Limit <= 0 || req.Limit > 100 {
+		req.Limit = 10
+	}
+	filters := storage.SignatureListFilter{
+		Limit:  req.Limit,
+		Offset: req.Offset,
+	}
+
+	selector, err := pkgTypes.HexFromString(req.Selector)
+	if err != nil {
+		return filters, errors.Wrapf(err, "decoding selector: %s", req.Selector)
+	}
+	filters.Selector = selector
+
+	return filters, nil
+}
+
+// List godoc
+//
+//	@Summary		Lookup signatures
+//	@Description	Returns text signatures known for the 4-byte function selector or the 32-byte event topic. Several signatures mean a selector collision. The registry is filled from ABIs of verified contracts, known interfaces and the imported signatures dump.
+//	@Tags			signatures
+//	@ID				list-signatures
+//	@Param			selector	query	string	true	"Function selector (4 bytes) or event topic (32 bytes)"	example(0xa9059cbb)
+//	@Param			limit		query	integer	false	"Number of signatures to return (default: 10)"			minimum(1)	maximum(100)	default(10)
+//	@Param			offset		query	integer	false	"Number of signatures to skip (default: 0)"				minimum(0)	default(0)
+//	@Produce		json
+//	@Success		200	{array}		responses.Signature	"List of signatures"
+//	@Failure		400	{object}	Error				"Invalid request parameters"
+//	@Failure		500	{object}	Error				"Internal server error"
+//	@Router			/signatures [get]
+func (handler *SignatureHandler) List(c echo.Context) error {
+	req, err := bindAndValidate[listSignatures](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	filters, err := req.ToFilters()
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	signatures, err := handler.signatures.Filter(c.Request().Context(), filters)
+	if err != nil {
+		return handleError(c, err, handler.signatures)
+	}
+
+	response := make([]responses.Signature, len(signatures))
+	for i := range signatures {
+		response[i] = responses.NewSignature(signatures[i])
+	}
+
+	return returnArray(c, response)
+}
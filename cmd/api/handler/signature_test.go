@@ -0,0 +1,134 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/NobleScope/noble-indexer/cmd/api/handler/responses"
+	"github.com/NobleScope/noble-indexer/internal/storage"
+	"github.com/NobleScope/noble-indexer/internal/storage/mock"
+	"github.com/NobleScope/noble-indexer/internal/storage/types"
+	pkgTypes "github.com/NobleScope/noble-indexer/pkg/types"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+// SignatureHandlerTestSuite -
+type SignatureHandlerTestSuite struct {
+	suite.Suite
+	signatures *mock.MockISignature
+	echo       *echo.Echo
+	handler    *SignatureHandler
+	ctrl       *gomock.Controller
+}
+
+// SetupSuite -
+func (s *SignatureHandlerTestSuite) SetupSuite() {
+	s.echo = echo.New()
+	s.echo.Validator = NewApiValidator()
+	s.ctrl = gomock.NewController(s.T())
+	s.signatures = mock.NewMockISignature(s.ctrl)
+	s.handler = NewSignatureHandler(s.signatures)
+}
+
+// TearDownSuite -
+func (s *SignatureHandlerTestSuite) TearDownSuite() {
+	s.ctrl.Finish()
+	s.Require().NoError(s.echo.Shutdown(context.Background()))
+}
+
+func TestSuiteSignatureHandler_Run(t *testing.T) {
+	suite.Run(t, new(SignatureHandlerTestSuite))
+}
+
+// TestListSuccess tests lookup of the colliding function selector
+func (s *SignatureHandlerTestSuite) TestListSuccess() {
+	q := make(url.Values)
+	q.Set("selector", "0xa9059cbb")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/signatures")
+
+	s.signatures.EXPECT().
+		Filter(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, filter storage.SignatureListFilter) ([]storage.Signature, error) {
+			s.Require().Equal(10, filter.Limit)
+			s.Require().Equal("0xa9059cbb", filter.Selector.Hex())
+			return []storage.Signature{
+				{
+					Id:        1,
+					Selector:  pkgTypes.MustDecodeHex("0xa9059cbb"),
+					Signature: "transfer(address,uint256)",
+					Type:      types.Function,
+				}, {
+					Id:        2,
+					Selector:  pkgTypes.MustDecodeHex("0xa9059cbb"),
+					Signature: "many_msg_babbage(bytes1)",
+					Type:      types.Function,
+				},
+			}, nil
+		}).
+		Times(1)
+
+	s.Require().NoError(s.handler.List(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var signatures []responses.Signature
+	err := json.NewDecoder(rec.Body).Decode(&signatures)
+	s.Require().NoError(err)
+	s.Require().Len(signatures, 2)
+	s.Require().Equal("0xa9059cbb", signatures[0].Selector)
+	s.Require().Equal("transfer(address,uint256)", signatures[0].Signature)
+	s.Require().Equal("function", signatures[0].Type)
+	s.Require().Equal("many_msg_babbage(bytes1)", signatures[1].Signature)
+}
+
+// TestListEventTopic tests lookup of the event topic
+func (s *SignatureHandlerTestSuite) TestListEventTopic() {
+	q := make(url.Values)
+	q.Set("selector", "ddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/signatures")
+
+	s.signatures.EXPECT().
+		Filter(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, filter storage.SignatureListFilter) ([]storage.Signature, error) {
+			s.Require().Len(filter.Selector, 32)
+			return []storage.Signature{}, nil
+		}).
+		Times(1)
+
+	s.Require().NoError(s.handler.List(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var signatures []responses.Signature
+	err := json.NewDecoder(rec.Body).Decode(&signatures)
+	s.Require().NoError(err)
+	s.Require().Len(signatures, 0)
+}
+
+// TestListInvalidSelector tests handling of missing and malformed selectors
+func (s *SignatureHandlerTestSuite) TestListInvalidSelector() {
+	for _, selector := range []string{"", "0xa9059c", "0xa9059cbb00", "invalid"} {
+		q := make(url.Values)
+		q.Set("selector", selector)
+
+		req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+		rec := httptest.NewRecorder()
+		c := s.echo.NewContext(req, rec)
+		c.SetPath("/signatures")
+
+		s.Require().NoError(s.handler.List(c))
+		s.Require().Equal(http.StatusBadRequest, rec.Code, selector)
+	}
+}
@@ -112,3 +112,32 @@ func (s *StateTestSuite) TestNoHead() {
 	s.Require().NoError(s.handler.Head(c))
 	s.Require().Equal(http.StatusNoContent, rec.Code)
 }
+
+func (s *StateTestSuite) TestHeadFinality() {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/head")
+
+	s.state.EXPECT().
+		ByName(gomock.Any(), testIndexerName).
+		Return(storage.State{
+			Id:              1,
+			Name:            testIndexerName,
+			LastHeight:      100,
+			LastTime:        testTime,
+			SafeHeight:      110,
+			FinalizedHeight: 90,
+		}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.Head(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var state responses.State
+	err := json.NewDecoder(rec.Body).Decode(&state)
+	s.Require().NoError(err)
+	// the node may be ahead of the indexer
+	s.Require().EqualValues(100, state.SafeHeight)
+	s.Require().EqualValues(90, state.FinalizedHeight)
+}
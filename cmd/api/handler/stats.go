@@ -3,6 +3,7 @@ package handler
 import (
 	"time"
 
+	"github.com/NobleScope/noble-indexer/cmd/api/handler/responses"
 	"github.com/NobleScope/noble-indexer/internal/storage"
 	"github.com/labstack/echo/v4"
 )
@@ -42,3 +43,25 @@ func (sh *StatsHandler) AvgBlockTime(c echo.Context) error {
 	}
 	return c.JSON(200, blockTime)
 }
+
+// BlobFee godoc
+//
+//	@Summary		Get blob fee statistics
+//	@Description	Returns EIP-4844 blob statistics for the last 24 hours: count of blobs, used blob gas, burned blob fee and average blob gas price.
+//	@Tags			stats
+//	@ID				blob-fee-stats
+//	@Produce		json
+//	@Success		200		{object}	responses.BlobStats		"Blob fee statistics"
+//	@Failure		500		{object}	Error					"Internal server error"
+//	@Router			/stats/blob_fee [get]
+func (sh *StatsHandler) BlobFee(c echo.Context) error {
+	state, err := sh.state.ByName(c.Request().Context(), sh.indexerName)
+	if err != nil {
+		return handleError(c, err, sh.state)
+	}
+	stats, err := sh.blockStats.BlobStats(c.Request().Context(), state.LastTime.Add(-24*time.Hour).UTC())
+	if err != nil {
+		return handleError(c, err, sh.blockStats)
+	}
+	return c.JSON(200, responses.NewBlobStats(stats))
+}
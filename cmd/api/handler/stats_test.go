@@ -8,9 +8,11 @@ import (
 	"testing"
 	"time"
 
+	"github.com/NobleScope/noble-indexer/cmd/api/handler/responses"
 	"github.com/NobleScope/noble-indexer/internal/storage"
 	"github.com/NobleScope/noble-indexer/internal/storage/mock"
 	"github.com/labstack/echo/v4"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/mock/gomock"
 )
@@ -69,3 +71,36 @@ func (s *StatsTestSuite) TestAvgBlockTime() {
 	s.Require().NoError(err)
 	s.Require().EqualValues(123.456, blockTime)
 }
+
+func (s *StatsTestSuite) TestBlobFee() {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/stats/blob_fee")
+
+	lastTime := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	s.state.EXPECT().
+		ByName(gomock.Any(), "test-indexer").
+		Return(storage.State{LastTime: lastTime}, nil).
+		Times(1)
+
+	s.blockStats.EXPECT().
+		BlobStats(gomock.Any(), lastTime.Add(-24*time.Hour)).
+		Return(storage.BlobStats{
+			BlobsCount:  3,
+			BlobGasUsed: decimal.NewFromInt(393216),
+			BlobFee:     decimal.NewFromInt(786432),
+		}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.BlobFee(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var stats responses.BlobStats
+	err := json.NewDecoder(rec.Body).Decode(&stats)
+	s.Require().NoError(err)
+	s.Require().EqualValues(3, stats.BlobsCount)
+	s.Require().Equal("393216", stats.BlobGasUsed.String())
+	s.Require().Equal("786432", stats.BlobFee.String())
+	s.Require().Equal("2", stats.AvgBlobGasPrice.String())
+}
@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"errors"
 	"net/http"
 	"time"
 
@@ -14,26 +15,35 @@ import (
 )
 
 type TokenHandler struct {
-	token    storage.IToken
-	transfer storage.ITransfer
-	tbs      storage.ITokenBalance
-	address  storage.IAddress
-	tx       storage.ITx
+	token       storage.IToken
+	transfer    storage.ITransfer
+	tbs         storage.ITokenBalance
+	tbHistory   storage.ITokenBalanceHistory
+	address     storage.IAddress
+	tx          storage.ITx
+	state       storage.IState
+	indexerName string
 }
 
 func NewTokenHandler(
 	token storage.IToken,
 	transfer storage.ITransfer,
 	tbs storage.ITokenBalance,
+	tbHistory storage.ITokenBalanceHistory,
 	address storage.IAddress,
 	tx storage.ITx,
+	state storage.IState,
+	indexerName string,
 ) *TokenHandler {
 	return &TokenHandler{
-		token:    token,
-		transfer: transfer,
-		tbs:      tbs,
-		address:  address,
-		tx:       tx,
+		token:       token,
+		transfer:    transfer,
+		tbs:         tbs,
+		tbHistory:   tbHistory,
+		address:     address,
+		tx:          tx,
+		state:       state,
+		indexerName: indexerName,
 	}
 }
 
@@ -187,6 +197,7 @@ type transferListRequest struct {
 	AddressTo   string      `query:"address_to"   validate:"omitempty,address"`
 	Contract    string      `query:"contract"     validate:"omitempty,address"`
 	TokenId     *string     `query:"token_id"     validate:"omitempty"`
+	Finality    string      `query:"finality"     validate:"omitempty,finality"`
 	Cursor      string      `query:"cursor"       validate:"omitempty"`
 
 	From int64 `example:"1692892095" query:"time_from" swaggertype:"integer" validate:"omitempty,min=1"`
@@ -220,11 +231,13 @@ func (p *transferListRequest) SetDefault() {
 //	@Param			address_to		query	string	false	"Filter by recipient address"								minlength(42)	maxlength(42)	example(0x742d35Cc6634C0532925a3b844Bc9e7595f0bEb)
 //	@Param			contract		query	string	false	"Filter by token contract address"							minlength(42)	maxlength(42)	example(0x742d35Cc6634C0532925a3b844Bc9e7595f0bEb)
 //	@Param			token_id		query	string	false	"Filter by token ID"										example(0)
+//	@Param			finality		query	string	false	"Return only data of safe or finalized blocks, safe includes finalized ones (default: latest)"	Enums(latest, safe, finalized)
 //	@Param			cursor			query	string	false	"Opaque cursor for keyset pagination. Base64url-encoded value from the previous response's 'cursor' field. Encodes (timestamp, id) of the last returned record. Cannot be used together with offset (returns 400)."
 //	@Produce		json
 //	@Success		200	{object}	CursorResponse	"List of token transfers"
 //	@Failure		400	{object}	Error				"Invalid request parameters"
 //	@Failure		500	{object}	Error				"Internal server error"
+//	@Failure		503	{object}	Error				"Requested finality is not tracked by the indexer"
 //	@Router			/transfers [get]
 func (handler *TokenHandler) TransferList(c echo.Context) error {
 	req, err := bindAndValidate[transferListRequest](c)
@@ -246,6 +259,11 @@ func (handler *TokenHandler) TransferList(c echo.Context) error {
 		Type:   transferTypes,
 	}
 
+	filters.MaxHeight, err = finalityHeight(c.Request().Context(), handler.state, handler.indexerName, req.Finality)
+	if err != nil {
+		return handleError(c, err, handler.state)
+	}
+
 	if req.Cursor != "" {
 		if req.Offset > 0 {
 			return badRequestError(c, errCursorWithOffset)
@@ -367,6 +385,7 @@ type tokenBalanceListRequest struct {
 	Address  string  `query:"address"  validate:"omitempty,address"`
 	Contract string  `query:"contract" validate:"omitempty,address"`
 	TokenId  *string `query:"token_id" validate:"omitempty"`
+	Finality string  `query:"finality" validate:"omitempty,finality"`
 }
 
 func (p *tokenBalanceListRequest) SetDefault() {
@@ -390,10 +409,12 @@ func (p *tokenBalanceListRequest) SetDefault() {
 //	@Param			address			query	string	false	"Filter by holder address"						minlength(42)	maxlength(42)	example(0x742d35Cc6634C0532925a3b844Bc9e7595f0bEb)
 //	@Param			contract		query	string	false	"Filter by token contract address"				minlength(42)	maxlength(42)	example(0x742d35Cc6634C0532925a3b844Bc9e7595f0bEb)
 //	@Param			token_id		query	string	false	"Filter by token ID"							example(0)
+//	@Param			finality		query	string	false	"Return only data of safe or finalized blocks, safe includes finalized ones (default: latest)"	Enums(latest, safe, finalized)
 //	@Produce		json
 //	@Success		200	{object}	CursorResponse	"List of token balances"
 //	@Failure		400	{object}	Error					"Invalid request parameters"
 //	@Failure		500	{object}	Error					"Internal server error"
+//	@Failure		503	{object}	Error					"Requested finality is not tracked by the indexer"
 //	@Router			/token_balances [get]
 func (handler *TokenHandler) TokenBalanceList(c echo.Context) error {
 	req, err := bindAndValidate[tokenBalanceListRequest](c)
@@ -408,6 +429,11 @@ func (handler *TokenHandler) TokenBalanceList(c echo.Context) error {
 		Sort:   pgSort(req.Sort),
 	}
 
+	filters.MaxHeight, err = finalityHeight(c.Request().Context(), handler.state, handler.indexerName, req.Finality)
+	if err != nil {
+		return handleError(c, err, handler.state)
+	}
+
 	if req.TokenId != nil {
 		tokenId, err := decimal.NewFromString(*req.TokenId)
 		if err != nil {
@@ -458,3 +484,161 @@ func (handler *TokenHandler) getAddressByHash(c echo.Context, h string) (storage
 
 	return address, nil
 }
+
+var errHeightWithoutHolder = errors.New("address and contract are required when height is set")
+
+type tokenBalanceHistoryRequest struct {
+	Limit    int          `query:"limit"    validate:"omitempty,min=1,max=100"`
+	Offset   int          `query:"offset"   validate:"omitempty,min=0"`
+	Sort     string       `query:"sort"     validate:"omitempty,oneof=asc desc"`
+	Address  string       `query:"address"  validate:"omitempty,address"`
+	Contract string       `query:"contract" validate:"omitempty,address"`
+	TokenId  *string      `query:"token_id" validate:"omitempty"`
+	Height   *types.Level `query:"height"   validate:"omitempty,min=0"`
+	Finality string       `query:"finality" validate:"omitempty,finality"`
+	Cursor   string       `query:"cursor"   validate:"omitempty"`
+}
+
+func (p *tokenBalanceHistoryRequest) SetDefault() {
+	if p.Limit == 0 {
+		p.Limit = 10
+	}
+	if p.Sort == "" {
+		p.Sort = desc
+	}
+}
+
+// TokenBalanceHistory godoc
+//
+//	@Summary		Token balance history
+//	@Description	Returns the history of token balance changes. Every item is a snapshot of the holder balance after the block which changed it.
+//	@Description	If `height` is set the endpoint returns the single snapshot valid at that height (balance at height N) instead of the list. In this case `address` and `contract` are required and `token_id` defaults to 0.
+//	@Tags			token
+//	@ID				token-balance-history
+//	@Param			limit			query	integer	false	"Number of snapshots to return (default: 10)"	minimum(1)	maximum(100)	default(10)
+//	@Param			offset			query	integer	false	"Number of snapshots to skip (default: 0)"		minimum(0)	default(0)
+//	@Param			sort			query	string	false	"Sort order by timestamp (default: desc)"		Enums(asc, desc)	default(desc)
+//	@Param			address			query	string	false	"Filter by holder address"						minlength(42)	maxlength(42)	example(0x742d35Cc6634C0532925a3b844Bc9e7595f0bEb)
+//	@Param			contract		query	string	false	"Filter by token contract address"				minlength(42)	maxlength(42)	example(0x742d35Cc6634C0532925a3b844Bc9e7595f0bEb)
+//	@Param			token_id		query	string	false	"Filter by token ID"							example(0)
+//	@Param			height			query	integer	false	"Return token balance at the block height"		minimum(0)	example(12345)
+//	@Param			finality		query	string	false	"Return only data of safe or finalized blocks, safe includes finalized ones (default: latest)"	Enums(latest, safe, finalized)
+//	@Param			cursor			query	string	false	"Opaque cursor for keyset pagination. Base64url-encoded value from the previous response's 'cursor' field. Encodes (timestamp, id) of the last returned record. Cannot be used together with offset (returns 400)."
+//	@Produce		json
+//	@Success		200	{object}	CursorResponse	"List of token balance snapshots or responses.TokenBalanceHistory if height is set"
+//	@Success		204								"Address or contract not found"
+//	@Failure		400	{object}	Error			"Invalid request parameters"
+//	@Failure		500	{object}	Error			"Internal server error"
+//	@Failure		503	{object}	Error			"Requested finality is not tracked by the indexer"
+//	@Router			/token_balances/history [get]
+func (handler *TokenHandler) TokenBalanceHistory(c echo.Context) error {
+	req, err := bindAndValidate[tokenBalanceHistoryRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	req.SetDefault()
+
+	if req.Height != nil && (req.Address == "" || req.Contract == "") {
+		return badRequestError(c, errHeightWithoutHolder)
+	}
+
+	var (
+		filters = storage.TokenBalanceHistoryListFilter{
+			Limit:  req.Limit,
+			Offset: req.Offset,
+			Sort:   pgSort(req.Sort),
+		}
+		holder   storage.Address
+		contract storage.Address
+	)
+
+	filters.MaxHeight, err = finalityHeight(c.Request().Context(), handler.state, handler.indexerName, req.Finality)
+	if err != nil {
+		return handleError(c, err, handler.state)
+	}
+
+	if req.TokenId != nil {
+		tokenId, err := decimal.NewFromString(*req.TokenId)
+		if err != nil {
+			return badRequestError(c, err)
+		}
+		filters.TokenId = &tokenId
+	}
+
+	if req.Address != "" {
+		hash, err := types.HexFromString(req.Address)
+		if err != nil {
+			return badRequestError(c, err)
+		}
+		holder, err = handler.address.ByHash(c.Request().Context(), hash)
+		if err != nil {
+			return handleError(c, err, handler.address)
+		}
+		filters.AddressId = &holder.Id
+	}
+
+	if req.Contract != "" {
+		hash, err := types.HexFromString(req.Contract)
+		if err != nil {
+			return badRequestError(c, err)
+		}
+		contract, err = handler.address.ByHash(c.Request().Context(), hash)
+		if err != nil {
+			return handleError(c, err, handler.address)
+		}
+		filters.ContractId = &contract.Id
+	}
+
+	if req.Height != nil {
+		tokenId := decimal.Zero
+		if filters.TokenId != nil {
+			tokenId = *filters.TokenId
+		}
+		height := *req.Height
+		if filters.MaxHeight != nil {
+			height = min(height, types.Level(*filters.MaxHeight))
+		}
+		history, err := handler.tbHistory.AtHeight(c.Request().Context(), holder.Id, contract.Id, tokenId, height)
+		if err != nil {
+			if !handler.tbHistory.IsNoRows(err) {
+				return handleError(c, err, handler.tbHistory)
+			}
+			history.TokenID = tokenId
+			history.Balance = decimal.Zero
+			history.Delta = decimal.Zero
+			history.Address = holder
+			history.Contract.Address = contract
+		}
+		return c.JSON(http.StatusOK, responses.NewTokenBalanceHistory(history))
+	}
+
+	if req.Cursor != "" {
+		if req.Offset > 0 {
+			return badRequestError(c, errCursorWithOffset)
+		}
+		cursorTime, cursorID, err := helpers.DecodeTimeIDCursor(req.Cursor)
+		if err != nil {
+			return badRequestError(c, err)
+		}
+		filters.CursorTime = cursorTime
+		filters.CursorID = cursorID
+	}
+
+	history, err := handler.tbHistory.Filter(c.Request().Context(), filters)
+	if err != nil {
+		return handleError(c, err, handler.tbHistory)
+	}
+
+	response := make([]responses.TokenBalanceHistory, len(history))
+	for i := range history {
+		response[i] = responses.NewTokenBalanceHistory(history[i])
+	}
+
+	var cursor string
+	if len(history) > 0 {
+		last := history[len(history)-1]
+		cursor = helpers.EncodeTimeIDCursor(last.Time, last.Id)
+	}
+
+	return returnCursorList(c, response, cursor)
+}
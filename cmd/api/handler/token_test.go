@@ -143,19 +143,47 @@ var (
 		Balance:    decimal.NewFromInt(100),
 		Address:    testFromAddress,
 	}
+
+	testTokenBalanceHistory1 = storage.TokenBalanceHistory{
+		Id:         1,
+		Height:     100,
+		Time:       testTime,
+		TokenID:    decimal.NewFromInt(0),
+		ContractID: 1,
+		AddressID:  1,
+		Balance:    decimal.NewFromInt(6000),
+		Delta:      decimal.NewFromInt(6000),
+		Contract:   testContract,
+		Address:    testFromAddress,
+	}
+
+	testTokenBalanceHistory2 = storage.TokenBalanceHistory{
+		Id:         2,
+		Height:     101,
+		Time:       testTime.Add(time.Hour),
+		TokenID:    decimal.NewFromInt(0),
+		ContractID: 1,
+		AddressID:  1,
+		Balance:    decimal.NewFromInt(5000),
+		Delta:      decimal.NewFromInt(-1000),
+		Contract:   testContract,
+		Address:    testFromAddress,
+	}
 )
 
 // TokenHandlerTestSuite -
 type TokenHandlerTestSuite struct {
 	suite.Suite
-	token    *mock.MockIToken
-	transfer *mock.MockITransfer
-	tbs      *mock.MockITokenBalance
-	address  *mock.MockIAddress
-	tx       *mock.MockITx
-	echo     *echo.Echo
-	handler  *TokenHandler
-	ctrl     *gomock.Controller
+	token     *mock.MockIToken
+	transfer  *mock.MockITransfer
+	tbs       *mock.MockITokenBalance
+	tbHistory *mock.MockITokenBalanceHistory
+	address   *mock.MockIAddress
+	tx        *mock.MockITx
+	state     *mock.MockIState
+	echo      *echo.Echo
+	handler   *TokenHandler
+	ctrl      *gomock.Controller
 }
 
 // SetupSuite -
@@ -166,9 +194,11 @@ func (s *TokenHandlerTestSuite) SetupSuite() {
 	s.token = mock.NewMockIToken(s.ctrl)
 	s.transfer = mock.NewMockITransfer(s.ctrl)
 	s.tbs = mock.NewMockITokenBalance(s.ctrl)
+	s.tbHistory = mock.NewMockITokenBalanceHistory(s.ctrl)
 	s.address = mock.NewMockIAddress(s.ctrl)
 	s.tx = mock.NewMockITx(s.ctrl)
-	s.handler = NewTokenHandler(s.token, s.transfer, s.tbs, s.address, s.tx)
+	s.state = mock.NewMockIState(s.ctrl)
+	s.handler = NewTokenHandler(s.token, s.transfer, s.tbs, s.tbHistory, s.address, s.tx, s.state, testIndexerName)
 }
 
 // TearDownSuite -
@@ -1179,3 +1209,231 @@ func (s *TokenHandlerTestSuite) TestTransferListInvalidCursor() {
 	s.Require().NoError(s.handler.TransferList(c))
 	s.Require().Equal(http.StatusBadRequest, rec.Code)
 }
+
+// ====================================
+// Token Balance History Tests
+// ====================================
+
+// TestTokenBalanceHistoryList tests listing of token balance snapshots
+func (s *TokenHandlerTestSuite) TestTokenBalanceHistoryList() {
+	q := make(url.Values)
+	q.Set("address", testAddressHex1.Hex())
+	q.Set("contract", testAddressHex3.Hex())
+	q.Set("token_id", "0")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/token_balances/history")
+
+	s.address.EXPECT().
+		ByHash(gomock.Any(), testAddressHex1).
+		Return(testFromAddress, nil).
+		Times(1)
+
+	s.address.EXPECT().
+		ByHash(gomock.Any(), testAddressHex3).
+		Return(storage.Address{Id: 3, Hash: testAddressHex3}, nil).
+		Times(1)
+
+	s.tbHistory.EXPECT().
+		Filter(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, filter storage.TokenBalanceHistoryListFilter) ([]storage.TokenBalanceHistory, error) {
+			s.Require().Equal(10, filter.Limit)
+			s.Require().Equal(sdk.SortOrderDesc, filter.Sort)
+			s.Require().NotNil(filter.AddressId)
+			s.Require().EqualValues(1, *filter.AddressId)
+			s.Require().NotNil(filter.ContractId)
+			s.Require().EqualValues(3, *filter.ContractId)
+			s.Require().NotNil(filter.TokenId)
+			s.Require().True(filter.TokenId.IsZero())
+			return []storage.TokenBalanceHistory{testTokenBalanceHistory2, testTokenBalanceHistory1}, nil
+		}).
+		Times(1)
+
+	s.Require().NoError(s.handler.TokenBalanceHistory(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var body struct {
+		Result []responses.TokenBalanceHistory `json:"result"`
+		Cursor string                          `json:"cursor"`
+	}
+	err := json.NewDecoder(rec.Body).Decode(&body)
+	s.Require().NoError(err)
+	s.Require().Len(body.Result, 2)
+
+	item := body.Result[0]
+	s.Require().EqualValues(101, item.Height)
+	s.Require().Equal(testAddressHex1.Hex(), item.Address)
+	s.Require().Equal(testAddressHex3.Hex(), item.Contract)
+	s.Require().Equal("0", item.TokenId)
+	s.Require().Equal("5000", item.Value)
+	s.Require().Equal("-1000", item.Delta)
+
+	cursorTime, cursorID, err := helpers.DecodeTimeIDCursor(body.Cursor)
+	s.Require().NoError(err)
+	s.Require().True(testTokenBalanceHistory1.Time.Equal(cursorTime))
+	s.Require().EqualValues(testTokenBalanceHistory1.Id, cursorID)
+}
+
+// TestTokenBalanceHistoryWithCursor tests cursor-based pagination for token balance history
+func (s *TokenHandlerTestSuite) TestTokenBalanceHistoryWithCursor() {
+	q := make(url.Values)
+	q.Set("cursor", helpers.EncodeTimeIDCursor(testTokenBalanceHistory2.Time, testTokenBalanceHistory2.Id))
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/token_balances/history")
+
+	s.tbHistory.EXPECT().
+		Filter(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, filter storage.TokenBalanceHistoryListFilter) ([]storage.TokenBalanceHistory, error) {
+			s.Require().EqualValues(testTokenBalanceHistory2.Id, filter.CursorID)
+			s.Require().True(testTokenBalanceHistory2.Time.Equal(filter.CursorTime))
+			s.Require().Nil(filter.AddressId)
+			s.Require().Nil(filter.ContractId)
+			return []storage.TokenBalanceHistory{testTokenBalanceHistory1}, nil
+		}).
+		Times(1)
+
+	s.Require().NoError(s.handler.TokenBalanceHistory(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+}
+
+// TestTokenBalanceHistoryAtHeight tests token balance at the block height
+func (s *TokenHandlerTestSuite) TestTokenBalanceHistoryAtHeight() {
+	q := make(url.Values)
+	q.Set("address", testAddressHex1.Hex())
+	q.Set("contract", testAddressHex3.Hex())
+	q.Set("height", "150")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/token_balances/history")
+
+	s.address.EXPECT().
+		ByHash(gomock.Any(), testAddressHex1).
+		Return(testFromAddress, nil).
+		Times(1)
+
+	s.address.EXPECT().
+		ByHash(gomock.Any(), testAddressHex3).
+		Return(storage.Address{Id: 3, Hash: testAddressHex3}, nil).
+		Times(1)
+
+	s.tbHistory.EXPECT().
+		AtHeight(gomock.Any(), uint64(1), uint64(3), decimal.Zero, pkgTypes.Level(150)).
+		Return(testTokenBalanceHistory2, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.TokenBalanceHistory(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var response responses.TokenBalanceHistory
+	err := json.NewDecoder(rec.Body).Decode(&response)
+	s.Require().NoError(err)
+	s.Require().EqualValues(101, response.Height)
+	s.Require().Equal("5000", response.Value)
+}
+
+// TestTokenBalanceHistoryAtHeightBeforeFirstChange tests zero balance before the first change
+func (s *TokenHandlerTestSuite) TestTokenBalanceHistoryAtHeightBeforeFirstChange() {
+	q := make(url.Values)
+	q.Set("address", testAddressHex1.Hex())
+	q.Set("contract", testAddressHex3.Hex())
+	q.Set("token_id", "7")
+	q.Set("height", "10")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/token_balances/history")
+
+	s.address.EXPECT().
+		ByHash(gomock.Any(), testAddressHex1).
+		Return(testFromAddress, nil).
+		Times(1)
+
+	s.address.EXPECT().
+		ByHash(gomock.Any(), testAddressHex3).
+		Return(storage.Address{Id: 3, Hash: testAddressHex3}, nil).
+		Times(1)
+
+	s.tbHistory.EXPECT().
+		AtHeight(gomock.Any(), uint64(1), uint64(3), decimal.NewFromInt(7), pkgTypes.Level(10)).
+		Return(storage.TokenBalanceHistory{}, sql.ErrNoRows).
+		Times(1)
+
+	s.tbHistory.EXPECT().
+		IsNoRows(sql.ErrNoRows).
+		Return(true).
+		Times(1)
+
+	s.Require().NoError(s.handler.TokenBalanceHistory(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var response responses.TokenBalanceHistory
+	err := json.NewDecoder(rec.Body).Decode(&response)
+	s.Require().NoError(err)
+	s.Require().Equal("0", response.Value)
+	s.Require().Equal("0", response.Delta)
+	s.Require().Equal("7", response.TokenId)
+	s.Require().Equal(testAddressHex1.Hex(), response.Address)
+	s.Require().Equal(testAddressHex3.Hex(), response.Contract)
+}
+
+// TestTokenBalanceHistoryHeightWithoutContract tests that height requires address and contract
+func (s *TokenHandlerTestSuite) TestTokenBalanceHistoryHeightWithoutContract() {
+	q := make(url.Values)
+	q.Set("address", testAddressHex1.Hex())
+	q.Set("height", "100")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/token_balances/history")
+
+	s.Require().NoError(s.handler.TokenBalanceHistory(c))
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+}
+
+// TestTokenBalanceHistoryCursorWithOffset tests that cursor and offset cannot be combined
+func (s *TokenHandlerTestSuite) TestTokenBalanceHistoryCursorWithOffset() {
+	q := make(url.Values)
+	q.Set("offset", "10")
+	q.Set("cursor", helpers.EncodeTimeIDCursor(testTime, 1))
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/token_balances/history")
+
+	s.Require().NoError(s.handler.TokenBalanceHistory(c))
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+}
+
+// TestTokenBalanceHistoryUnknownAddress tests that unknown holder returns no content
+func (s *TokenHandlerTestSuite) TestTokenBalanceHistoryUnknownAddress() {
+	q := make(url.Values)
+	q.Set("address", testAddressHex2.Hex())
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/token_balances/history")
+
+	s.address.EXPECT().
+		ByHash(gomock.Any(), testAddressHex2).
+		Return(storage.Address{}, sql.ErrNoRows).
+		Times(1)
+
+	s.address.EXPECT().
+		IsNoRows(sql.ErrNoRows).
+		Return(true).
+		Times(1)
+
+	s.Require().NoError(s.handler.TokenBalanceHistory(c))
+	s.Require().Equal(http.StatusNoContent, rec.Code)
+}
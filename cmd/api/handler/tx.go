@@ -13,23 +13,32 @@ import (
 )
 
 type TxHandler struct {
-	tx          storage.ITx
-	trace       storage.ITrace
-	address     storage.IAddress
-	indexerName string
+	tx             storage.ITx
+	trace          storage.ITrace
+	address        storage.IAddress
+	accessList     storage.ITxAccessList
+	authorizations storage.ITxAuthorization
+	state          storage.IState
+	indexerName    string
 }
 
 func NewTxHandler(
 	tx storage.ITx,
 	trace storage.ITrace,
 	address storage.IAddress,
+	accessList storage.ITxAccessList,
+	authorizations storage.ITxAuthorization,
+	state storage.IState,
 	indexerName string,
 ) *TxHandler {
 	return &TxHandler{
-		tx:          tx,
-		trace:       trace,
-		address:     address,
-		indexerName: indexerName,
+		tx:             tx,
+		trace:          trace,
+		address:        address,
+		accessList:     accessList,
+		authorizations: authorizations,
+		state:          state,
+		indexerName:    indexerName,
 	}
 }
 
@@ -68,7 +77,38 @@ func (handler *TxHandler) Get(c echo.Context) error {
 		return handleError(c, err, handler.tx)
 	}
 
-	return c.JSON(http.StatusOK, responses.NewTransaction(tx))
+	result := responses.NewTransaction(tx)
+
+	switch tx.Type {
+	case internalTypes.TxTypeAccessList, internalTypes.TxTypeDynamicFee, internalTypes.TxTypeBlob, internalTypes.TxTypeSetCode:
+		accessList, err := handler.accessList.ByTxId(c.Request().Context(), tx.Id)
+		if err != nil {
+			return handleError(c, err, handler.tx)
+		}
+		result.AccessList = make([]responses.AccessListItem, len(accessList))
+		for i := range accessList {
+			result.AccessList[i] = responses.NewAccessListItem(accessList[i])
+		}
+	}
+
+	if tx.Type == internalTypes.TxTypeSetCode {
+		authorizations, err := handler.authorizations.ByTxId(c.Request().Context(), tx.Id)
+		if err != nil {
+			return handleError(c, err, handler.tx)
+		}
+		result.AuthorizationList = make([]responses.Authorization, len(authorizations))
+		for i := range authorizations {
+			result.AuthorizationList[i] = responses.NewAuthorization(authorizations[i])
+		}
+	}
+
+	finality, err := heightFinality(c.Request().Context(), handler.state, handler.indexerName, tx.Height)
+	if err != nil {
+		return handleError(c, err, handler.state)
+	}
+	preventCaching(c, finality)
+
+	return c.JSON(http.StatusOK, result)
 }
 
 type getTxTraces struct {
@@ -84,6 +124,7 @@ type getTxTraces struct {
 	CallType    StringArray `query:"call_type"    validate:"omitempty,dive,call_type"`
 	Sort        string      `query:"sort"         validate:"omitempty,oneof=asc desc"`
 	Decode      bool        `query:"decode"       validate:"omitempty"`
+	Finality    string      `query:"finality"     validate:"omitempty,finality"`
 	Cursor      string      `query:"cursor"       validate:"omitempty"`
 }
 
@@ -113,11 +154,13 @@ func (req *getTxTraces) SetDefault() {
 //	@Param			call_type		query	string	false	"Filter by call type (comma-separated list)"				Enums(call, delegatecall, staticcall, callcode)
 //	@Param			sort			query	string	false	"Sort order (default: desc)"								Enums(asc, desc)	default(desc)
 //	@Param			decode			query	boolean	false	"Decode trace input using contract ABI"						default(false)
+//	@Param			finality		query	string	false	"Return only data of safe or finalized blocks, safe includes finalized ones (default: latest)"	Enums(latest, safe, finalized)
 //	@Param			cursor			query	string	false	"Opaque cursor for keyset pagination. Base64url-encoded value from the previous response's 'cursor' field. Encodes (timestamp, id) of the last returned record. Cannot be used together with offset (returns 400)."
 //	@Produce		json
 //	@Success		200	{object}	CursorResponse	"List of execution traces"
 //	@Failure		400	{object}	Error			"Invalid request parameters"
 //	@Failure		500	{object}	Error			"Internal server error"
+//	@Failure		503	{object}	Error			"Requested finality is not tracked by the indexer"
 //	@Router			/traces [get]
 func (handler *TxHandler) Traces(c echo.Context) error {
 	req, err := bindAndValidate[getTxTraces](c)
@@ -146,6 +189,11 @@ func (handler *TxHandler) Traces(c echo.Context) error {
 		WithABI:  req.Decode,
 	}
 
+	filters.MaxHeight, err = finalityHeight(c.Request().Context(), handler.state, handler.indexerName, req.Finality)
+	if err != nil {
+		return handleError(c, err, handler.state)
+	}
+
 	if req.Cursor != "" {
 		if req.Offset > 0 {
 			return badRequestError(c, errCursorWithOffset)
@@ -235,6 +283,7 @@ type listTxs struct {
 	Type        StringArray `query:"type"         validate:"omitempty,dive,tx_type"`
 	Status      StringArray `query:"status"       validate:"omitempty,dive,tx_status"`
 	Decode      bool        `query:"decode"       validate:"omitempty"`
+	Finality    string      `query:"finality"     validate:"omitempty,finality"`
 	Cursor      string      `query:"cursor"       validate:"omitempty"`
 
 	From int64 `example:"1692892095" query:"time_from" swaggertype:"integer" validate:"omitempty,min=1"`
@@ -262,17 +311,19 @@ func (req *listTxs) SetDefault() {
 //	@Param			address_to		query	string	false	"Filter by recipient address"						minlength(42)	maxlength(42)	example(0x742d35Cc6634C0532925a3b844Bc9e7595f0bEb)
 //	@Param			contract		query	string	false	"Filter by called contract address"					minlength(42)	maxlength(42)	example(0x742d35Cc6634C0532925a3b844Bc9e7595f0bEb)
 //	@Param			height			query	integer	false	"Filter by block height"							minimum(1)	example(12345)
-//	@Param			type			query	string	false	"Filter by transaction type (comma-separated list)"	Enums(TxTypeUnknown, TxTypeLegacy, TxTypeDynamicFee, TxTypeBlob, TxTypeSetCode)
+//	@Param			type			query	string	false	"Filter by transaction type (comma-separated list)"	Enums(TxTypeUnknown, TxTypeLegacy, TxTypeAccessList, TxTypeDynamicFee, TxTypeBlob, TxTypeSetCode)
 //	@Param			status			query	string	false	"Filter by execution status (comma-separated list)"	Enums(TxStatusSuccess, TxStatusRevert)
 //	@Param			time_from		query	integer	false	"Filter by timestamp from (Unix timestamp)"			minimum(1)	example(1692892095)
 //	@Param			time_to			query	integer	false	"Filter by timestamp to (Unix timestamp)"			minimum(1)	example(1692892095)
 //	@Param			sort			query	string	false	"Sort order by timestamp (default: desc)"			Enums(asc, desc)	default(desc)
 //	@Param			decode			query	boolean	false	"Decode transaction input using contract ABI"		default(false)
+//	@Param			finality		query	string	false	"Return only data of safe or finalized blocks, safe includes finalized ones (default: latest)"	Enums(latest, safe, finalized)
 //	@Param			cursor			query	string	false	"Opaque cursor for keyset pagination. Base64url-encoded value from the previous response's 'cursor' field. Encodes (timestamp, id) of the last returned record. Cannot be used together with offset (returns 400)."
 //	@Produce		json
 //	@Success		200	{object}	CursorResponse	"List of transactions"
 //	@Failure		400	{object}	Error					"Invalid request parameters"
 //	@Failure		500	{object}	Error					"Internal server error"
+//	@Failure		503	{object}	Error					"Requested finality is not tracked by the indexer"
 //	@Router			/txs [get]
 func (handler *TxHandler) List(c echo.Context) error {
 	req, err := bindAndValidate[listTxs](c)
@@ -301,6 +352,11 @@ func (handler *TxHandler) List(c echo.Context) error {
 		WithABI: req.Decode,
 	}
 
+	filters.MaxHeight, err = finalityHeight(c.Request().Context(), handler.state, handler.indexerName, req.Finality)
+	if err != nil {
+		return handleError(c, err, handler.state)
+	}
+
 	if req.Cursor != "" {
 		if req.Offset > 0 {
 			return badRequestError(c, errCursorWithOffset)
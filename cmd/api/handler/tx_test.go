@@ -36,12 +36,15 @@ func callTypePtr(v types.CallType) *types.CallType {
 // TxHandlerTestSuite -
 type TxHandlerTestSuite struct {
 	suite.Suite
-	tx      *mock.MockITx
-	trace   *mock.MockITrace
-	address *mock.MockIAddress
-	echo    *echo.Echo
-	handler *TxHandler
-	ctrl    *gomock.Controller
+	tx             *mock.MockITx
+	trace          *mock.MockITrace
+	address        *mock.MockIAddress
+	accessList     *mock.MockITxAccessList
+	authorizations *mock.MockITxAuthorization
+	state          *mock.MockIState
+	echo           *echo.Echo
+	handler        *TxHandler
+	ctrl           *gomock.Controller
 }
 
 // SetupSuite -
@@ -52,7 +55,10 @@ func (s *TxHandlerTestSuite) SetupSuite() {
 	s.tx = mock.NewMockITx(s.ctrl)
 	s.trace = mock.NewMockITrace(s.ctrl)
 	s.address = mock.NewMockIAddress(s.ctrl)
-	s.handler = NewTxHandler(s.tx, s.trace, s.address, testIndexerName)
+	s.accessList = mock.NewMockITxAccessList(s.ctrl)
+	s.authorizations = mock.NewMockITxAuthorization(s.ctrl)
+	s.state = mock.NewMockIState(s.ctrl)
+	s.handler = NewTxHandler(s.tx, s.trace, s.address, s.accessList, s.authorizations, s.state, testIndexerName)
 }
 
 // TearDownSuite -
@@ -79,6 +85,16 @@ func (s *TxHandlerTestSuite) TestGetSuccess() {
 		Return(testTxWithToAddress, nil).
 		Times(1)
 
+	s.accessList.EXPECT().
+		ByTxId(gomock.Any(), testTxWithToAddress.Id).
+		Return([]storage.TxAccessList{}, nil).
+		Times(1)
+
+	s.state.EXPECT().
+		ByName(gomock.Any(), testIndexerName).
+		Return(storage.State{FinalizedHeight: 1000}, nil).
+		Times(1)
+
 	s.Require().NoError(s.handler.Get(c))
 	s.Require().Equal(http.StatusOK, rec.Code)
 
@@ -96,6 +112,36 @@ func (s *TxHandlerTestSuite) TestGetSuccess() {
 	s.Require().Equal("1000000", tx.GasPrice.String())
 	s.Require().Equal("21000000000", tx.Fee.String())
 	s.Require().Equal("TxStatusSuccess", tx.Status)
+	s.Require().Empty(rec.Header().Get(echo.HeaderCacheControl))
+}
+
+// TestGetNotFinalized tests that the response with the transaction of not finalized block isn't cached
+func (s *TxHandlerTestSuite) TestGetNotFinalized() {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/tx/:hash")
+	c.SetParamNames("hash")
+	c.SetParamValues(testTxHash.Hex())
+
+	s.tx.EXPECT().
+		ByHash(gomock.Any(), testTxHash, false).
+		Return(testTxWithToAddress, nil).
+		Times(1)
+
+	s.accessList.EXPECT().
+		ByTxId(gomock.Any(), testTxWithToAddress.Id).
+		Return([]storage.TxAccessList{}, nil).
+		Times(1)
+
+	s.state.EXPECT().
+		ByName(gomock.Any(), testIndexerName).
+		Return(storage.State{LastHeight: 120, SafeHeight: 100, FinalizedHeight: 90}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.Get(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+	s.Require().Equal("no-store", rec.Header().Get(echo.HeaderCacheControl))
 }
 
 // TestGetContractCreation tests retrieval of a contract creation transaction
@@ -116,6 +162,16 @@ func (s *TxHandlerTestSuite) TestGetContractCreation() {
 		Return(testTxContractCreation, nil).
 		Times(1)
 
+	s.accessList.EXPECT().
+		ByTxId(gomock.Any(), testTxContractCreation.Id).
+		Return([]storage.TxAccessList{}, nil).
+		Times(1)
+
+	s.state.EXPECT().
+		ByName(gomock.Any(), testIndexerName).
+		Return(storage.State{FinalizedHeight: 1000}, nil).
+		Times(1)
+
 	s.Require().NoError(s.handler.Get(c))
 	s.Require().Equal(http.StatusOK, rec.Code)
 
@@ -152,6 +208,16 @@ func (s *TxHandlerTestSuite) TestGetContractCall() {
 		Return(testTxContractCall, nil).
 		Times(1)
 
+	s.accessList.EXPECT().
+		ByTxId(gomock.Any(), testTxContractCall.Id).
+		Return([]storage.TxAccessList{}, nil).
+		Times(1)
+
+	s.state.EXPECT().
+		ByName(gomock.Any(), testIndexerName).
+		Return(storage.State{FinalizedHeight: 1000}, nil).
+		Times(1)
+
 	s.Require().NoError(s.handler.Get(c))
 	s.Require().Equal(http.StatusOK, rec.Code)
 
@@ -171,6 +237,122 @@ func (s *TxHandlerTestSuite) TestGetContractCall() {
 	s.Require().Equal("TxStatusSuccess", tx.Status)
 }
 
+// TestGetDynamicFee tests retrieval of a dynamic fee transaction with access list
+func (s *TxHandlerTestSuite) TestGetDynamicFee() {
+	maxFee := decimal.NewFromInt(2000000)
+	maxPriorityFee := decimal.NewFromInt(100000)
+	tx := testTxWithToAddress
+	tx.Id = 10
+	tx.Type = types.TxTypeDynamicFee
+	tx.MaxFeePerGas = &maxFee
+	tx.MaxPriorityFeePerGas = &maxPriorityFee
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/tx/:hash")
+	c.SetParamNames("hash")
+	c.SetParamValues(testTxHash.Hex())
+
+	s.tx.EXPECT().
+		ByHash(gomock.Any(), testTxHash, false).
+		Return(tx, nil).
+		Times(1)
+
+	s.accessList.EXPECT().
+		ByTxId(gomock.Any(), uint64(10)).
+		Return([]storage.TxAccessList{
+			{
+				TxId:        10,
+				Address:     testAddressHex2,
+				StorageKeys: []pkgTypes.Hex{testTxHash},
+			},
+		}, nil).
+		Times(1)
+
+	s.state.EXPECT().
+		ByName(gomock.Any(), testIndexerName).
+		Return(storage.State{FinalizedHeight: 1000}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.Get(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var response responses.Transaction
+	err := json.NewDecoder(rec.Body).Decode(&response)
+	s.Require().NoError(err)
+	s.Require().Equal("TxTypeDynamicFee", response.Type)
+	s.Require().NotNil(response.MaxFeePerGas)
+	s.Require().Equal("2000000", response.MaxFeePerGas.String())
+	s.Require().NotNil(response.MaxPriorityFeePerGas)
+	s.Require().Equal("100000", response.MaxPriorityFeePerGas.String())
+	s.Require().Nil(response.MaxFeePerBlobGas)
+	s.Require().Len(response.AccessList, 1)
+	s.Require().Equal(testAddressHex2.Hex(), response.AccessList[0].Address)
+	s.Require().Equal([]string{testTxHash.Hex()}, response.AccessList[0].StorageKeys)
+	s.Require().Empty(response.AuthorizationList)
+}
+
+// TestGetSetCode tests retrieval of a set code transaction with authorization list
+func (s *TxHandlerTestSuite) TestGetSetCode() {
+	tx := testTxWithToAddress
+	tx.Id = 11
+	tx.Type = types.TxTypeSetCode
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/tx/:hash")
+	c.SetParamNames("hash")
+	c.SetParamValues(testTxHash.Hex())
+
+	s.tx.EXPECT().
+		ByHash(gomock.Any(), testTxHash, false).
+		Return(tx, nil).
+		Times(1)
+
+	s.accessList.EXPECT().
+		ByTxId(gomock.Any(), uint64(11)).
+		Return([]storage.TxAccessList{}, nil).
+		Times(1)
+
+	s.authorizations.EXPECT().
+		ByTxId(gomock.Any(), uint64(11)).
+		Return([]storage.TxAuthorization{
+			{
+				TxId:    11,
+				ChainId: decimal.NewFromInt(1),
+				Address: testAddressHex2,
+				Nonce:   decimal.NewFromInt(5),
+				YParity: 1,
+				R:       pkgTypes.Hex{0x01},
+				S:       pkgTypes.Hex{0x02},
+			},
+		}, nil).
+		Times(1)
+
+	s.state.EXPECT().
+		ByName(gomock.Any(), testIndexerName).
+		Return(storage.State{FinalizedHeight: 1000}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.Get(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var response responses.Transaction
+	err := json.NewDecoder(rec.Body).Decode(&response)
+	s.Require().NoError(err)
+	s.Require().Equal("TxTypeSetCode", response.Type)
+	s.Require().Empty(response.AccessList)
+	s.Require().Len(response.AuthorizationList, 1)
+	s.Require().Equal("1", response.AuthorizationList[0].ChainId.String())
+	s.Require().Equal(testAddressHex2.Hex(), response.AuthorizationList[0].Address)
+	s.Require().Equal("5", response.AuthorizationList[0].Nonce.String())
+	s.Require().EqualValues(1, response.AuthorizationList[0].YParity)
+	s.Require().Equal("0x01", response.AuthorizationList[0].R)
+	s.Require().Equal("0x02", response.AuthorizationList[0].S)
+}
+
 // TestGetNoContent tests when transaction is not found
 func (s *TxHandlerTestSuite) TestGetNoContent() {
 	txHash := "0xaabbccddee000000000000000000000000000000000000000000000000000000"
@@ -574,6 +756,42 @@ func (s *TxHandlerTestSuite) TestListInvalidLimit() {
 	s.Require().Equal(http.StatusOK, rec.Code)
 }
 
+// TestListSafe tests list of transactions from safe blocks
+func (s *TxHandlerTestSuite) TestListSafe() {
+	q := make(url.Values)
+	q.Set("finality", "safe")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/tx")
+
+	s.state.EXPECT().
+		ByName(gomock.Any(), testIndexerName).
+		Return(storage.State{
+			LastHeight:      120,
+			SafeHeight:      110,
+			FinalizedHeight: 100,
+		}, nil).
+		Times(1)
+
+	maxHeight := uint64(110)
+	s.tx.EXPECT().
+		Filter(gomock.Any(), storage.TxListFilter{
+			Limit:     10,
+			Offset:    0,
+			Sort:      sdk.SortOrderDesc,
+			Type:      []types.TxType{},
+			Status:    []types.TxStatus{},
+			MaxHeight: &maxHeight,
+		}).
+		Return([]storage.Tx{testTxWithToAddress}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.List(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+}
+
 // TestListMaxLimit tests list with limit exceeding maximum
 func (s *TxHandlerTestSuite) TestListMaxLimit() {
 	q := make(url.Values)
@@ -1433,6 +1651,16 @@ func (s *TxHandlerTestSuite) TestGetWithDecode() {
 		Return(txWithABI, nil).
 		Times(1)
 
+	s.accessList.EXPECT().
+		ByTxId(gomock.Any(), txWithABI.Id).
+		Return([]storage.TxAccessList{}, nil).
+		Times(1)
+
+	s.state.EXPECT().
+		ByName(gomock.Any(), testIndexerName).
+		Return(storage.State{FinalizedHeight: 1000}, nil).
+		Times(1)
+
 	s.Require().NoError(s.handler.Get(c))
 	s.Require().Equal(http.StatusOK, rec.Code)
 
@@ -1445,6 +1673,101 @@ func (s *TxHandlerTestSuite) TestGetWithDecode() {
 	s.Require().Contains(tx.Decoded.Args, "amount")
 }
 
+// TestGetWithDecodeProxy tests that input of a call to a proxy is decoded with the implementation ABI
+func (s *TxHandlerTestSuite) TestGetWithDecodeProxy() {
+	q := make(url.Values)
+	q.Set("decode", "true")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/tx/:hash")
+	c.SetParamNames("hash")
+	c.SetParamValues(testTxHash.Hex())
+
+	txWithABI := testTxWithToAddress
+	txWithABI.Input = pkgTypes.MustDecodeHex(
+		"a9059cbb" +
+			"000000000000000000000000dead000000000000000000000000000000000000" +
+			"00000000000000000000000000000000000000000000000000000000000003e8",
+	)
+	txWithABI.ToContractABI = []byte(`[{"inputs":[{"name":"newImplementation","type":"address"}],"name":"upgradeTo","outputs":[],"stateMutability":"nonpayable","type":"function"}]`)
+	txWithABI.ToImplementationABI = []byte(`[{"inputs":[{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],"name":"transfer","outputs":[{"name":"","type":"bool"}],"stateMutability":"nonpayable","type":"function"}]`)
+
+	s.tx.EXPECT().
+		ByHash(gomock.Any(), testTxHash, true).
+		Return(txWithABI, nil).
+		Times(1)
+
+	s.accessList.EXPECT().
+		ByTxId(gomock.Any(), txWithABI.Id).
+		Return([]storage.TxAccessList{}, nil).
+		Times(1)
+
+	s.state.EXPECT().
+		ByName(gomock.Any(), testIndexerName).
+		Return(storage.State{FinalizedHeight: 1000}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.Get(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var tx responses.Transaction
+	err := json.NewDecoder(rec.Body).Decode(&tx)
+	s.Require().NoError(err)
+	s.Require().NotNil(tx.Decoded)
+	s.Require().Equal("transfer", tx.Decoded.Method)
+	s.Require().Equal("1000", tx.Decoded.Args["amount"])
+}
+
+// TestGetWithDecodeSignatures tests that input of unverified contract is decoded with the signature registry
+func (s *TxHandlerTestSuite) TestGetWithDecodeSignatures() {
+	q := make(url.Values)
+	q.Set("decode", "true")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/tx/:hash")
+	c.SetParamNames("hash")
+	c.SetParamValues(testTxHash.Hex())
+
+	txWithSignatures := testTxWithToAddress
+	txWithSignatures.Input = pkgTypes.MustDecodeHex(
+		"a9059cbb" +
+			"000000000000000000000000dead000000000000000000000000000000000000" +
+			"00000000000000000000000000000000000000000000000000000000000003e8",
+	)
+	txWithSignatures.ToSignatures = []byte(`[{"type":"function","name":"transfer","inputs":[{"name":"arg0","type":"address"},{"name":"arg1","type":"uint256"}]},{"type":"function","name":"many_msg_babbage","inputs":[{"name":"arg0","type":"bytes1"}]}]`)
+
+	s.tx.EXPECT().
+		ByHash(gomock.Any(), testTxHash, true).
+		Return(txWithSignatures, nil).
+		Times(1)
+
+	s.accessList.EXPECT().
+		ByTxId(gomock.Any(), txWithSignatures.Id).
+		Return([]storage.TxAccessList{}, nil).
+		Times(1)
+
+	s.state.EXPECT().
+		ByName(gomock.Any(), testIndexerName).
+		Return(storage.State{FinalizedHeight: 1000}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.Get(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var tx responses.Transaction
+	err := json.NewDecoder(rec.Body).Decode(&tx)
+	s.Require().NoError(err)
+	s.Require().NotNil(tx.Decoded)
+	s.Require().Equal("transfer", tx.Decoded.Method)
+	s.Require().Equal("transfer(address,uint256)", tx.Decoded.Signature)
+	s.Require().Equal([]string{"transfer(address,uint256)", "many_msg_babbage(bytes1)"}, tx.Decoded.Candidates)
+	s.Require().Equal("1000", tx.Decoded.Args["arg1"])
+}
+
 // TestGetWithDecodeNoABI tests that decode returns nil when no ABI is available
 func (s *TxHandlerTestSuite) TestGetWithDecodeNoABI() {
 	q := make(url.Values)
@@ -1462,6 +1785,16 @@ func (s *TxHandlerTestSuite) TestGetWithDecodeNoABI() {
 		Return(testTxWithToAddress, nil).
 		Times(1)
 
+	s.accessList.EXPECT().
+		ByTxId(gomock.Any(), testTxWithToAddress.Id).
+		Return([]storage.TxAccessList{}, nil).
+		Times(1)
+
+	s.state.EXPECT().
+		ByName(gomock.Any(), testIndexerName).
+		Return(storage.State{FinalizedHeight: 1000}, nil).
+		Times(1)
+
 	s.Require().NoError(s.handler.Get(c))
 	s.Require().Equal(http.StatusOK, rec.Code)
 
@@ -5,12 +5,16 @@ import (
 	"regexp"
 
 	"github.com/NobleScope/noble-indexer/internal/storage/types"
+	"github.com/NobleScope/noble-indexer/pkg/contract_verifier/standard_json"
 	"github.com/go-playground/validator/v10"
 	"github.com/labstack/echo/v4"
 )
 
 var evmAddressRegex = regexp.MustCompile(`^(0x)?[0-9a-fA-F]{40}$`)
 var evmTransactionHashRegex = regexp.MustCompile(`^(0x)?[0-9a-fA-F]{64}$`)
+var evmTopicRegex = regexp.MustCompile(`^(0x)?[0-9a-fA-F]{64}$`)
+var evmSelectorRegex = regexp.MustCompile(`^(0x)?([0-9a-fA-F]{8}|[0-9a-fA-F]{64})$`)
+var eventArgRegex = regexp.MustCompile(`^[a-zA-Z_$][a-zA-Z0-9_$]*:.+$`)
 
 type ApiValidator struct {
 	validator *validator.Validate
@@ -24,6 +28,9 @@ func NewApiValidator() *ApiValidator {
 	if err := v.RegisterValidation("tx_hash", txHashValidator()); err != nil {
 		panic(err)
 	}
+	if err := v.RegisterValidation("topic", topicValidator()); err != nil {
+		panic(err)
+	}
 	if err := v.RegisterValidation("trace_type", traceTypeValidator()); err != nil {
 		panic(err)
 	}
@@ -48,6 +55,9 @@ func NewApiValidator() *ApiValidator {
 	if err := v.RegisterValidation("contract_name", contractNameValidator()); err != nil {
 		panic(err)
 	}
+	if err := v.RegisterValidation("fully_qualified_name", fullyQualifiedNameValidator()); err != nil {
+		panic(err)
+	}
 	if err := v.RegisterValidation("compiler_version", compilerVersionValidator()); err != nil {
 		panic(err)
 	}
@@ -57,9 +67,27 @@ func NewApiValidator() *ApiValidator {
 	if err := v.RegisterValidation("evm_version", evmVersionValidator()); err != nil {
 		panic(err)
 	}
+	if err := v.RegisterValidation("language", languageValidator()); err != nil {
+		panic(err)
+	}
 	if err := v.RegisterValidation("call_type", callTypeValidator()); err != nil {
 		panic(err)
 	}
+	if err := v.RegisterValidation("approval_type", approvalTypeValidator()); err != nil {
+		panic(err)
+	}
+	if err := v.RegisterValidation("proxy_upgrade_type", proxyUpgradeTypeValidator()); err != nil {
+		panic(err)
+	}
+	if err := v.RegisterValidation("selector", selectorValidator()); err != nil {
+		panic(err)
+	}
+	if err := v.RegisterValidation("event_arg", eventArgValidator()); err != nil {
+		panic(err)
+	}
+	if err := v.RegisterValidation("finality", finalityValidator()); err != nil {
+		panic(err)
+	}
 	return &ApiValidator{validator: v}
 }
 
@@ -84,6 +112,12 @@ func txHashValidator() validator.Func {
 	}
 }
 
+func topicValidator() validator.Func {
+	return func(fl validator.FieldLevel) bool {
+		return evmTopicRegex.MatchString(fl.Field().String())
+	}
+}
+
 func traceTypeValidator() validator.Func {
 	return func(fl validator.FieldLevel) bool {
 		_, err := types.ParseTraceType(fl.Field().String())
@@ -145,6 +179,13 @@ func contractNameValidator() validator.Func {
 	}
 }
 
+func fullyQualifiedNameValidator() validator.Func {
+	return func(fl validator.FieldLevel) bool {
+		_, _, err := standard_json.SplitContractName(fl.Field().String())
+		return err == nil
+	}
+}
+
 func compilerVersionValidator() validator.Func {
 	return func(fl validator.FieldLevel) bool {
 		return types.CompilerVersionRe.MatchString(fl.Field().String())
@@ -164,3 +205,43 @@ func evmVersionValidator() validator.Func {
 		return err == nil
 	}
 }
+
+func languageValidator() validator.Func {
+	return func(fl validator.FieldLevel) bool {
+		_, err := types.ParseLanguage(fl.Field().String())
+		return err == nil
+	}
+}
+
+func finalityValidator() validator.Func {
+	return func(fl validator.FieldLevel) bool {
+		_, err := types.ParseFinality(fl.Field().String())
+		return err == nil
+	}
+}
+
+func approvalTypeValidator() validator.Func {
+	return func(fl validator.FieldLevel) bool {
+		_, err := types.ParseApprovalType(fl.Field().String())
+		return err == nil
+	}
+}
+
+func proxyUpgradeTypeValidator() validator.Func {
+	return func(fl validator.FieldLevel) bool {
+		_, err := types.ParseProxyUpgradeType(fl.Field().String())
+		return err == nil
+	}
+}
+
+func selectorValidator() validator.Func {
+	return func(fl validator.FieldLevel) bool {
+		return evmSelectorRegex.MatchString(fl.Field().String())
+	}
+}
+
+func eventArgValidator() validator.Func {
+	return func(fl validator.FieldLevel) bool {
+		return eventArgRegex.MatchString(fl.Field().String())
+	}
+}
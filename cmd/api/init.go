@@ -2,19 +2,23 @@ package main
 
 import (
 	"context"
+	"os"
 	"time"
 
 	"github.com/NobleScope/noble-indexer/cmd/api/bus"
 	apiCache "github.com/NobleScope/noble-indexer/cmd/api/cache"
 	"github.com/NobleScope/noble-indexer/cmd/api/handler"
+	"github.com/NobleScope/noble-indexer/cmd/api/handler/jsonrpc"
 	"github.com/NobleScope/noble-indexer/cmd/api/handler/websocket"
 	"github.com/NobleScope/noble-indexer/internal/cache"
 	"github.com/NobleScope/noble-indexer/internal/storage"
 	"github.com/NobleScope/noble-indexer/internal/storage/postgres"
+	"github.com/NobleScope/noble-indexer/pkg/contract_verifier/compilers"
 	"github.com/NobleScope/noble-indexer/pkg/indexer/config"
 	golibCfg "github.com/dipdup-net/go-lib/config"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
+	"github.com/pkg/errors"
 	"github.com/rs/zerolog/log"
 	echoSwagger "github.com/swaggo/echo-swagger"
 	"golang.org/x/time/rate"
@@ -66,7 +70,7 @@ func initHandlers(ctx context.Context, e *echo.Echo, cfg config.Config, db postg
 			heightGroup.GET("/transactions", blockHandlers.TransactionsList, defaultMiddlewareCache)
 		}
 	}
-	txHandlers := handler.NewTxHandler(db.Tx, db.Trace, db.Addresses, cfg.Indexer.Name)
+	txHandlers := handler.NewTxHandler(db.Tx, db.Trace, db.Addresses, db.TxAccessList, db.TxAuthorization, db.State, cfg.Indexer.Name)
 	txGroup := v1.Group("/txs")
 	{
 		txGroup.GET("", txHandlers.List)
@@ -75,20 +79,24 @@ func initHandlers(ctx context.Context, e *echo.Echo, cfg config.Config, db postg
 	}
 	v1.GET("/traces", txHandlers.Traces)
 
-	logHandlers := handler.NewLogHandler(db.Logs, db.Tx, db.Addresses)
+	logHandlers := handler.NewLogHandler(db.Logs, db.Tx, db.Addresses, db.State, cfg.Indexer.Name)
 	v1.GET("/logs", logHandlers.List)
 
-	addressHandlers := handler.NewAddressHandler(db.Addresses)
+	addressHandlers := handler.NewAddressHandler(db.Addresses, db.BalanceHistory, db.Allowance, db.Delegation, db.State, cfg.Indexer.Name)
 	addressesGroup := v1.Group("/addresses")
 	{
 		addressesGroup.GET("", addressHandlers.List)
 		addressGroup := addressesGroup.Group("/:hash")
 		{
 			addressGroup.GET("", addressHandlers.Get)
+			addressGroup.GET("/balance_history", addressHandlers.BalanceHistory)
+			addressGroup.GET("/approvals", addressHandlers.Approvals)
 		}
 	}
 
-	contractHandlers := handler.NewContractHandler(db.Contracts, db.Addresses, db.Tx, db.Sources)
+	contractHandlers := handler.NewContractHandler(db.Contracts, db.Addresses, db.Tx, db.Sources, db.ProxyUpgrades)
+	compilerRegistry, vyperRegistry, compilersEnabled := initCompilers(cfg)
+	contractVerificationHandler := handler.NewContractVerificationHandler(db.Contracts, db.VerificationTasks, db.VerificationFiles, db.Transactable, compilerRegistry, vyperRegistry)
 	contractsGroup := v1.Group("/contracts")
 	{
 		contractsGroup.GET("", contractHandlers.List)
@@ -97,10 +105,12 @@ func initHandlers(ctx context.Context, e *echo.Echo, cfg config.Config, db postg
 			hashGroup.GET("", contractHandlers.Get)
 			hashGroup.GET("/sources", contractHandlers.ContractSources, defaultMiddlewareCache)
 			hashGroup.GET("/code", contractHandlers.GetCode, defaultMiddlewareCache)
+			hashGroup.GET("/implementations", contractHandlers.Implementations)
+			hashGroup.GET("/verification", contractVerificationHandler.ContractVerification)
 		}
 	}
 
-	tokenHandlers := handler.NewTokenHandler(db.Token, db.Transfer, db.TokenBalance, db.Addresses, db.Tx)
+	tokenHandlers := handler.NewTokenHandler(db.Token, db.Transfer, db.TokenBalance, db.TokenBalanceHistory, db.Addresses, db.Tx, db.State, cfg.Indexer.Name)
 	tokensGroup := v1.Group("/tokens")
 	{
 		tokensGroup.GET("", tokenHandlers.List)
@@ -112,6 +122,7 @@ func initHandlers(ctx context.Context, e *echo.Echo, cfg config.Config, db postg
 		tokenTransfersGroup.GET("/:id", tokenHandlers.GetTransfer)
 	}
 	v1.GET("/token_balances", tokenHandlers.TokenBalanceList)
+	v1.GET("/token_balances/history", tokenHandlers.TokenBalanceHistory)
 
 	userOpHandlers := handler.NewUserOpHandler(db.ERC4337UserOps, db.Tx, db.Addresses)
 	userOpsGroup := v1.Group("/user_ops")
@@ -129,29 +140,56 @@ func initHandlers(ctx context.Context, e *echo.Echo, cfg config.Config, db postg
 		proxyGroup.GET("", proxyHandlers.List)
 	}
 
+	blobHandlers := handler.NewBlobHandler(db.Blob, db.Addresses)
+	v1.GET("/blobs", blobHandlers.List)
+
+	signatureHandlers := handler.NewSignatureHandler(db.Signatures)
+	v1.GET("/signatures", signatureHandlers.List)
+
+	delegationHandlers := handler.NewDelegationHandler(db.Delegation, db.TxAuthorization, db.Addresses)
+	delegationGroup := v1.Group("/delegations")
+	{
+		delegationGroup.GET("", delegationHandlers.List)
+		delegationGroup.GET("/:hash/history", delegationHandlers.History)
+	}
+
 	statsHandler := handler.NewStatsHandler(db.State, db.BlockStats, cfg.Indexer.Name)
 	statsGroup := v1.Group("/stats")
 	{
 		statsGroup.GET("/block_time", statsHandler.AvgBlockTime, defaultMiddlewareCache)
+		statsGroup.GET("/blob_fee", statsHandler.BlobFee, defaultMiddlewareCache)
 	}
 
-	beaconWithdrawalHandler := handler.NewBeaconWithdrawalHandler(db.BeaconWithdrawal, db.Addresses)
+	beaconWithdrawalHandler := handler.NewBeaconWithdrawalHandler(db.BeaconWithdrawal, db.Addresses, db.State, cfg.Indexer.Name)
 	beaconWithdrawalsGroup := v1.Group("/beacon_withdrawals")
 	{
 		beaconWithdrawalsGroup.GET("", beaconWithdrawalHandler.List)
 	}
 
-	contractVerificationHandler := handler.NewContractVerificationHandler(db.Contracts, db.VerificationTasks, db.VerificationFiles, db.Transactable)
-	verificationGroup := v1.Group("/verification/code")
+	verificationGroup := v1.Group("/verification")
 	{
 		verificationRateLimit := middleware.RateLimiter(middleware.NewRateLimiterMemoryStore(rate.Limit(1)))
-		verificationGroup.POST("", contractVerificationHandler.ContractVerify, verificationRateLimit)
+		verificationGroup.POST("/code", contractVerificationHandler.ContractVerify, verificationRateLimit)
+		verificationGroup.GET("/tasks/:id", contractVerificationHandler.Task)
+		if compilersEnabled {
+			verificationGroup.GET("/compilers", contractVerificationHandler.Compilers)
+		}
 	}
 
 	if cfg.API.Websocket {
 		initWebsocket(ctx, v1)
 	}
 
+	if cfg.API.JsonRpc.Enabled {
+		rpcServer := jsonrpc.NewServer(
+			db.Blocks, db.Tx, db.Logs, db.Addresses, db.Contracts, db.State, cfg.Indexer.Name,
+			jsonrpc.WithMaxBlockRange(cfg.API.JsonRpc.MaxBlockRange),
+			jsonrpc.WithMaxLogs(cfg.API.JsonRpc.MaxLogs),
+			jsonrpc.WithMaxBatchSize(cfg.API.JsonRpc.MaxBatchSize),
+		)
+		e.POST("/rpc", rpcServer.Handle)
+	}
+
 	log.Info().Msg("API routes:")
 	for _, route := range e.Routes() {
 		log.Info().Msgf("[%s] %s -> %s", route.Method, route.Path, route.Name)
@@ -168,3 +206,33 @@ func initWebsocket(ctx context.Context, group *echo.Group) {
 	wsManager.Start(ctx)
 	group.GET("/ws", wsManager.Handle)
 }
+
+// initCompilers loads registries of solc and vyper compilers of the verifier. The compilers endpoint is disabled
+// if a registry can't be loaded, since the API can't check versions of verification requests then.
+func initCompilers(cfg config.Config) (*compilers.Registry, *compilers.Registry, bool) {
+	var solc *compilers.Registry
+	if cfg.ContractVerifier.CompilersDir != "" {
+		registry, err := compilers.NewRegistry(cfg.ContractVerifier.CompilersDir)
+		if err != nil {
+			log.Err(err).Str("dir", cfg.ContractVerifier.CompilersDir).Msg("load solc registry, compilers endpoint is disabled")
+			return nil, nil, false
+		}
+		solc = registry
+	}
+
+	vyperDir := cfg.ContractVerifier.VyperDir
+	if vyperDir == "" {
+		vyperDir = ".vyper"
+	}
+	// the build list of vyper is optional: vyper versions aren't checked without it
+	vyper, err := compilers.NewRegistry(vyperDir)
+	switch {
+	case err == nil:
+		return solc, vyper, true
+	case errors.Is(err, os.ErrNotExist):
+		return solc, nil, true
+	default:
+		log.Err(err).Str("dir", vyperDir).Msg("load vyper registry, compilers endpoint is disabled")
+		return solc, nil, false
+	}
+}
@@ -43,7 +43,11 @@ func main() {
 		return
 	}
 
-	verifier := contract_verifier.NewModule(pg, *cfg)
+	verifier, err := contract_verifier.NewModule(pg, *cfg)
+	if err != nil {
+		log.Panic().Err(err).Msg("can't create contract verifier")
+		return
+	}
 	verifier.Start(ctx)
 
 	<-notifyCtx.Done()
@@ -0,0 +1,56 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/NobleScope/noble-indexer/internal/storage/types"
+	pkgTypes "github.com/NobleScope/noble-indexer/pkg/types"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/shopspring/decimal"
+	"github.com/uptrace/bun"
+)
+
+type AllowanceListFilter struct {
+	Limit          int
+	Offset         int
+	Sort           storage.SortOrder
+	OwnerId        uint64
+	SpenderId      *uint64
+	ContractId     *uint64
+	Type           []types.ApprovalType
+	IncludeRevoked bool
+	CursorID       uint64
+}
+
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
+type IAllowance interface {
+	storage.Table[*Allowance]
+
+	Filter(ctx context.Context, filter AllowanceListFilter) ([]Allowance, error)
+}
+
+// Allowance - current state of the approval given by owner to spender
+type Allowance struct {
+	bun.BaseModel `bun:"allowance" comment:"Table with current token approvals"`
+
+	Id         uint64             `bun:",pk,autoincrement"                          comment:"Unique internal identity"`
+	Height     pkgTypes.Level     `bun:"height"                                     comment:"Block height of the first approval"`
+	LastHeight pkgTypes.Level     `bun:"last_height"                                comment:"Block height of the last approval change"`
+	Type       types.ApprovalType `bun:",type:approval_type,unique:allowance_idx"   comment:"Approval type"`
+	ContractId uint64             `bun:"contract_id,unique:allowance_idx"           comment:"Contract address id"`
+	OwnerId    uint64             `bun:"owner_id,unique:allowance_idx"              comment:"Token owner address id"`
+	SpenderId  uint64             `bun:"spender_id,unique:allowance_idx"            comment:"Approved spender (operator) address id"`
+	TokenID    decimal.Decimal    `bun:"token_id,type:numeric,unique:allowance_idx" comment:"Token ID"`
+	Amount     decimal.Decimal    `bun:"amount,type:numeric"                        comment:"Approved amount"`
+	Approved   bool               `bun:"approved"                                   comment:"Spender is approved"`
+
+	Contract Contract `bun:"rel:belongs-to,join:contract_id=id"`
+	Owner    Address  `bun:"rel:belongs-to,join:owner_id=id"`
+	Spender  Address  `bun:"rel:belongs-to,join:spender_id=id"`
+	Token    *Token   `bun:"rel:belongs-to,join:token_id=token_id,join:contract_id=contract_id"`
+}
+
+// TableName -
+func (Allowance) TableName() string {
+	return "allowance"
+}
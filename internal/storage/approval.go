@@ -0,0 +1,37 @@
+package storage
+
+import (
+	"time"
+
+	"github.com/NobleScope/noble-indexer/internal/storage/types"
+	pkgTypes "github.com/NobleScope/noble-indexer/pkg/types"
+	"github.com/shopspring/decimal"
+	"github.com/uptrace/bun"
+)
+
+// Approval - Approval or ApprovalForAll event emitted by token contract
+type Approval struct {
+	bun.BaseModel `bun:"approval" comment:"Table with token approval events"`
+
+	Id         uint64             `bun:",pk,autoincrement"     comment:"Unique internal identity"`
+	Height     pkgTypes.Level     `bun:"height"                comment:"Block height"`
+	Time       time.Time          `bun:"time,pk,notnull"       comment:"Time of block"`
+	Type       types.ApprovalType `bun:",type:approval_type"   comment:"Approval type"`
+	ContractId uint64             `bun:"contract_id"           comment:"Contract address id"`
+	OwnerId    uint64             `bun:"owner_id"              comment:"Token owner address id"`
+	SpenderId  uint64             `bun:"spender_id"            comment:"Approved spender (operator) address id"`
+	TokenID    decimal.Decimal    `bun:"token_id,type:numeric" comment:"Token ID"`
+	Amount     decimal.Decimal    `bun:"amount,type:numeric"   comment:"Approved amount"`
+	Approved   bool               `bun:"approved"              comment:"Spender is approved after the event"`
+	TxID       uint64             `bun:"tx_id"                 comment:"Transaction id"`
+
+	Contract Contract `bun:"rel:belongs-to,join:contract_id=id"`
+	Owner    Address  `bun:"rel:belongs-to,join:owner_id=id"`
+	Spender  Address  `bun:"rel:belongs-to,join:spender_id=id"`
+	Tx       Tx       `bun:"rel:belongs-to,join:tx_id=id"`
+}
+
+// TableName -
+func (Approval) TableName() string {
+	return "approval"
+}
@@ -0,0 +1,75 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	pkgTypes "github.com/NobleScope/noble-indexer/pkg/types"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/shopspring/decimal"
+	"github.com/uptrace/bun"
+)
+
+type Timeframe string
+
+const (
+	TimeframeHour  Timeframe = "hour"
+	TimeframeDay   Timeframe = "day"
+	TimeframeWeek  Timeframe = "week"
+	TimeframeMonth Timeframe = "month"
+)
+
+type BalanceHistoryListFilter struct {
+	Limit      int
+	Offset     int
+	Sort       storage.SortOrder
+	AddressId  uint64
+	TimeFrom   time.Time
+	TimeTo     time.Time
+	MaxHeight  *uint64
+	CursorTime time.Time
+	CursorID   uint64
+}
+
+type BalanceSeriesFilter struct {
+	AddressId uint64
+	Timeframe Timeframe
+	TimeFrom  time.Time
+	TimeTo    time.Time
+	MaxHeight *uint64
+	Limit     int
+}
+
+// BalanceSeriesItem - balance at the end of the time bucket
+type BalanceSeriesItem struct {
+	Time  time.Time       `bun:"bucket"`
+	Value decimal.Decimal `bun:"value"`
+}
+
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
+type IBalanceHistory interface {
+	storage.Table[*BalanceHistory]
+
+	Filter(ctx context.Context, filter BalanceHistoryListFilter) ([]BalanceHistory, error)
+	AtHeight(ctx context.Context, addressId uint64, height pkgTypes.Level) (BalanceHistory, error)
+	AtTime(ctx context.Context, addressId uint64, t time.Time) (BalanceHistory, error)
+	Series(ctx context.Context, filter BalanceSeriesFilter) ([]BalanceSeriesItem, error)
+}
+
+// BalanceHistory - snapshot of the native balance after the block changing it
+type BalanceHistory struct {
+	bun.BaseModel `bun:"balance_history" comment:"Table with historical snapshots of account balances."`
+
+	Id        uint64          `bun:",pk,notnull,autoincrement" comment:"Unique internal identity"`
+	Height    pkgTypes.Level  `bun:"height,notnull"            comment:"The number (height) of block"`
+	Time      time.Time       `bun:"time,pk,notnull"           comment:"The time of block"`
+	AddressId uint64          `bun:"address_id,notnull"        comment:"Address ID"`
+	Value     decimal.Decimal `bun:"value,type:numeric"        comment:"Balance value after the block"`
+	Delta     decimal.Decimal `bun:"delta,type:numeric"        comment:"Balance change in the block"`
+
+	Address Address `bun:"rel:belongs-to,join:address_id=id"`
+}
+
+func (BalanceHistory) TableName() string {
+	return "balance_history"
+}
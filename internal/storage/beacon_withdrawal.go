@@ -16,6 +16,7 @@ type BeaconWithdrawalListFilter struct {
 	Sort       storage.SortOrder
 	Height     *pkgTypes.Level
 	AddressId  *uint64
+	MaxHeight  *uint64
 	CursorTime time.Time
 	CursorID   uint64
 }
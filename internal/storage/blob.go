@@ -0,0 +1,47 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	pkgTypes "github.com/NobleScope/noble-indexer/pkg/types"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/uptrace/bun"
+)
+
+type BlobListFilter struct {
+	Limit    int
+	Offset   int
+	Sort     storage.SortOrder
+	Height   *uint64
+	SenderId *uint64
+	CursorID uint64
+}
+
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
+type IBlob interface {
+	storage.Table[*Blob]
+
+	Filter(ctx context.Context, filter BlobListFilter) ([]Blob, error)
+}
+
+// Blob - EIP-4844 blob referenced by the blob transaction
+type Blob struct {
+	bun.BaseModel `bun:"blob" comment:"Table with EIP-4844 blobs"`
+
+	Id            uint64         `bun:"id,pk,notnull,autoincrement" comment:"Unique internal identity"`
+	Height        pkgTypes.Level `bun:"height"                      comment:"Block height"`
+	Time          time.Time      `bun:"time,pk,notnull"             comment:"Block time"`
+	TxId          uint64         `bun:"tx_id"                       comment:"Transaction id"`
+	SenderId      uint64         `bun:"sender_id"                   comment:"Transaction sender address id"`
+	Index         int64          `bun:"index"                       comment:"Blob index in the transaction"`
+	VersionedHash pkgTypes.Hex   `bun:"versioned_hash,type:bytea"   comment:"Blob versioned hash"`
+
+	Tx     Tx      `bun:"rel:belongs-to,join:tx_id=id"`
+	Sender Address `bun:"rel:belongs-to,join:sender_id=id"`
+}
+
+// TableName -
+func (Blob) TableName() string {
+	return "blob"
+}
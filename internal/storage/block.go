@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/NobleScope/noble-indexer/internal/storage/types"
 	pkgTypes "github.com/NobleScope/noble-indexer/pkg/types"
 	"github.com/dipdup-net/indexer-sdk/pkg/storage"
 	"github.com/shopspring/decimal"
@@ -15,6 +16,7 @@ type BlockListFilter struct {
 	Offset     int
 	Sort       storage.SortOrder
 	WithStats  bool
+	MaxHeight  *uint64
 	CursorTime time.Time
 	CursorID   uint64
 }
@@ -24,7 +26,9 @@ type IBlock interface {
 	storage.Table[*Block]
 
 	Last(ctx context.Context) (Block, error)
+	LastInRange(ctx context.Context, start, end pkgTypes.Level) (Block, error)
 	ByHeight(ctx context.Context, height pkgTypes.Level, withStats bool) (Block, error)
+	ByHash(ctx context.Context, hash pkgTypes.Hex) (Block, error)
 	Filter(ctx context.Context, filters BlockListFilter) ([]Block, error)
 }
 
@@ -32,13 +36,18 @@ type IBlock interface {
 type Block struct {
 	bun.BaseModel `bun:"block" comment:"Table with blocks."`
 
-	Id            uint64          `bun:",pk,notnull,autoincrement"     comment:"Unique internal identity"`
-	Height        pkgTypes.Level  `bun:"height"                        comment:"The number (height) of this block"`
-	Time          time.Time       `bun:"time,pk,notnull"               comment:"The time of block"`
-	GasLimit      decimal.Decimal `bun:"gas_limit,type:numeric"        comment:"Gas limit"`
-	GasUsed       decimal.Decimal `bun:"gas_used,type:numeric"         comment:"Gas used"`
-	BaseFeePerGas uint64          `bun:"base_fee_per_gas,type:numeric" comment:"Fee per gas"`
-	MinerId       uint64          `bun:"miner_id"                      comment:"Miner address id"`
+	Id            uint64          `bun:",pk,notnull,autoincrement"                       comment:"Unique internal identity"`
+	Height        pkgTypes.Level  `bun:"height"                                          comment:"The number (height) of this block"`
+	Time          time.Time       `bun:"time,pk,notnull"                                 comment:"The time of block"`
+	GasLimit      decimal.Decimal `bun:"gas_limit,type:numeric"                          comment:"Gas limit"`
+	GasUsed       decimal.Decimal `bun:"gas_used,type:numeric"                           comment:"Gas used"`
+	BaseFeePerGas uint64          `bun:"base_fee_per_gas,type:numeric"                   comment:"Fee per gas"`
+	MinerId       uint64          `bun:"miner_id"                                        comment:"Miner address id"`
+	Finality      types.Finality  `bun:"finality,type:finality,notnull,default:'latest'" comment:"Finality of the block: latest, safe or finalized"`
+
+	BlobGasUsed   *decimal.Decimal `bun:"blob_gas_used,type:numeric"   comment:"Total blob gas used by the transactions in the block (EIP-4844)"`
+	ExcessBlobGas *decimal.Decimal `bun:"excess_blob_gas,type:numeric" comment:"Excess blob gas (EIP-4844)"`
+	BlobGasPrice  *decimal.Decimal `bun:"blob_gas_price,type:numeric"  comment:"Blob gas price (EIP-4844)"`
 
 	DifficultyHash       pkgTypes.Hex `bun:"difficulty_hash,type:bytea"        comment:"Difficulty hash"`
 	ExtraDataHash        pkgTypes.Hex `bun:"extra_data_hash,type:bytea"        comment:"Extra data hash"`
@@ -6,6 +6,7 @@ import (
 
 	pkgTypes "github.com/NobleScope/noble-indexer/pkg/types"
 	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/shopspring/decimal"
 	"github.com/uptrace/bun"
 )
 
@@ -15,6 +16,7 @@ type IBlockStats interface {
 
 	ByHeight(ctx context.Context, height pkgTypes.Level) (BlockStats, error)
 	AvgBlockTime(ctx context.Context, from time.Time) (float64, error)
+	BlobStats(ctx context.Context, from time.Time) (BlobStats, error)
 }
 
 type BlockStats struct {
@@ -25,8 +27,19 @@ type BlockStats struct {
 	Time      time.Time      `bun:"time,pk,notnull"           comment:"The time of block"`
 	TxCount   int64          `bun:"tx_count"                  comment:"Count of transactions in block"`
 	BlockTime uint64         `bun:"block_time"                comment:"Time in milliseconds between current and previous block"`
+
+	BlobsCount  int64           `bun:"blobs_count,notnull,default:0"                 comment:"Count of blobs in block (EIP-4844)"`
+	BlobGasUsed decimal.Decimal `bun:"blob_gas_used,type:numeric,notnull,default:0" comment:"Blob gas used in block (EIP-4844)"`
+	BlobFee     decimal.Decimal `bun:"blob_fee,type:numeric,notnull,default:0"      comment:"Burned blob fee in Wei (EIP-4844)"`
 }
 
 func (BlockStats) TableName() string {
 	return "block_stats"
 }
+
+// BlobStats - aggregated EIP-4844 blob statistics
+type BlobStats struct {
+	BlobsCount  int64           `bun:"blobs_count"`
+	BlobGasUsed decimal.Decimal `bun:"blob_gas_used"`
+	BlobFee     decimal.Decimal `bun:"blob_fee"`
+}
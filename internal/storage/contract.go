@@ -30,28 +30,37 @@ type IContract interface {
 	ListWithTx(ctx context.Context, filters ContractListFilter) ([]Contract, error)
 	PendingMetadata(ctx context.Context, delay time.Duration, limit int) ([]*Contract, error)
 	Code(ctx context.Context, hash pkgTypes.Hex) (pkgTypes.Hex, json.RawMessage, error)
+	ListWithABI(ctx context.Context, cursorId uint64, limit int) ([]Contract, error)
+	SimilarPending(ctx context.Context, limit int) ([]Contract, error)
+	SimilarSources(ctx context.Context, codeHash pkgTypes.Hex) ([]Contract, error)
+	SimilarCandidates(ctx context.Context, codeHash pkgTypes.Hex, afterId uint64, limit int) ([]Contract, error)
 }
 
 // Contract -
 type Contract struct {
 	bun.BaseModel `bun:"contract" comment:"Table with contracts."`
 
-	Id               uint64               `bun:"id,pk,notnull"                    comment:"Unique internal identity"`
-	Height           pkgTypes.Level       `bun:"height"                           comment:"Block number in which the contract was deployed"`
-	Code             pkgTypes.Hex         `bun:"code,type:bytea"                  comment:"Contract code"`
-	Verified         bool                 `bun:"verified,default:false,notnull"   comment:"Verified or not"`
-	TxId             *uint64              `bun:"tx_id"                            comment:"Transaction in which this contract was deployed"`
-	DeployerId       *uint64              `bun:"deployer_id"                      comment:"Deployer account internal identity"`
-	ABI              json.RawMessage      `bun:"abi,type:jsonb,nullzero"          comment:"Contract ABI"`
-	CompilerVersion  string               `bun:"compiler_version,notnull"         comment:"Compiler version"`
-	MetadataLink     string               `bun:"metadata_link"                    comment:"Metadata link"`
-	Language         string               `bun:"language"                         comment:"Language"`
-	OptimizerEnabled bool                 `bun:"optimizer_enabled"                comment:"Optimizer enabled"`
-	Tags             []string             `bun:"tags,array"                       comment:"Implemented interfaces tags"`
-	Status           types.MetadataStatus `bun:",type:metadata_status,nullzero"   comment:"Contract metadata status"`
-	RetryCount       uint64               `bun:"retry_count"                      comment:"Retry count to resolve metadata"`
-	Error            string               `bun:"error"                            comment:"Error"`
-	UpdatedAt        time.Time            `bun:"updated_at,notnull,default:now()" comment:"Last update time"`
+	Id               uint64               `bun:"id,pk,notnull"                         comment:"Unique internal identity"`
+	Height           pkgTypes.Level       `bun:"height"                                comment:"Block number in which the contract was deployed"`
+	Code             pkgTypes.Hex         `bun:"code,type:bytea"                       comment:"Contract code"`
+	Verified         bool                 `bun:"verified,default:false,notnull"        comment:"Verified or not"`
+	TxId             *uint64              `bun:"tx_id"                                 comment:"Transaction in which this contract was deployed"`
+	DeployerId       *uint64              `bun:"deployer_id"                           comment:"Deployer account internal identity"`
+	ABI              json.RawMessage      `bun:"abi,type:jsonb,nullzero"               comment:"Contract ABI"`
+	CompilerVersion  string               `bun:"compiler_version,notnull"              comment:"Compiler version"`
+	MetadataLink     string               `bun:"metadata_link"                         comment:"Metadata link"`
+	Language         string               `bun:"language"                              comment:"Language"`
+	OptimizerEnabled bool                 `bun:"optimizer_enabled"                     comment:"Optimizer enabled"`
+	Tags             []string             `bun:"tags,array"                            comment:"Implemented interfaces tags"`
+	Status           types.MetadataStatus `bun:",type:metadata_status,nullzero"        comment:"Contract metadata status"`
+	RetryCount       uint64               `bun:"retry_count"                           comment:"Retry count to resolve metadata"`
+	Error            string               `bun:"error"                                 comment:"Error"`
+	UpdatedAt        time.Time            `bun:"updated_at,notnull,default:now()"      comment:"Last update time"`
+	ConstructorArgs  pkgTypes.Hex         `bun:"constructor_args,type:bytea"           comment:"ABI-encoded constructor arguments extracted from the creation code"`
+	MatchType        types.MatchType      `bun:"match_type,type:match_type,nullzero"   comment:"Verification match type"`
+	CodeHash         pkgTypes.Hex         `bun:"code_hash,type:bytea"                  comment:"Hash of the code without metadata and immutable values"`
+	ImmutableRefs    []int64              `bun:"immutable_refs,array"                  comment:"Offsets of immutable values in the code of the verified contract"`
+	SimilarChecked   bool                 `bun:"similar_checked,notnull,default:false" comment:"Contract was processed by the similar match"`
 
 	Address        Address       `bun:"rel:belongs-to,join:id=id"`
 	Tx             *Tx           `bun:"tx,scanonly"`
@@ -0,0 +1,39 @@
+package storage
+
+import "encoding/json"
+
+// nullBytes returns NULL for nil values of bytea columns which are written as empty values by COPY otherwise.
+func nullBytes[T ~[]byte](value T) any {
+	if value == nil {
+		return nil
+	}
+	return []byte(value)
+}
+
+// nullJSON returns the value of jsonb column as text, because COPY writes byte slices in the bytea format.
+func nullJSON[T ~[]byte](value T) any {
+	if value == nil {
+		return nil
+	}
+	return string(value)
+}
+
+// nullString returns NULL for empty strings of nullzero columns.
+func nullString(value string) any {
+	if value == "" {
+		return nil
+	}
+	return value
+}
+
+// marshalSlice encodes the slice to JSON as bun does for slice columns. Nil slices are NULL.
+func marshalSlice[T any](value []T) []byte {
+	if value == nil {
+		return nil
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil
+	}
+	return data
+}
@@ -0,0 +1,45 @@
+package storage
+
+import (
+	"context"
+
+	pkgTypes "github.com/NobleScope/noble-indexer/pkg/types"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/uptrace/bun"
+)
+
+type DelegationListFilter struct {
+	Limit      int
+	Offset     int
+	Sort       storage.SortOrder
+	DelegateId *uint64
+	CursorID   uint64
+}
+
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
+type IDelegation interface {
+	storage.Table[*Delegation]
+
+	ByAuthorityId(ctx context.Context, authorityId uint64) (Delegation, error)
+	Filter(ctx context.Context, filter DelegationListFilter) ([]Delegation, error)
+}
+
+// Delegation - current EIP-7702 delegation of the externally owned account
+type Delegation struct {
+	bun.BaseModel `bun:"delegation" comment:"Table with current EIP-7702 delegations"`
+
+	Id         uint64         `bun:"id,pk,notnull"  comment:"Unique internal identity, match authority address ID"`
+	Height     pkgTypes.Level `bun:"height"         comment:"Block height of the first delegation"`
+	LastHeight pkgTypes.Level `bun:"last_height"    comment:"Block height of the last delegation change"`
+	DelegateId *uint64        `bun:"delegate_id"    comment:"Delegate address id, empty if the delegation is cleared"`
+	TxId       uint64         `bun:"tx_id"          comment:"Transaction id of the last delegation change"`
+
+	Authority Address  `bun:"rel:belongs-to,join:id=id"`
+	Delegate  *Address `bun:"rel:belongs-to,join:delegate_id=id"`
+	Tx        Tx       `bun:"rel:belongs-to,join:tx_id=id"`
+}
+
+// TableName -
+func (Delegation) TableName() string {
+	return "delegation"
+}
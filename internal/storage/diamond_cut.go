@@ -0,0 +1,38 @@
+package storage
+
+import (
+	"time"
+
+	"github.com/NobleScope/noble-indexer/internal/storage/types"
+	pkgTypes "github.com/NobleScope/noble-indexer/pkg/types"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/uptrace/bun"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
+type IDiamondCut interface {
+	storage.Table[*DiamondCut]
+}
+
+// DiamondCut - change of the single function selector made by EIP-2535 DiamondCut event
+type DiamondCut struct {
+	bun.BaseModel `bun:"diamond_cut" comment:"Table with diamond function selectors history"`
+
+	Id        uint64                 `bun:",pk,autoincrement"        comment:"Unique internal identity"`
+	Height    pkgTypes.Level         `bun:"height"                   comment:"Block height"`
+	Time      time.Time              `bun:"time,pk,notnull"          comment:"Time of block"`
+	DiamondId uint64                 `bun:"diamond_id"               comment:"Diamond contract id"`
+	FacetId   *uint64                `bun:"facet_id"                 comment:"Facet contract id, empty for removed selector"`
+	Action    types.DiamondCutAction `bun:",type:diamond_cut_action" comment:"Facet cut action"`
+	Selector  pkgTypes.Hex           `bun:"selector"                 comment:"Function selector"`
+	TxId      uint64                 `bun:"tx_id"                    comment:"Transaction id"`
+
+	Diamond Address  `bun:"rel:belongs-to,join:diamond_id=id"`
+	Facet   *Address `bun:"rel:belongs-to,join:facet_id=id"`
+	Tx      Tx       `bun:"rel:belongs-to,join:tx_id=id"`
+}
+
+// TableName -
+func (DiamondCut) TableName() string {
+	return "diamond_cut"
+}
@@ -0,0 +1,29 @@
+package storage
+
+import (
+	pkgTypes "github.com/NobleScope/noble-indexer/pkg/types"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/uptrace/bun"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
+type IDiamondFacet interface {
+	storage.Table[*DiamondFacet]
+}
+
+// DiamondFacet - current facet of the diamond function selector
+type DiamondFacet struct {
+	bun.BaseModel `bun:"diamond_facet" comment:"Table with diamond function selectors mapped to facets"`
+
+	DiamondId uint64         `bun:"diamond_id,pk" comment:"Diamond contract id"`
+	Selector  pkgTypes.Hex   `bun:"selector,pk"   comment:"Function selector"`
+	FacetId   uint64         `bun:"facet_id"      comment:"Facet contract id"`
+	Height    pkgTypes.Level `bun:"height"        comment:"Block height of the last selector change"`
+
+	Facet Address `bun:"rel:belongs-to,join:facet_id=id"`
+}
+
+// TableName -
+func (DiamondFacet) TableName() string {
+	return "diamond_facet"
+}
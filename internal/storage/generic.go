@@ -3,7 +3,9 @@ package storage
 import (
 	"context"
 	"io"
+	"time"
 
+	storageTypes "github.com/NobleScope/noble-indexer/internal/storage/types"
 	"github.com/NobleScope/noble-indexer/pkg/types"
 	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
 	"github.com/lib/pq"
@@ -16,6 +18,7 @@ var Models = []any{
 	&Transfer{},
 	&Token{},
 	&TokenBalance{},
+	&TokenBalanceHistory{},
 	&Log{},
 	&Address{},
 	&Contract{},
@@ -23,11 +26,22 @@ var Models = []any{
 	&Source{},
 	&Trace{},
 	&Balance{},
+	&BalanceHistory{},
 	&State{},
 	&VerificationTask{},
 	&VerificationFile{},
 	&ERC4337UserOp{},
 	&BeaconWithdrawal{},
+	&Approval{},
+	&Allowance{},
+	&TxAccessList{},
+	&TxAuthorization{},
+	&Delegation{},
+	&Blob{},
+	&ProxyUpgrade{},
+	&DiamondCut{},
+	&DiamondFacet{},
+	&Signature{},
 }
 
 //go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
@@ -36,19 +50,41 @@ type Transaction interface {
 
 	SaveTransactions(ctx context.Context, txs ...*Tx) error
 	SaveLogs(ctx context.Context, logs ...*Log) error
+	SaveDecodedLogs(ctx context.Context, logs ...*Log) error
+	ResetDecodedLogs(ctx context.Context, contractIds ...uint64) error
+	ResetUnknownLogs(ctx context.Context, signatures ...*Signature) error
+	SaveSimilarChecked(ctx context.Context, contracts ...*Contract) error
+	ResetSimilarChecked(ctx context.Context, contractIds ...uint64) error
+	CopySources(ctx context.Context, sourceContractId uint64, contractIds ...uint64) error
 	SaveTraces(ctx context.Context, traces ...*Trace) error
 	SaveAddresses(ctx context.Context, addresses ...*Address) (int64, error)
-	SaveBalances(ctx context.Context, balances ...*Balance) error
+	SaveBalances(ctx context.Context, balances ...*Balance) ([]Balance, error)
+	SaveBalanceHistory(ctx context.Context, history ...*BalanceHistory) error
 	SaveContracts(ctx context.Context, addresses ...*Contract) (int64, error)
 	SaveTransfers(ctx context.Context, transfers ...*Transfer) error
 	SaveTokens(ctx context.Context, tokens ...*Token) (int64, error)
 	SaveTokenBalances(ctx context.Context, tokenBalances ...*TokenBalance) (tb []TokenBalance, err error)
+	SaveTokenBalanceHistory(ctx context.Context, history ...*TokenBalanceHistory) error
 	SaveTokenMetadata(ctx context.Context, tokens ...*Token) error
 	SaveSources(ctx context.Context, sources ...*Source) error
 	SaveProxyContracts(ctx context.Context, contracts ...*ProxyContract) error
 	SaveERC4337UserOps(ctx context.Context, userOps ...*ERC4337UserOp) error
 	SaveBeaconWithdrawals(ctx context.Context, withdrawals ...*BeaconWithdrawal) error
+	SaveApprovals(ctx context.Context, approvals ...*Approval) error
+	SaveAllowances(ctx context.Context, allowances ...*Allowance) error
+	SaveTxAccessLists(ctx context.Context, accessLists ...*TxAccessList) error
+	SaveTxAuthorizations(ctx context.Context, authorizations ...*TxAuthorization) error
+	SaveDelegations(ctx context.Context, delegations ...*Delegation) error
+	SaveBlobs(ctx context.Context, blobs ...*Blob) error
+	SaveProxyUpgrades(ctx context.Context, upgrades ...*ProxyUpgrade) error
+	UpdateBeaconProxies(ctx context.Context, beaconIds ...uint64) error
+	SaveDiamondCuts(ctx context.Context, cuts ...*DiamondCut) error
+	SaveDiamondFacets(ctx context.Context, facets ...*DiamondFacet) error
+	DeleteDiamondFacets(ctx context.Context, facets ...*DiamondFacet) error
+	SaveSignatures(ctx context.Context, signatures ...*Signature) error
 	UpdateVerificationTask(ctx context.Context, task *VerificationTask) error
+	ClaimVerificationTasks(ctx context.Context, limit int, staleBefore time.Time) ([]VerificationTask, error)
+	IncrementVerifiedContracts(ctx context.Context, indexerName string, count int) error
 	AddVerificationTask(ctx context.Context, task *VerificationTask) error
 	SaveVerificationFiles(ctx context.Context, files ...*VerificationFile) error
 
@@ -59,16 +95,33 @@ type Transaction interface {
 	RollbackTraces(ctx context.Context, height types.Level) (traces []Trace, err error)
 	RollbackLogs(ctx context.Context, height types.Level) error
 	RollbackTransfers(ctx context.Context, height types.Level) (transfers []Transfer, err error)
+	RollbackTokenBalanceHistory(ctx context.Context, height types.Level) error
 	RollbackTokens(ctx context.Context, height types.Level) (tokens []Token, err error)
 	RollbackContracts(ctx context.Context, height types.Level) error
 	RollbackERC4337UserOps(ctx context.Context, height types.Level) error
 	RollbackBeaconWithdrawals(ctx context.Context, height types.Level) error
+	RollbackBalanceHistory(ctx context.Context, height types.Level) error
+	RollbackApprovals(ctx context.Context, height types.Level) error
+	RollbackAllowances(ctx context.Context, height types.Level) error
+	RollbackTxAccessLists(ctx context.Context, height types.Level) error
+	RollbackTxAuthorizations(ctx context.Context, height types.Level) error
+	RollbackDelegations(ctx context.Context, height types.Level) error
+	RollbackBlobs(ctx context.Context, height types.Level) error
+	RollbackProxyUpgrades(ctx context.Context, height types.Level) error
+	RollbackDiamondCuts(ctx context.Context, height types.Level) error
 	DeleteBalances(ctx context.Context, ids []uint64) error
 	DeleteTokenBalances(ctx context.Context, tokenIds []string, contractIds []uint64, zeroBalances []*TokenBalance) error
 	DeleteVerificationFiles(ctx context.Context, taskId uint64) error
 
+	RecalculateBalanceHistory(ctx context.Context, from, to types.Level) error
+	RecalculateTokenBalanceHistory(ctx context.Context, from, to types.Level) error
+	UpdateBlockTime(ctx context.Context, height types.Level) error
+	UpdateBlockFinality(ctx context.Context, finality storageTypes.Finality, from, to types.Level) error
+	DeleteState(ctx context.Context, name string) error
+
 	State(ctx context.Context, name string) (state State, err error)
 	LastBlock(ctx context.Context) (block Block, err error)
+	LastBlockInRange(ctx context.Context, start, end types.Level) (block Block, err error)
 }
 
 //go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
@@ -15,20 +15,43 @@ type LogListFilter struct {
 	Offset     int
 	Sort       storage.SortOrder
 	Height     *uint64
+	MaxHeight  *uint64
 	TxId       *uint64
 	AddressId  *uint64
+	Topic0     []pkgTypes.Hex
+	Topic1     []pkgTypes.Hex
+	Topic2     []pkgTypes.Hex
+	Topic3     []pkgTypes.Hex
 	TimeFrom   time.Time
 	TimeTo     time.Time
+	EventName  string
+	EventArgs  map[string]string
 	WithABI    bool
 	CursorTime time.Time
 	CursorID   uint64
 }
 
+// LogRangeFilter selects logs in the inclusive height range in the eth_getLogs manner.
+// Empty address and topic lists match any value.
+type LogRangeFilter struct {
+	HeightFrom uint64
+	HeightTo   uint64
+	TxId       *uint64
+	AddressIds []uint64
+	Topic0     []pkgTypes.Hex
+	Topic1     []pkgTypes.Hex
+	Topic2     []pkgTypes.Hex
+	Topic3     []pkgTypes.Hex
+	Limit      int
+}
+
 //go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
 type ILog interface {
 	storage.Table[*Log]
 
 	Filter(ctx context.Context, filter LogListFilter) ([]Log, error)
+	ByRange(ctx context.Context, filter LogRangeFilter) ([]Log, error)
+	Undecoded(ctx context.Context, limit int) ([]Log, error)
 }
 
 // Log -
@@ -43,16 +66,49 @@ type Log struct {
 	TxId      uint64         `bun:"tx_id"                       comment:"Transaction id"`
 	Data      pkgTypes.Hex   `bun:"data"                        comment:"Log data"`
 	Topics    []pkgTypes.Hex `bun:"topics,type:bytea"           comment:"Log topics"`
+	Topic0    pkgTypes.Hex   `bun:"topic0,type:bytea"           comment:"First log topic (event signature)"`
+	Topic1    pkgTypes.Hex   `bun:"topic1,type:bytea"           comment:"Second log topic"`
+	Topic2    pkgTypes.Hex   `bun:"topic2,type:bytea"           comment:"Third log topic"`
+	Topic3    pkgTypes.Hex   `bun:"topic3,type:bytea"           comment:"Fourth log topic"`
 	AddressId uint64         `bun:"address_id"                  comment:"Contract address ID whose invocation generated this log"`
 	Removed   bool           `bun:"removed"                     comment:"Removed during the reorg"`
 
+	EventName      string          `bun:"event_name,nullzero"              comment:"Decoded event name"`
+	EventSignature string          `bun:"event_signature,nullzero"         comment:"Decoded event signature"`
+	EventArgs      json.RawMessage `bun:"event_args,type:jsonb"            comment:"Decoded event arguments"`
+	Decoded        bool            `bun:"decoded,notnull,default:false"    comment:"Log was processed by the log decoder"`
+	DecodeVersion  int64           `bun:"decode_version,notnull,default:0" comment:"Incremented on every reset of the decoded event"`
+
 	Address Address `bun:"rel:belongs-to,join:address_id=id"`
 	Tx      Tx      `bun:"rel:belongs-to,join:tx_id=id"`
 
-	ContractABI json.RawMessage `bun:"contract_abi,scanonly"`
+	ContractABI       json.RawMessage `bun:"contract_abi,scanonly"`
+	ImplementationABI json.RawMessage `bun:"implementation_abi,scanonly"`
+	FacetABIs         json.RawMessage `bun:"facet_abis,scanonly"`
+	Signatures        json.RawMessage `bun:"signatures,scanonly"`
+	BlockHash         pkgTypes.Hex    `bun:"block_hash,scanonly"`
 }
 
 // TableName -
 func (Log) TableName() string {
 	return "log"
 }
+
+// Columns - columns of the log table filled by COPY
+func (Log) Columns() []string {
+	return []string{
+		"height", "time", "index", "name", "tx_id", "data", "topics", "topic0", "topic1", "topic2", "topic3",
+		"address_id", "removed", "event_name", "event_signature", "event_args", "decoded",
+		"decode_version",
+	}
+}
+
+// Flat - values of the log in the order of Columns encoded the same way as bun does
+func (l Log) Flat() []any {
+	return []any{
+		l.Height, l.Time, l.Index, l.Name, l.TxId, nullBytes(l.Data), nullBytes(marshalSlice(l.Topics)),
+		nullBytes(l.Topic0), nullBytes(l.Topic1), nullBytes(l.Topic2), nullBytes(l.Topic3),
+		l.AddressId, l.Removed, nullString(l.EventName), nullString(l.EventSignature), nullJSON(l.EventArgs), l.Decoded,
+		l.DecodeVersion,
+	}
+}
@@ -0,0 +1,352 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: allowance.go
+//
+// Generated by this command:
+//
+//	mockgen -source=allowance.go -destination=mock/allowance.go -package=mock -typed
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	storage "github.com/NobleScope/noble-indexer/internal/storage"
+	storage0 "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIAllowance is a mock of IAllowance interface.
+type MockIAllowance struct {
+	ctrl     *gomock.Controller
+	recorder *MockIAllowanceMockRecorder
+	isgomock struct{}
+}
+
+// MockIAllowanceMockRecorder is the mock recorder for MockIAllowance.
+type MockIAllowanceMockRecorder struct {
+	mock *MockIAllowance
+}
+
+// NewMockIAllowance creates a new mock instance.
+func NewMockIAllowance(ctrl *gomock.Controller) *MockIAllowance {
+	mock := &MockIAllowance{ctrl: ctrl}
+	mock.recorder = &MockIAllowanceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIAllowance) EXPECT() *MockIAllowanceMockRecorder {
+	return m.recorder
+}
+
+// CursorList mocks base method.
+func (m *MockIAllowance) CursorList(ctx context.Context, id, limit uint64, order storage0.SortOrder, cmp storage0.Comparator) ([]*storage.Allowance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CursorList", ctx, id, limit, order, cmp)
+	ret0, _ := ret[0].([]*storage.Allowance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CursorList indicates an expected call of CursorList.
+func (mr *MockIAllowanceMockRecorder) CursorList(ctx, id, limit, order, cmp any) *MockIAllowanceCursorListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CursorList", reflect.TypeOf((*MockIAllowance)(nil).CursorList), ctx, id, limit, order, cmp)
+	return &MockIAllowanceCursorListCall{Call: call}
+}
+
+// MockIAllowanceCursorListCall wrap *gomock.Call
+type MockIAllowanceCursorListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIAllowanceCursorListCall) Return(arg0 []*storage.Allowance, arg1 error) *MockIAllowanceCursorListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIAllowanceCursorListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.Allowance, error)) *MockIAllowanceCursorListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIAllowanceCursorListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.Allowance, error)) *MockIAllowanceCursorListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Filter mocks base method.
+func (m *MockIAllowance) Filter(ctx context.Context, filter storage.AllowanceListFilter) ([]storage.Allowance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Filter", ctx, filter)
+	ret0, _ := ret[0].([]storage.Allowance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Filter indicates an expected call of Filter.
+func (mr *MockIAllowanceMockRecorder) Filter(ctx, filter any) *MockIAllowanceFilterCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Filter", reflect.TypeOf((*MockIAllowance)(nil).Filter), ctx, filter)
+	return &MockIAllowanceFilterCall{Call: call}
+}
+
+// MockIAllowanceFilterCall wrap *gomock.Call
+type MockIAllowanceFilterCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIAllowanceFilterCall) Return(arg0 []storage.Allowance, arg1 error) *MockIAllowanceFilterCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIAllowanceFilterCall) Do(f func(context.Context, storage.AllowanceListFilter) ([]storage.Allowance, error)) *MockIAllowanceFilterCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIAllowanceFilterCall) DoAndReturn(f func(context.Context, storage.AllowanceListFilter) ([]storage.Allowance, error)) *MockIAllowanceFilterCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByID mocks base method.
+func (m *MockIAllowance) GetByID(ctx context.Context, id uint64) (*storage.Allowance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*storage.Allowance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockIAllowanceMockRecorder) GetByID(ctx, id any) *MockIAllowanceGetByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockIAllowance)(nil).GetByID), ctx, id)
+	return &MockIAllowanceGetByIDCall{Call: call}
+}
+
+// MockIAllowanceGetByIDCall wrap *gomock.Call
+type MockIAllowanceGetByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIAllowanceGetByIDCall) Return(arg0 *storage.Allowance, arg1 error) *MockIAllowanceGetByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIAllowanceGetByIDCall) Do(f func(context.Context, uint64) (*storage.Allowance, error)) *MockIAllowanceGetByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIAllowanceGetByIDCall) DoAndReturn(f func(context.Context, uint64) (*storage.Allowance, error)) *MockIAllowanceGetByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// IsNoRows mocks base method.
+func (m *MockIAllowance) IsNoRows(err error) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsNoRows", err)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsNoRows indicates an expected call of IsNoRows.
+func (mr *MockIAllowanceMockRecorder) IsNoRows(err any) *MockIAllowanceIsNoRowsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsNoRows", reflect.TypeOf((*MockIAllowance)(nil).IsNoRows), err)
+	return &MockIAllowanceIsNoRowsCall{Call: call}
+}
+
+// MockIAllowanceIsNoRowsCall wrap *gomock.Call
+type MockIAllowanceIsNoRowsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIAllowanceIsNoRowsCall) Return(arg0 bool) *MockIAllowanceIsNoRowsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIAllowanceIsNoRowsCall) Do(f func(error) bool) *MockIAllowanceIsNoRowsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIAllowanceIsNoRowsCall) DoAndReturn(f func(error) bool) *MockIAllowanceIsNoRowsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LastID mocks base method.
+func (m *MockIAllowance) LastID(ctx context.Context) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastID", ctx)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastID indicates an expected call of LastID.
+func (mr *MockIAllowanceMockRecorder) LastID(ctx any) *MockIAllowanceLastIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastID", reflect.TypeOf((*MockIAllowance)(nil).LastID), ctx)
+	return &MockIAllowanceLastIDCall{Call: call}
+}
+
+// MockIAllowanceLastIDCall wrap *gomock.Call
+type MockIAllowanceLastIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIAllowanceLastIDCall) Return(arg0 uint64, arg1 error) *MockIAllowanceLastIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIAllowanceLastIDCall) Do(f func(context.Context) (uint64, error)) *MockIAllowanceLastIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIAllowanceLastIDCall) DoAndReturn(f func(context.Context) (uint64, error)) *MockIAllowanceLastIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MockIAllowance) List(ctx context.Context, limit, offset uint64, order storage0.SortOrder) ([]*storage.Allowance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, limit, offset, order)
+	ret0, _ := ret[0].([]*storage.Allowance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockIAllowanceMockRecorder) List(ctx, limit, offset, order any) *MockIAllowanceListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockIAllowance)(nil).List), ctx, limit, offset, order)
+	return &MockIAllowanceListCall{Call: call}
+}
+
+// MockIAllowanceListCall wrap *gomock.Call
+type MockIAllowanceListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIAllowanceListCall) Return(arg0 []*storage.Allowance, arg1 error) *MockIAllowanceListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIAllowanceListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.Allowance, error)) *MockIAllowanceListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIAllowanceListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.Allowance, error)) *MockIAllowanceListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Save mocks base method.
+func (m_2 *MockIAllowance) Save(ctx context.Context, m *storage.Allowance) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Save", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockIAllowanceMockRecorder) Save(ctx, m any) *MockIAllowanceSaveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockIAllowance)(nil).Save), ctx, m)
+	return &MockIAllowanceSaveCall{Call: call}
+}
+
+// MockIAllowanceSaveCall wrap *gomock.Call
+type MockIAllowanceSaveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIAllowanceSaveCall) Return(arg0 error) *MockIAllowanceSaveCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIAllowanceSaveCall) Do(f func(context.Context, *storage.Allowance) error) *MockIAllowanceSaveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIAllowanceSaveCall) DoAndReturn(f func(context.Context, *storage.Allowance) error) *MockIAllowanceSaveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Update mocks base method.
+func (m_2 *MockIAllowance) Update(ctx context.Context, m *storage.Allowance) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Update", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockIAllowanceMockRecorder) Update(ctx, m any) *MockIAllowanceUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIAllowance)(nil).Update), ctx, m)
+	return &MockIAllowanceUpdateCall{Call: call}
+}
+
+// MockIAllowanceUpdateCall wrap *gomock.Call
+type MockIAllowanceUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIAllowanceUpdateCall) Return(arg0 error) *MockIAllowanceUpdateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIAllowanceUpdateCall) Do(f func(context.Context, *storage.Allowance) error) *MockIAllowanceUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIAllowanceUpdateCall) DoAndReturn(f func(context.Context, *storage.Allowance) error) *MockIAllowanceUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
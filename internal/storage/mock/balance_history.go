@@ -0,0 +1,471 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: balance_history.go
+//
+// Generated by this command:
+//
+//	mockgen -source=balance_history.go -destination=mock/balance_history.go -package=mock -typed
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	storage "github.com/NobleScope/noble-indexer/internal/storage"
+	types "github.com/NobleScope/noble-indexer/pkg/types"
+	storage0 "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIBalanceHistory is a mock of IBalanceHistory interface.
+type MockIBalanceHistory struct {
+	ctrl     *gomock.Controller
+	recorder *MockIBalanceHistoryMockRecorder
+	isgomock struct{}
+}
+
+// MockIBalanceHistoryMockRecorder is the mock recorder for MockIBalanceHistory.
+type MockIBalanceHistoryMockRecorder struct {
+	mock *MockIBalanceHistory
+}
+
+// NewMockIBalanceHistory creates a new mock instance.
+func NewMockIBalanceHistory(ctrl *gomock.Controller) *MockIBalanceHistory {
+	mock := &MockIBalanceHistory{ctrl: ctrl}
+	mock.recorder = &MockIBalanceHistoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIBalanceHistory) EXPECT() *MockIBalanceHistoryMockRecorder {
+	return m.recorder
+}
+
+// AtHeight mocks base method.
+func (m *MockIBalanceHistory) AtHeight(ctx context.Context, addressId uint64, height types.Level) (storage.BalanceHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AtHeight", ctx, addressId, height)
+	ret0, _ := ret[0].(storage.BalanceHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AtHeight indicates an expected call of AtHeight.
+func (mr *MockIBalanceHistoryMockRecorder) AtHeight(ctx, addressId, height any) *MockIBalanceHistoryAtHeightCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AtHeight", reflect.TypeOf((*MockIBalanceHistory)(nil).AtHeight), ctx, addressId, height)
+	return &MockIBalanceHistoryAtHeightCall{Call: call}
+}
+
+// MockIBalanceHistoryAtHeightCall wrap *gomock.Call
+type MockIBalanceHistoryAtHeightCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIBalanceHistoryAtHeightCall) Return(arg0 storage.BalanceHistory, arg1 error) *MockIBalanceHistoryAtHeightCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIBalanceHistoryAtHeightCall) Do(f func(context.Context, uint64, types.Level) (storage.BalanceHistory, error)) *MockIBalanceHistoryAtHeightCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIBalanceHistoryAtHeightCall) DoAndReturn(f func(context.Context, uint64, types.Level) (storage.BalanceHistory, error)) *MockIBalanceHistoryAtHeightCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// AtTime mocks base method.
+func (m *MockIBalanceHistory) AtTime(ctx context.Context, addressId uint64, t time.Time) (storage.BalanceHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AtTime", ctx, addressId, t)
+	ret0, _ := ret[0].(storage.BalanceHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AtTime indicates an expected call of AtTime.
+func (mr *MockIBalanceHistoryMockRecorder) AtTime(ctx, addressId, t any) *MockIBalanceHistoryAtTimeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AtTime", reflect.TypeOf((*MockIBalanceHistory)(nil).AtTime), ctx, addressId, t)
+	return &MockIBalanceHistoryAtTimeCall{Call: call}
+}
+
+// MockIBalanceHistoryAtTimeCall wrap *gomock.Call
+type MockIBalanceHistoryAtTimeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIBalanceHistoryAtTimeCall) Return(arg0 storage.BalanceHistory, arg1 error) *MockIBalanceHistoryAtTimeCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIBalanceHistoryAtTimeCall) Do(f func(context.Context, uint64, time.Time) (storage.BalanceHistory, error)) *MockIBalanceHistoryAtTimeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIBalanceHistoryAtTimeCall) DoAndReturn(f func(context.Context, uint64, time.Time) (storage.BalanceHistory, error)) *MockIBalanceHistoryAtTimeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CursorList mocks base method.
+func (m *MockIBalanceHistory) CursorList(ctx context.Context, id, limit uint64, order storage0.SortOrder, cmp storage0.Comparator) ([]*storage.BalanceHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CursorList", ctx, id, limit, order, cmp)
+	ret0, _ := ret[0].([]*storage.BalanceHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CursorList indicates an expected call of CursorList.
+func (mr *MockIBalanceHistoryMockRecorder) CursorList(ctx, id, limit, order, cmp any) *MockIBalanceHistoryCursorListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CursorList", reflect.TypeOf((*MockIBalanceHistory)(nil).CursorList), ctx, id, limit, order, cmp)
+	return &MockIBalanceHistoryCursorListCall{Call: call}
+}
+
+// MockIBalanceHistoryCursorListCall wrap *gomock.Call
+type MockIBalanceHistoryCursorListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIBalanceHistoryCursorListCall) Return(arg0 []*storage.BalanceHistory, arg1 error) *MockIBalanceHistoryCursorListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIBalanceHistoryCursorListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.BalanceHistory, error)) *MockIBalanceHistoryCursorListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIBalanceHistoryCursorListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.BalanceHistory, error)) *MockIBalanceHistoryCursorListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Filter mocks base method.
+func (m *MockIBalanceHistory) Filter(ctx context.Context, filter storage.BalanceHistoryListFilter) ([]storage.BalanceHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Filter", ctx, filter)
+	ret0, _ := ret[0].([]storage.BalanceHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Filter indicates an expected call of Filter.
+func (mr *MockIBalanceHistoryMockRecorder) Filter(ctx, filter any) *MockIBalanceHistoryFilterCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Filter", reflect.TypeOf((*MockIBalanceHistory)(nil).Filter), ctx, filter)
+	return &MockIBalanceHistoryFilterCall{Call: call}
+}
+
+// MockIBalanceHistoryFilterCall wrap *gomock.Call
+type MockIBalanceHistoryFilterCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIBalanceHistoryFilterCall) Return(arg0 []storage.BalanceHistory, arg1 error) *MockIBalanceHistoryFilterCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIBalanceHistoryFilterCall) Do(f func(context.Context, storage.BalanceHistoryListFilter) ([]storage.BalanceHistory, error)) *MockIBalanceHistoryFilterCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIBalanceHistoryFilterCall) DoAndReturn(f func(context.Context, storage.BalanceHistoryListFilter) ([]storage.BalanceHistory, error)) *MockIBalanceHistoryFilterCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByID mocks base method.
+func (m *MockIBalanceHistory) GetByID(ctx context.Context, id uint64) (*storage.BalanceHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*storage.BalanceHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockIBalanceHistoryMockRecorder) GetByID(ctx, id any) *MockIBalanceHistoryGetByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockIBalanceHistory)(nil).GetByID), ctx, id)
+	return &MockIBalanceHistoryGetByIDCall{Call: call}
+}
+
+// MockIBalanceHistoryGetByIDCall wrap *gomock.Call
+type MockIBalanceHistoryGetByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIBalanceHistoryGetByIDCall) Return(arg0 *storage.BalanceHistory, arg1 error) *MockIBalanceHistoryGetByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIBalanceHistoryGetByIDCall) Do(f func(context.Context, uint64) (*storage.BalanceHistory, error)) *MockIBalanceHistoryGetByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIBalanceHistoryGetByIDCall) DoAndReturn(f func(context.Context, uint64) (*storage.BalanceHistory, error)) *MockIBalanceHistoryGetByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// IsNoRows mocks base method.
+func (m *MockIBalanceHistory) IsNoRows(err error) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsNoRows", err)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsNoRows indicates an expected call of IsNoRows.
+func (mr *MockIBalanceHistoryMockRecorder) IsNoRows(err any) *MockIBalanceHistoryIsNoRowsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsNoRows", reflect.TypeOf((*MockIBalanceHistory)(nil).IsNoRows), err)
+	return &MockIBalanceHistoryIsNoRowsCall{Call: call}
+}
+
+// MockIBalanceHistoryIsNoRowsCall wrap *gomock.Call
+type MockIBalanceHistoryIsNoRowsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIBalanceHistoryIsNoRowsCall) Return(arg0 bool) *MockIBalanceHistoryIsNoRowsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIBalanceHistoryIsNoRowsCall) Do(f func(error) bool) *MockIBalanceHistoryIsNoRowsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIBalanceHistoryIsNoRowsCall) DoAndReturn(f func(error) bool) *MockIBalanceHistoryIsNoRowsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LastID mocks base method.
+func (m *MockIBalanceHistory) LastID(ctx context.Context) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastID", ctx)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastID indicates an expected call of LastID.
+func (mr *MockIBalanceHistoryMockRecorder) LastID(ctx any) *MockIBalanceHistoryLastIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastID", reflect.TypeOf((*MockIBalanceHistory)(nil).LastID), ctx)
+	return &MockIBalanceHistoryLastIDCall{Call: call}
+}
+
+// MockIBalanceHistoryLastIDCall wrap *gomock.Call
+type MockIBalanceHistoryLastIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIBalanceHistoryLastIDCall) Return(arg0 uint64, arg1 error) *MockIBalanceHistoryLastIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIBalanceHistoryLastIDCall) Do(f func(context.Context) (uint64, error)) *MockIBalanceHistoryLastIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIBalanceHistoryLastIDCall) DoAndReturn(f func(context.Context) (uint64, error)) *MockIBalanceHistoryLastIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MockIBalanceHistory) List(ctx context.Context, limit, offset uint64, order storage0.SortOrder) ([]*storage.BalanceHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, limit, offset, order)
+	ret0, _ := ret[0].([]*storage.BalanceHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockIBalanceHistoryMockRecorder) List(ctx, limit, offset, order any) *MockIBalanceHistoryListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockIBalanceHistory)(nil).List), ctx, limit, offset, order)
+	return &MockIBalanceHistoryListCall{Call: call}
+}
+
+// MockIBalanceHistoryListCall wrap *gomock.Call
+type MockIBalanceHistoryListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIBalanceHistoryListCall) Return(arg0 []*storage.BalanceHistory, arg1 error) *MockIBalanceHistoryListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIBalanceHistoryListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.BalanceHistory, error)) *MockIBalanceHistoryListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIBalanceHistoryListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.BalanceHistory, error)) *MockIBalanceHistoryListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Save mocks base method.
+func (m_2 *MockIBalanceHistory) Save(ctx context.Context, m *storage.BalanceHistory) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Save", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockIBalanceHistoryMockRecorder) Save(ctx, m any) *MockIBalanceHistorySaveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockIBalanceHistory)(nil).Save), ctx, m)
+	return &MockIBalanceHistorySaveCall{Call: call}
+}
+
+// MockIBalanceHistorySaveCall wrap *gomock.Call
+type MockIBalanceHistorySaveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIBalanceHistorySaveCall) Return(arg0 error) *MockIBalanceHistorySaveCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIBalanceHistorySaveCall) Do(f func(context.Context, *storage.BalanceHistory) error) *MockIBalanceHistorySaveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIBalanceHistorySaveCall) DoAndReturn(f func(context.Context, *storage.BalanceHistory) error) *MockIBalanceHistorySaveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Series mocks base method.
+func (m *MockIBalanceHistory) Series(ctx context.Context, filter storage.BalanceSeriesFilter) ([]storage.BalanceSeriesItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Series", ctx, filter)
+	ret0, _ := ret[0].([]storage.BalanceSeriesItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Series indicates an expected call of Series.
+func (mr *MockIBalanceHistoryMockRecorder) Series(ctx, filter any) *MockIBalanceHistorySeriesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Series", reflect.TypeOf((*MockIBalanceHistory)(nil).Series), ctx, filter)
+	return &MockIBalanceHistorySeriesCall{Call: call}
+}
+
+// MockIBalanceHistorySeriesCall wrap *gomock.Call
+type MockIBalanceHistorySeriesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIBalanceHistorySeriesCall) Return(arg0 []storage.BalanceSeriesItem, arg1 error) *MockIBalanceHistorySeriesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIBalanceHistorySeriesCall) Do(f func(context.Context, storage.BalanceSeriesFilter) ([]storage.BalanceSeriesItem, error)) *MockIBalanceHistorySeriesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIBalanceHistorySeriesCall) DoAndReturn(f func(context.Context, storage.BalanceSeriesFilter) ([]storage.BalanceSeriesItem, error)) *MockIBalanceHistorySeriesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Update mocks base method.
+func (m_2 *MockIBalanceHistory) Update(ctx context.Context, m *storage.BalanceHistory) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Update", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockIBalanceHistoryMockRecorder) Update(ctx, m any) *MockIBalanceHistoryUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIBalanceHistory)(nil).Update), ctx, m)
+	return &MockIBalanceHistoryUpdateCall{Call: call}
+}
+
+// MockIBalanceHistoryUpdateCall wrap *gomock.Call
+type MockIBalanceHistoryUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIBalanceHistoryUpdateCall) Return(arg0 error) *MockIBalanceHistoryUpdateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIBalanceHistoryUpdateCall) Do(f func(context.Context, *storage.BalanceHistory) error) *MockIBalanceHistoryUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIBalanceHistoryUpdateCall) DoAndReturn(f func(context.Context, *storage.BalanceHistory) error) *MockIBalanceHistoryUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
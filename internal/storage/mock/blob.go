@@ -0,0 +1,352 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: blob.go
+//
+// Generated by this command:
+//
+//	mockgen -source=blob.go -destination=mock/blob.go -package=mock -typed
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	storage "github.com/NobleScope/noble-indexer/internal/storage"
+	storage0 "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIBlob is a mock of IBlob interface.
+type MockIBlob struct {
+	ctrl     *gomock.Controller
+	recorder *MockIBlobMockRecorder
+	isgomock struct{}
+}
+
+// MockIBlobMockRecorder is the mock recorder for MockIBlob.
+type MockIBlobMockRecorder struct {
+	mock *MockIBlob
+}
+
+// NewMockIBlob creates a new mock instance.
+func NewMockIBlob(ctrl *gomock.Controller) *MockIBlob {
+	mock := &MockIBlob{ctrl: ctrl}
+	mock.recorder = &MockIBlobMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIBlob) EXPECT() *MockIBlobMockRecorder {
+	return m.recorder
+}
+
+// CursorList mocks base method.
+func (m *MockIBlob) CursorList(ctx context.Context, id, limit uint64, order storage0.SortOrder, cmp storage0.Comparator) ([]*storage.Blob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CursorList", ctx, id, limit, order, cmp)
+	ret0, _ := ret[0].([]*storage.Blob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CursorList indicates an expected call of CursorList.
+func (mr *MockIBlobMockRecorder) CursorList(ctx, id, limit, order, cmp any) *MockIBlobCursorListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CursorList", reflect.TypeOf((*MockIBlob)(nil).CursorList), ctx, id, limit, order, cmp)
+	return &MockIBlobCursorListCall{Call: call}
+}
+
+// MockIBlobCursorListCall wrap *gomock.Call
+type MockIBlobCursorListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIBlobCursorListCall) Return(arg0 []*storage.Blob, arg1 error) *MockIBlobCursorListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIBlobCursorListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.Blob, error)) *MockIBlobCursorListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIBlobCursorListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.Blob, error)) *MockIBlobCursorListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Filter mocks base method.
+func (m *MockIBlob) Filter(ctx context.Context, filter storage.BlobListFilter) ([]storage.Blob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Filter", ctx, filter)
+	ret0, _ := ret[0].([]storage.Blob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Filter indicates an expected call of Filter.
+func (mr *MockIBlobMockRecorder) Filter(ctx, filter any) *MockIBlobFilterCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Filter", reflect.TypeOf((*MockIBlob)(nil).Filter), ctx, filter)
+	return &MockIBlobFilterCall{Call: call}
+}
+
+// MockIBlobFilterCall wrap *gomock.Call
+type MockIBlobFilterCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIBlobFilterCall) Return(arg0 []storage.Blob, arg1 error) *MockIBlobFilterCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIBlobFilterCall) Do(f func(context.Context, storage.BlobListFilter) ([]storage.Blob, error)) *MockIBlobFilterCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIBlobFilterCall) DoAndReturn(f func(context.Context, storage.BlobListFilter) ([]storage.Blob, error)) *MockIBlobFilterCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByID mocks base method.
+func (m *MockIBlob) GetByID(ctx context.Context, id uint64) (*storage.Blob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*storage.Blob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockIBlobMockRecorder) GetByID(ctx, id any) *MockIBlobGetByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockIBlob)(nil).GetByID), ctx, id)
+	return &MockIBlobGetByIDCall{Call: call}
+}
+
+// MockIBlobGetByIDCall wrap *gomock.Call
+type MockIBlobGetByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIBlobGetByIDCall) Return(arg0 *storage.Blob, arg1 error) *MockIBlobGetByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIBlobGetByIDCall) Do(f func(context.Context, uint64) (*storage.Blob, error)) *MockIBlobGetByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIBlobGetByIDCall) DoAndReturn(f func(context.Context, uint64) (*storage.Blob, error)) *MockIBlobGetByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// IsNoRows mocks base method.
+func (m *MockIBlob) IsNoRows(err error) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsNoRows", err)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsNoRows indicates an expected call of IsNoRows.
+func (mr *MockIBlobMockRecorder) IsNoRows(err any) *MockIBlobIsNoRowsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsNoRows", reflect.TypeOf((*MockIBlob)(nil).IsNoRows), err)
+	return &MockIBlobIsNoRowsCall{Call: call}
+}
+
+// MockIBlobIsNoRowsCall wrap *gomock.Call
+type MockIBlobIsNoRowsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIBlobIsNoRowsCall) Return(arg0 bool) *MockIBlobIsNoRowsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIBlobIsNoRowsCall) Do(f func(error) bool) *MockIBlobIsNoRowsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIBlobIsNoRowsCall) DoAndReturn(f func(error) bool) *MockIBlobIsNoRowsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LastID mocks base method.
+func (m *MockIBlob) LastID(ctx context.Context) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastID", ctx)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastID indicates an expected call of LastID.
+func (mr *MockIBlobMockRecorder) LastID(ctx any) *MockIBlobLastIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastID", reflect.TypeOf((*MockIBlob)(nil).LastID), ctx)
+	return &MockIBlobLastIDCall{Call: call}
+}
+
+// MockIBlobLastIDCall wrap *gomock.Call
+type MockIBlobLastIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIBlobLastIDCall) Return(arg0 uint64, arg1 error) *MockIBlobLastIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIBlobLastIDCall) Do(f func(context.Context) (uint64, error)) *MockIBlobLastIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIBlobLastIDCall) DoAndReturn(f func(context.Context) (uint64, error)) *MockIBlobLastIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MockIBlob) List(ctx context.Context, limit, offset uint64, order storage0.SortOrder) ([]*storage.Blob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, limit, offset, order)
+	ret0, _ := ret[0].([]*storage.Blob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockIBlobMockRecorder) List(ctx, limit, offset, order any) *MockIBlobListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockIBlob)(nil).List), ctx, limit, offset, order)
+	return &MockIBlobListCall{Call: call}
+}
+
+// MockIBlobListCall wrap *gomock.Call
+type MockIBlobListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIBlobListCall) Return(arg0 []*storage.Blob, arg1 error) *MockIBlobListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIBlobListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.Blob, error)) *MockIBlobListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIBlobListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.Blob, error)) *MockIBlobListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Save mocks base method.
+func (m_2 *MockIBlob) Save(ctx context.Context, m *storage.Blob) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Save", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockIBlobMockRecorder) Save(ctx, m any) *MockIBlobSaveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockIBlob)(nil).Save), ctx, m)
+	return &MockIBlobSaveCall{Call: call}
+}
+
+// MockIBlobSaveCall wrap *gomock.Call
+type MockIBlobSaveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIBlobSaveCall) Return(arg0 error) *MockIBlobSaveCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIBlobSaveCall) Do(f func(context.Context, *storage.Blob) error) *MockIBlobSaveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIBlobSaveCall) DoAndReturn(f func(context.Context, *storage.Blob) error) *MockIBlobSaveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Update mocks base method.
+func (m_2 *MockIBlob) Update(ctx context.Context, m *storage.Blob) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Update", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockIBlobMockRecorder) Update(ctx, m any) *MockIBlobUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIBlob)(nil).Update), ctx, m)
+	return &MockIBlobUpdateCall{Call: call}
+}
+
+// MockIBlobUpdateCall wrap *gomock.Call
+type MockIBlobUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIBlobUpdateCall) Return(arg0 error) *MockIBlobUpdateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIBlobUpdateCall) Do(f func(context.Context, *storage.Blob) error) *MockIBlobUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIBlobUpdateCall) DoAndReturn(f func(context.Context, *storage.Blob) error) *MockIBlobUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
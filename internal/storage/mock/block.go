@@ -43,6 +43,45 @@ func (m *MockIBlock) EXPECT() *MockIBlockMockRecorder {
 	return m.recorder
 }
 
+// ByHash mocks base method.
+func (m *MockIBlock) ByHash(ctx context.Context, hash types.Hex) (storage.Block, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ByHash", ctx, hash)
+	ret0, _ := ret[0].(storage.Block)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ByHash indicates an expected call of ByHash.
+func (mr *MockIBlockMockRecorder) ByHash(ctx, hash any) *MockIBlockByHashCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ByHash", reflect.TypeOf((*MockIBlock)(nil).ByHash), ctx, hash)
+	return &MockIBlockByHashCall{Call: call}
+}
+
+// MockIBlockByHashCall wrap *gomock.Call
+type MockIBlockByHashCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIBlockByHashCall) Return(arg0 storage.Block, arg1 error) *MockIBlockByHashCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIBlockByHashCall) Do(f func(context.Context, types.Hex) (storage.Block, error)) *MockIBlockByHashCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIBlockByHashCall) DoAndReturn(f func(context.Context, types.Hex) (storage.Block, error)) *MockIBlockByHashCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ByHeight mocks base method.
 func (m *MockIBlock) ByHeight(ctx context.Context, height types.Level, withStats bool) (storage.Block, error) {
 	m.ctrl.T.Helper()
@@ -315,6 +354,45 @@ func (c *MockIBlockLastIDCall) DoAndReturn(f func(context.Context) (uint64, erro
 	return c
 }
 
+// LastInRange mocks base method.
+func (m *MockIBlock) LastInRange(ctx context.Context, start, end types.Level) (storage.Block, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastInRange", ctx, start, end)
+	ret0, _ := ret[0].(storage.Block)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastInRange indicates an expected call of LastInRange.
+func (mr *MockIBlockMockRecorder) LastInRange(ctx, start, end any) *MockIBlockLastInRangeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastInRange", reflect.TypeOf((*MockIBlock)(nil).LastInRange), ctx, start, end)
+	return &MockIBlockLastInRangeCall{Call: call}
+}
+
+// MockIBlockLastInRangeCall wrap *gomock.Call
+type MockIBlockLastInRangeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIBlockLastInRangeCall) Return(arg0 storage.Block, arg1 error) *MockIBlockLastInRangeCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIBlockLastInRangeCall) Do(f func(context.Context, types.Level, types.Level) (storage.Block, error)) *MockIBlockLastInRangeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIBlockLastInRangeCall) DoAndReturn(f func(context.Context, types.Level, types.Level) (storage.Block, error)) *MockIBlockLastInRangeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // List mocks base method.
 func (m *MockIBlock) List(ctx context.Context, limit, offset uint64, order storage0.SortOrder) ([]*storage.Block, error) {
 	m.ctrl.T.Helper()
@@ -83,6 +83,45 @@ func (c *MockIBlockStatsAvgBlockTimeCall) DoAndReturn(f func(context.Context, ti
 	return c
 }
 
+// BlobStats mocks base method.
+func (m *MockIBlockStats) BlobStats(ctx context.Context, from time.Time) (storage.BlobStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlobStats", ctx, from)
+	ret0, _ := ret[0].(storage.BlobStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BlobStats indicates an expected call of BlobStats.
+func (mr *MockIBlockStatsMockRecorder) BlobStats(ctx, from any) *MockIBlockStatsBlobStatsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlobStats", reflect.TypeOf((*MockIBlockStats)(nil).BlobStats), ctx, from)
+	return &MockIBlockStatsBlobStatsCall{Call: call}
+}
+
+// MockIBlockStatsBlobStatsCall wrap *gomock.Call
+type MockIBlockStatsBlobStatsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIBlockStatsBlobStatsCall) Return(arg0 storage.BlobStats, arg1 error) *MockIBlockStatsBlobStatsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIBlockStatsBlobStatsCall) Do(f func(context.Context, time.Time) (storage.BlobStats, error)) *MockIBlockStatsBlobStatsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIBlockStatsBlobStatsCall) DoAndReturn(f func(context.Context, time.Time) (storage.BlobStats, error)) *MockIBlockStatsBlobStatsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ByHeight mocks base method.
 func (m *MockIBlockStats) ByHeight(ctx context.Context, height types.Level) (storage.BlockStats, error) {
 	m.ctrl.T.Helper()
@@ -318,6 +318,45 @@ func (c *MockIContractListCall) DoAndReturn(f func(context.Context, uint64, uint
 	return c
 }
 
+// ListWithABI mocks base method.
+func (m *MockIContract) ListWithABI(ctx context.Context, cursorId uint64, limit int) ([]storage.Contract, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWithABI", ctx, cursorId, limit)
+	ret0, _ := ret[0].([]storage.Contract)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWithABI indicates an expected call of ListWithABI.
+func (mr *MockIContractMockRecorder) ListWithABI(ctx, cursorId, limit any) *MockIContractListWithABICall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWithABI", reflect.TypeOf((*MockIContract)(nil).ListWithABI), ctx, cursorId, limit)
+	return &MockIContractListWithABICall{Call: call}
+}
+
+// MockIContractListWithABICall wrap *gomock.Call
+type MockIContractListWithABICall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIContractListWithABICall) Return(arg0 []storage.Contract, arg1 error) *MockIContractListWithABICall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIContractListWithABICall) Do(f func(context.Context, uint64, int) ([]storage.Contract, error)) *MockIContractListWithABICall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIContractListWithABICall) DoAndReturn(f func(context.Context, uint64, int) ([]storage.Contract, error)) *MockIContractListWithABICall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ListWithTx mocks base method.
 func (m *MockIContract) ListWithTx(ctx context.Context, filters storage.ContractListFilter) ([]storage.Contract, error) {
 	m.ctrl.T.Helper()
@@ -434,6 +473,123 @@ func (c *MockIContractSaveCall) DoAndReturn(f func(context.Context, *storage.Con
 	return c
 }
 
+// SimilarCandidates mocks base method.
+func (m *MockIContract) SimilarCandidates(ctx context.Context, codeHash types.Hex, afterId uint64, limit int) ([]storage.Contract, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SimilarCandidates", ctx, codeHash, afterId, limit)
+	ret0, _ := ret[0].([]storage.Contract)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SimilarCandidates indicates an expected call of SimilarCandidates.
+func (mr *MockIContractMockRecorder) SimilarCandidates(ctx, codeHash, afterId, limit any) *MockIContractSimilarCandidatesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SimilarCandidates", reflect.TypeOf((*MockIContract)(nil).SimilarCandidates), ctx, codeHash, afterId, limit)
+	return &MockIContractSimilarCandidatesCall{Call: call}
+}
+
+// MockIContractSimilarCandidatesCall wrap *gomock.Call
+type MockIContractSimilarCandidatesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIContractSimilarCandidatesCall) Return(arg0 []storage.Contract, arg1 error) *MockIContractSimilarCandidatesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIContractSimilarCandidatesCall) Do(f func(context.Context, types.Hex, uint64, int) ([]storage.Contract, error)) *MockIContractSimilarCandidatesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIContractSimilarCandidatesCall) DoAndReturn(f func(context.Context, types.Hex, uint64, int) ([]storage.Contract, error)) *MockIContractSimilarCandidatesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SimilarPending mocks base method.
+func (m *MockIContract) SimilarPending(ctx context.Context, limit int) ([]storage.Contract, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SimilarPending", ctx, limit)
+	ret0, _ := ret[0].([]storage.Contract)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SimilarPending indicates an expected call of SimilarPending.
+func (mr *MockIContractMockRecorder) SimilarPending(ctx, limit any) *MockIContractSimilarPendingCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SimilarPending", reflect.TypeOf((*MockIContract)(nil).SimilarPending), ctx, limit)
+	return &MockIContractSimilarPendingCall{Call: call}
+}
+
+// MockIContractSimilarPendingCall wrap *gomock.Call
+type MockIContractSimilarPendingCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIContractSimilarPendingCall) Return(arg0 []storage.Contract, arg1 error) *MockIContractSimilarPendingCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIContractSimilarPendingCall) Do(f func(context.Context, int) ([]storage.Contract, error)) *MockIContractSimilarPendingCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIContractSimilarPendingCall) DoAndReturn(f func(context.Context, int) ([]storage.Contract, error)) *MockIContractSimilarPendingCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SimilarSources mocks base method.
+func (m *MockIContract) SimilarSources(ctx context.Context, codeHash types.Hex) ([]storage.Contract, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SimilarSources", ctx, codeHash)
+	ret0, _ := ret[0].([]storage.Contract)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SimilarSources indicates an expected call of SimilarSources.
+func (mr *MockIContractMockRecorder) SimilarSources(ctx, codeHash any) *MockIContractSimilarSourcesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SimilarSources", reflect.TypeOf((*MockIContract)(nil).SimilarSources), ctx, codeHash)
+	return &MockIContractSimilarSourcesCall{Call: call}
+}
+
+// MockIContractSimilarSourcesCall wrap *gomock.Call
+type MockIContractSimilarSourcesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIContractSimilarSourcesCall) Return(arg0 []storage.Contract, arg1 error) *MockIContractSimilarSourcesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIContractSimilarSourcesCall) Do(f func(context.Context, types.Hex) ([]storage.Contract, error)) *MockIContractSimilarSourcesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIContractSimilarSourcesCall) DoAndReturn(f func(context.Context, types.Hex) ([]storage.Contract, error)) *MockIContractSimilarSourcesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Update mocks base method.
 func (m_2 *MockIContract) Update(ctx context.Context, m *storage.Contract) error {
 	m_2.ctrl.T.Helper()
@@ -0,0 +1,391 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: delegation.go
+//
+// Generated by this command:
+//
+//	mockgen -source=delegation.go -destination=mock/delegation.go -package=mock -typed
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	storage "github.com/NobleScope/noble-indexer/internal/storage"
+	storage0 "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIDelegation is a mock of IDelegation interface.
+type MockIDelegation struct {
+	ctrl     *gomock.Controller
+	recorder *MockIDelegationMockRecorder
+	isgomock struct{}
+}
+
+// MockIDelegationMockRecorder is the mock recorder for MockIDelegation.
+type MockIDelegationMockRecorder struct {
+	mock *MockIDelegation
+}
+
+// NewMockIDelegation creates a new mock instance.
+func NewMockIDelegation(ctrl *gomock.Controller) *MockIDelegation {
+	mock := &MockIDelegation{ctrl: ctrl}
+	mock.recorder = &MockIDelegationMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIDelegation) EXPECT() *MockIDelegationMockRecorder {
+	return m.recorder
+}
+
+// ByAuthorityId mocks base method.
+func (m *MockIDelegation) ByAuthorityId(ctx context.Context, authorityId uint64) (storage.Delegation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ByAuthorityId", ctx, authorityId)
+	ret0, _ := ret[0].(storage.Delegation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ByAuthorityId indicates an expected call of ByAuthorityId.
+func (mr *MockIDelegationMockRecorder) ByAuthorityId(ctx, authorityId any) *MockIDelegationByAuthorityIdCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ByAuthorityId", reflect.TypeOf((*MockIDelegation)(nil).ByAuthorityId), ctx, authorityId)
+	return &MockIDelegationByAuthorityIdCall{Call: call}
+}
+
+// MockIDelegationByAuthorityIdCall wrap *gomock.Call
+type MockIDelegationByAuthorityIdCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIDelegationByAuthorityIdCall) Return(arg0 storage.Delegation, arg1 error) *MockIDelegationByAuthorityIdCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIDelegationByAuthorityIdCall) Do(f func(context.Context, uint64) (storage.Delegation, error)) *MockIDelegationByAuthorityIdCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIDelegationByAuthorityIdCall) DoAndReturn(f func(context.Context, uint64) (storage.Delegation, error)) *MockIDelegationByAuthorityIdCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CursorList mocks base method.
+func (m *MockIDelegation) CursorList(ctx context.Context, id, limit uint64, order storage0.SortOrder, cmp storage0.Comparator) ([]*storage.Delegation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CursorList", ctx, id, limit, order, cmp)
+	ret0, _ := ret[0].([]*storage.Delegation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CursorList indicates an expected call of CursorList.
+func (mr *MockIDelegationMockRecorder) CursorList(ctx, id, limit, order, cmp any) *MockIDelegationCursorListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CursorList", reflect.TypeOf((*MockIDelegation)(nil).CursorList), ctx, id, limit, order, cmp)
+	return &MockIDelegationCursorListCall{Call: call}
+}
+
+// MockIDelegationCursorListCall wrap *gomock.Call
+type MockIDelegationCursorListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIDelegationCursorListCall) Return(arg0 []*storage.Delegation, arg1 error) *MockIDelegationCursorListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIDelegationCursorListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.Delegation, error)) *MockIDelegationCursorListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIDelegationCursorListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.Delegation, error)) *MockIDelegationCursorListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Filter mocks base method.
+func (m *MockIDelegation) Filter(ctx context.Context, filter storage.DelegationListFilter) ([]storage.Delegation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Filter", ctx, filter)
+	ret0, _ := ret[0].([]storage.Delegation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Filter indicates an expected call of Filter.
+func (mr *MockIDelegationMockRecorder) Filter(ctx, filter any) *MockIDelegationFilterCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Filter", reflect.TypeOf((*MockIDelegation)(nil).Filter), ctx, filter)
+	return &MockIDelegationFilterCall{Call: call}
+}
+
+// MockIDelegationFilterCall wrap *gomock.Call
+type MockIDelegationFilterCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIDelegationFilterCall) Return(arg0 []storage.Delegation, arg1 error) *MockIDelegationFilterCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIDelegationFilterCall) Do(f func(context.Context, storage.DelegationListFilter) ([]storage.Delegation, error)) *MockIDelegationFilterCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIDelegationFilterCall) DoAndReturn(f func(context.Context, storage.DelegationListFilter) ([]storage.Delegation, error)) *MockIDelegationFilterCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByID mocks base method.
+func (m *MockIDelegation) GetByID(ctx context.Context, id uint64) (*storage.Delegation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*storage.Delegation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockIDelegationMockRecorder) GetByID(ctx, id any) *MockIDelegationGetByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockIDelegation)(nil).GetByID), ctx, id)
+	return &MockIDelegationGetByIDCall{Call: call}
+}
+
+// MockIDelegationGetByIDCall wrap *gomock.Call
+type MockIDelegationGetByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIDelegationGetByIDCall) Return(arg0 *storage.Delegation, arg1 error) *MockIDelegationGetByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIDelegationGetByIDCall) Do(f func(context.Context, uint64) (*storage.Delegation, error)) *MockIDelegationGetByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIDelegationGetByIDCall) DoAndReturn(f func(context.Context, uint64) (*storage.Delegation, error)) *MockIDelegationGetByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// IsNoRows mocks base method.
+func (m *MockIDelegation) IsNoRows(err error) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsNoRows", err)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsNoRows indicates an expected call of IsNoRows.
+func (mr *MockIDelegationMockRecorder) IsNoRows(err any) *MockIDelegationIsNoRowsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsNoRows", reflect.TypeOf((*MockIDelegation)(nil).IsNoRows), err)
+	return &MockIDelegationIsNoRowsCall{Call: call}
+}
+
+// MockIDelegationIsNoRowsCall wrap *gomock.Call
+type MockIDelegationIsNoRowsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIDelegationIsNoRowsCall) Return(arg0 bool) *MockIDelegationIsNoRowsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIDelegationIsNoRowsCall) Do(f func(error) bool) *MockIDelegationIsNoRowsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIDelegationIsNoRowsCall) DoAndReturn(f func(error) bool) *MockIDelegationIsNoRowsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LastID mocks base method.
+func (m *MockIDelegation) LastID(ctx context.Context) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastID", ctx)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastID indicates an expected call of LastID.
+func (mr *MockIDelegationMockRecorder) LastID(ctx any) *MockIDelegationLastIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastID", reflect.TypeOf((*MockIDelegation)(nil).LastID), ctx)
+	return &MockIDelegationLastIDCall{Call: call}
+}
+
+// MockIDelegationLastIDCall wrap *gomock.Call
+type MockIDelegationLastIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIDelegationLastIDCall) Return(arg0 uint64, arg1 error) *MockIDelegationLastIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIDelegationLastIDCall) Do(f func(context.Context) (uint64, error)) *MockIDelegationLastIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIDelegationLastIDCall) DoAndReturn(f func(context.Context) (uint64, error)) *MockIDelegationLastIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MockIDelegation) List(ctx context.Context, limit, offset uint64, order storage0.SortOrder) ([]*storage.Delegation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, limit, offset, order)
+	ret0, _ := ret[0].([]*storage.Delegation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockIDelegationMockRecorder) List(ctx, limit, offset, order any) *MockIDelegationListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockIDelegation)(nil).List), ctx, limit, offset, order)
+	return &MockIDelegationListCall{Call: call}
+}
+
+// MockIDelegationListCall wrap *gomock.Call
+type MockIDelegationListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIDelegationListCall) Return(arg0 []*storage.Delegation, arg1 error) *MockIDelegationListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIDelegationListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.Delegation, error)) *MockIDelegationListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIDelegationListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.Delegation, error)) *MockIDelegationListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Save mocks base method.
+func (m_2 *MockIDelegation) Save(ctx context.Context, m *storage.Delegation) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Save", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockIDelegationMockRecorder) Save(ctx, m any) *MockIDelegationSaveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockIDelegation)(nil).Save), ctx, m)
+	return &MockIDelegationSaveCall{Call: call}
+}
+
+// MockIDelegationSaveCall wrap *gomock.Call
+type MockIDelegationSaveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIDelegationSaveCall) Return(arg0 error) *MockIDelegationSaveCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIDelegationSaveCall) Do(f func(context.Context, *storage.Delegation) error) *MockIDelegationSaveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIDelegationSaveCall) DoAndReturn(f func(context.Context, *storage.Delegation) error) *MockIDelegationSaveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Update mocks base method.
+func (m_2 *MockIDelegation) Update(ctx context.Context, m *storage.Delegation) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Update", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockIDelegationMockRecorder) Update(ctx, m any) *MockIDelegationUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIDelegation)(nil).Update), ctx, m)
+	return &MockIDelegationUpdateCall{Call: call}
+}
+
+// MockIDelegationUpdateCall wrap *gomock.Call
+type MockIDelegationUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIDelegationUpdateCall) Return(arg0 error) *MockIDelegationUpdateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIDelegationUpdateCall) Do(f func(context.Context, *storage.Delegation) error) *MockIDelegationUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIDelegationUpdateCall) DoAndReturn(f func(context.Context, *storage.Delegation) error) *MockIDelegationUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
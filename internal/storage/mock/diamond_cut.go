@@ -0,0 +1,313 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: diamond_cut.go
+//
+// Generated by this command:
+//
+//	mockgen -source=diamond_cut.go -destination=mock/diamond_cut.go -package=mock -typed
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	storage "github.com/NobleScope/noble-indexer/internal/storage"
+	storage0 "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIDiamondCut is a mock of IDiamondCut interface.
+type MockIDiamondCut struct {
+	ctrl     *gomock.Controller
+	recorder *MockIDiamondCutMockRecorder
+	isgomock struct{}
+}
+
+// MockIDiamondCutMockRecorder is the mock recorder for MockIDiamondCut.
+type MockIDiamondCutMockRecorder struct {
+	mock *MockIDiamondCut
+}
+
+// NewMockIDiamondCut creates a new mock instance.
+func NewMockIDiamondCut(ctrl *gomock.Controller) *MockIDiamondCut {
+	mock := &MockIDiamondCut{ctrl: ctrl}
+	mock.recorder = &MockIDiamondCutMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIDiamondCut) EXPECT() *MockIDiamondCutMockRecorder {
+	return m.recorder
+}
+
+// CursorList mocks base method.
+func (m *MockIDiamondCut) CursorList(ctx context.Context, id, limit uint64, order storage0.SortOrder, cmp storage0.Comparator) ([]*storage.DiamondCut, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CursorList", ctx, id, limit, order, cmp)
+	ret0, _ := ret[0].([]*storage.DiamondCut)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CursorList indicates an expected call of CursorList.
+func (mr *MockIDiamondCutMockRecorder) CursorList(ctx, id, limit, order, cmp any) *MockIDiamondCutCursorListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CursorList", reflect.TypeOf((*MockIDiamondCut)(nil).CursorList), ctx, id, limit, order, cmp)
+	return &MockIDiamondCutCursorListCall{Call: call}
+}
+
+// MockIDiamondCutCursorListCall wrap *gomock.Call
+type MockIDiamondCutCursorListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIDiamondCutCursorListCall) Return(arg0 []*storage.DiamondCut, arg1 error) *MockIDiamondCutCursorListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIDiamondCutCursorListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.DiamondCut, error)) *MockIDiamondCutCursorListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIDiamondCutCursorListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.DiamondCut, error)) *MockIDiamondCutCursorListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByID mocks base method.
+func (m *MockIDiamondCut) GetByID(ctx context.Context, id uint64) (*storage.DiamondCut, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*storage.DiamondCut)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockIDiamondCutMockRecorder) GetByID(ctx, id any) *MockIDiamondCutGetByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockIDiamondCut)(nil).GetByID), ctx, id)
+	return &MockIDiamondCutGetByIDCall{Call: call}
+}
+
+// MockIDiamondCutGetByIDCall wrap *gomock.Call
+type MockIDiamondCutGetByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIDiamondCutGetByIDCall) Return(arg0 *storage.DiamondCut, arg1 error) *MockIDiamondCutGetByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIDiamondCutGetByIDCall) Do(f func(context.Context, uint64) (*storage.DiamondCut, error)) *MockIDiamondCutGetByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIDiamondCutGetByIDCall) DoAndReturn(f func(context.Context, uint64) (*storage.DiamondCut, error)) *MockIDiamondCutGetByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// IsNoRows mocks base method.
+func (m *MockIDiamondCut) IsNoRows(err error) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsNoRows", err)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsNoRows indicates an expected call of IsNoRows.
+func (mr *MockIDiamondCutMockRecorder) IsNoRows(err any) *MockIDiamondCutIsNoRowsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsNoRows", reflect.TypeOf((*MockIDiamondCut)(nil).IsNoRows), err)
+	return &MockIDiamondCutIsNoRowsCall{Call: call}
+}
+
+// MockIDiamondCutIsNoRowsCall wrap *gomock.Call
+type MockIDiamondCutIsNoRowsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIDiamondCutIsNoRowsCall) Return(arg0 bool) *MockIDiamondCutIsNoRowsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIDiamondCutIsNoRowsCall) Do(f func(error) bool) *MockIDiamondCutIsNoRowsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIDiamondCutIsNoRowsCall) DoAndReturn(f func(error) bool) *MockIDiamondCutIsNoRowsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LastID mocks base method.
+func (m *MockIDiamondCut) LastID(ctx context.Context) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastID", ctx)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastID indicates an expected call of LastID.
+func (mr *MockIDiamondCutMockRecorder) LastID(ctx any) *MockIDiamondCutLastIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastID", reflect.TypeOf((*MockIDiamondCut)(nil).LastID), ctx)
+	return &MockIDiamondCutLastIDCall{Call: call}
+}
+
+// MockIDiamondCutLastIDCall wrap *gomock.Call
+type MockIDiamondCutLastIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIDiamondCutLastIDCall) Return(arg0 uint64, arg1 error) *MockIDiamondCutLastIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIDiamondCutLastIDCall) Do(f func(context.Context) (uint64, error)) *MockIDiamondCutLastIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIDiamondCutLastIDCall) DoAndReturn(f func(context.Context) (uint64, error)) *MockIDiamondCutLastIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MockIDiamondCut) List(ctx context.Context, limit, offset uint64, order storage0.SortOrder) ([]*storage.DiamondCut, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, limit, offset, order)
+	ret0, _ := ret[0].([]*storage.DiamondCut)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockIDiamondCutMockRecorder) List(ctx, limit, offset, order any) *MockIDiamondCutListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockIDiamondCut)(nil).List), ctx, limit, offset, order)
+	return &MockIDiamondCutListCall{Call: call}
+}
+
+// MockIDiamondCutListCall wrap *gomock.Call
+type MockIDiamondCutListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIDiamondCutListCall) Return(arg0 []*storage.DiamondCut, arg1 error) *MockIDiamondCutListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIDiamondCutListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.DiamondCut, error)) *MockIDiamondCutListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIDiamondCutListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.DiamondCut, error)) *MockIDiamondCutListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Save mocks base method.
+func (m_2 *MockIDiamondCut) Save(ctx context.Context, m *storage.DiamondCut) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Save", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockIDiamondCutMockRecorder) Save(ctx, m any) *MockIDiamondCutSaveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockIDiamondCut)(nil).Save), ctx, m)
+	return &MockIDiamondCutSaveCall{Call: call}
+}
+
+// MockIDiamondCutSaveCall wrap *gomock.Call
+type MockIDiamondCutSaveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIDiamondCutSaveCall) Return(arg0 error) *MockIDiamondCutSaveCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIDiamondCutSaveCall) Do(f func(context.Context, *storage.DiamondCut) error) *MockIDiamondCutSaveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIDiamondCutSaveCall) DoAndReturn(f func(context.Context, *storage.DiamondCut) error) *MockIDiamondCutSaveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Update mocks base method.
+func (m_2 *MockIDiamondCut) Update(ctx context.Context, m *storage.DiamondCut) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Update", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockIDiamondCutMockRecorder) Update(ctx, m any) *MockIDiamondCutUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIDiamondCut)(nil).Update), ctx, m)
+	return &MockIDiamondCutUpdateCall{Call: call}
+}
+
+// MockIDiamondCutUpdateCall wrap *gomock.Call
+type MockIDiamondCutUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIDiamondCutUpdateCall) Return(arg0 error) *MockIDiamondCutUpdateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIDiamondCutUpdateCall) Do(f func(context.Context, *storage.DiamondCut) error) *MockIDiamondCutUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIDiamondCutUpdateCall) DoAndReturn(f func(context.Context, *storage.DiamondCut) error) *MockIDiamondCutUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,313 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: diamond_facet.go
+//
+// Generated by this command:
+//
+//	mockgen -source=diamond_facet.go -destination=mock/diamond_facet.go -package=mock -typed
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	storage "github.com/NobleScope/noble-indexer/internal/storage"
+	storage0 "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIDiamondFacet is a mock of IDiamondFacet interface.
+type MockIDiamondFacet struct {
+	ctrl     *gomock.Controller
+	recorder *MockIDiamondFacetMockRecorder
+	isgomock struct{}
+}
+
+// MockIDiamondFacetMockRecorder is the mock recorder for MockIDiamondFacet.
+type MockIDiamondFacetMockRecorder struct {
+	mock *MockIDiamondFacet
+}
+
+// NewMockIDiamondFacet creates a new mock instance.
+func NewMockIDiamondFacet(ctrl *gomock.Controller) *MockIDiamondFacet {
+	mock := &MockIDiamondFacet{ctrl: ctrl}
+	mock.recorder = &MockIDiamondFacetMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIDiamondFacet) EXPECT() *MockIDiamondFacetMockRecorder {
+	return m.recorder
+}
+
+// CursorList mocks base method.
+func (m *MockIDiamondFacet) CursorList(ctx context.Context, id, limit uint64, order storage0.SortOrder, cmp storage0.Comparator) ([]*storage.DiamondFacet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CursorList", ctx, id, limit, order, cmp)
+	ret0, _ := ret[0].([]*storage.DiamondFacet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CursorList indicates an expected call of CursorList.
+func (mr *MockIDiamondFacetMockRecorder) CursorList(ctx, id, limit, order, cmp any) *MockIDiamondFacetCursorListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CursorList", reflect.TypeOf((*MockIDiamondFacet)(nil).CursorList), ctx, id, limit, order, cmp)
+	return &MockIDiamondFacetCursorListCall{Call: call}
+}
+
+// MockIDiamondFacetCursorListCall wrap *gomock.Call
+type MockIDiamondFacetCursorListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIDiamondFacetCursorListCall) Return(arg0 []*storage.DiamondFacet, arg1 error) *MockIDiamondFacetCursorListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIDiamondFacetCursorListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.DiamondFacet, error)) *MockIDiamondFacetCursorListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIDiamondFacetCursorListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.DiamondFacet, error)) *MockIDiamondFacetCursorListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByID mocks base method.
+func (m *MockIDiamondFacet) GetByID(ctx context.Context, id uint64) (*storage.DiamondFacet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*storage.DiamondFacet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockIDiamondFacetMockRecorder) GetByID(ctx, id any) *MockIDiamondFacetGetByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockIDiamondFacet)(nil).GetByID), ctx, id)
+	return &MockIDiamondFacetGetByIDCall{Call: call}
+}
+
+// MockIDiamondFacetGetByIDCall wrap *gomock.Call
+type MockIDiamondFacetGetByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIDiamondFacetGetByIDCall) Return(arg0 *storage.DiamondFacet, arg1 error) *MockIDiamondFacetGetByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIDiamondFacetGetByIDCall) Do(f func(context.Context, uint64) (*storage.DiamondFacet, error)) *MockIDiamondFacetGetByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIDiamondFacetGetByIDCall) DoAndReturn(f func(context.Context, uint64) (*storage.DiamondFacet, error)) *MockIDiamondFacetGetByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// IsNoRows mocks base method.
+func (m *MockIDiamondFacet) IsNoRows(err error) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsNoRows", err)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsNoRows indicates an expected call of IsNoRows.
+func (mr *MockIDiamondFacetMockRecorder) IsNoRows(err any) *MockIDiamondFacetIsNoRowsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsNoRows", reflect.TypeOf((*MockIDiamondFacet)(nil).IsNoRows), err)
+	return &MockIDiamondFacetIsNoRowsCall{Call: call}
+}
+
+// MockIDiamondFacetIsNoRowsCall wrap *gomock.Call
+type MockIDiamondFacetIsNoRowsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIDiamondFacetIsNoRowsCall) Return(arg0 bool) *MockIDiamondFacetIsNoRowsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIDiamondFacetIsNoRowsCall) Do(f func(error) bool) *MockIDiamondFacetIsNoRowsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIDiamondFacetIsNoRowsCall) DoAndReturn(f func(error) bool) *MockIDiamondFacetIsNoRowsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LastID mocks base method.
+func (m *MockIDiamondFacet) LastID(ctx context.Context) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastID", ctx)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastID indicates an expected call of LastID.
+func (mr *MockIDiamondFacetMockRecorder) LastID(ctx any) *MockIDiamondFacetLastIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastID", reflect.TypeOf((*MockIDiamondFacet)(nil).LastID), ctx)
+	return &MockIDiamondFacetLastIDCall{Call: call}
+}
+
+// MockIDiamondFacetLastIDCall wrap *gomock.Call
+type MockIDiamondFacetLastIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIDiamondFacetLastIDCall) Return(arg0 uint64, arg1 error) *MockIDiamondFacetLastIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIDiamondFacetLastIDCall) Do(f func(context.Context) (uint64, error)) *MockIDiamondFacetLastIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIDiamondFacetLastIDCall) DoAndReturn(f func(context.Context) (uint64, error)) *MockIDiamondFacetLastIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MockIDiamondFacet) List(ctx context.Context, limit, offset uint64, order storage0.SortOrder) ([]*storage.DiamondFacet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, limit, offset, order)
+	ret0, _ := ret[0].([]*storage.DiamondFacet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockIDiamondFacetMockRecorder) List(ctx, limit, offset, order any) *MockIDiamondFacetListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockIDiamondFacet)(nil).List), ctx, limit, offset, order)
+	return &MockIDiamondFacetListCall{Call: call}
+}
+
+// MockIDiamondFacetListCall wrap *gomock.Call
+type MockIDiamondFacetListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIDiamondFacetListCall) Return(arg0 []*storage.DiamondFacet, arg1 error) *MockIDiamondFacetListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIDiamondFacetListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.DiamondFacet, error)) *MockIDiamondFacetListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIDiamondFacetListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.DiamondFacet, error)) *MockIDiamondFacetListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Save mocks base method.
+func (m_2 *MockIDiamondFacet) Save(ctx context.Context, m *storage.DiamondFacet) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Save", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockIDiamondFacetMockRecorder) Save(ctx, m any) *MockIDiamondFacetSaveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockIDiamondFacet)(nil).Save), ctx, m)
+	return &MockIDiamondFacetSaveCall{Call: call}
+}
+
+// MockIDiamondFacetSaveCall wrap *gomock.Call
+type MockIDiamondFacetSaveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIDiamondFacetSaveCall) Return(arg0 error) *MockIDiamondFacetSaveCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIDiamondFacetSaveCall) Do(f func(context.Context, *storage.DiamondFacet) error) *MockIDiamondFacetSaveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIDiamondFacetSaveCall) DoAndReturn(f func(context.Context, *storage.DiamondFacet) error) *MockIDiamondFacetSaveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Update mocks base method.
+func (m_2 *MockIDiamondFacet) Update(ctx context.Context, m *storage.DiamondFacet) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Update", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockIDiamondFacetMockRecorder) Update(ctx, m any) *MockIDiamondFacetUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIDiamondFacet)(nil).Update), ctx, m)
+	return &MockIDiamondFacetUpdateCall{Call: call}
+}
+
+// MockIDiamondFacetUpdateCall wrap *gomock.Call
+type MockIDiamondFacetUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIDiamondFacetUpdateCall) Return(arg0 error) *MockIDiamondFacetUpdateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIDiamondFacetUpdateCall) Do(f func(context.Context, *storage.DiamondFacet) error) *MockIDiamondFacetUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIDiamondFacetUpdateCall) DoAndReturn(f func(context.Context, *storage.DiamondFacet) error) *MockIDiamondFacetUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
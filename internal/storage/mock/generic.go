@@ -12,9 +12,11 @@ package mock
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	storage "github.com/NobleScope/noble-indexer/internal/storage"
-	types "github.com/NobleScope/noble-indexer/pkg/types"
+	types "github.com/NobleScope/noble-indexer/internal/storage/types"
+	types0 "github.com/NobleScope/noble-indexer/pkg/types"
 	storage0 "github.com/dipdup-net/indexer-sdk/pkg/storage"
 	pq "github.com/lib/pq"
 	bun "github.com/uptrace/bun"
@@ -159,6 +161,45 @@ func (c *MockTransactionBulkSaveCall) DoAndReturn(f func(context.Context, []any)
 	return c
 }
 
+// ClaimVerificationTasks mocks base method.
+func (m *MockTransaction) ClaimVerificationTasks(ctx context.Context, limit int, staleBefore time.Time) ([]storage.VerificationTask, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimVerificationTasks", ctx, limit, staleBefore)
+	ret0, _ := ret[0].([]storage.VerificationTask)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimVerificationTasks indicates an expected call of ClaimVerificationTasks.
+func (mr *MockTransactionMockRecorder) ClaimVerificationTasks(ctx, limit, staleBefore any) *MockTransactionClaimVerificationTasksCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimVerificationTasks", reflect.TypeOf((*MockTransaction)(nil).ClaimVerificationTasks), ctx, limit, staleBefore)
+	return &MockTransactionClaimVerificationTasksCall{Call: call}
+}
+
+// MockTransactionClaimVerificationTasksCall wrap *gomock.Call
+type MockTransactionClaimVerificationTasksCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionClaimVerificationTasksCall) Return(arg0 []storage.VerificationTask, arg1 error) *MockTransactionClaimVerificationTasksCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionClaimVerificationTasksCall) Do(f func(context.Context, int, time.Time) ([]storage.VerificationTask, error)) *MockTransactionClaimVerificationTasksCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionClaimVerificationTasksCall) DoAndReturn(f func(context.Context, int, time.Time) ([]storage.VerificationTask, error)) *MockTransactionClaimVerificationTasksCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Close mocks base method.
 func (m *MockTransaction) Close(ctx context.Context) error {
 	m.ctrl.T.Helper()
@@ -235,6 +276,49 @@ func (c *MockTransactionCopyFromCall) DoAndReturn(f func(context.Context, string
 	return c
 }
 
+// CopySources mocks base method.
+func (m *MockTransaction) CopySources(ctx context.Context, sourceContractId uint64, contractIds ...uint64) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, sourceContractId}
+	for _, a := range contractIds {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CopySources", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CopySources indicates an expected call of CopySources.
+func (mr *MockTransactionMockRecorder) CopySources(ctx, sourceContractId any, contractIds ...any) *MockTransactionCopySourcesCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, sourceContractId}, contractIds...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopySources", reflect.TypeOf((*MockTransaction)(nil).CopySources), varargs...)
+	return &MockTransactionCopySourcesCall{Call: call}
+}
+
+// MockTransactionCopySourcesCall wrap *gomock.Call
+type MockTransactionCopySourcesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionCopySourcesCall) Return(arg0 error) *MockTransactionCopySourcesCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionCopySourcesCall) Do(f func(context.Context, uint64, ...uint64) error) *MockTransactionCopySourcesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionCopySourcesCall) DoAndReturn(f func(context.Context, uint64, ...uint64) error) *MockTransactionCopySourcesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // DeleteBalances mocks base method.
 func (m *MockTransaction) DeleteBalances(ctx context.Context, ids []uint64) error {
 	m.ctrl.T.Helper()
@@ -273,6 +357,87 @@ func (c *MockTransactionDeleteBalancesCall) DoAndReturn(f func(context.Context,
 	return c
 }
 
+// DeleteDiamondFacets mocks base method.
+func (m *MockTransaction) DeleteDiamondFacets(ctx context.Context, facets ...*storage.DiamondFacet) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range facets {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteDiamondFacets", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDiamondFacets indicates an expected call of DeleteDiamondFacets.
+func (mr *MockTransactionMockRecorder) DeleteDiamondFacets(ctx any, facets ...any) *MockTransactionDeleteDiamondFacetsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, facets...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDiamondFacets", reflect.TypeOf((*MockTransaction)(nil).DeleteDiamondFacets), varargs...)
+	return &MockTransactionDeleteDiamondFacetsCall{Call: call}
+}
+
+// MockTransactionDeleteDiamondFacetsCall wrap *gomock.Call
+type MockTransactionDeleteDiamondFacetsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionDeleteDiamondFacetsCall) Return(arg0 error) *MockTransactionDeleteDiamondFacetsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionDeleteDiamondFacetsCall) Do(f func(context.Context, ...*storage.DiamondFacet) error) *MockTransactionDeleteDiamondFacetsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionDeleteDiamondFacetsCall) DoAndReturn(f func(context.Context, ...*storage.DiamondFacet) error) *MockTransactionDeleteDiamondFacetsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteState mocks base method.
+func (m *MockTransaction) DeleteState(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteState", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteState indicates an expected call of DeleteState.
+func (mr *MockTransactionMockRecorder) DeleteState(ctx, name any) *MockTransactionDeleteStateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteState", reflect.TypeOf((*MockTransaction)(nil).DeleteState), ctx, name)
+	return &MockTransactionDeleteStateCall{Call: call}
+}
+
+// MockTransactionDeleteStateCall wrap *gomock.Call
+type MockTransactionDeleteStateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionDeleteStateCall) Return(arg0 error) *MockTransactionDeleteStateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionDeleteStateCall) Do(f func(context.Context, string) error) *MockTransactionDeleteStateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionDeleteStateCall) DoAndReturn(f func(context.Context, string) error) *MockTransactionDeleteStateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // DeleteTokenBalances mocks base method.
 func (m *MockTransaction) DeleteTokenBalances(ctx context.Context, tokenIds []string, contractIds []uint64, zeroBalances []*storage.TokenBalance) error {
 	m.ctrl.T.Helper()
@@ -469,353 +634,939 @@ func (c *MockTransactionHandleErrorCall) DoAndReturn(f func(context.Context, err
 	return c
 }
 
-// LastBlock mocks base method.
-func (m *MockTransaction) LastBlock(ctx context.Context) (storage.Block, error) {
+// IncrementVerifiedContracts mocks base method.
+func (m *MockTransaction) IncrementVerifiedContracts(ctx context.Context, indexerName string, count int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "LastBlock", ctx)
-	ret0, _ := ret[0].(storage.Block)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "IncrementVerifiedContracts", ctx, indexerName, count)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// LastBlock indicates an expected call of LastBlock.
-func (mr *MockTransactionMockRecorder) LastBlock(ctx any) *MockTransactionLastBlockCall {
+// IncrementVerifiedContracts indicates an expected call of IncrementVerifiedContracts.
+func (mr *MockTransactionMockRecorder) IncrementVerifiedContracts(ctx, indexerName, count any) *MockTransactionIncrementVerifiedContractsCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastBlock", reflect.TypeOf((*MockTransaction)(nil).LastBlock), ctx)
-	return &MockTransactionLastBlockCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementVerifiedContracts", reflect.TypeOf((*MockTransaction)(nil).IncrementVerifiedContracts), ctx, indexerName, count)
+	return &MockTransactionIncrementVerifiedContractsCall{Call: call}
 }
 
-// MockTransactionLastBlockCall wrap *gomock.Call
-type MockTransactionLastBlockCall struct {
+// MockTransactionIncrementVerifiedContractsCall wrap *gomock.Call
+type MockTransactionIncrementVerifiedContractsCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockTransactionLastBlockCall) Return(block storage.Block, err error) *MockTransactionLastBlockCall {
-	c.Call = c.Call.Return(block, err)
+func (c *MockTransactionIncrementVerifiedContractsCall) Return(arg0 error) *MockTransactionIncrementVerifiedContractsCall {
+	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockTransactionLastBlockCall) Do(f func(context.Context) (storage.Block, error)) *MockTransactionLastBlockCall {
+func (c *MockTransactionIncrementVerifiedContractsCall) Do(f func(context.Context, string, int) error) *MockTransactionIncrementVerifiedContractsCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockTransactionLastBlockCall) DoAndReturn(f func(context.Context) (storage.Block, error)) *MockTransactionLastBlockCall {
+func (c *MockTransactionIncrementVerifiedContractsCall) DoAndReturn(f func(context.Context, string, int) error) *MockTransactionIncrementVerifiedContractsCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// Rollback mocks base method.
-func (m *MockTransaction) Rollback(ctx context.Context) error {
+// LastBlock mocks base method.
+func (m *MockTransaction) LastBlock(ctx context.Context) (storage.Block, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Rollback", ctx)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "LastBlock", ctx)
+	ret0, _ := ret[0].(storage.Block)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// Rollback indicates an expected call of Rollback.
-func (mr *MockTransactionMockRecorder) Rollback(ctx any) *MockTransactionRollbackCall {
+// LastBlock indicates an expected call of LastBlock.
+func (mr *MockTransactionMockRecorder) LastBlock(ctx any) *MockTransactionLastBlockCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rollback", reflect.TypeOf((*MockTransaction)(nil).Rollback), ctx)
-	return &MockTransactionRollbackCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastBlock", reflect.TypeOf((*MockTransaction)(nil).LastBlock), ctx)
+	return &MockTransactionLastBlockCall{Call: call}
 }
 
-// MockTransactionRollbackCall wrap *gomock.Call
-type MockTransactionRollbackCall struct {
+// MockTransactionLastBlockCall wrap *gomock.Call
+type MockTransactionLastBlockCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockTransactionRollbackCall) Return(arg0 error) *MockTransactionRollbackCall {
-	c.Call = c.Call.Return(arg0)
+func (c *MockTransactionLastBlockCall) Return(block storage.Block, err error) *MockTransactionLastBlockCall {
+	c.Call = c.Call.Return(block, err)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockTransactionRollbackCall) Do(f func(context.Context) error) *MockTransactionRollbackCall {
+func (c *MockTransactionLastBlockCall) Do(f func(context.Context) (storage.Block, error)) *MockTransactionLastBlockCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockTransactionRollbackCall) DoAndReturn(f func(context.Context) error) *MockTransactionRollbackCall {
+func (c *MockTransactionLastBlockCall) DoAndReturn(f func(context.Context) (storage.Block, error)) *MockTransactionLastBlockCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// RollbackAddresses mocks base method.
-func (m *MockTransaction) RollbackAddresses(ctx context.Context, height types.Level) ([]storage.Address, error) {
+// LastBlockInRange mocks base method.
+func (m *MockTransaction) LastBlockInRange(ctx context.Context, start, end types0.Level) (storage.Block, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RollbackAddresses", ctx, height)
-	ret0, _ := ret[0].([]storage.Address)
+	ret := m.ctrl.Call(m, "LastBlockInRange", ctx, start, end)
+	ret0, _ := ret[0].(storage.Block)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// RollbackAddresses indicates an expected call of RollbackAddresses.
-func (mr *MockTransactionMockRecorder) RollbackAddresses(ctx, height any) *MockTransactionRollbackAddressesCall {
+// LastBlockInRange indicates an expected call of LastBlockInRange.
+func (mr *MockTransactionMockRecorder) LastBlockInRange(ctx, start, end any) *MockTransactionLastBlockInRangeCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackAddresses", reflect.TypeOf((*MockTransaction)(nil).RollbackAddresses), ctx, height)
-	return &MockTransactionRollbackAddressesCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastBlockInRange", reflect.TypeOf((*MockTransaction)(nil).LastBlockInRange), ctx, start, end)
+	return &MockTransactionLastBlockInRangeCall{Call: call}
 }
 
-// MockTransactionRollbackAddressesCall wrap *gomock.Call
-type MockTransactionRollbackAddressesCall struct {
+// MockTransactionLastBlockInRangeCall wrap *gomock.Call
+type MockTransactionLastBlockInRangeCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockTransactionRollbackAddressesCall) Return(addresses []storage.Address, err error) *MockTransactionRollbackAddressesCall {
-	c.Call = c.Call.Return(addresses, err)
+func (c *MockTransactionLastBlockInRangeCall) Return(block storage.Block, err error) *MockTransactionLastBlockInRangeCall {
+	c.Call = c.Call.Return(block, err)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockTransactionRollbackAddressesCall) Do(f func(context.Context, types.Level) ([]storage.Address, error)) *MockTransactionRollbackAddressesCall {
+func (c *MockTransactionLastBlockInRangeCall) Do(f func(context.Context, types0.Level, types0.Level) (storage.Block, error)) *MockTransactionLastBlockInRangeCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockTransactionRollbackAddressesCall) DoAndReturn(f func(context.Context, types.Level) ([]storage.Address, error)) *MockTransactionRollbackAddressesCall {
+func (c *MockTransactionLastBlockInRangeCall) DoAndReturn(f func(context.Context, types0.Level, types0.Level) (storage.Block, error)) *MockTransactionLastBlockInRangeCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// RollbackBeaconWithdrawals mocks base method.
-func (m *MockTransaction) RollbackBeaconWithdrawals(ctx context.Context, height types.Level) error {
+// RecalculateBalanceHistory mocks base method.
+func (m *MockTransaction) RecalculateBalanceHistory(ctx context.Context, from, to types0.Level) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RollbackBeaconWithdrawals", ctx, height)
+	ret := m.ctrl.Call(m, "RecalculateBalanceHistory", ctx, from, to)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// RollbackBeaconWithdrawals indicates an expected call of RollbackBeaconWithdrawals.
-func (mr *MockTransactionMockRecorder) RollbackBeaconWithdrawals(ctx, height any) *MockTransactionRollbackBeaconWithdrawalsCall {
+// RecalculateBalanceHistory indicates an expected call of RecalculateBalanceHistory.
+func (mr *MockTransactionMockRecorder) RecalculateBalanceHistory(ctx, from, to any) *MockTransactionRecalculateBalanceHistoryCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackBeaconWithdrawals", reflect.TypeOf((*MockTransaction)(nil).RollbackBeaconWithdrawals), ctx, height)
-	return &MockTransactionRollbackBeaconWithdrawalsCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecalculateBalanceHistory", reflect.TypeOf((*MockTransaction)(nil).RecalculateBalanceHistory), ctx, from, to)
+	return &MockTransactionRecalculateBalanceHistoryCall{Call: call}
 }
 
-// MockTransactionRollbackBeaconWithdrawalsCall wrap *gomock.Call
-type MockTransactionRollbackBeaconWithdrawalsCall struct {
+// MockTransactionRecalculateBalanceHistoryCall wrap *gomock.Call
+type MockTransactionRecalculateBalanceHistoryCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockTransactionRollbackBeaconWithdrawalsCall) Return(arg0 error) *MockTransactionRollbackBeaconWithdrawalsCall {
+func (c *MockTransactionRecalculateBalanceHistoryCall) Return(arg0 error) *MockTransactionRecalculateBalanceHistoryCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockTransactionRollbackBeaconWithdrawalsCall) Do(f func(context.Context, types.Level) error) *MockTransactionRollbackBeaconWithdrawalsCall {
+func (c *MockTransactionRecalculateBalanceHistoryCall) Do(f func(context.Context, types0.Level, types0.Level) error) *MockTransactionRecalculateBalanceHistoryCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockTransactionRollbackBeaconWithdrawalsCall) DoAndReturn(f func(context.Context, types.Level) error) *MockTransactionRollbackBeaconWithdrawalsCall {
+func (c *MockTransactionRecalculateBalanceHistoryCall) DoAndReturn(f func(context.Context, types0.Level, types0.Level) error) *MockTransactionRecalculateBalanceHistoryCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// RollbackBlock mocks base method.
-func (m *MockTransaction) RollbackBlock(ctx context.Context, height types.Level) error {
+// RecalculateTokenBalanceHistory mocks base method.
+func (m *MockTransaction) RecalculateTokenBalanceHistory(ctx context.Context, from, to types0.Level) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RollbackBlock", ctx, height)
+	ret := m.ctrl.Call(m, "RecalculateTokenBalanceHistory", ctx, from, to)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// RollbackBlock indicates an expected call of RollbackBlock.
-func (mr *MockTransactionMockRecorder) RollbackBlock(ctx, height any) *MockTransactionRollbackBlockCall {
+// RecalculateTokenBalanceHistory indicates an expected call of RecalculateTokenBalanceHistory.
+func (mr *MockTransactionMockRecorder) RecalculateTokenBalanceHistory(ctx, from, to any) *MockTransactionRecalculateTokenBalanceHistoryCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackBlock", reflect.TypeOf((*MockTransaction)(nil).RollbackBlock), ctx, height)
-	return &MockTransactionRollbackBlockCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecalculateTokenBalanceHistory", reflect.TypeOf((*MockTransaction)(nil).RecalculateTokenBalanceHistory), ctx, from, to)
+	return &MockTransactionRecalculateTokenBalanceHistoryCall{Call: call}
 }
 
-// MockTransactionRollbackBlockCall wrap *gomock.Call
-type MockTransactionRollbackBlockCall struct {
+// MockTransactionRecalculateTokenBalanceHistoryCall wrap *gomock.Call
+type MockTransactionRecalculateTokenBalanceHistoryCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockTransactionRollbackBlockCall) Return(arg0 error) *MockTransactionRollbackBlockCall {
+func (c *MockTransactionRecalculateTokenBalanceHistoryCall) Return(arg0 error) *MockTransactionRecalculateTokenBalanceHistoryCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockTransactionRollbackBlockCall) Do(f func(context.Context, types.Level) error) *MockTransactionRollbackBlockCall {
+func (c *MockTransactionRecalculateTokenBalanceHistoryCall) Do(f func(context.Context, types0.Level, types0.Level) error) *MockTransactionRecalculateTokenBalanceHistoryCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockTransactionRollbackBlockCall) DoAndReturn(f func(context.Context, types.Level) error) *MockTransactionRollbackBlockCall {
+func (c *MockTransactionRecalculateTokenBalanceHistoryCall) DoAndReturn(f func(context.Context, types0.Level, types0.Level) error) *MockTransactionRecalculateTokenBalanceHistoryCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// RollbackBlockStats mocks base method.
-func (m *MockTransaction) RollbackBlockStats(ctx context.Context, height types.Level) (storage.BlockStats, error) {
+// ResetDecodedLogs mocks base method.
+func (m *MockTransaction) ResetDecodedLogs(ctx context.Context, contractIds ...uint64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RollbackBlockStats", ctx, height)
-	ret0, _ := ret[0].(storage.BlockStats)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	varargs := []any{ctx}
+	for _, a := range contractIds {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResetDecodedLogs", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// RollbackBlockStats indicates an expected call of RollbackBlockStats.
-func (mr *MockTransactionMockRecorder) RollbackBlockStats(ctx, height any) *MockTransactionRollbackBlockStatsCall {
+// ResetDecodedLogs indicates an expected call of ResetDecodedLogs.
+func (mr *MockTransactionMockRecorder) ResetDecodedLogs(ctx any, contractIds ...any) *MockTransactionResetDecodedLogsCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackBlockStats", reflect.TypeOf((*MockTransaction)(nil).RollbackBlockStats), ctx, height)
-	return &MockTransactionRollbackBlockStatsCall{Call: call}
+	varargs := append([]any{ctx}, contractIds...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetDecodedLogs", reflect.TypeOf((*MockTransaction)(nil).ResetDecodedLogs), varargs...)
+	return &MockTransactionResetDecodedLogsCall{Call: call}
 }
 
-// MockTransactionRollbackBlockStatsCall wrap *gomock.Call
-type MockTransactionRollbackBlockStatsCall struct {
+// MockTransactionResetDecodedLogsCall wrap *gomock.Call
+type MockTransactionResetDecodedLogsCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockTransactionRollbackBlockStatsCall) Return(stats storage.BlockStats, err error) *MockTransactionRollbackBlockStatsCall {
-	c.Call = c.Call.Return(stats, err)
+func (c *MockTransactionResetDecodedLogsCall) Return(arg0 error) *MockTransactionResetDecodedLogsCall {
+	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockTransactionRollbackBlockStatsCall) Do(f func(context.Context, types.Level) (storage.BlockStats, error)) *MockTransactionRollbackBlockStatsCall {
+func (c *MockTransactionResetDecodedLogsCall) Do(f func(context.Context, ...uint64) error) *MockTransactionResetDecodedLogsCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockTransactionRollbackBlockStatsCall) DoAndReturn(f func(context.Context, types.Level) (storage.BlockStats, error)) *MockTransactionRollbackBlockStatsCall {
+func (c *MockTransactionResetDecodedLogsCall) DoAndReturn(f func(context.Context, ...uint64) error) *MockTransactionResetDecodedLogsCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// RollbackContracts mocks base method.
-func (m *MockTransaction) RollbackContracts(ctx context.Context, height types.Level) error {
+// ResetSimilarChecked mocks base method.
+func (m *MockTransaction) ResetSimilarChecked(ctx context.Context, contractIds ...uint64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RollbackContracts", ctx, height)
+	varargs := []any{ctx}
+	for _, a := range contractIds {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResetSimilarChecked", varargs...)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// RollbackContracts indicates an expected call of RollbackContracts.
-func (mr *MockTransactionMockRecorder) RollbackContracts(ctx, height any) *MockTransactionRollbackContractsCall {
+// ResetSimilarChecked indicates an expected call of ResetSimilarChecked.
+func (mr *MockTransactionMockRecorder) ResetSimilarChecked(ctx any, contractIds ...any) *MockTransactionResetSimilarCheckedCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackContracts", reflect.TypeOf((*MockTransaction)(nil).RollbackContracts), ctx, height)
-	return &MockTransactionRollbackContractsCall{Call: call}
+	varargs := append([]any{ctx}, contractIds...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetSimilarChecked", reflect.TypeOf((*MockTransaction)(nil).ResetSimilarChecked), varargs...)
+	return &MockTransactionResetSimilarCheckedCall{Call: call}
 }
 
-// MockTransactionRollbackContractsCall wrap *gomock.Call
-type MockTransactionRollbackContractsCall struct {
+// MockTransactionResetSimilarCheckedCall wrap *gomock.Call
+type MockTransactionResetSimilarCheckedCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockTransactionRollbackContractsCall) Return(arg0 error) *MockTransactionRollbackContractsCall {
+func (c *MockTransactionResetSimilarCheckedCall) Return(arg0 error) *MockTransactionResetSimilarCheckedCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockTransactionRollbackContractsCall) Do(f func(context.Context, types.Level) error) *MockTransactionRollbackContractsCall {
+func (c *MockTransactionResetSimilarCheckedCall) Do(f func(context.Context, ...uint64) error) *MockTransactionResetSimilarCheckedCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockTransactionRollbackContractsCall) DoAndReturn(f func(context.Context, types.Level) error) *MockTransactionRollbackContractsCall {
+func (c *MockTransactionResetSimilarCheckedCall) DoAndReturn(f func(context.Context, ...uint64) error) *MockTransactionResetSimilarCheckedCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// RollbackERC4337UserOps mocks base method.
-func (m *MockTransaction) RollbackERC4337UserOps(ctx context.Context, height types.Level) error {
+// ResetUnknownLogs mocks base method.
+func (m *MockTransaction) ResetUnknownLogs(ctx context.Context, signatures ...*storage.Signature) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RollbackERC4337UserOps", ctx, height)
+	varargs := []any{ctx}
+	for _, a := range signatures {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResetUnknownLogs", varargs...)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// RollbackERC4337UserOps indicates an expected call of RollbackERC4337UserOps.
-func (mr *MockTransactionMockRecorder) RollbackERC4337UserOps(ctx, height any) *MockTransactionRollbackERC4337UserOpsCall {
+// ResetUnknownLogs indicates an expected call of ResetUnknownLogs.
+func (mr *MockTransactionMockRecorder) ResetUnknownLogs(ctx any, signatures ...any) *MockTransactionResetUnknownLogsCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackERC4337UserOps", reflect.TypeOf((*MockTransaction)(nil).RollbackERC4337UserOps), ctx, height)
-	return &MockTransactionRollbackERC4337UserOpsCall{Call: call}
+	varargs := append([]any{ctx}, signatures...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetUnknownLogs", reflect.TypeOf((*MockTransaction)(nil).ResetUnknownLogs), varargs...)
+	return &MockTransactionResetUnknownLogsCall{Call: call}
 }
 
-// MockTransactionRollbackERC4337UserOpsCall wrap *gomock.Call
-type MockTransactionRollbackERC4337UserOpsCall struct {
+// MockTransactionResetUnknownLogsCall wrap *gomock.Call
+type MockTransactionResetUnknownLogsCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockTransactionRollbackERC4337UserOpsCall) Return(arg0 error) *MockTransactionRollbackERC4337UserOpsCall {
+func (c *MockTransactionResetUnknownLogsCall) Return(arg0 error) *MockTransactionResetUnknownLogsCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockTransactionRollbackERC4337UserOpsCall) Do(f func(context.Context, types.Level) error) *MockTransactionRollbackERC4337UserOpsCall {
+func (c *MockTransactionResetUnknownLogsCall) Do(f func(context.Context, ...*storage.Signature) error) *MockTransactionResetUnknownLogsCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockTransactionRollbackERC4337UserOpsCall) DoAndReturn(f func(context.Context, types.Level) error) *MockTransactionRollbackERC4337UserOpsCall {
+func (c *MockTransactionResetUnknownLogsCall) DoAndReturn(f func(context.Context, ...*storage.Signature) error) *MockTransactionResetUnknownLogsCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// RollbackLogs mocks base method.
-func (m *MockTransaction) RollbackLogs(ctx context.Context, height types.Level) error {
+// Rollback mocks base method.
+func (m *MockTransaction) Rollback(ctx context.Context) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RollbackLogs", ctx, height)
+	ret := m.ctrl.Call(m, "Rollback", ctx)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// RollbackLogs indicates an expected call of RollbackLogs.
-func (mr *MockTransactionMockRecorder) RollbackLogs(ctx, height any) *MockTransactionRollbackLogsCall {
+// Rollback indicates an expected call of Rollback.
+func (mr *MockTransactionMockRecorder) Rollback(ctx any) *MockTransactionRollbackCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackLogs", reflect.TypeOf((*MockTransaction)(nil).RollbackLogs), ctx, height)
-	return &MockTransactionRollbackLogsCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rollback", reflect.TypeOf((*MockTransaction)(nil).Rollback), ctx)
+	return &MockTransactionRollbackCall{Call: call}
 }
 
-// MockTransactionRollbackLogsCall wrap *gomock.Call
-type MockTransactionRollbackLogsCall struct {
+// MockTransactionRollbackCall wrap *gomock.Call
+type MockTransactionRollbackCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockTransactionRollbackLogsCall) Return(arg0 error) *MockTransactionRollbackLogsCall {
+func (c *MockTransactionRollbackCall) Return(arg0 error) *MockTransactionRollbackCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockTransactionRollbackLogsCall) Do(f func(context.Context, types.Level) error) *MockTransactionRollbackLogsCall {
+func (c *MockTransactionRollbackCall) Do(f func(context.Context) error) *MockTransactionRollbackCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockTransactionRollbackLogsCall) DoAndReturn(f func(context.Context, types.Level) error) *MockTransactionRollbackLogsCall {
+func (c *MockTransactionRollbackCall) DoAndReturn(f func(context.Context) error) *MockTransactionRollbackCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// RollbackTokens mocks base method.
-func (m *MockTransaction) RollbackTokens(ctx context.Context, height types.Level) ([]storage.Token, error) {
+// RollbackAddresses mocks base method.
+func (m *MockTransaction) RollbackAddresses(ctx context.Context, height types0.Level) ([]storage.Address, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackAddresses", ctx, height)
+	ret0, _ := ret[0].([]storage.Address)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RollbackAddresses indicates an expected call of RollbackAddresses.
+func (mr *MockTransactionMockRecorder) RollbackAddresses(ctx, height any) *MockTransactionRollbackAddressesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackAddresses", reflect.TypeOf((*MockTransaction)(nil).RollbackAddresses), ctx, height)
+	return &MockTransactionRollbackAddressesCall{Call: call}
+}
+
+// MockTransactionRollbackAddressesCall wrap *gomock.Call
+type MockTransactionRollbackAddressesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionRollbackAddressesCall) Return(addresses []storage.Address, err error) *MockTransactionRollbackAddressesCall {
+	c.Call = c.Call.Return(addresses, err)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionRollbackAddressesCall) Do(f func(context.Context, types0.Level) ([]storage.Address, error)) *MockTransactionRollbackAddressesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionRollbackAddressesCall) DoAndReturn(f func(context.Context, types0.Level) ([]storage.Address, error)) *MockTransactionRollbackAddressesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RollbackAllowances mocks base method.
+func (m *MockTransaction) RollbackAllowances(ctx context.Context, height types0.Level) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackAllowances", ctx, height)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackAllowances indicates an expected call of RollbackAllowances.
+func (mr *MockTransactionMockRecorder) RollbackAllowances(ctx, height any) *MockTransactionRollbackAllowancesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackAllowances", reflect.TypeOf((*MockTransaction)(nil).RollbackAllowances), ctx, height)
+	return &MockTransactionRollbackAllowancesCall{Call: call}
+}
+
+// MockTransactionRollbackAllowancesCall wrap *gomock.Call
+type MockTransactionRollbackAllowancesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionRollbackAllowancesCall) Return(arg0 error) *MockTransactionRollbackAllowancesCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionRollbackAllowancesCall) Do(f func(context.Context, types0.Level) error) *MockTransactionRollbackAllowancesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionRollbackAllowancesCall) DoAndReturn(f func(context.Context, types0.Level) error) *MockTransactionRollbackAllowancesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RollbackApprovals mocks base method.
+func (m *MockTransaction) RollbackApprovals(ctx context.Context, height types0.Level) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackApprovals", ctx, height)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackApprovals indicates an expected call of RollbackApprovals.
+func (mr *MockTransactionMockRecorder) RollbackApprovals(ctx, height any) *MockTransactionRollbackApprovalsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackApprovals", reflect.TypeOf((*MockTransaction)(nil).RollbackApprovals), ctx, height)
+	return &MockTransactionRollbackApprovalsCall{Call: call}
+}
+
+// MockTransactionRollbackApprovalsCall wrap *gomock.Call
+type MockTransactionRollbackApprovalsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionRollbackApprovalsCall) Return(arg0 error) *MockTransactionRollbackApprovalsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionRollbackApprovalsCall) Do(f func(context.Context, types0.Level) error) *MockTransactionRollbackApprovalsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionRollbackApprovalsCall) DoAndReturn(f func(context.Context, types0.Level) error) *MockTransactionRollbackApprovalsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RollbackBalanceHistory mocks base method.
+func (m *MockTransaction) RollbackBalanceHistory(ctx context.Context, height types0.Level) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackBalanceHistory", ctx, height)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackBalanceHistory indicates an expected call of RollbackBalanceHistory.
+func (mr *MockTransactionMockRecorder) RollbackBalanceHistory(ctx, height any) *MockTransactionRollbackBalanceHistoryCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackBalanceHistory", reflect.TypeOf((*MockTransaction)(nil).RollbackBalanceHistory), ctx, height)
+	return &MockTransactionRollbackBalanceHistoryCall{Call: call}
+}
+
+// MockTransactionRollbackBalanceHistoryCall wrap *gomock.Call
+type MockTransactionRollbackBalanceHistoryCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionRollbackBalanceHistoryCall) Return(arg0 error) *MockTransactionRollbackBalanceHistoryCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionRollbackBalanceHistoryCall) Do(f func(context.Context, types0.Level) error) *MockTransactionRollbackBalanceHistoryCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionRollbackBalanceHistoryCall) DoAndReturn(f func(context.Context, types0.Level) error) *MockTransactionRollbackBalanceHistoryCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RollbackBeaconWithdrawals mocks base method.
+func (m *MockTransaction) RollbackBeaconWithdrawals(ctx context.Context, height types0.Level) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackBeaconWithdrawals", ctx, height)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackBeaconWithdrawals indicates an expected call of RollbackBeaconWithdrawals.
+func (mr *MockTransactionMockRecorder) RollbackBeaconWithdrawals(ctx, height any) *MockTransactionRollbackBeaconWithdrawalsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackBeaconWithdrawals", reflect.TypeOf((*MockTransaction)(nil).RollbackBeaconWithdrawals), ctx, height)
+	return &MockTransactionRollbackBeaconWithdrawalsCall{Call: call}
+}
+
+// MockTransactionRollbackBeaconWithdrawalsCall wrap *gomock.Call
+type MockTransactionRollbackBeaconWithdrawalsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionRollbackBeaconWithdrawalsCall) Return(arg0 error) *MockTransactionRollbackBeaconWithdrawalsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionRollbackBeaconWithdrawalsCall) Do(f func(context.Context, types0.Level) error) *MockTransactionRollbackBeaconWithdrawalsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionRollbackBeaconWithdrawalsCall) DoAndReturn(f func(context.Context, types0.Level) error) *MockTransactionRollbackBeaconWithdrawalsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RollbackBlobs mocks base method.
+func (m *MockTransaction) RollbackBlobs(ctx context.Context, height types0.Level) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackBlobs", ctx, height)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackBlobs indicates an expected call of RollbackBlobs.
+func (mr *MockTransactionMockRecorder) RollbackBlobs(ctx, height any) *MockTransactionRollbackBlobsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackBlobs", reflect.TypeOf((*MockTransaction)(nil).RollbackBlobs), ctx, height)
+	return &MockTransactionRollbackBlobsCall{Call: call}
+}
+
+// MockTransactionRollbackBlobsCall wrap *gomock.Call
+type MockTransactionRollbackBlobsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionRollbackBlobsCall) Return(arg0 error) *MockTransactionRollbackBlobsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionRollbackBlobsCall) Do(f func(context.Context, types0.Level) error) *MockTransactionRollbackBlobsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionRollbackBlobsCall) DoAndReturn(f func(context.Context, types0.Level) error) *MockTransactionRollbackBlobsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RollbackBlock mocks base method.
+func (m *MockTransaction) RollbackBlock(ctx context.Context, height types0.Level) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackBlock", ctx, height)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackBlock indicates an expected call of RollbackBlock.
+func (mr *MockTransactionMockRecorder) RollbackBlock(ctx, height any) *MockTransactionRollbackBlockCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackBlock", reflect.TypeOf((*MockTransaction)(nil).RollbackBlock), ctx, height)
+	return &MockTransactionRollbackBlockCall{Call: call}
+}
+
+// MockTransactionRollbackBlockCall wrap *gomock.Call
+type MockTransactionRollbackBlockCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionRollbackBlockCall) Return(arg0 error) *MockTransactionRollbackBlockCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionRollbackBlockCall) Do(f func(context.Context, types0.Level) error) *MockTransactionRollbackBlockCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionRollbackBlockCall) DoAndReturn(f func(context.Context, types0.Level) error) *MockTransactionRollbackBlockCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RollbackBlockStats mocks base method.
+func (m *MockTransaction) RollbackBlockStats(ctx context.Context, height types0.Level) (storage.BlockStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackBlockStats", ctx, height)
+	ret0, _ := ret[0].(storage.BlockStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RollbackBlockStats indicates an expected call of RollbackBlockStats.
+func (mr *MockTransactionMockRecorder) RollbackBlockStats(ctx, height any) *MockTransactionRollbackBlockStatsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackBlockStats", reflect.TypeOf((*MockTransaction)(nil).RollbackBlockStats), ctx, height)
+	return &MockTransactionRollbackBlockStatsCall{Call: call}
+}
+
+// MockTransactionRollbackBlockStatsCall wrap *gomock.Call
+type MockTransactionRollbackBlockStatsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionRollbackBlockStatsCall) Return(stats storage.BlockStats, err error) *MockTransactionRollbackBlockStatsCall {
+	c.Call = c.Call.Return(stats, err)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionRollbackBlockStatsCall) Do(f func(context.Context, types0.Level) (storage.BlockStats, error)) *MockTransactionRollbackBlockStatsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionRollbackBlockStatsCall) DoAndReturn(f func(context.Context, types0.Level) (storage.BlockStats, error)) *MockTransactionRollbackBlockStatsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RollbackContracts mocks base method.
+func (m *MockTransaction) RollbackContracts(ctx context.Context, height types0.Level) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackContracts", ctx, height)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackContracts indicates an expected call of RollbackContracts.
+func (mr *MockTransactionMockRecorder) RollbackContracts(ctx, height any) *MockTransactionRollbackContractsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackContracts", reflect.TypeOf((*MockTransaction)(nil).RollbackContracts), ctx, height)
+	return &MockTransactionRollbackContractsCall{Call: call}
+}
+
+// MockTransactionRollbackContractsCall wrap *gomock.Call
+type MockTransactionRollbackContractsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionRollbackContractsCall) Return(arg0 error) *MockTransactionRollbackContractsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionRollbackContractsCall) Do(f func(context.Context, types0.Level) error) *MockTransactionRollbackContractsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionRollbackContractsCall) DoAndReturn(f func(context.Context, types0.Level) error) *MockTransactionRollbackContractsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RollbackDelegations mocks base method.
+func (m *MockTransaction) RollbackDelegations(ctx context.Context, height types0.Level) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackDelegations", ctx, height)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackDelegations indicates an expected call of RollbackDelegations.
+func (mr *MockTransactionMockRecorder) RollbackDelegations(ctx, height any) *MockTransactionRollbackDelegationsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackDelegations", reflect.TypeOf((*MockTransaction)(nil).RollbackDelegations), ctx, height)
+	return &MockTransactionRollbackDelegationsCall{Call: call}
+}
+
+// MockTransactionRollbackDelegationsCall wrap *gomock.Call
+type MockTransactionRollbackDelegationsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionRollbackDelegationsCall) Return(arg0 error) *MockTransactionRollbackDelegationsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionRollbackDelegationsCall) Do(f func(context.Context, types0.Level) error) *MockTransactionRollbackDelegationsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionRollbackDelegationsCall) DoAndReturn(f func(context.Context, types0.Level) error) *MockTransactionRollbackDelegationsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RollbackDiamondCuts mocks base method.
+func (m *MockTransaction) RollbackDiamondCuts(ctx context.Context, height types0.Level) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackDiamondCuts", ctx, height)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackDiamondCuts indicates an expected call of RollbackDiamondCuts.
+func (mr *MockTransactionMockRecorder) RollbackDiamondCuts(ctx, height any) *MockTransactionRollbackDiamondCutsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackDiamondCuts", reflect.TypeOf((*MockTransaction)(nil).RollbackDiamondCuts), ctx, height)
+	return &MockTransactionRollbackDiamondCutsCall{Call: call}
+}
+
+// MockTransactionRollbackDiamondCutsCall wrap *gomock.Call
+type MockTransactionRollbackDiamondCutsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionRollbackDiamondCutsCall) Return(arg0 error) *MockTransactionRollbackDiamondCutsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionRollbackDiamondCutsCall) Do(f func(context.Context, types0.Level) error) *MockTransactionRollbackDiamondCutsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionRollbackDiamondCutsCall) DoAndReturn(f func(context.Context, types0.Level) error) *MockTransactionRollbackDiamondCutsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RollbackERC4337UserOps mocks base method.
+func (m *MockTransaction) RollbackERC4337UserOps(ctx context.Context, height types0.Level) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackERC4337UserOps", ctx, height)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackERC4337UserOps indicates an expected call of RollbackERC4337UserOps.
+func (mr *MockTransactionMockRecorder) RollbackERC4337UserOps(ctx, height any) *MockTransactionRollbackERC4337UserOpsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackERC4337UserOps", reflect.TypeOf((*MockTransaction)(nil).RollbackERC4337UserOps), ctx, height)
+	return &MockTransactionRollbackERC4337UserOpsCall{Call: call}
+}
+
+// MockTransactionRollbackERC4337UserOpsCall wrap *gomock.Call
+type MockTransactionRollbackERC4337UserOpsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionRollbackERC4337UserOpsCall) Return(arg0 error) *MockTransactionRollbackERC4337UserOpsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionRollbackERC4337UserOpsCall) Do(f func(context.Context, types0.Level) error) *MockTransactionRollbackERC4337UserOpsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionRollbackERC4337UserOpsCall) DoAndReturn(f func(context.Context, types0.Level) error) *MockTransactionRollbackERC4337UserOpsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RollbackLogs mocks base method.
+func (m *MockTransaction) RollbackLogs(ctx context.Context, height types0.Level) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackLogs", ctx, height)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackLogs indicates an expected call of RollbackLogs.
+func (mr *MockTransactionMockRecorder) RollbackLogs(ctx, height any) *MockTransactionRollbackLogsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackLogs", reflect.TypeOf((*MockTransaction)(nil).RollbackLogs), ctx, height)
+	return &MockTransactionRollbackLogsCall{Call: call}
+}
+
+// MockTransactionRollbackLogsCall wrap *gomock.Call
+type MockTransactionRollbackLogsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionRollbackLogsCall) Return(arg0 error) *MockTransactionRollbackLogsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionRollbackLogsCall) Do(f func(context.Context, types0.Level) error) *MockTransactionRollbackLogsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionRollbackLogsCall) DoAndReturn(f func(context.Context, types0.Level) error) *MockTransactionRollbackLogsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RollbackProxyUpgrades mocks base method.
+func (m *MockTransaction) RollbackProxyUpgrades(ctx context.Context, height types0.Level) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackProxyUpgrades", ctx, height)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackProxyUpgrades indicates an expected call of RollbackProxyUpgrades.
+func (mr *MockTransactionMockRecorder) RollbackProxyUpgrades(ctx, height any) *MockTransactionRollbackProxyUpgradesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackProxyUpgrades", reflect.TypeOf((*MockTransaction)(nil).RollbackProxyUpgrades), ctx, height)
+	return &MockTransactionRollbackProxyUpgradesCall{Call: call}
+}
+
+// MockTransactionRollbackProxyUpgradesCall wrap *gomock.Call
+type MockTransactionRollbackProxyUpgradesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionRollbackProxyUpgradesCall) Return(arg0 error) *MockTransactionRollbackProxyUpgradesCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionRollbackProxyUpgradesCall) Do(f func(context.Context, types0.Level) error) *MockTransactionRollbackProxyUpgradesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionRollbackProxyUpgradesCall) DoAndReturn(f func(context.Context, types0.Level) error) *MockTransactionRollbackProxyUpgradesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RollbackTokenBalanceHistory mocks base method.
+func (m *MockTransaction) RollbackTokenBalanceHistory(ctx context.Context, height types0.Level) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackTokenBalanceHistory", ctx, height)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackTokenBalanceHistory indicates an expected call of RollbackTokenBalanceHistory.
+func (mr *MockTransactionMockRecorder) RollbackTokenBalanceHistory(ctx, height any) *MockTransactionRollbackTokenBalanceHistoryCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackTokenBalanceHistory", reflect.TypeOf((*MockTransaction)(nil).RollbackTokenBalanceHistory), ctx, height)
+	return &MockTransactionRollbackTokenBalanceHistoryCall{Call: call}
+}
+
+// MockTransactionRollbackTokenBalanceHistoryCall wrap *gomock.Call
+type MockTransactionRollbackTokenBalanceHistoryCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionRollbackTokenBalanceHistoryCall) Return(arg0 error) *MockTransactionRollbackTokenBalanceHistoryCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionRollbackTokenBalanceHistoryCall) Do(f func(context.Context, types0.Level) error) *MockTransactionRollbackTokenBalanceHistoryCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionRollbackTokenBalanceHistoryCall) DoAndReturn(f func(context.Context, types0.Level) error) *MockTransactionRollbackTokenBalanceHistoryCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RollbackTokens mocks base method.
+func (m *MockTransaction) RollbackTokens(ctx context.Context, height types0.Level) ([]storage.Token, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "RollbackTokens", ctx, height)
 	ret0, _ := ret[0].([]storage.Token)
@@ -823,452 +1574,1002 @@ func (m *MockTransaction) RollbackTokens(ctx context.Context, height types.Level
 	return ret0, ret1
 }
 
-// RollbackTokens indicates an expected call of RollbackTokens.
-func (mr *MockTransactionMockRecorder) RollbackTokens(ctx, height any) *MockTransactionRollbackTokensCall {
+// RollbackTokens indicates an expected call of RollbackTokens.
+func (mr *MockTransactionMockRecorder) RollbackTokens(ctx, height any) *MockTransactionRollbackTokensCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackTokens", reflect.TypeOf((*MockTransaction)(nil).RollbackTokens), ctx, height)
+	return &MockTransactionRollbackTokensCall{Call: call}
+}
+
+// MockTransactionRollbackTokensCall wrap *gomock.Call
+type MockTransactionRollbackTokensCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionRollbackTokensCall) Return(tokens []storage.Token, err error) *MockTransactionRollbackTokensCall {
+	c.Call = c.Call.Return(tokens, err)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionRollbackTokensCall) Do(f func(context.Context, types0.Level) ([]storage.Token, error)) *MockTransactionRollbackTokensCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionRollbackTokensCall) DoAndReturn(f func(context.Context, types0.Level) ([]storage.Token, error)) *MockTransactionRollbackTokensCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RollbackTraces mocks base method.
+func (m *MockTransaction) RollbackTraces(ctx context.Context, height types0.Level) ([]storage.Trace, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackTraces", ctx, height)
+	ret0, _ := ret[0].([]storage.Trace)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RollbackTraces indicates an expected call of RollbackTraces.
+func (mr *MockTransactionMockRecorder) RollbackTraces(ctx, height any) *MockTransactionRollbackTracesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackTraces", reflect.TypeOf((*MockTransaction)(nil).RollbackTraces), ctx, height)
+	return &MockTransactionRollbackTracesCall{Call: call}
+}
+
+// MockTransactionRollbackTracesCall wrap *gomock.Call
+type MockTransactionRollbackTracesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionRollbackTracesCall) Return(traces []storage.Trace, err error) *MockTransactionRollbackTracesCall {
+	c.Call = c.Call.Return(traces, err)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionRollbackTracesCall) Do(f func(context.Context, types0.Level) ([]storage.Trace, error)) *MockTransactionRollbackTracesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionRollbackTracesCall) DoAndReturn(f func(context.Context, types0.Level) ([]storage.Trace, error)) *MockTransactionRollbackTracesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RollbackTransfers mocks base method.
+func (m *MockTransaction) RollbackTransfers(ctx context.Context, height types0.Level) ([]storage.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackTransfers", ctx, height)
+	ret0, _ := ret[0].([]storage.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RollbackTransfers indicates an expected call of RollbackTransfers.
+func (mr *MockTransactionMockRecorder) RollbackTransfers(ctx, height any) *MockTransactionRollbackTransfersCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackTransfers", reflect.TypeOf((*MockTransaction)(nil).RollbackTransfers), ctx, height)
+	return &MockTransactionRollbackTransfersCall{Call: call}
+}
+
+// MockTransactionRollbackTransfersCall wrap *gomock.Call
+type MockTransactionRollbackTransfersCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionRollbackTransfersCall) Return(transfers []storage.Transfer, err error) *MockTransactionRollbackTransfersCall {
+	c.Call = c.Call.Return(transfers, err)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionRollbackTransfersCall) Do(f func(context.Context, types0.Level) ([]storage.Transfer, error)) *MockTransactionRollbackTransfersCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionRollbackTransfersCall) DoAndReturn(f func(context.Context, types0.Level) ([]storage.Transfer, error)) *MockTransactionRollbackTransfersCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RollbackTxAccessLists mocks base method.
+func (m *MockTransaction) RollbackTxAccessLists(ctx context.Context, height types0.Level) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackTxAccessLists", ctx, height)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackTxAccessLists indicates an expected call of RollbackTxAccessLists.
+func (mr *MockTransactionMockRecorder) RollbackTxAccessLists(ctx, height any) *MockTransactionRollbackTxAccessListsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackTxAccessLists", reflect.TypeOf((*MockTransaction)(nil).RollbackTxAccessLists), ctx, height)
+	return &MockTransactionRollbackTxAccessListsCall{Call: call}
+}
+
+// MockTransactionRollbackTxAccessListsCall wrap *gomock.Call
+type MockTransactionRollbackTxAccessListsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionRollbackTxAccessListsCall) Return(arg0 error) *MockTransactionRollbackTxAccessListsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionRollbackTxAccessListsCall) Do(f func(context.Context, types0.Level) error) *MockTransactionRollbackTxAccessListsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionRollbackTxAccessListsCall) DoAndReturn(f func(context.Context, types0.Level) error) *MockTransactionRollbackTxAccessListsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RollbackTxAuthorizations mocks base method.
+func (m *MockTransaction) RollbackTxAuthorizations(ctx context.Context, height types0.Level) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackTxAuthorizations", ctx, height)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackTxAuthorizations indicates an expected call of RollbackTxAuthorizations.
+func (mr *MockTransactionMockRecorder) RollbackTxAuthorizations(ctx, height any) *MockTransactionRollbackTxAuthorizationsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackTxAuthorizations", reflect.TypeOf((*MockTransaction)(nil).RollbackTxAuthorizations), ctx, height)
+	return &MockTransactionRollbackTxAuthorizationsCall{Call: call}
+}
+
+// MockTransactionRollbackTxAuthorizationsCall wrap *gomock.Call
+type MockTransactionRollbackTxAuthorizationsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionRollbackTxAuthorizationsCall) Return(arg0 error) *MockTransactionRollbackTxAuthorizationsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionRollbackTxAuthorizationsCall) Do(f func(context.Context, types0.Level) error) *MockTransactionRollbackTxAuthorizationsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionRollbackTxAuthorizationsCall) DoAndReturn(f func(context.Context, types0.Level) error) *MockTransactionRollbackTxAuthorizationsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RollbackTxs mocks base method.
+func (m *MockTransaction) RollbackTxs(ctx context.Context, height types0.Level) ([]storage.Tx, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackTxs", ctx, height)
+	ret0, _ := ret[0].([]storage.Tx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RollbackTxs indicates an expected call of RollbackTxs.
+func (mr *MockTransactionMockRecorder) RollbackTxs(ctx, height any) *MockTransactionRollbackTxsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackTxs", reflect.TypeOf((*MockTransaction)(nil).RollbackTxs), ctx, height)
+	return &MockTransactionRollbackTxsCall{Call: call}
+}
+
+// MockTransactionRollbackTxsCall wrap *gomock.Call
+type MockTransactionRollbackTxsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionRollbackTxsCall) Return(txs []storage.Tx, err error) *MockTransactionRollbackTxsCall {
+	c.Call = c.Call.Return(txs, err)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionRollbackTxsCall) Do(f func(context.Context, types0.Level) ([]storage.Tx, error)) *MockTransactionRollbackTxsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionRollbackTxsCall) DoAndReturn(f func(context.Context, types0.Level) ([]storage.Tx, error)) *MockTransactionRollbackTxsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SaveAddresses mocks base method.
+func (m *MockTransaction) SaveAddresses(ctx context.Context, addresses ...*storage.Address) (int64, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range addresses {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveAddresses", varargs...)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveAddresses indicates an expected call of SaveAddresses.
+func (mr *MockTransactionMockRecorder) SaveAddresses(ctx any, addresses ...any) *MockTransactionSaveAddressesCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, addresses...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveAddresses", reflect.TypeOf((*MockTransaction)(nil).SaveAddresses), varargs...)
+	return &MockTransactionSaveAddressesCall{Call: call}
+}
+
+// MockTransactionSaveAddressesCall wrap *gomock.Call
+type MockTransactionSaveAddressesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionSaveAddressesCall) Return(arg0 int64, arg1 error) *MockTransactionSaveAddressesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionSaveAddressesCall) Do(f func(context.Context, ...*storage.Address) (int64, error)) *MockTransactionSaveAddressesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionSaveAddressesCall) DoAndReturn(f func(context.Context, ...*storage.Address) (int64, error)) *MockTransactionSaveAddressesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SaveAllowances mocks base method.
+func (m *MockTransaction) SaveAllowances(ctx context.Context, allowances ...*storage.Allowance) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range allowances {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveAllowances", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveAllowances indicates an expected call of SaveAllowances.
+func (mr *MockTransactionMockRecorder) SaveAllowances(ctx any, allowances ...any) *MockTransactionSaveAllowancesCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, allowances...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveAllowances", reflect.TypeOf((*MockTransaction)(nil).SaveAllowances), varargs...)
+	return &MockTransactionSaveAllowancesCall{Call: call}
+}
+
+// MockTransactionSaveAllowancesCall wrap *gomock.Call
+type MockTransactionSaveAllowancesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionSaveAllowancesCall) Return(arg0 error) *MockTransactionSaveAllowancesCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionSaveAllowancesCall) Do(f func(context.Context, ...*storage.Allowance) error) *MockTransactionSaveAllowancesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionSaveAllowancesCall) DoAndReturn(f func(context.Context, ...*storage.Allowance) error) *MockTransactionSaveAllowancesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SaveApprovals mocks base method.
+func (m *MockTransaction) SaveApprovals(ctx context.Context, approvals ...*storage.Approval) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range approvals {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveApprovals", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveApprovals indicates an expected call of SaveApprovals.
+func (mr *MockTransactionMockRecorder) SaveApprovals(ctx any, approvals ...any) *MockTransactionSaveApprovalsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, approvals...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveApprovals", reflect.TypeOf((*MockTransaction)(nil).SaveApprovals), varargs...)
+	return &MockTransactionSaveApprovalsCall{Call: call}
+}
+
+// MockTransactionSaveApprovalsCall wrap *gomock.Call
+type MockTransactionSaveApprovalsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionSaveApprovalsCall) Return(arg0 error) *MockTransactionSaveApprovalsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionSaveApprovalsCall) Do(f func(context.Context, ...*storage.Approval) error) *MockTransactionSaveApprovalsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionSaveApprovalsCall) DoAndReturn(f func(context.Context, ...*storage.Approval) error) *MockTransactionSaveApprovalsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SaveBalanceHistory mocks base method.
+func (m *MockTransaction) SaveBalanceHistory(ctx context.Context, history ...*storage.BalanceHistory) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range history {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveBalanceHistory", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveBalanceHistory indicates an expected call of SaveBalanceHistory.
+func (mr *MockTransactionMockRecorder) SaveBalanceHistory(ctx any, history ...any) *MockTransactionSaveBalanceHistoryCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, history...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveBalanceHistory", reflect.TypeOf((*MockTransaction)(nil).SaveBalanceHistory), varargs...)
+	return &MockTransactionSaveBalanceHistoryCall{Call: call}
+}
+
+// MockTransactionSaveBalanceHistoryCall wrap *gomock.Call
+type MockTransactionSaveBalanceHistoryCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionSaveBalanceHistoryCall) Return(arg0 error) *MockTransactionSaveBalanceHistoryCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionSaveBalanceHistoryCall) Do(f func(context.Context, ...*storage.BalanceHistory) error) *MockTransactionSaveBalanceHistoryCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionSaveBalanceHistoryCall) DoAndReturn(f func(context.Context, ...*storage.BalanceHistory) error) *MockTransactionSaveBalanceHistoryCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SaveBalances mocks base method.
+func (m *MockTransaction) SaveBalances(ctx context.Context, balances ...*storage.Balance) ([]storage.Balance, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range balances {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveBalances", varargs...)
+	ret0, _ := ret[0].([]storage.Balance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveBalances indicates an expected call of SaveBalances.
+func (mr *MockTransactionMockRecorder) SaveBalances(ctx any, balances ...any) *MockTransactionSaveBalancesCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackTokens", reflect.TypeOf((*MockTransaction)(nil).RollbackTokens), ctx, height)
-	return &MockTransactionRollbackTokensCall{Call: call}
+	varargs := append([]any{ctx}, balances...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveBalances", reflect.TypeOf((*MockTransaction)(nil).SaveBalances), varargs...)
+	return &MockTransactionSaveBalancesCall{Call: call}
 }
 
-// MockTransactionRollbackTokensCall wrap *gomock.Call
-type MockTransactionRollbackTokensCall struct {
+// MockTransactionSaveBalancesCall wrap *gomock.Call
+type MockTransactionSaveBalancesCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockTransactionRollbackTokensCall) Return(tokens []storage.Token, err error) *MockTransactionRollbackTokensCall {
-	c.Call = c.Call.Return(tokens, err)
+func (c *MockTransactionSaveBalancesCall) Return(arg0 []storage.Balance, arg1 error) *MockTransactionSaveBalancesCall {
+	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockTransactionRollbackTokensCall) Do(f func(context.Context, types.Level) ([]storage.Token, error)) *MockTransactionRollbackTokensCall {
+func (c *MockTransactionSaveBalancesCall) Do(f func(context.Context, ...*storage.Balance) ([]storage.Balance, error)) *MockTransactionSaveBalancesCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockTransactionRollbackTokensCall) DoAndReturn(f func(context.Context, types.Level) ([]storage.Token, error)) *MockTransactionRollbackTokensCall {
+func (c *MockTransactionSaveBalancesCall) DoAndReturn(f func(context.Context, ...*storage.Balance) ([]storage.Balance, error)) *MockTransactionSaveBalancesCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// RollbackTraces mocks base method.
-func (m *MockTransaction) RollbackTraces(ctx context.Context, height types.Level) ([]storage.Trace, error) {
+// SaveBeaconWithdrawals mocks base method.
+func (m *MockTransaction) SaveBeaconWithdrawals(ctx context.Context, withdrawals ...*storage.BeaconWithdrawal) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RollbackTraces", ctx, height)
-	ret0, _ := ret[0].([]storage.Trace)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	varargs := []any{ctx}
+	for _, a := range withdrawals {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveBeaconWithdrawals", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// RollbackTraces indicates an expected call of RollbackTraces.
-func (mr *MockTransactionMockRecorder) RollbackTraces(ctx, height any) *MockTransactionRollbackTracesCall {
+// SaveBeaconWithdrawals indicates an expected call of SaveBeaconWithdrawals.
+func (mr *MockTransactionMockRecorder) SaveBeaconWithdrawals(ctx any, withdrawals ...any) *MockTransactionSaveBeaconWithdrawalsCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackTraces", reflect.TypeOf((*MockTransaction)(nil).RollbackTraces), ctx, height)
-	return &MockTransactionRollbackTracesCall{Call: call}
+	varargs := append([]any{ctx}, withdrawals...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveBeaconWithdrawals", reflect.TypeOf((*MockTransaction)(nil).SaveBeaconWithdrawals), varargs...)
+	return &MockTransactionSaveBeaconWithdrawalsCall{Call: call}
 }
 
-// MockTransactionRollbackTracesCall wrap *gomock.Call
-type MockTransactionRollbackTracesCall struct {
+// MockTransactionSaveBeaconWithdrawalsCall wrap *gomock.Call
+type MockTransactionSaveBeaconWithdrawalsCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockTransactionRollbackTracesCall) Return(traces []storage.Trace, err error) *MockTransactionRollbackTracesCall {
-	c.Call = c.Call.Return(traces, err)
+func (c *MockTransactionSaveBeaconWithdrawalsCall) Return(arg0 error) *MockTransactionSaveBeaconWithdrawalsCall {
+	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockTransactionRollbackTracesCall) Do(f func(context.Context, types.Level) ([]storage.Trace, error)) *MockTransactionRollbackTracesCall {
+func (c *MockTransactionSaveBeaconWithdrawalsCall) Do(f func(context.Context, ...*storage.BeaconWithdrawal) error) *MockTransactionSaveBeaconWithdrawalsCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockTransactionRollbackTracesCall) DoAndReturn(f func(context.Context, types.Level) ([]storage.Trace, error)) *MockTransactionRollbackTracesCall {
+func (c *MockTransactionSaveBeaconWithdrawalsCall) DoAndReturn(f func(context.Context, ...*storage.BeaconWithdrawal) error) *MockTransactionSaveBeaconWithdrawalsCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// RollbackTransfers mocks base method.
-func (m *MockTransaction) RollbackTransfers(ctx context.Context, height types.Level) ([]storage.Transfer, error) {
+// SaveBlobs mocks base method.
+func (m *MockTransaction) SaveBlobs(ctx context.Context, blobs ...*storage.Blob) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RollbackTransfers", ctx, height)
-	ret0, _ := ret[0].([]storage.Transfer)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	varargs := []any{ctx}
+	for _, a := range blobs {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveBlobs", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// RollbackTransfers indicates an expected call of RollbackTransfers.
-func (mr *MockTransactionMockRecorder) RollbackTransfers(ctx, height any) *MockTransactionRollbackTransfersCall {
+// SaveBlobs indicates an expected call of SaveBlobs.
+func (mr *MockTransactionMockRecorder) SaveBlobs(ctx any, blobs ...any) *MockTransactionSaveBlobsCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackTransfers", reflect.TypeOf((*MockTransaction)(nil).RollbackTransfers), ctx, height)
-	return &MockTransactionRollbackTransfersCall{Call: call}
+	varargs := append([]any{ctx}, blobs...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveBlobs", reflect.TypeOf((*MockTransaction)(nil).SaveBlobs), varargs...)
+	return &MockTransactionSaveBlobsCall{Call: call}
 }
 
-// MockTransactionRollbackTransfersCall wrap *gomock.Call
-type MockTransactionRollbackTransfersCall struct {
+// MockTransactionSaveBlobsCall wrap *gomock.Call
+type MockTransactionSaveBlobsCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockTransactionRollbackTransfersCall) Return(transfers []storage.Transfer, err error) *MockTransactionRollbackTransfersCall {
-	c.Call = c.Call.Return(transfers, err)
+func (c *MockTransactionSaveBlobsCall) Return(arg0 error) *MockTransactionSaveBlobsCall {
+	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockTransactionRollbackTransfersCall) Do(f func(context.Context, types.Level) ([]storage.Transfer, error)) *MockTransactionRollbackTransfersCall {
+func (c *MockTransactionSaveBlobsCall) Do(f func(context.Context, ...*storage.Blob) error) *MockTransactionSaveBlobsCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockTransactionRollbackTransfersCall) DoAndReturn(f func(context.Context, types.Level) ([]storage.Transfer, error)) *MockTransactionRollbackTransfersCall {
+func (c *MockTransactionSaveBlobsCall) DoAndReturn(f func(context.Context, ...*storage.Blob) error) *MockTransactionSaveBlobsCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// RollbackTxs mocks base method.
-func (m *MockTransaction) RollbackTxs(ctx context.Context, height types.Level) ([]storage.Tx, error) {
+// SaveContracts mocks base method.
+func (m *MockTransaction) SaveContracts(ctx context.Context, addresses ...*storage.Contract) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RollbackTxs", ctx, height)
-	ret0, _ := ret[0].([]storage.Tx)
+	varargs := []any{ctx}
+	for _, a := range addresses {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveContracts", varargs...)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// RollbackTxs indicates an expected call of RollbackTxs.
-func (mr *MockTransactionMockRecorder) RollbackTxs(ctx, height any) *MockTransactionRollbackTxsCall {
+// SaveContracts indicates an expected call of SaveContracts.
+func (mr *MockTransactionMockRecorder) SaveContracts(ctx any, addresses ...any) *MockTransactionSaveContractsCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackTxs", reflect.TypeOf((*MockTransaction)(nil).RollbackTxs), ctx, height)
-	return &MockTransactionRollbackTxsCall{Call: call}
+	varargs := append([]any{ctx}, addresses...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveContracts", reflect.TypeOf((*MockTransaction)(nil).SaveContracts), varargs...)
+	return &MockTransactionSaveContractsCall{Call: call}
 }
 
-// MockTransactionRollbackTxsCall wrap *gomock.Call
-type MockTransactionRollbackTxsCall struct {
+// MockTransactionSaveContractsCall wrap *gomock.Call
+type MockTransactionSaveContractsCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockTransactionRollbackTxsCall) Return(txs []storage.Tx, err error) *MockTransactionRollbackTxsCall {
-	c.Call = c.Call.Return(txs, err)
+func (c *MockTransactionSaveContractsCall) Return(arg0 int64, arg1 error) *MockTransactionSaveContractsCall {
+	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockTransactionRollbackTxsCall) Do(f func(context.Context, types.Level) ([]storage.Tx, error)) *MockTransactionRollbackTxsCall {
+func (c *MockTransactionSaveContractsCall) Do(f func(context.Context, ...*storage.Contract) (int64, error)) *MockTransactionSaveContractsCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockTransactionRollbackTxsCall) DoAndReturn(f func(context.Context, types.Level) ([]storage.Tx, error)) *MockTransactionRollbackTxsCall {
+func (c *MockTransactionSaveContractsCall) DoAndReturn(f func(context.Context, ...*storage.Contract) (int64, error)) *MockTransactionSaveContractsCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// SaveAddresses mocks base method.
-func (m *MockTransaction) SaveAddresses(ctx context.Context, addresses ...*storage.Address) (int64, error) {
+// SaveDecodedLogs mocks base method.
+func (m *MockTransaction) SaveDecodedLogs(ctx context.Context, logs ...*storage.Log) error {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx}
-	for _, a := range addresses {
+	for _, a := range logs {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "SaveAddresses", varargs...)
-	ret0, _ := ret[0].(int64)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "SaveDecodedLogs", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// SaveAddresses indicates an expected call of SaveAddresses.
-func (mr *MockTransactionMockRecorder) SaveAddresses(ctx any, addresses ...any) *MockTransactionSaveAddressesCall {
+// SaveDecodedLogs indicates an expected call of SaveDecodedLogs.
+func (mr *MockTransactionMockRecorder) SaveDecodedLogs(ctx any, logs ...any) *MockTransactionSaveDecodedLogsCall {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx}, addresses...)
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveAddresses", reflect.TypeOf((*MockTransaction)(nil).SaveAddresses), varargs...)
-	return &MockTransactionSaveAddressesCall{Call: call}
+	varargs := append([]any{ctx}, logs...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveDecodedLogs", reflect.TypeOf((*MockTransaction)(nil).SaveDecodedLogs), varargs...)
+	return &MockTransactionSaveDecodedLogsCall{Call: call}
 }
 
-// MockTransactionSaveAddressesCall wrap *gomock.Call
-type MockTransactionSaveAddressesCall struct {
+// MockTransactionSaveDecodedLogsCall wrap *gomock.Call
+type MockTransactionSaveDecodedLogsCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockTransactionSaveAddressesCall) Return(arg0 int64, arg1 error) *MockTransactionSaveAddressesCall {
-	c.Call = c.Call.Return(arg0, arg1)
+func (c *MockTransactionSaveDecodedLogsCall) Return(arg0 error) *MockTransactionSaveDecodedLogsCall {
+	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockTransactionSaveAddressesCall) Do(f func(context.Context, ...*storage.Address) (int64, error)) *MockTransactionSaveAddressesCall {
+func (c *MockTransactionSaveDecodedLogsCall) Do(f func(context.Context, ...*storage.Log) error) *MockTransactionSaveDecodedLogsCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockTransactionSaveAddressesCall) DoAndReturn(f func(context.Context, ...*storage.Address) (int64, error)) *MockTransactionSaveAddressesCall {
+func (c *MockTransactionSaveDecodedLogsCall) DoAndReturn(f func(context.Context, ...*storage.Log) error) *MockTransactionSaveDecodedLogsCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// SaveBalances mocks base method.
-func (m *MockTransaction) SaveBalances(ctx context.Context, balances ...*storage.Balance) error {
+// SaveDelegations mocks base method.
+func (m *MockTransaction) SaveDelegations(ctx context.Context, delegations ...*storage.Delegation) error {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx}
-	for _, a := range balances {
+	for _, a := range delegations {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "SaveBalances", varargs...)
+	ret := m.ctrl.Call(m, "SaveDelegations", varargs...)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// SaveBalances indicates an expected call of SaveBalances.
-func (mr *MockTransactionMockRecorder) SaveBalances(ctx any, balances ...any) *MockTransactionSaveBalancesCall {
+// SaveDelegations indicates an expected call of SaveDelegations.
+func (mr *MockTransactionMockRecorder) SaveDelegations(ctx any, delegations ...any) *MockTransactionSaveDelegationsCall {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx}, balances...)
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveBalances", reflect.TypeOf((*MockTransaction)(nil).SaveBalances), varargs...)
-	return &MockTransactionSaveBalancesCall{Call: call}
+	varargs := append([]any{ctx}, delegations...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveDelegations", reflect.TypeOf((*MockTransaction)(nil).SaveDelegations), varargs...)
+	return &MockTransactionSaveDelegationsCall{Call: call}
 }
 
-// MockTransactionSaveBalancesCall wrap *gomock.Call
-type MockTransactionSaveBalancesCall struct {
+// MockTransactionSaveDelegationsCall wrap *gomock.Call
+type MockTransactionSaveDelegationsCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockTransactionSaveBalancesCall) Return(arg0 error) *MockTransactionSaveBalancesCall {
+func (c *MockTransactionSaveDelegationsCall) Return(arg0 error) *MockTransactionSaveDelegationsCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockTransactionSaveBalancesCall) Do(f func(context.Context, ...*storage.Balance) error) *MockTransactionSaveBalancesCall {
+func (c *MockTransactionSaveDelegationsCall) Do(f func(context.Context, ...*storage.Delegation) error) *MockTransactionSaveDelegationsCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockTransactionSaveBalancesCall) DoAndReturn(f func(context.Context, ...*storage.Balance) error) *MockTransactionSaveBalancesCall {
+func (c *MockTransactionSaveDelegationsCall) DoAndReturn(f func(context.Context, ...*storage.Delegation) error) *MockTransactionSaveDelegationsCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// SaveBeaconWithdrawals mocks base method.
-func (m *MockTransaction) SaveBeaconWithdrawals(ctx context.Context, withdrawals ...*storage.BeaconWithdrawal) error {
+// SaveDiamondCuts mocks base method.
+func (m *MockTransaction) SaveDiamondCuts(ctx context.Context, cuts ...*storage.DiamondCut) error {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx}
-	for _, a := range withdrawals {
+	for _, a := range cuts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "SaveBeaconWithdrawals", varargs...)
+	ret := m.ctrl.Call(m, "SaveDiamondCuts", varargs...)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// SaveBeaconWithdrawals indicates an expected call of SaveBeaconWithdrawals.
-func (mr *MockTransactionMockRecorder) SaveBeaconWithdrawals(ctx any, withdrawals ...any) *MockTransactionSaveBeaconWithdrawalsCall {
+// SaveDiamondCuts indicates an expected call of SaveDiamondCuts.
+func (mr *MockTransactionMockRecorder) SaveDiamondCuts(ctx any, cuts ...any) *MockTransactionSaveDiamondCutsCall {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx}, withdrawals...)
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveBeaconWithdrawals", reflect.TypeOf((*MockTransaction)(nil).SaveBeaconWithdrawals), varargs...)
-	return &MockTransactionSaveBeaconWithdrawalsCall{Call: call}
+	varargs := append([]any{ctx}, cuts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveDiamondCuts", reflect.TypeOf((*MockTransaction)(nil).SaveDiamondCuts), varargs...)
+	return &MockTransactionSaveDiamondCutsCall{Call: call}
+}
+
+// MockTransactionSaveDiamondCutsCall wrap *gomock.Call
+type MockTransactionSaveDiamondCutsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionSaveDiamondCutsCall) Return(arg0 error) *MockTransactionSaveDiamondCutsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionSaveDiamondCutsCall) Do(f func(context.Context, ...*storage.DiamondCut) error) *MockTransactionSaveDiamondCutsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionSaveDiamondCutsCall) DoAndReturn(f func(context.Context, ...*storage.DiamondCut) error) *MockTransactionSaveDiamondCutsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SaveDiamondFacets mocks base method.
+func (m *MockTransaction) SaveDiamondFacets(ctx context.Context, facets ...*storage.DiamondFacet) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range facets {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveDiamondFacets", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveDiamondFacets indicates an expected call of SaveDiamondFacets.
+func (mr *MockTransactionMockRecorder) SaveDiamondFacets(ctx any, facets ...any) *MockTransactionSaveDiamondFacetsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, facets...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveDiamondFacets", reflect.TypeOf((*MockTransaction)(nil).SaveDiamondFacets), varargs...)
+	return &MockTransactionSaveDiamondFacetsCall{Call: call}
+}
+
+// MockTransactionSaveDiamondFacetsCall wrap *gomock.Call
+type MockTransactionSaveDiamondFacetsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionSaveDiamondFacetsCall) Return(arg0 error) *MockTransactionSaveDiamondFacetsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionSaveDiamondFacetsCall) Do(f func(context.Context, ...*storage.DiamondFacet) error) *MockTransactionSaveDiamondFacetsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionSaveDiamondFacetsCall) DoAndReturn(f func(context.Context, ...*storage.DiamondFacet) error) *MockTransactionSaveDiamondFacetsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SaveERC4337UserOps mocks base method.
+func (m *MockTransaction) SaveERC4337UserOps(ctx context.Context, userOps ...*storage.ERC4337UserOp) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range userOps {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveERC4337UserOps", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveERC4337UserOps indicates an expected call of SaveERC4337UserOps.
+func (mr *MockTransactionMockRecorder) SaveERC4337UserOps(ctx any, userOps ...any) *MockTransactionSaveERC4337UserOpsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, userOps...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveERC4337UserOps", reflect.TypeOf((*MockTransaction)(nil).SaveERC4337UserOps), varargs...)
+	return &MockTransactionSaveERC4337UserOpsCall{Call: call}
+}
+
+// MockTransactionSaveERC4337UserOpsCall wrap *gomock.Call
+type MockTransactionSaveERC4337UserOpsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionSaveERC4337UserOpsCall) Return(arg0 error) *MockTransactionSaveERC4337UserOpsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionSaveERC4337UserOpsCall) Do(f func(context.Context, ...*storage.ERC4337UserOp) error) *MockTransactionSaveERC4337UserOpsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionSaveERC4337UserOpsCall) DoAndReturn(f func(context.Context, ...*storage.ERC4337UserOp) error) *MockTransactionSaveERC4337UserOpsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SaveLogs mocks base method.
+func (m *MockTransaction) SaveLogs(ctx context.Context, logs ...*storage.Log) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range logs {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveLogs", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveLogs indicates an expected call of SaveLogs.
+func (mr *MockTransactionMockRecorder) SaveLogs(ctx any, logs ...any) *MockTransactionSaveLogsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, logs...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveLogs", reflect.TypeOf((*MockTransaction)(nil).SaveLogs), varargs...)
+	return &MockTransactionSaveLogsCall{Call: call}
 }
 
-// MockTransactionSaveBeaconWithdrawalsCall wrap *gomock.Call
-type MockTransactionSaveBeaconWithdrawalsCall struct {
+// MockTransactionSaveLogsCall wrap *gomock.Call
+type MockTransactionSaveLogsCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockTransactionSaveBeaconWithdrawalsCall) Return(arg0 error) *MockTransactionSaveBeaconWithdrawalsCall {
+func (c *MockTransactionSaveLogsCall) Return(arg0 error) *MockTransactionSaveLogsCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockTransactionSaveBeaconWithdrawalsCall) Do(f func(context.Context, ...*storage.BeaconWithdrawal) error) *MockTransactionSaveBeaconWithdrawalsCall {
+func (c *MockTransactionSaveLogsCall) Do(f func(context.Context, ...*storage.Log) error) *MockTransactionSaveLogsCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockTransactionSaveBeaconWithdrawalsCall) DoAndReturn(f func(context.Context, ...*storage.BeaconWithdrawal) error) *MockTransactionSaveBeaconWithdrawalsCall {
+func (c *MockTransactionSaveLogsCall) DoAndReturn(f func(context.Context, ...*storage.Log) error) *MockTransactionSaveLogsCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// SaveContracts mocks base method.
-func (m *MockTransaction) SaveContracts(ctx context.Context, addresses ...*storage.Contract) (int64, error) {
+// SaveProxyContracts mocks base method.
+func (m *MockTransaction) SaveProxyContracts(ctx context.Context, contracts ...*storage.ProxyContract) error {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx}
-	for _, a := range addresses {
+	for _, a := range contracts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "SaveContracts", varargs...)
-	ret0, _ := ret[0].(int64)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "SaveProxyContracts", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// SaveContracts indicates an expected call of SaveContracts.
-func (mr *MockTransactionMockRecorder) SaveContracts(ctx any, addresses ...any) *MockTransactionSaveContractsCall {
+// SaveProxyContracts indicates an expected call of SaveProxyContracts.
+func (mr *MockTransactionMockRecorder) SaveProxyContracts(ctx any, contracts ...any) *MockTransactionSaveProxyContractsCall {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx}, addresses...)
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveContracts", reflect.TypeOf((*MockTransaction)(nil).SaveContracts), varargs...)
-	return &MockTransactionSaveContractsCall{Call: call}
+	varargs := append([]any{ctx}, contracts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveProxyContracts", reflect.TypeOf((*MockTransaction)(nil).SaveProxyContracts), varargs...)
+	return &MockTransactionSaveProxyContractsCall{Call: call}
 }
 
-// MockTransactionSaveContractsCall wrap *gomock.Call
-type MockTransactionSaveContractsCall struct {
+// MockTransactionSaveProxyContractsCall wrap *gomock.Call
+type MockTransactionSaveProxyContractsCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockTransactionSaveContractsCall) Return(arg0 int64, arg1 error) *MockTransactionSaveContractsCall {
-	c.Call = c.Call.Return(arg0, arg1)
+func (c *MockTransactionSaveProxyContractsCall) Return(arg0 error) *MockTransactionSaveProxyContractsCall {
+	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockTransactionSaveContractsCall) Do(f func(context.Context, ...*storage.Contract) (int64, error)) *MockTransactionSaveContractsCall {
+func (c *MockTransactionSaveProxyContractsCall) Do(f func(context.Context, ...*storage.ProxyContract) error) *MockTransactionSaveProxyContractsCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockTransactionSaveContractsCall) DoAndReturn(f func(context.Context, ...*storage.Contract) (int64, error)) *MockTransactionSaveContractsCall {
+func (c *MockTransactionSaveProxyContractsCall) DoAndReturn(f func(context.Context, ...*storage.ProxyContract) error) *MockTransactionSaveProxyContractsCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// SaveERC4337UserOps mocks base method.
-func (m *MockTransaction) SaveERC4337UserOps(ctx context.Context, userOps ...*storage.ERC4337UserOp) error {
+// SaveProxyUpgrades mocks base method.
+func (m *MockTransaction) SaveProxyUpgrades(ctx context.Context, upgrades ...*storage.ProxyUpgrade) error {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx}
-	for _, a := range userOps {
+	for _, a := range upgrades {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "SaveERC4337UserOps", varargs...)
+	ret := m.ctrl.Call(m, "SaveProxyUpgrades", varargs...)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// SaveERC4337UserOps indicates an expected call of SaveERC4337UserOps.
-func (mr *MockTransactionMockRecorder) SaveERC4337UserOps(ctx any, userOps ...any) *MockTransactionSaveERC4337UserOpsCall {
+// SaveProxyUpgrades indicates an expected call of SaveProxyUpgrades.
+func (mr *MockTransactionMockRecorder) SaveProxyUpgrades(ctx any, upgrades ...any) *MockTransactionSaveProxyUpgradesCall {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx}, userOps...)
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveERC4337UserOps", reflect.TypeOf((*MockTransaction)(nil).SaveERC4337UserOps), varargs...)
-	return &MockTransactionSaveERC4337UserOpsCall{Call: call}
+	varargs := append([]any{ctx}, upgrades...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveProxyUpgrades", reflect.TypeOf((*MockTransaction)(nil).SaveProxyUpgrades), varargs...)
+	return &MockTransactionSaveProxyUpgradesCall{Call: call}
 }
 
-// MockTransactionSaveERC4337UserOpsCall wrap *gomock.Call
-type MockTransactionSaveERC4337UserOpsCall struct {
+// MockTransactionSaveProxyUpgradesCall wrap *gomock.Call
+type MockTransactionSaveProxyUpgradesCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockTransactionSaveERC4337UserOpsCall) Return(arg0 error) *MockTransactionSaveERC4337UserOpsCall {
+func (c *MockTransactionSaveProxyUpgradesCall) Return(arg0 error) *MockTransactionSaveProxyUpgradesCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockTransactionSaveERC4337UserOpsCall) Do(f func(context.Context, ...*storage.ERC4337UserOp) error) *MockTransactionSaveERC4337UserOpsCall {
+func (c *MockTransactionSaveProxyUpgradesCall) Do(f func(context.Context, ...*storage.ProxyUpgrade) error) *MockTransactionSaveProxyUpgradesCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockTransactionSaveERC4337UserOpsCall) DoAndReturn(f func(context.Context, ...*storage.ERC4337UserOp) error) *MockTransactionSaveERC4337UserOpsCall {
+func (c *MockTransactionSaveProxyUpgradesCall) DoAndReturn(f func(context.Context, ...*storage.ProxyUpgrade) error) *MockTransactionSaveProxyUpgradesCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// SaveLogs mocks base method.
-func (m *MockTransaction) SaveLogs(ctx context.Context, logs ...*storage.Log) error {
+// SaveSignatures mocks base method.
+func (m *MockTransaction) SaveSignatures(ctx context.Context, signatures ...*storage.Signature) error {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx}
-	for _, a := range logs {
+	for _, a := range signatures {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "SaveLogs", varargs...)
+	ret := m.ctrl.Call(m, "SaveSignatures", varargs...)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// SaveLogs indicates an expected call of SaveLogs.
-func (mr *MockTransactionMockRecorder) SaveLogs(ctx any, logs ...any) *MockTransactionSaveLogsCall {
+// SaveSignatures indicates an expected call of SaveSignatures.
+func (mr *MockTransactionMockRecorder) SaveSignatures(ctx any, signatures ...any) *MockTransactionSaveSignaturesCall {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx}, logs...)
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveLogs", reflect.TypeOf((*MockTransaction)(nil).SaveLogs), varargs...)
-	return &MockTransactionSaveLogsCall{Call: call}
+	varargs := append([]any{ctx}, signatures...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveSignatures", reflect.TypeOf((*MockTransaction)(nil).SaveSignatures), varargs...)
+	return &MockTransactionSaveSignaturesCall{Call: call}
 }
 
-// MockTransactionSaveLogsCall wrap *gomock.Call
-type MockTransactionSaveLogsCall struct {
+// MockTransactionSaveSignaturesCall wrap *gomock.Call
+type MockTransactionSaveSignaturesCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockTransactionSaveLogsCall) Return(arg0 error) *MockTransactionSaveLogsCall {
+func (c *MockTransactionSaveSignaturesCall) Return(arg0 error) *MockTransactionSaveSignaturesCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockTransactionSaveLogsCall) Do(f func(context.Context, ...*storage.Log) error) *MockTransactionSaveLogsCall {
+func (c *MockTransactionSaveSignaturesCall) Do(f func(context.Context, ...*storage.Signature) error) *MockTransactionSaveSignaturesCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockTransactionSaveLogsCall) DoAndReturn(f func(context.Context, ...*storage.Log) error) *MockTransactionSaveLogsCall {
+func (c *MockTransactionSaveSignaturesCall) DoAndReturn(f func(context.Context, ...*storage.Signature) error) *MockTransactionSaveSignaturesCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// SaveProxyContracts mocks base method.
-func (m *MockTransaction) SaveProxyContracts(ctx context.Context, contracts ...*storage.ProxyContract) error {
+// SaveSimilarChecked mocks base method.
+func (m *MockTransaction) SaveSimilarChecked(ctx context.Context, contracts ...*storage.Contract) error {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx}
 	for _, a := range contracts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "SaveProxyContracts", varargs...)
+	ret := m.ctrl.Call(m, "SaveSimilarChecked", varargs...)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// SaveProxyContracts indicates an expected call of SaveProxyContracts.
-func (mr *MockTransactionMockRecorder) SaveProxyContracts(ctx any, contracts ...any) *MockTransactionSaveProxyContractsCall {
+// SaveSimilarChecked indicates an expected call of SaveSimilarChecked.
+func (mr *MockTransactionMockRecorder) SaveSimilarChecked(ctx any, contracts ...any) *MockTransactionSaveSimilarCheckedCall {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{ctx}, contracts...)
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveProxyContracts", reflect.TypeOf((*MockTransaction)(nil).SaveProxyContracts), varargs...)
-	return &MockTransactionSaveProxyContractsCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveSimilarChecked", reflect.TypeOf((*MockTransaction)(nil).SaveSimilarChecked), varargs...)
+	return &MockTransactionSaveSimilarCheckedCall{Call: call}
 }
 
-// MockTransactionSaveProxyContractsCall wrap *gomock.Call
-type MockTransactionSaveProxyContractsCall struct {
+// MockTransactionSaveSimilarCheckedCall wrap *gomock.Call
+type MockTransactionSaveSimilarCheckedCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockTransactionSaveProxyContractsCall) Return(arg0 error) *MockTransactionSaveProxyContractsCall {
+func (c *MockTransactionSaveSimilarCheckedCall) Return(arg0 error) *MockTransactionSaveSimilarCheckedCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockTransactionSaveProxyContractsCall) Do(f func(context.Context, ...*storage.ProxyContract) error) *MockTransactionSaveProxyContractsCall {
+func (c *MockTransactionSaveSimilarCheckedCall) Do(f func(context.Context, ...*storage.Contract) error) *MockTransactionSaveSimilarCheckedCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockTransactionSaveProxyContractsCall) DoAndReturn(f func(context.Context, ...*storage.ProxyContract) error) *MockTransactionSaveProxyContractsCall {
+func (c *MockTransactionSaveSimilarCheckedCall) DoAndReturn(f func(context.Context, ...*storage.Contract) error) *MockTransactionSaveSimilarCheckedCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -1316,6 +2617,49 @@ func (c *MockTransactionSaveSourcesCall) DoAndReturn(f func(context.Context, ...
 	return c
 }
 
+// SaveTokenBalanceHistory mocks base method.
+func (m *MockTransaction) SaveTokenBalanceHistory(ctx context.Context, history ...*storage.TokenBalanceHistory) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range history {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveTokenBalanceHistory", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveTokenBalanceHistory indicates an expected call of SaveTokenBalanceHistory.
+func (mr *MockTransactionMockRecorder) SaveTokenBalanceHistory(ctx any, history ...any) *MockTransactionSaveTokenBalanceHistoryCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, history...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveTokenBalanceHistory", reflect.TypeOf((*MockTransaction)(nil).SaveTokenBalanceHistory), varargs...)
+	return &MockTransactionSaveTokenBalanceHistoryCall{Call: call}
+}
+
+// MockTransactionSaveTokenBalanceHistoryCall wrap *gomock.Call
+type MockTransactionSaveTokenBalanceHistoryCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionSaveTokenBalanceHistoryCall) Return(arg0 error) *MockTransactionSaveTokenBalanceHistoryCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionSaveTokenBalanceHistoryCall) Do(f func(context.Context, ...*storage.TokenBalanceHistory) error) *MockTransactionSaveTokenBalanceHistoryCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionSaveTokenBalanceHistoryCall) DoAndReturn(f func(context.Context, ...*storage.TokenBalanceHistory) error) *MockTransactionSaveTokenBalanceHistoryCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // SaveTokenBalances mocks base method.
 func (m *MockTransaction) SaveTokenBalances(ctx context.Context, tokenBalances ...*storage.TokenBalance) ([]storage.TokenBalance, error) {
 	m.ctrl.T.Helper()
@@ -1576,6 +2920,92 @@ func (c *MockTransactionSaveTransfersCall) DoAndReturn(f func(context.Context, .
 	return c
 }
 
+// SaveTxAccessLists mocks base method.
+func (m *MockTransaction) SaveTxAccessLists(ctx context.Context, accessLists ...*storage.TxAccessList) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range accessLists {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveTxAccessLists", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveTxAccessLists indicates an expected call of SaveTxAccessLists.
+func (mr *MockTransactionMockRecorder) SaveTxAccessLists(ctx any, accessLists ...any) *MockTransactionSaveTxAccessListsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, accessLists...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveTxAccessLists", reflect.TypeOf((*MockTransaction)(nil).SaveTxAccessLists), varargs...)
+	return &MockTransactionSaveTxAccessListsCall{Call: call}
+}
+
+// MockTransactionSaveTxAccessListsCall wrap *gomock.Call
+type MockTransactionSaveTxAccessListsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionSaveTxAccessListsCall) Return(arg0 error) *MockTransactionSaveTxAccessListsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionSaveTxAccessListsCall) Do(f func(context.Context, ...*storage.TxAccessList) error) *MockTransactionSaveTxAccessListsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionSaveTxAccessListsCall) DoAndReturn(f func(context.Context, ...*storage.TxAccessList) error) *MockTransactionSaveTxAccessListsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SaveTxAuthorizations mocks base method.
+func (m *MockTransaction) SaveTxAuthorizations(ctx context.Context, authorizations ...*storage.TxAuthorization) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range authorizations {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveTxAuthorizations", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveTxAuthorizations indicates an expected call of SaveTxAuthorizations.
+func (mr *MockTransactionMockRecorder) SaveTxAuthorizations(ctx any, authorizations ...any) *MockTransactionSaveTxAuthorizationsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, authorizations...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveTxAuthorizations", reflect.TypeOf((*MockTransaction)(nil).SaveTxAuthorizations), varargs...)
+	return &MockTransactionSaveTxAuthorizationsCall{Call: call}
+}
+
+// MockTransactionSaveTxAuthorizationsCall wrap *gomock.Call
+type MockTransactionSaveTxAuthorizationsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionSaveTxAuthorizationsCall) Return(arg0 error) *MockTransactionSaveTxAuthorizationsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionSaveTxAuthorizationsCall) Do(f func(context.Context, ...*storage.TxAuthorization) error) *MockTransactionSaveTxAuthorizationsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionSaveTxAuthorizationsCall) DoAndReturn(f func(context.Context, ...*storage.TxAuthorization) error) *MockTransactionSaveTxAuthorizationsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // SaveVerificationFiles mocks base method.
 func (m *MockTransaction) SaveVerificationFiles(ctx context.Context, files ...*storage.VerificationFile) error {
 	m.ctrl.T.Helper()
@@ -1734,6 +3164,125 @@ func (c *MockTransactionUpdateCall) DoAndReturn(f func(context.Context, any) err
 	return c
 }
 
+// UpdateBeaconProxies mocks base method.
+func (m *MockTransaction) UpdateBeaconProxies(ctx context.Context, beaconIds ...uint64) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range beaconIds {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateBeaconProxies", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateBeaconProxies indicates an expected call of UpdateBeaconProxies.
+func (mr *MockTransactionMockRecorder) UpdateBeaconProxies(ctx any, beaconIds ...any) *MockTransactionUpdateBeaconProxiesCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, beaconIds...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBeaconProxies", reflect.TypeOf((*MockTransaction)(nil).UpdateBeaconProxies), varargs...)
+	return &MockTransactionUpdateBeaconProxiesCall{Call: call}
+}
+
+// MockTransactionUpdateBeaconProxiesCall wrap *gomock.Call
+type MockTransactionUpdateBeaconProxiesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionUpdateBeaconProxiesCall) Return(arg0 error) *MockTransactionUpdateBeaconProxiesCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionUpdateBeaconProxiesCall) Do(f func(context.Context, ...uint64) error) *MockTransactionUpdateBeaconProxiesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionUpdateBeaconProxiesCall) DoAndReturn(f func(context.Context, ...uint64) error) *MockTransactionUpdateBeaconProxiesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateBlockFinality mocks base method.
+func (m *MockTransaction) UpdateBlockFinality(ctx context.Context, finality types.Finality, from, to types0.Level) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateBlockFinality", ctx, finality, from, to)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateBlockFinality indicates an expected call of UpdateBlockFinality.
+func (mr *MockTransactionMockRecorder) UpdateBlockFinality(ctx, finality, from, to any) *MockTransactionUpdateBlockFinalityCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBlockFinality", reflect.TypeOf((*MockTransaction)(nil).UpdateBlockFinality), ctx, finality, from, to)
+	return &MockTransactionUpdateBlockFinalityCall{Call: call}
+}
+
+// MockTransactionUpdateBlockFinalityCall wrap *gomock.Call
+type MockTransactionUpdateBlockFinalityCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionUpdateBlockFinalityCall) Return(arg0 error) *MockTransactionUpdateBlockFinalityCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionUpdateBlockFinalityCall) Do(f func(context.Context, types.Finality, types0.Level, types0.Level) error) *MockTransactionUpdateBlockFinalityCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionUpdateBlockFinalityCall) DoAndReturn(f func(context.Context, types.Finality, types0.Level, types0.Level) error) *MockTransactionUpdateBlockFinalityCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateBlockTime mocks base method.
+func (m *MockTransaction) UpdateBlockTime(ctx context.Context, height types0.Level) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateBlockTime", ctx, height)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateBlockTime indicates an expected call of UpdateBlockTime.
+func (mr *MockTransactionMockRecorder) UpdateBlockTime(ctx, height any) *MockTransactionUpdateBlockTimeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBlockTime", reflect.TypeOf((*MockTransaction)(nil).UpdateBlockTime), ctx, height)
+	return &MockTransactionUpdateBlockTimeCall{Call: call}
+}
+
+// MockTransactionUpdateBlockTimeCall wrap *gomock.Call
+type MockTransactionUpdateBlockTimeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionUpdateBlockTimeCall) Return(arg0 error) *MockTransactionUpdateBlockTimeCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionUpdateBlockTimeCall) Do(f func(context.Context, types0.Level) error) *MockTransactionUpdateBlockTimeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionUpdateBlockTimeCall) DoAndReturn(f func(context.Context, types0.Level) error) *MockTransactionUpdateBlockTimeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // UpdateVerificationTask mocks base method.
 func (m *MockTransaction) UpdateVerificationTask(ctx context.Context, task *storage.VerificationTask) error {
 	m.ctrl.T.Helper()
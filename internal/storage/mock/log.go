@@ -42,6 +42,45 @@ func (m *MockILog) EXPECT() *MockILogMockRecorder {
 	return m.recorder
 }
 
+// ByRange mocks base method.
+func (m *MockILog) ByRange(ctx context.Context, filter storage.LogRangeFilter) ([]storage.Log, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ByRange", ctx, filter)
+	ret0, _ := ret[0].([]storage.Log)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ByRange indicates an expected call of ByRange.
+func (mr *MockILogMockRecorder) ByRange(ctx, filter any) *MockILogByRangeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ByRange", reflect.TypeOf((*MockILog)(nil).ByRange), ctx, filter)
+	return &MockILogByRangeCall{Call: call}
+}
+
+// MockILogByRangeCall wrap *gomock.Call
+type MockILogByRangeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockILogByRangeCall) Return(arg0 []storage.Log, arg1 error) *MockILogByRangeCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockILogByRangeCall) Do(f func(context.Context, storage.LogRangeFilter) ([]storage.Log, error)) *MockILogByRangeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockILogByRangeCall) DoAndReturn(f func(context.Context, storage.LogRangeFilter) ([]storage.Log, error)) *MockILogByRangeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // CursorList mocks base method.
 func (m *MockILog) CursorList(ctx context.Context, id, limit uint64, order storage0.SortOrder, cmp storage0.Comparator) ([]*storage.Log, error) {
 	m.ctrl.T.Helper()
@@ -313,6 +352,45 @@ func (c *MockILogSaveCall) DoAndReturn(f func(context.Context, *storage.Log) err
 	return c
 }
 
+// Undecoded mocks base method.
+func (m *MockILog) Undecoded(ctx context.Context, limit int) ([]storage.Log, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Undecoded", ctx, limit)
+	ret0, _ := ret[0].([]storage.Log)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Undecoded indicates an expected call of Undecoded.
+func (mr *MockILogMockRecorder) Undecoded(ctx, limit any) *MockILogUndecodedCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Undecoded", reflect.TypeOf((*MockILog)(nil).Undecoded), ctx, limit)
+	return &MockILogUndecodedCall{Call: call}
+}
+
+// MockILogUndecodedCall wrap *gomock.Call
+type MockILogUndecodedCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockILogUndecodedCall) Return(arg0 []storage.Log, arg1 error) *MockILogUndecodedCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockILogUndecodedCall) Do(f func(context.Context, int) ([]storage.Log, error)) *MockILogUndecodedCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockILogUndecodedCall) DoAndReturn(f func(context.Context, int) ([]storage.Log, error)) *MockILogUndecodedCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Update mocks base method.
 func (m_2 *MockILog) Update(ctx context.Context, m *storage.Log) error {
 	m_2.ctrl.T.Helper()
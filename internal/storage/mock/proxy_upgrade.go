@@ -0,0 +1,352 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: proxy_upgrade.go
+//
+// Generated by this command:
+//
+//	mockgen -source=proxy_upgrade.go -destination=mock/proxy_upgrade.go -package=mock -typed
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	storage "github.com/NobleScope/noble-indexer/internal/storage"
+	storage0 "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIProxyUpgrade is a mock of IProxyUpgrade interface.
+type MockIProxyUpgrade struct {
+	ctrl     *gomock.Controller
+	recorder *MockIProxyUpgradeMockRecorder
+	isgomock struct{}
+}
+
+// MockIProxyUpgradeMockRecorder is the mock recorder for MockIProxyUpgrade.
+type MockIProxyUpgradeMockRecorder struct {
+	mock *MockIProxyUpgrade
+}
+
+// NewMockIProxyUpgrade creates a new mock instance.
+func NewMockIProxyUpgrade(ctrl *gomock.Controller) *MockIProxyUpgrade {
+	mock := &MockIProxyUpgrade{ctrl: ctrl}
+	mock.recorder = &MockIProxyUpgradeMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIProxyUpgrade) EXPECT() *MockIProxyUpgradeMockRecorder {
+	return m.recorder
+}
+
+// CursorList mocks base method.
+func (m *MockIProxyUpgrade) CursorList(ctx context.Context, id, limit uint64, order storage0.SortOrder, cmp storage0.Comparator) ([]*storage.ProxyUpgrade, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CursorList", ctx, id, limit, order, cmp)
+	ret0, _ := ret[0].([]*storage.ProxyUpgrade)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CursorList indicates an expected call of CursorList.
+func (mr *MockIProxyUpgradeMockRecorder) CursorList(ctx, id, limit, order, cmp any) *MockIProxyUpgradeCursorListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CursorList", reflect.TypeOf((*MockIProxyUpgrade)(nil).CursorList), ctx, id, limit, order, cmp)
+	return &MockIProxyUpgradeCursorListCall{Call: call}
+}
+
+// MockIProxyUpgradeCursorListCall wrap *gomock.Call
+type MockIProxyUpgradeCursorListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIProxyUpgradeCursorListCall) Return(arg0 []*storage.ProxyUpgrade, arg1 error) *MockIProxyUpgradeCursorListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIProxyUpgradeCursorListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.ProxyUpgrade, error)) *MockIProxyUpgradeCursorListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIProxyUpgradeCursorListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.ProxyUpgrade, error)) *MockIProxyUpgradeCursorListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Filter mocks base method.
+func (m *MockIProxyUpgrade) Filter(ctx context.Context, filter storage.ProxyUpgradeListFilter) ([]storage.ProxyUpgrade, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Filter", ctx, filter)
+	ret0, _ := ret[0].([]storage.ProxyUpgrade)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Filter indicates an expected call of Filter.
+func (mr *MockIProxyUpgradeMockRecorder) Filter(ctx, filter any) *MockIProxyUpgradeFilterCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Filter", reflect.TypeOf((*MockIProxyUpgrade)(nil).Filter), ctx, filter)
+	return &MockIProxyUpgradeFilterCall{Call: call}
+}
+
+// MockIProxyUpgradeFilterCall wrap *gomock.Call
+type MockIProxyUpgradeFilterCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIProxyUpgradeFilterCall) Return(arg0 []storage.ProxyUpgrade, arg1 error) *MockIProxyUpgradeFilterCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIProxyUpgradeFilterCall) Do(f func(context.Context, storage.ProxyUpgradeListFilter) ([]storage.ProxyUpgrade, error)) *MockIProxyUpgradeFilterCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIProxyUpgradeFilterCall) DoAndReturn(f func(context.Context, storage.ProxyUpgradeListFilter) ([]storage.ProxyUpgrade, error)) *MockIProxyUpgradeFilterCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByID mocks base method.
+func (m *MockIProxyUpgrade) GetByID(ctx context.Context, id uint64) (*storage.ProxyUpgrade, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*storage.ProxyUpgrade)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockIProxyUpgradeMockRecorder) GetByID(ctx, id any) *MockIProxyUpgradeGetByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockIProxyUpgrade)(nil).GetByID), ctx, id)
+	return &MockIProxyUpgradeGetByIDCall{Call: call}
+}
+
+// MockIProxyUpgradeGetByIDCall wrap *gomock.Call
+type MockIProxyUpgradeGetByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIProxyUpgradeGetByIDCall) Return(arg0 *storage.ProxyUpgrade, arg1 error) *MockIProxyUpgradeGetByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIProxyUpgradeGetByIDCall) Do(f func(context.Context, uint64) (*storage.ProxyUpgrade, error)) *MockIProxyUpgradeGetByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIProxyUpgradeGetByIDCall) DoAndReturn(f func(context.Context, uint64) (*storage.ProxyUpgrade, error)) *MockIProxyUpgradeGetByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// IsNoRows mocks base method.
+func (m *MockIProxyUpgrade) IsNoRows(err error) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsNoRows", err)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsNoRows indicates an expected call of IsNoRows.
+func (mr *MockIProxyUpgradeMockRecorder) IsNoRows(err any) *MockIProxyUpgradeIsNoRowsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsNoRows", reflect.TypeOf((*MockIProxyUpgrade)(nil).IsNoRows), err)
+	return &MockIProxyUpgradeIsNoRowsCall{Call: call}
+}
+
+// MockIProxyUpgradeIsNoRowsCall wrap *gomock.Call
+type MockIProxyUpgradeIsNoRowsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIProxyUpgradeIsNoRowsCall) Return(arg0 bool) *MockIProxyUpgradeIsNoRowsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIProxyUpgradeIsNoRowsCall) Do(f func(error) bool) *MockIProxyUpgradeIsNoRowsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIProxyUpgradeIsNoRowsCall) DoAndReturn(f func(error) bool) *MockIProxyUpgradeIsNoRowsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LastID mocks base method.
+func (m *MockIProxyUpgrade) LastID(ctx context.Context) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastID", ctx)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastID indicates an expected call of LastID.
+func (mr *MockIProxyUpgradeMockRecorder) LastID(ctx any) *MockIProxyUpgradeLastIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastID", reflect.TypeOf((*MockIProxyUpgrade)(nil).LastID), ctx)
+	return &MockIProxyUpgradeLastIDCall{Call: call}
+}
+
+// MockIProxyUpgradeLastIDCall wrap *gomock.Call
+type MockIProxyUpgradeLastIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIProxyUpgradeLastIDCall) Return(arg0 uint64, arg1 error) *MockIProxyUpgradeLastIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIProxyUpgradeLastIDCall) Do(f func(context.Context) (uint64, error)) *MockIProxyUpgradeLastIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIProxyUpgradeLastIDCall) DoAndReturn(f func(context.Context) (uint64, error)) *MockIProxyUpgradeLastIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MockIProxyUpgrade) List(ctx context.Context, limit, offset uint64, order storage0.SortOrder) ([]*storage.ProxyUpgrade, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, limit, offset, order)
+	ret0, _ := ret[0].([]*storage.ProxyUpgrade)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockIProxyUpgradeMockRecorder) List(ctx, limit, offset, order any) *MockIProxyUpgradeListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockIProxyUpgrade)(nil).List), ctx, limit, offset, order)
+	return &MockIProxyUpgradeListCall{Call: call}
+}
+
+// MockIProxyUpgradeListCall wrap *gomock.Call
+type MockIProxyUpgradeListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIProxyUpgradeListCall) Return(arg0 []*storage.ProxyUpgrade, arg1 error) *MockIProxyUpgradeListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIProxyUpgradeListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.ProxyUpgrade, error)) *MockIProxyUpgradeListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIProxyUpgradeListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.ProxyUpgrade, error)) *MockIProxyUpgradeListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Save mocks base method.
+func (m_2 *MockIProxyUpgrade) Save(ctx context.Context, m *storage.ProxyUpgrade) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Save", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockIProxyUpgradeMockRecorder) Save(ctx, m any) *MockIProxyUpgradeSaveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockIProxyUpgrade)(nil).Save), ctx, m)
+	return &MockIProxyUpgradeSaveCall{Call: call}
+}
+
+// MockIProxyUpgradeSaveCall wrap *gomock.Call
+type MockIProxyUpgradeSaveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIProxyUpgradeSaveCall) Return(arg0 error) *MockIProxyUpgradeSaveCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIProxyUpgradeSaveCall) Do(f func(context.Context, *storage.ProxyUpgrade) error) *MockIProxyUpgradeSaveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIProxyUpgradeSaveCall) DoAndReturn(f func(context.Context, *storage.ProxyUpgrade) error) *MockIProxyUpgradeSaveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Update mocks base method.
+func (m_2 *MockIProxyUpgrade) Update(ctx context.Context, m *storage.ProxyUpgrade) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Update", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockIProxyUpgradeMockRecorder) Update(ctx, m any) *MockIProxyUpgradeUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIProxyUpgrade)(nil).Update), ctx, m)
+	return &MockIProxyUpgradeUpdateCall{Call: call}
+}
+
+// MockIProxyUpgradeUpdateCall wrap *gomock.Call
+type MockIProxyUpgradeUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIProxyUpgradeUpdateCall) Return(arg0 error) *MockIProxyUpgradeUpdateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIProxyUpgradeUpdateCall) Do(f func(context.Context, *storage.ProxyUpgrade) error) *MockIProxyUpgradeUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIProxyUpgradeUpdateCall) DoAndReturn(f func(context.Context, *storage.ProxyUpgrade) error) *MockIProxyUpgradeUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
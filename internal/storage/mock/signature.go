@@ -0,0 +1,352 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: signature.go
+//
+// Generated by this command:
+//
+//	mockgen -source=signature.go -destination=mock/signature.go -package=mock -typed
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	storage "github.com/NobleScope/noble-indexer/internal/storage"
+	storage0 "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockISignature is a mock of ISignature interface.
+type MockISignature struct {
+	ctrl     *gomock.Controller
+	recorder *MockISignatureMockRecorder
+	isgomock struct{}
+}
+
+// MockISignatureMockRecorder is the mock recorder for MockISignature.
+type MockISignatureMockRecorder struct {
+	mock *MockISignature
+}
+
+// NewMockISignature creates a new mock instance.
+func NewMockISignature(ctrl *gomock.Controller) *MockISignature {
+	mock := &MockISignature{ctrl: ctrl}
+	mock.recorder = &MockISignatureMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockISignature) EXPECT() *MockISignatureMockRecorder {
+	return m.recorder
+}
+
+// CursorList mocks base method.
+func (m *MockISignature) CursorList(ctx context.Context, id, limit uint64, order storage0.SortOrder, cmp storage0.Comparator) ([]*storage.Signature, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CursorList", ctx, id, limit, order, cmp)
+	ret0, _ := ret[0].([]*storage.Signature)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CursorList indicates an expected call of CursorList.
+func (mr *MockISignatureMockRecorder) CursorList(ctx, id, limit, order, cmp any) *MockISignatureCursorListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CursorList", reflect.TypeOf((*MockISignature)(nil).CursorList), ctx, id, limit, order, cmp)
+	return &MockISignatureCursorListCall{Call: call}
+}
+
+// MockISignatureCursorListCall wrap *gomock.Call
+type MockISignatureCursorListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockISignatureCursorListCall) Return(arg0 []*storage.Signature, arg1 error) *MockISignatureCursorListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockISignatureCursorListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.Signature, error)) *MockISignatureCursorListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockISignatureCursorListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.Signature, error)) *MockISignatureCursorListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Filter mocks base method.
+func (m *MockISignature) Filter(ctx context.Context, filter storage.SignatureListFilter) ([]storage.Signature, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Filter", ctx, filter)
+	ret0, _ := ret[0].([]storage.Signature)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Filter indicates an expected call of Filter.
+func (mr *MockISignatureMockRecorder) Filter(ctx, filter any) *MockISignatureFilterCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Filter", reflect.TypeOf((*MockISignature)(nil).Filter), ctx, filter)
+	return &MockISignatureFilterCall{Call: call}
+}
+
+// MockISignatureFilterCall wrap *gomock.Call
+type MockISignatureFilterCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockISignatureFilterCall) Return(arg0 []storage.Signature, arg1 error) *MockISignatureFilterCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockISignatureFilterCall) Do(f func(context.Context, storage.SignatureListFilter) ([]storage.Signature, error)) *MockISignatureFilterCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockISignatureFilterCall) DoAndReturn(f func(context.Context, storage.SignatureListFilter) ([]storage.Signature, error)) *MockISignatureFilterCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByID mocks base method.
+func (m *MockISignature) GetByID(ctx context.Context, id uint64) (*storage.Signature, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*storage.Signature)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockISignatureMockRecorder) GetByID(ctx, id any) *MockISignatureGetByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockISignature)(nil).GetByID), ctx, id)
+	return &MockISignatureGetByIDCall{Call: call}
+}
+
+// MockISignatureGetByIDCall wrap *gomock.Call
+type MockISignatureGetByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockISignatureGetByIDCall) Return(arg0 *storage.Signature, arg1 error) *MockISignatureGetByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockISignatureGetByIDCall) Do(f func(context.Context, uint64) (*storage.Signature, error)) *MockISignatureGetByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockISignatureGetByIDCall) DoAndReturn(f func(context.Context, uint64) (*storage.Signature, error)) *MockISignatureGetByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// IsNoRows mocks base method.
+func (m *MockISignature) IsNoRows(err error) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsNoRows", err)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsNoRows indicates an expected call of IsNoRows.
+func (mr *MockISignatureMockRecorder) IsNoRows(err any) *MockISignatureIsNoRowsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsNoRows", reflect.TypeOf((*MockISignature)(nil).IsNoRows), err)
+	return &MockISignatureIsNoRowsCall{Call: call}
+}
+
+// MockISignatureIsNoRowsCall wrap *gomock.Call
+type MockISignatureIsNoRowsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockISignatureIsNoRowsCall) Return(arg0 bool) *MockISignatureIsNoRowsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockISignatureIsNoRowsCall) Do(f func(error) bool) *MockISignatureIsNoRowsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockISignatureIsNoRowsCall) DoAndReturn(f func(error) bool) *MockISignatureIsNoRowsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LastID mocks base method.
+func (m *MockISignature) LastID(ctx context.Context) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastID", ctx)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastID indicates an expected call of LastID.
+func (mr *MockISignatureMockRecorder) LastID(ctx any) *MockISignatureLastIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastID", reflect.TypeOf((*MockISignature)(nil).LastID), ctx)
+	return &MockISignatureLastIDCall{Call: call}
+}
+
+// MockISignatureLastIDCall wrap *gomock.Call
+type MockISignatureLastIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockISignatureLastIDCall) Return(arg0 uint64, arg1 error) *MockISignatureLastIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockISignatureLastIDCall) Do(f func(context.Context) (uint64, error)) *MockISignatureLastIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockISignatureLastIDCall) DoAndReturn(f func(context.Context) (uint64, error)) *MockISignatureLastIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MockISignature) List(ctx context.Context, limit, offset uint64, order storage0.SortOrder) ([]*storage.Signature, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, limit, offset, order)
+	ret0, _ := ret[0].([]*storage.Signature)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockISignatureMockRecorder) List(ctx, limit, offset, order any) *MockISignatureListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockISignature)(nil).List), ctx, limit, offset, order)
+	return &MockISignatureListCall{Call: call}
+}
+
+// MockISignatureListCall wrap *gomock.Call
+type MockISignatureListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockISignatureListCall) Return(arg0 []*storage.Signature, arg1 error) *MockISignatureListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockISignatureListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.Signature, error)) *MockISignatureListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockISignatureListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.Signature, error)) *MockISignatureListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Save mocks base method.
+func (m_2 *MockISignature) Save(ctx context.Context, m *storage.Signature) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Save", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockISignatureMockRecorder) Save(ctx, m any) *MockISignatureSaveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockISignature)(nil).Save), ctx, m)
+	return &MockISignatureSaveCall{Call: call}
+}
+
+// MockISignatureSaveCall wrap *gomock.Call
+type MockISignatureSaveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockISignatureSaveCall) Return(arg0 error) *MockISignatureSaveCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockISignatureSaveCall) Do(f func(context.Context, *storage.Signature) error) *MockISignatureSaveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockISignatureSaveCall) DoAndReturn(f func(context.Context, *storage.Signature) error) *MockISignatureSaveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Update mocks base method.
+func (m_2 *MockISignature) Update(ctx context.Context, m *storage.Signature) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Update", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockISignatureMockRecorder) Update(ctx, m any) *MockISignatureUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockISignature)(nil).Update), ctx, m)
+	return &MockISignatureUpdateCall{Call: call}
+}
+
+// MockISignatureUpdateCall wrap *gomock.Call
+type MockISignatureUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockISignatureUpdateCall) Return(arg0 error) *MockISignatureUpdateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockISignatureUpdateCall) Do(f func(context.Context, *storage.Signature) error) *MockISignatureUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockISignatureUpdateCall) DoAndReturn(f func(context.Context, *storage.Signature) error) *MockISignatureUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -275,6 +275,45 @@ func (c *MockIStateListCall) DoAndReturn(f func(context.Context, uint64, uint64,
 	return c
 }
 
+// Ranges mocks base method.
+func (m *MockIState) Ranges(ctx context.Context, name string) ([]storage.State, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ranges", ctx, name)
+	ret0, _ := ret[0].([]storage.State)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Ranges indicates an expected call of Ranges.
+func (mr *MockIStateMockRecorder) Ranges(ctx, name any) *MockIStateRangesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ranges", reflect.TypeOf((*MockIState)(nil).Ranges), ctx, name)
+	return &MockIStateRangesCall{Call: call}
+}
+
+// MockIStateRangesCall wrap *gomock.Call
+type MockIStateRangesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIStateRangesCall) Return(arg0 []storage.State, arg1 error) *MockIStateRangesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIStateRangesCall) Do(f func(context.Context, string) ([]storage.State, error)) *MockIStateRangesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIStateRangesCall) DoAndReturn(f func(context.Context, string) ([]storage.State, error)) *MockIStateRangesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Save mocks base method.
 func (m_2 *MockIState) Save(ctx context.Context, m *storage.State) error {
 	m_2.ctrl.T.Helper()
@@ -0,0 +1,393 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: token_balance_history.go
+//
+// Generated by this command:
+//
+//	mockgen -source=token_balance_history.go -destination=mock/token_balance_history.go -package=mock -typed
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	storage "github.com/NobleScope/noble-indexer/internal/storage"
+	types "github.com/NobleScope/noble-indexer/pkg/types"
+	storage0 "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	decimal "github.com/shopspring/decimal"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockITokenBalanceHistory is a mock of ITokenBalanceHistory interface.
+type MockITokenBalanceHistory struct {
+	ctrl     *gomock.Controller
+	recorder *MockITokenBalanceHistoryMockRecorder
+	isgomock struct{}
+}
+
+// MockITokenBalanceHistoryMockRecorder is the mock recorder for MockITokenBalanceHistory.
+type MockITokenBalanceHistoryMockRecorder struct {
+	mock *MockITokenBalanceHistory
+}
+
+// NewMockITokenBalanceHistory creates a new mock instance.
+func NewMockITokenBalanceHistory(ctrl *gomock.Controller) *MockITokenBalanceHistory {
+	mock := &MockITokenBalanceHistory{ctrl: ctrl}
+	mock.recorder = &MockITokenBalanceHistoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockITokenBalanceHistory) EXPECT() *MockITokenBalanceHistoryMockRecorder {
+	return m.recorder
+}
+
+// AtHeight mocks base method.
+func (m *MockITokenBalanceHistory) AtHeight(ctx context.Context, addressId, contractId uint64, tokenId decimal.Decimal, height types.Level) (storage.TokenBalanceHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AtHeight", ctx, addressId, contractId, tokenId, height)
+	ret0, _ := ret[0].(storage.TokenBalanceHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AtHeight indicates an expected call of AtHeight.
+func (mr *MockITokenBalanceHistoryMockRecorder) AtHeight(ctx, addressId, contractId, tokenId, height any) *MockITokenBalanceHistoryAtHeightCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AtHeight", reflect.TypeOf((*MockITokenBalanceHistory)(nil).AtHeight), ctx, addressId, contractId, tokenId, height)
+	return &MockITokenBalanceHistoryAtHeightCall{Call: call}
+}
+
+// MockITokenBalanceHistoryAtHeightCall wrap *gomock.Call
+type MockITokenBalanceHistoryAtHeightCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockITokenBalanceHistoryAtHeightCall) Return(arg0 storage.TokenBalanceHistory, arg1 error) *MockITokenBalanceHistoryAtHeightCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockITokenBalanceHistoryAtHeightCall) Do(f func(context.Context, uint64, uint64, decimal.Decimal, types.Level) (storage.TokenBalanceHistory, error)) *MockITokenBalanceHistoryAtHeightCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockITokenBalanceHistoryAtHeightCall) DoAndReturn(f func(context.Context, uint64, uint64, decimal.Decimal, types.Level) (storage.TokenBalanceHistory, error)) *MockITokenBalanceHistoryAtHeightCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CursorList mocks base method.
+func (m *MockITokenBalanceHistory) CursorList(ctx context.Context, id, limit uint64, order storage0.SortOrder, cmp storage0.Comparator) ([]*storage.TokenBalanceHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CursorList", ctx, id, limit, order, cmp)
+	ret0, _ := ret[0].([]*storage.TokenBalanceHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CursorList indicates an expected call of CursorList.
+func (mr *MockITokenBalanceHistoryMockRecorder) CursorList(ctx, id, limit, order, cmp any) *MockITokenBalanceHistoryCursorListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CursorList", reflect.TypeOf((*MockITokenBalanceHistory)(nil).CursorList), ctx, id, limit, order, cmp)
+	return &MockITokenBalanceHistoryCursorListCall{Call: call}
+}
+
+// MockITokenBalanceHistoryCursorListCall wrap *gomock.Call
+type MockITokenBalanceHistoryCursorListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockITokenBalanceHistoryCursorListCall) Return(arg0 []*storage.TokenBalanceHistory, arg1 error) *MockITokenBalanceHistoryCursorListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockITokenBalanceHistoryCursorListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.TokenBalanceHistory, error)) *MockITokenBalanceHistoryCursorListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockITokenBalanceHistoryCursorListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.TokenBalanceHistory, error)) *MockITokenBalanceHistoryCursorListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Filter mocks base method.
+func (m *MockITokenBalanceHistory) Filter(ctx context.Context, filter storage.TokenBalanceHistoryListFilter) ([]storage.TokenBalanceHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Filter", ctx, filter)
+	ret0, _ := ret[0].([]storage.TokenBalanceHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Filter indicates an expected call of Filter.
+func (mr *MockITokenBalanceHistoryMockRecorder) Filter(ctx, filter any) *MockITokenBalanceHistoryFilterCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Filter", reflect.TypeOf((*MockITokenBalanceHistory)(nil).Filter), ctx, filter)
+	return &MockITokenBalanceHistoryFilterCall{Call: call}
+}
+
+// MockITokenBalanceHistoryFilterCall wrap *gomock.Call
+type MockITokenBalanceHistoryFilterCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockITokenBalanceHistoryFilterCall) Return(arg0 []storage.TokenBalanceHistory, arg1 error) *MockITokenBalanceHistoryFilterCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockITokenBalanceHistoryFilterCall) Do(f func(context.Context, storage.TokenBalanceHistoryListFilter) ([]storage.TokenBalanceHistory, error)) *MockITokenBalanceHistoryFilterCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockITokenBalanceHistoryFilterCall) DoAndReturn(f func(context.Context, storage.TokenBalanceHistoryListFilter) ([]storage.TokenBalanceHistory, error)) *MockITokenBalanceHistoryFilterCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByID mocks base method.
+func (m *MockITokenBalanceHistory) GetByID(ctx context.Context, id uint64) (*storage.TokenBalanceHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*storage.TokenBalanceHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockITokenBalanceHistoryMockRecorder) GetByID(ctx, id any) *MockITokenBalanceHistoryGetByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockITokenBalanceHistory)(nil).GetByID), ctx, id)
+	return &MockITokenBalanceHistoryGetByIDCall{Call: call}
+}
+
+// MockITokenBalanceHistoryGetByIDCall wrap *gomock.Call
+type MockITokenBalanceHistoryGetByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockITokenBalanceHistoryGetByIDCall) Return(arg0 *storage.TokenBalanceHistory, arg1 error) *MockITokenBalanceHistoryGetByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockITokenBalanceHistoryGetByIDCall) Do(f func(context.Context, uint64) (*storage.TokenBalanceHistory, error)) *MockITokenBalanceHistoryGetByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockITokenBalanceHistoryGetByIDCall) DoAndReturn(f func(context.Context, uint64) (*storage.TokenBalanceHistory, error)) *MockITokenBalanceHistoryGetByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// IsNoRows mocks base method.
+func (m *MockITokenBalanceHistory) IsNoRows(err error) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsNoRows", err)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsNoRows indicates an expected call of IsNoRows.
+func (mr *MockITokenBalanceHistoryMockRecorder) IsNoRows(err any) *MockITokenBalanceHistoryIsNoRowsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsNoRows", reflect.TypeOf((*MockITokenBalanceHistory)(nil).IsNoRows), err)
+	return &MockITokenBalanceHistoryIsNoRowsCall{Call: call}
+}
+
+// MockITokenBalanceHistoryIsNoRowsCall wrap *gomock.Call
+type MockITokenBalanceHistoryIsNoRowsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockITokenBalanceHistoryIsNoRowsCall) Return(arg0 bool) *MockITokenBalanceHistoryIsNoRowsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockITokenBalanceHistoryIsNoRowsCall) Do(f func(error) bool) *MockITokenBalanceHistoryIsNoRowsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockITokenBalanceHistoryIsNoRowsCall) DoAndReturn(f func(error) bool) *MockITokenBalanceHistoryIsNoRowsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LastID mocks base method.
+func (m *MockITokenBalanceHistory) LastID(ctx context.Context) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastID", ctx)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastID indicates an expected call of LastID.
+func (mr *MockITokenBalanceHistoryMockRecorder) LastID(ctx any) *MockITokenBalanceHistoryLastIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastID", reflect.TypeOf((*MockITokenBalanceHistory)(nil).LastID), ctx)
+	return &MockITokenBalanceHistoryLastIDCall{Call: call}
+}
+
+// MockITokenBalanceHistoryLastIDCall wrap *gomock.Call
+type MockITokenBalanceHistoryLastIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockITokenBalanceHistoryLastIDCall) Return(arg0 uint64, arg1 error) *MockITokenBalanceHistoryLastIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockITokenBalanceHistoryLastIDCall) Do(f func(context.Context) (uint64, error)) *MockITokenBalanceHistoryLastIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockITokenBalanceHistoryLastIDCall) DoAndReturn(f func(context.Context) (uint64, error)) *MockITokenBalanceHistoryLastIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MockITokenBalanceHistory) List(ctx context.Context, limit, offset uint64, order storage0.SortOrder) ([]*storage.TokenBalanceHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, limit, offset, order)
+	ret0, _ := ret[0].([]*storage.TokenBalanceHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockITokenBalanceHistoryMockRecorder) List(ctx, limit, offset, order any) *MockITokenBalanceHistoryListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockITokenBalanceHistory)(nil).List), ctx, limit, offset, order)
+	return &MockITokenBalanceHistoryListCall{Call: call}
+}
+
+// MockITokenBalanceHistoryListCall wrap *gomock.Call
+type MockITokenBalanceHistoryListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockITokenBalanceHistoryListCall) Return(arg0 []*storage.TokenBalanceHistory, arg1 error) *MockITokenBalanceHistoryListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockITokenBalanceHistoryListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.TokenBalanceHistory, error)) *MockITokenBalanceHistoryListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockITokenBalanceHistoryListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.TokenBalanceHistory, error)) *MockITokenBalanceHistoryListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Save mocks base method.
+func (m_2 *MockITokenBalanceHistory) Save(ctx context.Context, m *storage.TokenBalanceHistory) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Save", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockITokenBalanceHistoryMockRecorder) Save(ctx, m any) *MockITokenBalanceHistorySaveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockITokenBalanceHistory)(nil).Save), ctx, m)
+	return &MockITokenBalanceHistorySaveCall{Call: call}
+}
+
+// MockITokenBalanceHistorySaveCall wrap *gomock.Call
+type MockITokenBalanceHistorySaveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockITokenBalanceHistorySaveCall) Return(arg0 error) *MockITokenBalanceHistorySaveCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockITokenBalanceHistorySaveCall) Do(f func(context.Context, *storage.TokenBalanceHistory) error) *MockITokenBalanceHistorySaveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockITokenBalanceHistorySaveCall) DoAndReturn(f func(context.Context, *storage.TokenBalanceHistory) error) *MockITokenBalanceHistorySaveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Update mocks base method.
+func (m_2 *MockITokenBalanceHistory) Update(ctx context.Context, m *storage.TokenBalanceHistory) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Update", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockITokenBalanceHistoryMockRecorder) Update(ctx, m any) *MockITokenBalanceHistoryUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockITokenBalanceHistory)(nil).Update), ctx, m)
+	return &MockITokenBalanceHistoryUpdateCall{Call: call}
+}
+
+// MockITokenBalanceHistoryUpdateCall wrap *gomock.Call
+type MockITokenBalanceHistoryUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockITokenBalanceHistoryUpdateCall) Return(arg0 error) *MockITokenBalanceHistoryUpdateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockITokenBalanceHistoryUpdateCall) Do(f func(context.Context, *storage.TokenBalanceHistory) error) *MockITokenBalanceHistoryUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockITokenBalanceHistoryUpdateCall) DoAndReturn(f func(context.Context, *storage.TokenBalanceHistory) error) *MockITokenBalanceHistoryUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
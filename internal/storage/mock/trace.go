@@ -81,6 +81,45 @@ func (c *MockITraceByTxIdCall) DoAndReturn(f func(context.Context, uint64, bool)
 	return c
 }
 
+// CreationByContractId mocks base method.
+func (m *MockITrace) CreationByContractId(ctx context.Context, contractId uint64) (storage.Trace, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreationByContractId", ctx, contractId)
+	ret0, _ := ret[0].(storage.Trace)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreationByContractId indicates an expected call of CreationByContractId.
+func (mr *MockITraceMockRecorder) CreationByContractId(ctx, contractId any) *MockITraceCreationByContractIdCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreationByContractId", reflect.TypeOf((*MockITrace)(nil).CreationByContractId), ctx, contractId)
+	return &MockITraceCreationByContractIdCall{Call: call}
+}
+
+// MockITraceCreationByContractIdCall wrap *gomock.Call
+type MockITraceCreationByContractIdCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockITraceCreationByContractIdCall) Return(arg0 storage.Trace, arg1 error) *MockITraceCreationByContractIdCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockITraceCreationByContractIdCall) Do(f func(context.Context, uint64) (storage.Trace, error)) *MockITraceCreationByContractIdCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockITraceCreationByContractIdCall) DoAndReturn(f func(context.Context, uint64) (storage.Trace, error)) *MockITraceCreationByContractIdCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // CursorList mocks base method.
 func (m *MockITrace) CursorList(ctx context.Context, id, limit uint64, order storage0.SortOrder, cmp storage0.Comparator) ([]*storage.Trace, error) {
 	m.ctrl.T.Helper()
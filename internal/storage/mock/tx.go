@@ -43,6 +43,45 @@ func (m *MockITx) EXPECT() *MockITxMockRecorder {
 	return m.recorder
 }
 
+// AllByHeight mocks base method.
+func (m *MockITx) AllByHeight(ctx context.Context, height types.Level) ([]storage.Tx, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllByHeight", ctx, height)
+	ret0, _ := ret[0].([]storage.Tx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AllByHeight indicates an expected call of AllByHeight.
+func (mr *MockITxMockRecorder) AllByHeight(ctx, height any) *MockITxAllByHeightCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllByHeight", reflect.TypeOf((*MockITx)(nil).AllByHeight), ctx, height)
+	return &MockITxAllByHeightCall{Call: call}
+}
+
+// MockITxAllByHeightCall wrap *gomock.Call
+type MockITxAllByHeightCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockITxAllByHeightCall) Return(arg0 []storage.Tx, arg1 error) *MockITxAllByHeightCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockITxAllByHeightCall) Do(f func(context.Context, types.Level) ([]storage.Tx, error)) *MockITxAllByHeightCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockITxAllByHeightCall) DoAndReturn(f func(context.Context, types.Level) ([]storage.Tx, error)) *MockITxAllByHeightCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ByHash mocks base method.
 func (m *MockITx) ByHash(ctx context.Context, hash types.Hex, withABI bool) (storage.Tx, error) {
 	m.ctrl.T.Helper()
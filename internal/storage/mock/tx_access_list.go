@@ -0,0 +1,352 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: tx_access_list.go
+//
+// Generated by this command:
+//
+//	mockgen -source=tx_access_list.go -destination=mock/tx_access_list.go -package=mock -typed
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	storage "github.com/NobleScope/noble-indexer/internal/storage"
+	storage0 "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockITxAccessList is a mock of ITxAccessList interface.
+type MockITxAccessList struct {
+	ctrl     *gomock.Controller
+	recorder *MockITxAccessListMockRecorder
+	isgomock struct{}
+}
+
+// MockITxAccessListMockRecorder is the mock recorder for MockITxAccessList.
+type MockITxAccessListMockRecorder struct {
+	mock *MockITxAccessList
+}
+
+// NewMockITxAccessList creates a new mock instance.
+func NewMockITxAccessList(ctrl *gomock.Controller) *MockITxAccessList {
+	mock := &MockITxAccessList{ctrl: ctrl}
+	mock.recorder = &MockITxAccessListMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockITxAccessList) EXPECT() *MockITxAccessListMockRecorder {
+	return m.recorder
+}
+
+// ByTxId mocks base method.
+func (m *MockITxAccessList) ByTxId(ctx context.Context, txId uint64) ([]storage.TxAccessList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ByTxId", ctx, txId)
+	ret0, _ := ret[0].([]storage.TxAccessList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ByTxId indicates an expected call of ByTxId.
+func (mr *MockITxAccessListMockRecorder) ByTxId(ctx, txId any) *MockITxAccessListByTxIdCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ByTxId", reflect.TypeOf((*MockITxAccessList)(nil).ByTxId), ctx, txId)
+	return &MockITxAccessListByTxIdCall{Call: call}
+}
+
+// MockITxAccessListByTxIdCall wrap *gomock.Call
+type MockITxAccessListByTxIdCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockITxAccessListByTxIdCall) Return(arg0 []storage.TxAccessList, arg1 error) *MockITxAccessListByTxIdCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockITxAccessListByTxIdCall) Do(f func(context.Context, uint64) ([]storage.TxAccessList, error)) *MockITxAccessListByTxIdCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockITxAccessListByTxIdCall) DoAndReturn(f func(context.Context, uint64) ([]storage.TxAccessList, error)) *MockITxAccessListByTxIdCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CursorList mocks base method.
+func (m *MockITxAccessList) CursorList(ctx context.Context, id, limit uint64, order storage0.SortOrder, cmp storage0.Comparator) ([]*storage.TxAccessList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CursorList", ctx, id, limit, order, cmp)
+	ret0, _ := ret[0].([]*storage.TxAccessList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CursorList indicates an expected call of CursorList.
+func (mr *MockITxAccessListMockRecorder) CursorList(ctx, id, limit, order, cmp any) *MockITxAccessListCursorListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CursorList", reflect.TypeOf((*MockITxAccessList)(nil).CursorList), ctx, id, limit, order, cmp)
+	return &MockITxAccessListCursorListCall{Call: call}
+}
+
+// MockITxAccessListCursorListCall wrap *gomock.Call
+type MockITxAccessListCursorListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockITxAccessListCursorListCall) Return(arg0 []*storage.TxAccessList, arg1 error) *MockITxAccessListCursorListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockITxAccessListCursorListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.TxAccessList, error)) *MockITxAccessListCursorListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockITxAccessListCursorListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.TxAccessList, error)) *MockITxAccessListCursorListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByID mocks base method.
+func (m *MockITxAccessList) GetByID(ctx context.Context, id uint64) (*storage.TxAccessList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*storage.TxAccessList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockITxAccessListMockRecorder) GetByID(ctx, id any) *MockITxAccessListGetByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockITxAccessList)(nil).GetByID), ctx, id)
+	return &MockITxAccessListGetByIDCall{Call: call}
+}
+
+// MockITxAccessListGetByIDCall wrap *gomock.Call
+type MockITxAccessListGetByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockITxAccessListGetByIDCall) Return(arg0 *storage.TxAccessList, arg1 error) *MockITxAccessListGetByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockITxAccessListGetByIDCall) Do(f func(context.Context, uint64) (*storage.TxAccessList, error)) *MockITxAccessListGetByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockITxAccessListGetByIDCall) DoAndReturn(f func(context.Context, uint64) (*storage.TxAccessList, error)) *MockITxAccessListGetByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// IsNoRows mocks base method.
+func (m *MockITxAccessList) IsNoRows(err error) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsNoRows", err)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsNoRows indicates an expected call of IsNoRows.
+func (mr *MockITxAccessListMockRecorder) IsNoRows(err any) *MockITxAccessListIsNoRowsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsNoRows", reflect.TypeOf((*MockITxAccessList)(nil).IsNoRows), err)
+	return &MockITxAccessListIsNoRowsCall{Call: call}
+}
+
+// MockITxAccessListIsNoRowsCall wrap *gomock.Call
+type MockITxAccessListIsNoRowsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockITxAccessListIsNoRowsCall) Return(arg0 bool) *MockITxAccessListIsNoRowsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockITxAccessListIsNoRowsCall) Do(f func(error) bool) *MockITxAccessListIsNoRowsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockITxAccessListIsNoRowsCall) DoAndReturn(f func(error) bool) *MockITxAccessListIsNoRowsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LastID mocks base method.
+func (m *MockITxAccessList) LastID(ctx context.Context) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastID", ctx)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastID indicates an expected call of LastID.
+func (mr *MockITxAccessListMockRecorder) LastID(ctx any) *MockITxAccessListLastIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastID", reflect.TypeOf((*MockITxAccessList)(nil).LastID), ctx)
+	return &MockITxAccessListLastIDCall{Call: call}
+}
+
+// MockITxAccessListLastIDCall wrap *gomock.Call
+type MockITxAccessListLastIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockITxAccessListLastIDCall) Return(arg0 uint64, arg1 error) *MockITxAccessListLastIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockITxAccessListLastIDCall) Do(f func(context.Context) (uint64, error)) *MockITxAccessListLastIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockITxAccessListLastIDCall) DoAndReturn(f func(context.Context) (uint64, error)) *MockITxAccessListLastIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MockITxAccessList) List(ctx context.Context, limit, offset uint64, order storage0.SortOrder) ([]*storage.TxAccessList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, limit, offset, order)
+	ret0, _ := ret[0].([]*storage.TxAccessList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockITxAccessListMockRecorder) List(ctx, limit, offset, order any) *MockITxAccessListListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockITxAccessList)(nil).List), ctx, limit, offset, order)
+	return &MockITxAccessListListCall{Call: call}
+}
+
+// MockITxAccessListListCall wrap *gomock.Call
+type MockITxAccessListListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockITxAccessListListCall) Return(arg0 []*storage.TxAccessList, arg1 error) *MockITxAccessListListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockITxAccessListListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.TxAccessList, error)) *MockITxAccessListListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockITxAccessListListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.TxAccessList, error)) *MockITxAccessListListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Save mocks base method.
+func (m_2 *MockITxAccessList) Save(ctx context.Context, m *storage.TxAccessList) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Save", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockITxAccessListMockRecorder) Save(ctx, m any) *MockITxAccessListSaveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockITxAccessList)(nil).Save), ctx, m)
+	return &MockITxAccessListSaveCall{Call: call}
+}
+
+// MockITxAccessListSaveCall wrap *gomock.Call
+type MockITxAccessListSaveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockITxAccessListSaveCall) Return(arg0 error) *MockITxAccessListSaveCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockITxAccessListSaveCall) Do(f func(context.Context, *storage.TxAccessList) error) *MockITxAccessListSaveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockITxAccessListSaveCall) DoAndReturn(f func(context.Context, *storage.TxAccessList) error) *MockITxAccessListSaveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Update mocks base method.
+func (m_2 *MockITxAccessList) Update(ctx context.Context, m *storage.TxAccessList) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Update", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockITxAccessListMockRecorder) Update(ctx, m any) *MockITxAccessListUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockITxAccessList)(nil).Update), ctx, m)
+	return &MockITxAccessListUpdateCall{Call: call}
+}
+
+// MockITxAccessListUpdateCall wrap *gomock.Call
+type MockITxAccessListUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockITxAccessListUpdateCall) Return(arg0 error) *MockITxAccessListUpdateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockITxAccessListUpdateCall) Do(f func(context.Context, *storage.TxAccessList) error) *MockITxAccessListUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockITxAccessListUpdateCall) DoAndReturn(f func(context.Context, *storage.TxAccessList) error) *MockITxAccessListUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,391 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: tx_authorization.go
+//
+// Generated by this command:
+//
+//	mockgen -source=tx_authorization.go -destination=mock/tx_authorization.go -package=mock -typed
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	storage "github.com/NobleScope/noble-indexer/internal/storage"
+	storage0 "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockITxAuthorization is a mock of ITxAuthorization interface.
+type MockITxAuthorization struct {
+	ctrl     *gomock.Controller
+	recorder *MockITxAuthorizationMockRecorder
+	isgomock struct{}
+}
+
+// MockITxAuthorizationMockRecorder is the mock recorder for MockITxAuthorization.
+type MockITxAuthorizationMockRecorder struct {
+	mock *MockITxAuthorization
+}
+
+// NewMockITxAuthorization creates a new mock instance.
+func NewMockITxAuthorization(ctrl *gomock.Controller) *MockITxAuthorization {
+	mock := &MockITxAuthorization{ctrl: ctrl}
+	mock.recorder = &MockITxAuthorizationMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockITxAuthorization) EXPECT() *MockITxAuthorizationMockRecorder {
+	return m.recorder
+}
+
+// ByTxId mocks base method.
+func (m *MockITxAuthorization) ByTxId(ctx context.Context, txId uint64) ([]storage.TxAuthorization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ByTxId", ctx, txId)
+	ret0, _ := ret[0].([]storage.TxAuthorization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ByTxId indicates an expected call of ByTxId.
+func (mr *MockITxAuthorizationMockRecorder) ByTxId(ctx, txId any) *MockITxAuthorizationByTxIdCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ByTxId", reflect.TypeOf((*MockITxAuthorization)(nil).ByTxId), ctx, txId)
+	return &MockITxAuthorizationByTxIdCall{Call: call}
+}
+
+// MockITxAuthorizationByTxIdCall wrap *gomock.Call
+type MockITxAuthorizationByTxIdCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockITxAuthorizationByTxIdCall) Return(arg0 []storage.TxAuthorization, arg1 error) *MockITxAuthorizationByTxIdCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockITxAuthorizationByTxIdCall) Do(f func(context.Context, uint64) ([]storage.TxAuthorization, error)) *MockITxAuthorizationByTxIdCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockITxAuthorizationByTxIdCall) DoAndReturn(f func(context.Context, uint64) ([]storage.TxAuthorization, error)) *MockITxAuthorizationByTxIdCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CursorList mocks base method.
+func (m *MockITxAuthorization) CursorList(ctx context.Context, id, limit uint64, order storage0.SortOrder, cmp storage0.Comparator) ([]*storage.TxAuthorization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CursorList", ctx, id, limit, order, cmp)
+	ret0, _ := ret[0].([]*storage.TxAuthorization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CursorList indicates an expected call of CursorList.
+func (mr *MockITxAuthorizationMockRecorder) CursorList(ctx, id, limit, order, cmp any) *MockITxAuthorizationCursorListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CursorList", reflect.TypeOf((*MockITxAuthorization)(nil).CursorList), ctx, id, limit, order, cmp)
+	return &MockITxAuthorizationCursorListCall{Call: call}
+}
+
+// MockITxAuthorizationCursorListCall wrap *gomock.Call
+type MockITxAuthorizationCursorListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockITxAuthorizationCursorListCall) Return(arg0 []*storage.TxAuthorization, arg1 error) *MockITxAuthorizationCursorListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockITxAuthorizationCursorListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.TxAuthorization, error)) *MockITxAuthorizationCursorListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockITxAuthorizationCursorListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.TxAuthorization, error)) *MockITxAuthorizationCursorListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Filter mocks base method.
+func (m *MockITxAuthorization) Filter(ctx context.Context, filter storage.TxAuthorizationListFilter) ([]storage.TxAuthorization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Filter", ctx, filter)
+	ret0, _ := ret[0].([]storage.TxAuthorization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Filter indicates an expected call of Filter.
+func (mr *MockITxAuthorizationMockRecorder) Filter(ctx, filter any) *MockITxAuthorizationFilterCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Filter", reflect.TypeOf((*MockITxAuthorization)(nil).Filter), ctx, filter)
+	return &MockITxAuthorizationFilterCall{Call: call}
+}
+
+// MockITxAuthorizationFilterCall wrap *gomock.Call
+type MockITxAuthorizationFilterCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockITxAuthorizationFilterCall) Return(arg0 []storage.TxAuthorization, arg1 error) *MockITxAuthorizationFilterCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockITxAuthorizationFilterCall) Do(f func(context.Context, storage.TxAuthorizationListFilter) ([]storage.TxAuthorization, error)) *MockITxAuthorizationFilterCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockITxAuthorizationFilterCall) DoAndReturn(f func(context.Context, storage.TxAuthorizationListFilter) ([]storage.TxAuthorization, error)) *MockITxAuthorizationFilterCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByID mocks base method.
+func (m *MockITxAuthorization) GetByID(ctx context.Context, id uint64) (*storage.TxAuthorization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*storage.TxAuthorization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockITxAuthorizationMockRecorder) GetByID(ctx, id any) *MockITxAuthorizationGetByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockITxAuthorization)(nil).GetByID), ctx, id)
+	return &MockITxAuthorizationGetByIDCall{Call: call}
+}
+
+// MockITxAuthorizationGetByIDCall wrap *gomock.Call
+type MockITxAuthorizationGetByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockITxAuthorizationGetByIDCall) Return(arg0 *storage.TxAuthorization, arg1 error) *MockITxAuthorizationGetByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockITxAuthorizationGetByIDCall) Do(f func(context.Context, uint64) (*storage.TxAuthorization, error)) *MockITxAuthorizationGetByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockITxAuthorizationGetByIDCall) DoAndReturn(f func(context.Context, uint64) (*storage.TxAuthorization, error)) *MockITxAuthorizationGetByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// IsNoRows mocks base method.
+func (m *MockITxAuthorization) IsNoRows(err error) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsNoRows", err)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsNoRows indicates an expected call of IsNoRows.
+func (mr *MockITxAuthorizationMockRecorder) IsNoRows(err any) *MockITxAuthorizationIsNoRowsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsNoRows", reflect.TypeOf((*MockITxAuthorization)(nil).IsNoRows), err)
+	return &MockITxAuthorizationIsNoRowsCall{Call: call}
+}
+
+// MockITxAuthorizationIsNoRowsCall wrap *gomock.Call
+type MockITxAuthorizationIsNoRowsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockITxAuthorizationIsNoRowsCall) Return(arg0 bool) *MockITxAuthorizationIsNoRowsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockITxAuthorizationIsNoRowsCall) Do(f func(error) bool) *MockITxAuthorizationIsNoRowsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockITxAuthorizationIsNoRowsCall) DoAndReturn(f func(error) bool) *MockITxAuthorizationIsNoRowsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LastID mocks base method.
+func (m *MockITxAuthorization) LastID(ctx context.Context) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastID", ctx)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastID indicates an expected call of LastID.
+func (mr *MockITxAuthorizationMockRecorder) LastID(ctx any) *MockITxAuthorizationLastIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastID", reflect.TypeOf((*MockITxAuthorization)(nil).LastID), ctx)
+	return &MockITxAuthorizationLastIDCall{Call: call}
+}
+
+// MockITxAuthorizationLastIDCall wrap *gomock.Call
+type MockITxAuthorizationLastIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockITxAuthorizationLastIDCall) Return(arg0 uint64, arg1 error) *MockITxAuthorizationLastIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockITxAuthorizationLastIDCall) Do(f func(context.Context) (uint64, error)) *MockITxAuthorizationLastIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockITxAuthorizationLastIDCall) DoAndReturn(f func(context.Context) (uint64, error)) *MockITxAuthorizationLastIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MockITxAuthorization) List(ctx context.Context, limit, offset uint64, order storage0.SortOrder) ([]*storage.TxAuthorization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, limit, offset, order)
+	ret0, _ := ret[0].([]*storage.TxAuthorization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockITxAuthorizationMockRecorder) List(ctx, limit, offset, order any) *MockITxAuthorizationListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockITxAuthorization)(nil).List), ctx, limit, offset, order)
+	return &MockITxAuthorizationListCall{Call: call}
+}
+
+// MockITxAuthorizationListCall wrap *gomock.Call
+type MockITxAuthorizationListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockITxAuthorizationListCall) Return(arg0 []*storage.TxAuthorization, arg1 error) *MockITxAuthorizationListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockITxAuthorizationListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.TxAuthorization, error)) *MockITxAuthorizationListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockITxAuthorizationListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.TxAuthorization, error)) *MockITxAuthorizationListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Save mocks base method.
+func (m_2 *MockITxAuthorization) Save(ctx context.Context, m *storage.TxAuthorization) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Save", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockITxAuthorizationMockRecorder) Save(ctx, m any) *MockITxAuthorizationSaveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockITxAuthorization)(nil).Save), ctx, m)
+	return &MockITxAuthorizationSaveCall{Call: call}
+}
+
+// MockITxAuthorizationSaveCall wrap *gomock.Call
+type MockITxAuthorizationSaveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockITxAuthorizationSaveCall) Return(arg0 error) *MockITxAuthorizationSaveCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockITxAuthorizationSaveCall) Do(f func(context.Context, *storage.TxAuthorization) error) *MockITxAuthorizationSaveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockITxAuthorizationSaveCall) DoAndReturn(f func(context.Context, *storage.TxAuthorization) error) *MockITxAuthorizationSaveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Update mocks base method.
+func (m_2 *MockITxAuthorization) Update(ctx context.Context, m *storage.TxAuthorization) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Update", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockITxAuthorizationMockRecorder) Update(ctx, m any) *MockITxAuthorizationUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockITxAuthorization)(nil).Update), ctx, m)
+	return &MockITxAuthorizationUpdateCall{Call: call}
+}
+
+// MockITxAuthorizationUpdateCall wrap *gomock.Call
+type MockITxAuthorizationUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockITxAuthorizationUpdateCall) Return(arg0 error) *MockITxAuthorizationUpdateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockITxAuthorizationUpdateCall) Do(f func(context.Context, *storage.TxAuthorization) error) *MockITxAuthorizationUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockITxAuthorizationUpdateCall) DoAndReturn(f func(context.Context, *storage.TxAuthorization) error) *MockITxAuthorizationUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
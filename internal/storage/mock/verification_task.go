@@ -81,6 +81,45 @@ func (c *MockIVerificationTaskByContractIdCall) DoAndReturn(f func(context.Conte
 	return c
 }
 
+// ById mocks base method.
+func (m *MockIVerificationTask) ById(ctx context.Context, id uint64) (storage.VerificationTask, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ById", ctx, id)
+	ret0, _ := ret[0].(storage.VerificationTask)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ById indicates an expected call of ById.
+func (mr *MockIVerificationTaskMockRecorder) ById(ctx, id any) *MockIVerificationTaskByIdCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ById", reflect.TypeOf((*MockIVerificationTask)(nil).ById), ctx, id)
+	return &MockIVerificationTaskByIdCall{Call: call}
+}
+
+// MockIVerificationTaskByIdCall wrap *gomock.Call
+type MockIVerificationTaskByIdCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIVerificationTaskByIdCall) Return(arg0 storage.VerificationTask, arg1 error) *MockIVerificationTaskByIdCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIVerificationTaskByIdCall) Do(f func(context.Context, uint64) (storage.VerificationTask, error)) *MockIVerificationTaskByIdCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIVerificationTaskByIdCall) DoAndReturn(f func(context.Context, uint64) (storage.VerificationTask, error)) *MockIVerificationTaskByIdCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // CursorList mocks base method.
 func (m *MockIVerificationTask) CursorList(ctx context.Context, id, limit uint64, order storage0.SortOrder, cmp storage0.Comparator) ([]*storage.VerificationTask, error) {
 	m.ctrl.T.Helper()
@@ -275,6 +314,45 @@ func (c *MockIVerificationTaskLatestCall) DoAndReturn(f func(context.Context) (s
 	return c
 }
 
+// LatestByContractId mocks base method.
+func (m *MockIVerificationTask) LatestByContractId(ctx context.Context, contractId uint64) (storage.VerificationTask, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LatestByContractId", ctx, contractId)
+	ret0, _ := ret[0].(storage.VerificationTask)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LatestByContractId indicates an expected call of LatestByContractId.
+func (mr *MockIVerificationTaskMockRecorder) LatestByContractId(ctx, contractId any) *MockIVerificationTaskLatestByContractIdCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LatestByContractId", reflect.TypeOf((*MockIVerificationTask)(nil).LatestByContractId), ctx, contractId)
+	return &MockIVerificationTaskLatestByContractIdCall{Call: call}
+}
+
+// MockIVerificationTaskLatestByContractIdCall wrap *gomock.Call
+type MockIVerificationTaskLatestByContractIdCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIVerificationTaskLatestByContractIdCall) Return(arg0 storage.VerificationTask, arg1 error) *MockIVerificationTaskLatestByContractIdCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIVerificationTaskLatestByContractIdCall) Do(f func(context.Context, uint64) (storage.VerificationTask, error)) *MockIVerificationTaskLatestByContractIdCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIVerificationTaskLatestByContractIdCall) DoAndReturn(f func(context.Context, uint64) (storage.VerificationTask, error)) *MockIVerificationTaskLatestByContractIdCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // List mocks base method.
 func (m *MockIVerificationTask) List(ctx context.Context, limit, offset uint64, order storage0.SortOrder) ([]*storage.VerificationTask, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,45 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/NobleScope/noble-indexer/internal/storage"
+	"github.com/dipdup-net/go-lib/database"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+)
+
+type Allowance struct {
+	*postgres.Table[*storage.Allowance]
+}
+
+// NewAllowance -
+func NewAllowance(db *database.Bun) *Allowance {
+	return &Allowance{
+		Table: postgres.NewTable[*storage.Allowance](db),
+	}
+}
+
+// Filter -
+func (a *Allowance) Filter(ctx context.Context, filter storage.AllowanceListFilter) (allowances []storage.Allowance, err error) {
+	query := a.DB().NewSelect().
+		Model(&allowances)
+
+	query = allowanceListFilter(query, filter)
+
+	outerQuery := a.DB().NewSelect().
+		ColumnExpr("allowance.*").
+		ColumnExpr("owner.hash AS owner__hash").
+		ColumnExpr("spender.hash AS spender__hash").
+		ColumnExpr("contract_addr.hash AS contract__address__hash").
+		ColumnExpr("token.name AS token__name, token.symbol AS token__symbol, token.decimals AS token__decimals, token.type AS token__type, token.logo as token__logo").
+		TableExpr("(?) AS allowance", query).
+		Join("LEFT JOIN address AS owner ON owner.id = allowance.owner_id").
+		Join("LEFT JOIN address AS spender ON spender.id = allowance.spender_id").
+		Join("LEFT JOIN address AS contract_addr ON contract_addr.id = allowance.contract_id").
+		Join("LEFT JOIN token ON token.token_id = allowance.token_id AND allowance.contract_id = token.contract_id")
+
+	outerQuery = sortScope(outerQuery, "allowance.id", filter.Sort)
+	err = outerQuery.Scan(ctx, &allowances)
+
+	return
+}
@@ -0,0 +1,117 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/NobleScope/noble-indexer/internal/storage"
+	"github.com/NobleScope/noble-indexer/internal/storage/types"
+	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/shopspring/decimal"
+)
+
+func (s *StorageTestSuite) TestAllowanceFilter() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	allowances, err := s.storage.Allowance.Filter(ctx, storage.AllowanceListFilter{
+		OwnerId: 1,
+		Limit:   10,
+		Sort:    sdk.SortOrderAsc,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(allowances, 3)
+
+	s.Require().EqualValues(1, allowances[0].Id)
+	s.Require().Equal(types.Allowance, allowances[0].Type)
+	s.Require().EqualValues(100, allowances[0].Height)
+	s.Require().EqualValues(150, allowances[0].LastHeight)
+	s.Require().True(allowances[0].Amount.Equal(decimal.NewFromInt(500)))
+	s.Require().True(allowances[0].Approved)
+	s.Require().Equal("0xa63d581a7fdab643c09f0524904b046cdb9ad9d2", allowances[0].Owner.Hash.Hex())
+	s.Require().Equal("0xaa725ef35d90060a8cdfb77e324a9b770ca7e127", allowances[0].Spender.Hash.Hex())
+	s.Require().Equal("0x30f055506ba543ea0942dc8ca03f596ab75bc879", allowances[0].Contract.Address.Hash.Hex())
+	s.Require().NotNil(allowances[0].Token)
+	s.Require().NotEmpty(allowances[0].Token.Type)
+
+	s.Require().EqualValues(2, allowances[1].Id)
+	s.Require().Equal(types.TokenApproval, allowances[1].Type)
+	s.Require().True(allowances[1].TokenID.Equal(decimal.NewFromInt(7)))
+
+	s.Require().EqualValues(3, allowances[2].Id)
+	s.Require().Equal(types.ApprovalForAll, allowances[2].Type)
+}
+
+func (s *StorageTestSuite) TestAllowanceFilterByType() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	contractId := uint64(4)
+	allowances, err := s.storage.Allowance.Filter(ctx, storage.AllowanceListFilter{
+		OwnerId:    1,
+		ContractId: &contractId,
+		Type:       []types.ApprovalType{types.ApprovalForAll},
+		Limit:      10,
+		Sort:       sdk.SortOrderDesc,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(allowances, 1)
+	s.Require().EqualValues(3, allowances[0].Id)
+	s.Require().Equal("0x60f055506ba543ea0942dc8ca03f596ab75bc882", allowances[0].Spender.Hash.Hex())
+}
+
+func (s *StorageTestSuite) TestAllowanceFilterBySpender() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	spenderId := uint64(5)
+	allowances, err := s.storage.Allowance.Filter(ctx, storage.AllowanceListFilter{
+		OwnerId:   1,
+		SpenderId: &spenderId,
+		Limit:     10,
+		Sort:      sdk.SortOrderAsc,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(allowances, 1)
+	s.Require().EqualValues(2, allowances[0].Id)
+}
+
+func (s *StorageTestSuite) TestAllowanceFilterRevoked() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	allowances, err := s.storage.Allowance.Filter(ctx, storage.AllowanceListFilter{
+		OwnerId: 2,
+		Limit:   10,
+		Sort:    sdk.SortOrderAsc,
+	})
+	s.Require().NoError(err)
+	s.Require().Empty(allowances)
+
+	allowances, err = s.storage.Allowance.Filter(ctx, storage.AllowanceListFilter{
+		OwnerId:        2,
+		IncludeRevoked: true,
+		Limit:          10,
+		Sort:           sdk.SortOrderAsc,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(allowances, 1)
+	s.Require().EqualValues(4, allowances[0].Id)
+	s.Require().False(allowances[0].Approved)
+}
+
+func (s *StorageTestSuite) TestAllowanceFilterCursor() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	allowances, err := s.storage.Allowance.Filter(ctx, storage.AllowanceListFilter{
+		OwnerId:  1,
+		CursorID: 1,
+		Limit:    10,
+		Sort:     sdk.SortOrderAsc,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(allowances, 2)
+	s.Require().EqualValues(2, allowances[0].Id)
+	s.Require().EqualValues(3, allowances[1].Id)
+}
@@ -0,0 +1,119 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/NobleScope/noble-indexer/internal/storage"
+	pkgTypes "github.com/NobleScope/noble-indexer/pkg/types"
+	"github.com/dipdup-net/go-lib/database"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+	"github.com/pkg/errors"
+)
+
+type BalanceHistory struct {
+	*postgres.Table[*storage.BalanceHistory]
+}
+
+// NewBalanceHistory -
+func NewBalanceHistory(db *database.Bun) *BalanceHistory {
+	return &BalanceHistory{
+		Table: postgres.NewTable[*storage.BalanceHistory](db),
+	}
+}
+
+// Filter -
+func (b *BalanceHistory) Filter(ctx context.Context, filter storage.BalanceHistoryListFilter) (history []storage.BalanceHistory, err error) {
+	query := b.DB().NewSelect().
+		Model(&history).
+		Where("address_id = ?", filter.AddressId)
+
+	if !filter.TimeFrom.IsZero() {
+		query = query.Where("time >= ?", filter.TimeFrom)
+	}
+	if !filter.TimeTo.IsZero() {
+		query = query.Where("time < ?", filter.TimeTo)
+	}
+	if filter.MaxHeight != nil {
+		query = query.Where("height <= ?", *filter.MaxHeight)
+	}
+
+	if filter.CursorID > 0 {
+		query = cursorTimeIDScope(query, filter.Sort, filter.CursorTime, filter.CursorID)
+	} else {
+		query = query.Offset(filter.Offset)
+	}
+
+	query = limitScope(query, filter.Limit)
+	query = sortTimeIDScope(query, filter.Sort)
+
+	err = query.Scan(ctx)
+	return
+}
+
+// AtHeight - returns the last balance snapshot at or before the height
+func (b *BalanceHistory) AtHeight(ctx context.Context, addressId uint64, height pkgTypes.Level) (history storage.BalanceHistory, err error) {
+	err = b.DB().NewSelect().
+		Model(&history).
+		Where("address_id = ?", addressId).
+		Where("height <= ?", height).
+		OrderExpr("time DESC, id DESC").
+		Limit(1).
+		Scan(ctx)
+	return
+}
+
+// AtTime - returns the last balance snapshot at or before the time
+func (b *BalanceHistory) AtTime(ctx context.Context, addressId uint64, t time.Time) (history storage.BalanceHistory, err error) {
+	err = b.DB().NewSelect().
+		Model(&history).
+		Where("address_id = ?", addressId).
+		Where("time <= ?", t).
+		OrderExpr("time DESC, id DESC").
+		Limit(1).
+		Scan(ctx)
+	return
+}
+
+// Series - returns the balance at the end of each time bucket. Buckets without balance changes are omitted.
+// The first filter.Limit buckets are returned if the limit is set.
+func (b *BalanceHistory) Series(ctx context.Context, filter storage.BalanceSeriesFilter) (items []storage.BalanceSeriesItem, err error) {
+	var interval string
+	switch filter.Timeframe {
+	case storage.TimeframeHour:
+		interval = "1 hour"
+	case storage.TimeframeDay:
+		interval = "1 day"
+	case storage.TimeframeWeek:
+		interval = "1 week"
+	case storage.TimeframeMonth:
+		interval = "1 month"
+	default:
+		return nil, errors.Errorf("unknown timeframe: %s", filter.Timeframe)
+	}
+
+	query := b.DB().NewSelect().
+		Model((*storage.BalanceHistory)(nil)).
+		ColumnExpr("time_bucket(?::interval, time) AS bucket", interval).
+		ColumnExpr("last(value, time) AS value").
+		Where("address_id = ?", filter.AddressId)
+
+	if !filter.TimeFrom.IsZero() {
+		query = query.Where("time >= ?", filter.TimeFrom)
+	}
+	if !filter.TimeTo.IsZero() {
+		query = query.Where("time < ?", filter.TimeTo)
+	}
+	if filter.MaxHeight != nil {
+		query = query.Where("height <= ?", *filter.MaxHeight)
+	}
+	if filter.Limit > 0 {
+		query = query.Limit(filter.Limit)
+	}
+
+	err = query.
+		GroupExpr("bucket").
+		OrderExpr("bucket ASC").
+		Scan(ctx, &items)
+	return
+}
@@ -0,0 +1,128 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/NobleScope/noble-indexer/internal/storage"
+	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/shopspring/decimal"
+)
+
+func (s *StorageTestSuite) TestBalanceHistoryFilter() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	history, err := s.storage.BalanceHistory.Filter(ctx, storage.BalanceHistoryListFilter{
+		AddressId: 1,
+		Limit:     10,
+		Sort:      sdk.SortOrderAsc,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(history, 4)
+
+	s.Require().EqualValues(1, history[0].Id)
+	s.Require().EqualValues(100, history[0].Height)
+	s.Require().True(history[0].Value.Equal(decimal.NewFromInt(1000)))
+	s.Require().True(history[0].Delta.Equal(decimal.NewFromInt(1000)))
+
+	s.Require().EqualValues(3, history[1].Id)
+	s.Require().True(history[1].Delta.Equal(decimal.NewFromInt(-300)))
+}
+
+func (s *StorageTestSuite) TestBalanceHistoryFilterTimeRangeDesc() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	history, err := s.storage.BalanceHistory.Filter(ctx, storage.BalanceHistoryListFilter{
+		AddressId: 1,
+		Limit:     10,
+		Sort:      sdk.SortOrderDesc,
+		TimeFrom:  time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+		TimeTo:    time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC),
+	})
+	s.Require().NoError(err)
+	s.Require().Len(history, 2)
+	s.Require().EqualValues(4, history[0].Id)
+	s.Require().EqualValues(3, history[1].Id)
+}
+
+func (s *StorageTestSuite) TestBalanceHistoryFilterCursor() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	history, err := s.storage.BalanceHistory.Filter(ctx, storage.BalanceHistoryListFilter{
+		AddressId:  1,
+		Limit:      10,
+		Sort:       sdk.SortOrderAsc,
+		CursorTime: time.Date(2024, 1, 1, 18, 0, 0, 0, time.UTC),
+		CursorID:   3,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(history, 2)
+	s.Require().EqualValues(4, history[0].Id)
+	s.Require().EqualValues(5, history[1].Id)
+}
+
+func (s *StorageTestSuite) TestBalanceHistoryAtHeight() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	history, err := s.storage.BalanceHistory.AtHeight(ctx, 1, 199)
+	s.Require().NoError(err)
+	s.Require().EqualValues(150, history.Height)
+	s.Require().True(history.Value.Equal(decimal.NewFromInt(700)))
+
+	history, err = s.storage.BalanceHistory.AtHeight(ctx, 1, 200)
+	s.Require().NoError(err)
+	s.Require().EqualValues(200, history.Height)
+	s.Require().True(history.Value.Equal(decimal.NewFromInt(900)))
+
+	_, err = s.storage.BalanceHistory.AtHeight(ctx, 1, 99)
+	s.Require().Error(err)
+	s.Require().True(s.storage.BalanceHistory.IsNoRows(err))
+}
+
+func (s *StorageTestSuite) TestBalanceHistoryAtTime() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	history, err := s.storage.BalanceHistory.AtTime(ctx, 1, time.Date(2024, 1, 2, 9, 0, 0, 0, time.UTC))
+	s.Require().NoError(err)
+	s.Require().EqualValues(150, history.Height)
+	s.Require().True(history.Value.Equal(decimal.NewFromInt(700)))
+
+	history, err = s.storage.BalanceHistory.AtTime(ctx, 2, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	s.Require().NoError(err)
+	s.Require().EqualValues(100, history.Height)
+	s.Require().True(history.Value.Equal(decimal.NewFromInt(500)))
+}
+
+func (s *StorageTestSuite) TestBalanceHistorySeries() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	items, err := s.storage.BalanceHistory.Series(ctx, storage.BalanceSeriesFilter{
+		AddressId: 1,
+		Timeframe: storage.TimeframeDay,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(items, 3)
+
+	s.Require().True(items[0].Time.Equal(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)))
+	s.Require().True(items[0].Value.Equal(decimal.NewFromInt(700)))
+	s.Require().True(items[1].Time.Equal(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)))
+	s.Require().True(items[1].Value.Equal(decimal.NewFromInt(900)))
+	s.Require().True(items[2].Value.Equal(decimal.NewFromInt(100000)))
+}
+
+func (s *StorageTestSuite) TestBalanceHistorySeriesInvalidTimeframe() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	_, err := s.storage.BalanceHistory.Series(ctx, storage.BalanceSeriesFilter{
+		AddressId: 1,
+		Timeframe: "year",
+	})
+	s.Require().Error(err)
+}
@@ -29,6 +29,9 @@ func (b *BeaconWithdrawal) Filter(ctx context.Context, filter storage.BeaconWith
 	if filter.AddressId != nil {
 		subQuery.Where("address_id = ?", *filter.AddressId)
 	}
+	if filter.MaxHeight != nil {
+		subQuery.Where("height <= ?", *filter.MaxHeight)
+	}
 
 	if filter.CursorID > 0 {
 		subQuery = cursorTimeIDScope(subQuery, filter.Sort, filter.CursorTime, filter.CursorID)
@@ -0,0 +1,53 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/NobleScope/noble-indexer/internal/storage"
+	"github.com/dipdup-net/go-lib/database"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+)
+
+type Blob struct {
+	*postgres.Table[*storage.Blob]
+}
+
+// NewBlob -
+func NewBlob(db *database.Bun) *Blob {
+	return &Blob{
+		Table: postgres.NewTable[*storage.Blob](db),
+	}
+}
+
+// Filter -
+func (b *Blob) Filter(ctx context.Context, filter storage.BlobListFilter) (blobs []storage.Blob, err error) {
+	query := b.DB().NewSelect().
+		Model(&blobs)
+
+	if filter.Height != nil {
+		query = query.Where("height = ?", *filter.Height)
+	}
+	if filter.SenderId != nil {
+		query = query.Where("sender_id = ?", *filter.SenderId)
+	}
+
+	if filter.CursorID > 0 {
+		query = cursorIDScope(query, filter.Sort, filter.CursorID)
+	} else {
+		query = query.Offset(filter.Offset)
+	}
+	query = limitScope(query, filter.Limit)
+	query = sortScope(query, "id", filter.Sort)
+
+	outerQuery := b.DB().NewSelect().
+		ColumnExpr("blob.*").
+		ColumnExpr("sender.hash AS sender__hash").
+		ColumnExpr("tx.hash AS tx__hash, tx.blob_gas_price AS tx__blob_gas_price").
+		TableExpr("(?) AS blob", query).
+		Join("LEFT JOIN address AS sender ON sender.id = blob.sender_id").
+		Join("LEFT JOIN tx ON tx.id = blob.tx_id")
+
+	outerQuery = sortScope(outerQuery, "blob.id", filter.Sort)
+	err = outerQuery.Scan(ctx, &blobs)
+	return
+}
@@ -0,0 +1,72 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/NobleScope/noble-indexer/internal/storage"
+	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
+)
+
+func (s *StorageTestSuite) TestBlobFilter() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	blobs, err := s.storage.Blob.Filter(ctx, storage.BlobListFilter{
+		Limit: 10,
+		Sort:  sdk.SortOrderDesc,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(blobs, 2)
+	s.Require().EqualValues(2, blobs[0].Id)
+	s.Require().EqualValues(1, blobs[1].Id)
+
+	blob := blobs[0]
+	s.Require().EqualValues(300, blob.Height)
+	s.Require().EqualValues(9, blob.TxId)
+	s.Require().EqualValues(1, blob.Index)
+	s.Require().Equal("0x01b6c6e6dad2cdce2f4f5e3dcc2b4f32c3ce5f32d88d9d6f2f5dcce6c6e7f8a9", blob.VersionedHash.Hex())
+	s.Require().Equal("0x40f055506ba543ea0942dc8ca03f596ab75bc880", blob.Sender.Hash.Hex())
+	s.Require().Equal("0x225595d2592694374455392199b68b8282deb447eeb7169c6e5ce5baa65ae9364", blob.Tx.Hash.Hex())
+	s.Require().NotNil(blob.Tx.BlobGasPrice)
+	s.Require().Equal("3", blob.Tx.BlobGasPrice.String())
+}
+
+func (s *StorageTestSuite) TestBlobFilterByHeightAndSender() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	height := uint64(300)
+	senderId := uint64(4)
+	blobs, err := s.storage.Blob.Filter(ctx, storage.BlobListFilter{
+		Limit:    10,
+		Sort:     sdk.SortOrderAsc,
+		Height:   &height,
+		SenderId: &senderId,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(blobs, 2)
+
+	height = 200
+	blobs, err = s.storage.Blob.Filter(ctx, storage.BlobListFilter{
+		Limit:  10,
+		Sort:   sdk.SortOrderAsc,
+		Height: &height,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(blobs, 0)
+}
+
+func (s *StorageTestSuite) TestBlobFilterCursor() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	blobs, err := s.storage.Blob.Filter(ctx, storage.BlobListFilter{
+		Limit:    10,
+		Sort:     sdk.SortOrderAsc,
+		CursorID: 1,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(blobs, 1)
+	s.Require().EqualValues(2, blobs[0].Id)
+}
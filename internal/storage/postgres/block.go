@@ -29,6 +29,21 @@ func (b *Block) Last(ctx context.Context) (block storage.Block, err error) {
 	return
 }
 
+// LastInRange returns the highest block between start and end heights. The range is not bounded above if end is 0.
+// Blocks of concurrent range indexers are interleaved by id, so the last block of the range is found by height.
+func (b *Block) LastInRange(ctx context.Context, start, end types.Level) (block storage.Block, err error) {
+	query := b.DB().NewSelect().Model(&block).
+		Where("height >= ?", start)
+	if end > 0 {
+		query = query.Where("height <= ?", end)
+	}
+	err = query.
+		Order("height DESC").
+		Limit(1).
+		Scan(ctx)
+	return
+}
+
 // ByHeight -
 func (b *Block) ByHeight(ctx context.Context, height types.Level, withStats bool) (block storage.Block, err error) {
 	query := b.DB().NewSelect().
@@ -49,11 +64,28 @@ func (b *Block) ByHeight(ctx context.Context, height types.Level, withStats bool
 	return
 }
 
+// ByHash -
+func (b *Block) ByHash(ctx context.Context, hash types.Hex) (block storage.Block, err error) {
+	err = b.DB().NewSelect().
+		Model(&block).
+		ColumnExpr("block.*").
+		ColumnExpr("address.hash AS miner__hash").
+		Join("LEFT JOIN address ON address.id = block.miner_id").
+		Where("block.hash = ?", hash).
+		Limit(1).
+		Scan(ctx, &block)
+	return
+}
+
 // Filter -
 func (b *Block) Filter(ctx context.Context, fltrs storage.BlockListFilter) (blocks []storage.Block, err error) {
 	query := b.DB().NewSelect().
 		Model(&blocks)
 
+	if fltrs.MaxHeight != nil {
+		query = query.Where("height <= ?", *fltrs.MaxHeight)
+	}
+
 	if fltrs.CursorID > 0 {
 		query = cursorTimeIDScope(query, fltrs.Sort, fltrs.CursorTime, fltrs.CursorID)
 	} else {
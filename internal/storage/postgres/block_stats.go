@@ -40,3 +40,15 @@ func (b *BlockStats) AvgBlockTime(ctx context.Context, from time.Time) (blockTim
 		Scan(ctx, &blockTime)
 	return
 }
+
+// BlobStats - returns aggregated blob statistics of the blocks since the time
+func (b *BlockStats) BlobStats(ctx context.Context, from time.Time) (stats storage.BlobStats, err error) {
+	err = b.DB().NewSelect().
+		Model((*storage.BlockStats)(nil)).
+		ColumnExpr("COALESCE(SUM(blobs_count), 0) AS blobs_count").
+		ColumnExpr("COALESCE(SUM(blob_gas_used), 0) AS blob_gas_used").
+		ColumnExpr("COALESCE(SUM(blob_fee), 0) AS blob_fee").
+		Where("time >= ?", from).
+		Scan(ctx, &stats)
+	return
+}
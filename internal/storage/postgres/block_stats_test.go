@@ -26,3 +26,20 @@ func (s *StorageTestSuite) TestBlockStatsAvgBlockTime() {
 	s.Require().NoError(err)
 	s.Require().EqualValues(11500, blockTime)
 }
+
+func (s *StorageTestSuite) TestBlockStatsBlobStats() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	from := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	stats, err := s.storage.BlockStats.BlobStats(ctx, from)
+	s.Require().NoError(err)
+	s.Require().EqualValues(2, stats.BlobsCount)
+	s.Require().Equal("262144", stats.BlobGasUsed.String())
+	s.Require().Equal("786432", stats.BlobFee.String())
+
+	stats, err = s.storage.BlockStats.BlobStats(ctx, time.Date(2024, 1, 4, 0, 0, 0, 0, time.UTC))
+	s.Require().NoError(err)
+	s.Require().EqualValues(0, stats.BlobsCount)
+	s.Require().True(stats.BlobFee.IsZero())
+}
@@ -25,6 +25,19 @@ func (s *StorageTestSuite) TestBlockLast() {
 	s.Require().EqualValues("0x5234567890abcdef1234567890abcdef1234567890abcdef1234567890abcdef", block.Hash.Hex())
 }
 
+func (s *StorageTestSuite) TestBlockLastInRange() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	block, err := s.storage.Blocks.LastInRange(ctx, 100, 300)
+	s.Require().NoError(err)
+	s.Require().EqualValues(300, block.Height)
+
+	block, err = s.storage.Blocks.LastInRange(ctx, 200, 0)
+	s.Require().NoError(err)
+	s.Require().EqualValues(500, block.Height)
+}
+
 func (s *StorageTestSuite) TestBlockByHeight() {
 	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer ctxCancel()
@@ -6,9 +6,11 @@ import (
 	"time"
 
 	"github.com/NobleScope/noble-indexer/internal/storage"
+	"github.com/NobleScope/noble-indexer/internal/storage/types"
 	pkgTypes "github.com/NobleScope/noble-indexer/pkg/types"
 	"github.com/dipdup-net/go-lib/database"
 	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+	"github.com/uptrace/bun"
 )
 
 // Contract -
@@ -65,7 +67,7 @@ func (c *Contract) ByHash(ctx context.Context, hash pkgTypes.Hex) (contract stor
 
 	err = c.DB().NewSelect().
 		TableExpr("(?) AS address", query).
-		ColumnExpr("contract.id, contract.height, contract.verified, contract.tx_id, contract.deployer_id, contract.compiler_version, contract.metadata_link, contract.language, contract.optimizer_enabled, contract.tags, contract.status, contract.retry_count, contract.error").
+		ColumnExpr("contract.id, contract.height, contract.verified, contract.tx_id, contract.deployer_id, contract.compiler_version, contract.metadata_link, contract.language, contract.optimizer_enabled, contract.tags, contract.status, contract.retry_count, contract.error, contract.abi, contract.constructor_args, contract.match_type").
 		ColumnExpr("address.id AS address__id, address.first_height AS address__first_height, address.last_height AS address__last_height, address.hash AS address__hash, address.is_contract AS address__is_contract, address.txs_count AS address__txs_count, address.contracts_count AS address__contracts_count, address.interactions AS address__interactions").
 		ColumnExpr("tx.hash AS tx__hash").
 		ColumnExpr("implementation_address.hash AS implementation").
@@ -96,3 +98,57 @@ func (c *Contract) Code(ctx context.Context, hash pkgTypes.Hex) (pkgTypes.Hex, j
 		Scan(ctx)
 	return contract.Code, contract.ABI, err
 }
+
+// ListWithABI - returns contracts with known ABI ordered by id starting after the cursor
+func (c *Contract) ListWithABI(ctx context.Context, cursorId uint64, limit int) (contracts []storage.Contract, err error) {
+	err = c.DB().NewSelect().
+		Model(&contracts).
+		Column("id", "abi").
+		Where("abi IS NOT NULL").
+		Where("id > ?", cursorId).
+		Order("id ASC").
+		Limit(limit).
+		Scan(ctx)
+
+	return
+}
+
+// SimilarPending - returns contracts which were not processed by the similar match
+func (c *Contract) SimilarPending(ctx context.Context, limit int) (contracts []storage.Contract, err error) {
+	err = c.DB().NewSelect().
+		Model(&contracts).
+		Where("similar_checked = false").
+		Order("id ASC").
+		Limit(limit).
+		Scan(ctx)
+
+	return
+}
+
+// SimilarSources - returns contracts verified from sources with the code hash
+func (c *Contract) SimilarSources(ctx context.Context, codeHash pkgTypes.Hex) (contracts []storage.Contract, err error) {
+	err = c.DB().NewSelect().
+		Model(&contracts).
+		Column("id", "code", "abi", "compiler_version", "language", "optimizer_enabled", "immutable_refs").
+		Where("code_hash = ?", codeHash).
+		Where("verified = true").
+		Where("match_type IN (?)", bun.In([]types.MatchType{types.Full, types.Partial})).
+		Order("id ASC").
+		Scan(ctx)
+
+	return
+}
+
+// SimilarCandidates - returns unverified contracts with the code hash ordered by id. Contracts are paged by the id of the last one.
+func (c *Contract) SimilarCandidates(ctx context.Context, codeHash pkgTypes.Hex, afterId uint64, limit int) (contracts []storage.Contract, err error) {
+	err = c.DB().NewSelect().
+		Model(&contracts).
+		Where("code_hash = ?", codeHash).
+		Where("verified = false").
+		Where("id > ?", afterId).
+		Order("id ASC").
+		Limit(limit).
+		Scan(ctx)
+
+	return
+}
@@ -322,3 +322,75 @@ func (s *StorageTestSuite) TestContractListByDeployerId() {
 		s.Require().EqualValues("0xa63d581a7fdab643c09f0524904b046cdb9ad9d2", contract.Deployer.Hash.Hex())
 	}
 }
+
+func (s *StorageTestSuite) TestContractListWithABI() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	contracts, err := s.storage.Contracts.ListWithABI(ctx, 0, 10)
+	s.Require().NoError(err)
+	s.Require().Len(contracts, 1)
+	s.Require().EqualValues(3, contracts[0].Id)
+	s.Require().JSONEq(`{}`, string(contracts[0].ABI))
+
+	contracts, err = s.storage.Contracts.ListWithABI(ctx, 3, 10)
+	s.Require().NoError(err)
+	s.Require().Len(contracts, 0)
+}
+
+func (s *StorageTestSuite) TestContractSimilarPending() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	contracts, err := s.storage.Contracts.SimilarPending(ctx, 10)
+	s.Require().NoError(err)
+	s.Require().Len(contracts, 4)
+	for i, id := range []uint64{3, 4, 5, 6} {
+		s.Require().EqualValues(id, contracts[i].Id)
+		s.Require().False(contracts[i].SimilarChecked)
+		s.Require().NotEmpty(contracts[i].Code)
+	}
+
+	contracts, err = s.storage.Contracts.SimilarPending(ctx, 2)
+	s.Require().NoError(err)
+	s.Require().Len(contracts, 2)
+}
+
+func (s *StorageTestSuite) TestContractSimilarSources() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	contract, err := s.storage.Contracts.GetByID(ctx, 3)
+	s.Require().NoError(err)
+	s.Require().NotEmpty(contract.CodeHash)
+
+	contracts, err := s.storage.Contracts.SimilarSources(ctx, contract.CodeHash)
+	s.Require().NoError(err)
+	s.Require().Len(contracts, 1)
+	s.Require().EqualValues(3, contracts[0].Id)
+	s.Require().Equal("Solidity", contracts[0].Language)
+	s.Require().NotEmpty(contracts[0].ABI)
+
+	contracts, err = s.storage.Contracts.SimilarSources(ctx, pkgTypes.MustDecodeHex("0xbb"))
+	s.Require().NoError(err)
+	s.Require().Len(contracts, 0)
+}
+
+func (s *StorageTestSuite) TestContractSimilarCandidates() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	contract, err := s.storage.Contracts.GetByID(ctx, 3)
+	s.Require().NoError(err)
+
+	contracts, err := s.storage.Contracts.SimilarCandidates(ctx, contract.CodeHash, 0, 10)
+	s.Require().NoError(err)
+	s.Require().Len(contracts, 1)
+	s.Require().EqualValues(4, contracts[0].Id)
+	s.Require().False(contracts[0].Verified)
+	s.Require().NotEmpty(contracts[0].Code)
+
+	contracts, err = s.storage.Contracts.SimilarCandidates(ctx, contract.CodeHash, 4, 10)
+	s.Require().NoError(err)
+	s.Require().Len(contracts, 0)
+}
@@ -21,25 +21,36 @@ type Storage struct {
 	cfg        config.Database
 	scriptsDir string
 
-	Blocks            models.IBlock
-	BlockStats        models.IBlockStats
-	Tx                models.ITx
-	Transfer          models.ITransfer
-	Token             models.IToken
-	TokenBalance      models.ITokenBalance
-	Trace             models.ITrace
-	Logs              models.ILog
-	Addresses         models.IAddress
-	Contracts         models.IContract
-	ProxyContracts    models.IProxyContract
-	Sources           models.ISource
-	State             models.IState
-	Search            models.ISearch
-	VerificationTasks models.IVerificationTask
-	VerificationFiles models.IVerificationFile
-	ERC4337UserOps    models.IERC4337UserOps
-	BeaconWithdrawal  models.IBeaconWithdrawal
-	Notificator       *Notificator
+	Blocks              models.IBlock
+	BlockStats          models.IBlockStats
+	Tx                  models.ITx
+	Transfer            models.ITransfer
+	Token               models.IToken
+	TokenBalance        models.ITokenBalance
+	TokenBalanceHistory models.ITokenBalanceHistory
+	Trace               models.ITrace
+	Logs                models.ILog
+	Addresses           models.IAddress
+	Contracts           models.IContract
+	ProxyContracts      models.IProxyContract
+	Sources             models.ISource
+	State               models.IState
+	Search              models.ISearch
+	VerificationTasks   models.IVerificationTask
+	VerificationFiles   models.IVerificationFile
+	ERC4337UserOps      models.IERC4337UserOps
+	BeaconWithdrawal    models.IBeaconWithdrawal
+	BalanceHistory      models.IBalanceHistory
+	Allowance           models.IAllowance
+	TxAccessList        models.ITxAccessList
+	TxAuthorization     models.ITxAuthorization
+	Delegation          models.IDelegation
+	Blob                models.IBlob
+	ProxyUpgrades       models.IProxyUpgrade
+	DiamondCuts         models.IDiamondCut
+	DiamondFacets       models.IDiamondFacet
+	Signatures          models.ISignature
+	Notificator         *Notificator
 }
 
 // Create -
@@ -54,28 +65,39 @@ func Create(ctx context.Context, cfg config.Database, scriptsDir string, withMig
 	}
 
 	s := Storage{
-		cfg:               cfg,
-		scriptsDir:        scriptsDir,
-		Storage:           strg,
-		Blocks:            NewBlock(strg.Connection()),
-		BlockStats:        NewBlockStats(strg.Connection()),
-		Logs:              NewLog(strg.Connection()),
-		Tx:                NewTx(strg.Connection()),
-		Transfer:          NewTransfer(strg.Connection()),
-		Token:             NewToken(strg.Connection()),
-		TokenBalance:      NewTokenBalance(strg.Connection()),
-		Trace:             NewTrace(strg.Connection()),
-		Addresses:         NewAddress(strg.Connection()),
-		Contracts:         NewContract(strg.Connection()),
-		ProxyContracts:    NewProxyContract(strg.Connection()),
-		Sources:           NewSource(strg.Connection()),
-		State:             NewState(strg.Connection()),
-		Search:            NewSearch(strg.Connection()),
-		VerificationTasks: NewVerificationTask(strg.Connection()),
-		VerificationFiles: NewVerificationFile(strg.Connection()),
-		ERC4337UserOps:    NewERC4337UserOps(strg.Connection()),
-		BeaconWithdrawal:  NewBeaconWithdrawal(strg.Connection()),
-		Notificator:       NewNotificator(cfg, strg.Connection().DB()),
+		cfg:                 cfg,
+		scriptsDir:          scriptsDir,
+		Storage:             strg,
+		Blocks:              NewBlock(strg.Connection()),
+		BlockStats:          NewBlockStats(strg.Connection()),
+		Logs:                NewLog(strg.Connection()),
+		Tx:                  NewTx(strg.Connection()),
+		Transfer:            NewTransfer(strg.Connection()),
+		Token:               NewToken(strg.Connection()),
+		TokenBalance:        NewTokenBalance(strg.Connection()),
+		TokenBalanceHistory: NewTokenBalanceHistory(strg.Connection()),
+		Trace:               NewTrace(strg.Connection()),
+		Addresses:           NewAddress(strg.Connection()),
+		Contracts:           NewContract(strg.Connection()),
+		ProxyContracts:      NewProxyContract(strg.Connection()),
+		Sources:             NewSource(strg.Connection()),
+		State:               NewState(strg.Connection()),
+		Search:              NewSearch(strg.Connection()),
+		VerificationTasks:   NewVerificationTask(strg.Connection()),
+		VerificationFiles:   NewVerificationFile(strg.Connection()),
+		ERC4337UserOps:      NewERC4337UserOps(strg.Connection()),
+		BeaconWithdrawal:    NewBeaconWithdrawal(strg.Connection()),
+		BalanceHistory:      NewBalanceHistory(strg.Connection()),
+		Allowance:           NewAllowance(strg.Connection()),
+		TxAccessList:        NewTxAccessList(strg.Connection()),
+		TxAuthorization:     NewTxAuthorization(strg.Connection()),
+		Delegation:          NewDelegation(strg.Connection()),
+		Blob:                NewBlob(strg.Connection()),
+		ProxyUpgrades:       NewProxyUpgrade(strg.Connection()),
+		DiamondCuts:         NewDiamondCut(strg.Connection()),
+		DiamondFacets:       NewDiamondFacet(strg.Connection()),
+		Signatures:          NewSignature(strg.Connection()),
+		Notificator:         NewNotificator(cfg, strg.Connection().DB()),
 	}
 
 	if err := s.createScripts(ctx, "functions", false); err != nil {
@@ -145,6 +167,14 @@ func createHypertables(ctx context.Context, conn *database.Bun) error {
 			&models.Log{},
 			&models.ERC4337UserOp{},
 			&models.BeaconWithdrawal{},
+			&models.BalanceHistory{},
+			&models.TokenBalanceHistory{},
+			&models.Approval{},
+			&models.TxAccessList{},
+			&models.TxAuthorization{},
+			&models.Blob{},
+			&models.ProxyUpgrade{},
+			&models.DiamondCut{},
 		} {
 			if _, err := tx.ExecContext(ctx,
 				`SELECT create_hypertable(?, 'time', chunk_time_interval => INTERVAL '1 month', if_not_exists => TRUE);`,
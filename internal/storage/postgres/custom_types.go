@@ -122,6 +122,76 @@ func createTypes(ctx context.Context, conn *database.Bun) error {
 			return err
 		}
 
+		if _, err := tx.ExecContext(
+			ctx,
+			createTypeQuery,
+			"verification_language",
+			bun.Safe("verification_language"),
+			bun.In(types.LanguageValues()),
+		); err != nil {
+			return err
+		}
+
+		if _, err := tx.ExecContext(
+			ctx,
+			createTypeQuery,
+			"match_type",
+			bun.Safe("match_type"),
+			bun.In(types.MatchTypeValues()),
+		); err != nil {
+			return err
+		}
+
+		if _, err := tx.ExecContext(
+			ctx,
+			createTypeQuery,
+			"approval_type",
+			bun.Safe("approval_type"),
+			bun.In(types.ApprovalTypeValues()),
+		); err != nil {
+			return err
+		}
+
+		if _, err := tx.ExecContext(
+			ctx,
+			createTypeQuery,
+			"proxy_upgrade_type",
+			bun.Safe("proxy_upgrade_type"),
+			bun.In(types.ProxyUpgradeTypeValues()),
+		); err != nil {
+			return err
+		}
+
+		if _, err := tx.ExecContext(
+			ctx,
+			createTypeQuery,
+			"diamond_cut_action",
+			bun.Safe("diamond_cut_action"),
+			bun.In(types.DiamondCutActionValues()),
+		); err != nil {
+			return err
+		}
+
+		if _, err := tx.ExecContext(
+			ctx,
+			createTypeQuery,
+			"signature_type",
+			bun.Safe("signature_type"),
+			bun.In(types.SignatureTypeValues()),
+		); err != nil {
+			return err
+		}
+
+		if _, err := tx.ExecContext(
+			ctx,
+			createTypeQuery,
+			"finality",
+			bun.Safe("finality"),
+			bun.In(types.FinalityValues()),
+		); err != nil {
+			return err
+		}
+
 		return nil
 	})
 }
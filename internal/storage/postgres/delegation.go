@@ -0,0 +1,70 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/NobleScope/noble-indexer/internal/storage"
+	"github.com/dipdup-net/go-lib/database"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+)
+
+type Delegation struct {
+	*postgres.Table[*storage.Delegation]
+}
+
+// NewDelegation -
+func NewDelegation(db *database.Bun) *Delegation {
+	return &Delegation{
+		Table: postgres.NewTable[*storage.Delegation](db),
+	}
+}
+
+// ByAuthorityId - returns current delegation of the authority address
+func (d *Delegation) ByAuthorityId(ctx context.Context, authorityId uint64) (delegation storage.Delegation, err error) {
+	err = d.DB().NewSelect().
+		Model(&delegation).
+		ColumnExpr("delegation.*").
+		ColumnExpr("authority.hash AS authority__hash").
+		ColumnExpr("delegate.hash AS delegate__hash").
+		ColumnExpr("tx.hash AS tx__hash").
+		Join("LEFT JOIN address AS authority ON authority.id = delegation.id").
+		Join("LEFT JOIN address AS delegate ON delegate.id = delegation.delegate_id").
+		Join("LEFT JOIN tx ON tx.id = delegation.tx_id").
+		Where("delegation.id = ?", authorityId).
+		Limit(1).
+		Scan(ctx)
+	return
+}
+
+// Filter - returns active delegations
+func (d *Delegation) Filter(ctx context.Context, filter storage.DelegationListFilter) (delegations []storage.Delegation, err error) {
+	query := d.DB().NewSelect().
+		Model(&delegations).
+		Where("delegate_id IS NOT NULL")
+
+	if filter.DelegateId != nil {
+		query = query.Where("delegate_id = ?", *filter.DelegateId)
+	}
+
+	if filter.CursorID > 0 {
+		query = cursorIDScope(query, filter.Sort, filter.CursorID)
+	} else {
+		query = query.Offset(filter.Offset)
+	}
+	query = limitScope(query, filter.Limit)
+	query = sortScope(query, "id", filter.Sort)
+
+	outerQuery := d.DB().NewSelect().
+		ColumnExpr("delegation.*").
+		ColumnExpr("authority.hash AS authority__hash").
+		ColumnExpr("delegate.hash AS delegate__hash").
+		ColumnExpr("tx.hash AS tx__hash").
+		TableExpr("(?) AS delegation", query).
+		Join("LEFT JOIN address AS authority ON authority.id = delegation.id").
+		Join("LEFT JOIN address AS delegate ON delegate.id = delegation.delegate_id").
+		Join("LEFT JOIN tx ON tx.id = delegation.tx_id")
+
+	outerQuery = sortScope(outerQuery, "delegation.id", filter.Sort)
+	err = outerQuery.Scan(ctx, &delegations)
+	return
+}
@@ -0,0 +1,86 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/NobleScope/noble-indexer/internal/storage"
+	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
+)
+
+func (s *StorageTestSuite) TestDelegationByAuthorityId() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	delegation, err := s.storage.Delegation.ByAuthorityId(ctx, 1)
+	s.Require().NoError(err)
+	s.Require().EqualValues(1, delegation.Id)
+	s.Require().EqualValues(100, delegation.Height)
+	s.Require().EqualValues(200, delegation.LastHeight)
+	s.Require().NotNil(delegation.DelegateId)
+	s.Require().EqualValues(4, *delegation.DelegateId)
+	s.Require().Equal("0xa63d581a7fdab643c09f0524904b046cdb9ad9d2", delegation.Authority.Hash.Hex())
+	s.Require().NotNil(delegation.Delegate)
+	s.Require().Equal("0x40f055506ba543ea0942dc8ca03f596ab75bc880", delegation.Delegate.Hash.Hex())
+	s.Require().Equal("0x9fc549127c437a716b577c31c717abfda4c26bc72699480cd28178e745581d5b", delegation.Tx.Hash.Hex())
+}
+
+func (s *StorageTestSuite) TestDelegationByAuthorityIdCleared() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	delegation, err := s.storage.Delegation.ByAuthorityId(ctx, 2)
+	s.Require().NoError(err)
+	s.Require().EqualValues(2, delegation.Id)
+	s.Require().Nil(delegation.DelegateId)
+}
+
+func (s *StorageTestSuite) TestDelegationByAuthorityIdNoRows() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	_, err := s.storage.Delegation.ByAuthorityId(ctx, 3)
+	s.Require().Error(err)
+	s.Require().True(s.storage.Delegation.IsNoRows(err))
+}
+
+func (s *StorageTestSuite) TestDelegationFilter() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	delegations, err := s.storage.Delegation.Filter(ctx, storage.DelegationListFilter{
+		Limit: 10,
+		Sort:  sdk.SortOrderDesc,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(delegations, 1)
+	s.Require().EqualValues(1, delegations[0].Id)
+	s.Require().Equal("0xa63d581a7fdab643c09f0524904b046cdb9ad9d2", delegations[0].Authority.Hash.Hex())
+	s.Require().NotNil(delegations[0].Delegate)
+	s.Require().Equal("0x40f055506ba543ea0942dc8ca03f596ab75bc880", delegations[0].Delegate.Hash.Hex())
+	s.Require().Equal("0x9fc549127c437a716b577c31c717abfda4c26bc72699480cd28178e745581d5b", delegations[0].Tx.Hash.Hex())
+}
+
+func (s *StorageTestSuite) TestDelegationFilterByDelegate() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	delegateId := uint64(4)
+	delegations, err := s.storage.Delegation.Filter(ctx, storage.DelegationListFilter{
+		Limit:      10,
+		Sort:       sdk.SortOrderAsc,
+		DelegateId: &delegateId,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(delegations, 1)
+	s.Require().EqualValues(1, delegations[0].Id)
+
+	delegateId = 5
+	delegations, err = s.storage.Delegation.Filter(ctx, storage.DelegationListFilter{
+		Limit:      10,
+		Sort:       sdk.SortOrderAsc,
+		DelegateId: &delegateId,
+	})
+	s.Require().NoError(err)
+	s.Require().Empty(delegations)
+}
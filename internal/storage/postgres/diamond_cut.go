@@ -0,0 +1,18 @@
+package postgres
+
+import (
+	"github.com/NobleScope/noble-indexer/internal/storage"
+	"github.com/dipdup-net/go-lib/database"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+)
+
+type DiamondCut struct {
+	*postgres.Table[*storage.DiamondCut]
+}
+
+// NewDiamondCut -
+func NewDiamondCut(db *database.Bun) *DiamondCut {
+	return &DiamondCut{
+		Table: postgres.NewTable[*storage.DiamondCut](db),
+	}
+}
@@ -0,0 +1,18 @@
+package postgres
+
+import (
+	"github.com/NobleScope/noble-indexer/internal/storage"
+	"github.com/dipdup-net/go-lib/database"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+)
+
+type DiamondFacet struct {
+	*postgres.Table[*storage.DiamondFacet]
+}
+
+// NewDiamondFacet -
+func NewDiamondFacet(db *database.Bun) *DiamondFacet {
+	return &DiamondFacet{
+		Table: postgres.NewTable[*storage.DiamondFacet](db),
+	}
+}
@@ -129,6 +129,77 @@ func createIndices(ctx context.Context, conn *database.Bun) error {
 			Exec(ctx); err != nil {
 			return err
 		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.Log)(nil)).
+			Index("log_topic0_idx").
+			Column("topic0", "time").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.Log)(nil)).
+			Index("log_address_id_topic0_idx").
+			Column("address_id", "topic0").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.Log)(nil)).
+			Index("log_topic1_idx").
+			Column("topic1").
+			Where("topic1 IS NOT NULL").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.Log)(nil)).
+			Index("log_topic2_idx").
+			Column("topic2").
+			Where("topic2 IS NOT NULL").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.Log)(nil)).
+			Index("log_topic3_idx").
+			Column("topic3").
+			Where("topic3 IS NOT NULL").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.Log)(nil)).
+			Index("log_event_name_idx").
+			Column("event_name", "time").
+			Where("event_name IS NOT NULL").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.Log)(nil)).
+			Index("log_undecoded_idx").
+			Column("time", "id").
+			Where("decoded = false").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.Log)(nil)).
+			Index("log_event_args_idx").
+			ColumnExpr("event_args jsonb_path_ops").
+			Using("GIN").
+			Where("event_args IS NOT NULL").
+			Exec(ctx); err != nil {
+			return err
+		}
 
 		// Contract
 		if _, err := tx.NewCreateIndex().
@@ -165,6 +236,23 @@ func createIndices(ctx context.Context, conn *database.Bun) error {
 			Exec(ctx); err != nil {
 			return err
 		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.Contract)(nil)).
+			Index("contract_code_hash_idx").
+			Column("code_hash").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.Contract)(nil)).
+			Index("contract_similar_pending_idx").
+			Column("id").
+			Where("similar_checked = false").
+			Exec(ctx); err != nil {
+			return err
+		}
 
 		// Trace
 		if _, err := tx.NewCreateIndex().
@@ -484,6 +572,60 @@ func createIndices(ctx context.Context, conn *database.Bun) error {
 			return err
 		}
 
+		// BalanceHistory
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.BalanceHistory)(nil)).
+			Index("balance_history_height_idx").
+			Column("height").
+			Using("BRIN").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.BalanceHistory)(nil)).
+			Index("balance_history_address_id_time_idx").
+			Column("address_id", "time").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.BalanceHistory)(nil)).
+			Index("balance_history_address_id_height_idx").
+			Column("address_id", "height").
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		// TokenBalanceHistory
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.TokenBalanceHistory)(nil)).
+			Index("token_balance_history_height_idx").
+			Column("height").
+			Using("BRIN").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.TokenBalanceHistory)(nil)).
+			Index("token_balance_history_address_id_contract_id_token_id_height_idx").
+			Column("address_id", "contract_id", "token_id", "height").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.TokenBalanceHistory)(nil)).
+			Index("token_balance_history_contract_id_token_id_idx").
+			Column("contract_id", "token_id").
+			Exec(ctx); err != nil {
+			return err
+		}
+
 		// Verification files
 		if _, err := tx.NewCreateIndex().
 			IfNotExists().
@@ -512,6 +654,189 @@ func createIndices(ctx context.Context, conn *database.Bun) error {
 			return err
 		}
 
+		// Approval
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.Approval)(nil)).
+			Index("approval_height_idx").
+			Column("height").
+			Using("BRIN").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.Approval)(nil)).
+			Index("approval_owner_id_contract_id_idx").
+			Column("owner_id", "contract_id").
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		// Allowance
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.Allowance)(nil)).
+			Index("allowance_owner_id_idx").
+			Column("owner_id").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.Allowance)(nil)).
+			Index("allowance_last_height_idx").
+			Column("last_height").
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		// TxAccessList
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.TxAccessList)(nil)).
+			Index("tx_access_list_height_idx").
+			Column("height").
+			Using("BRIN").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.TxAccessList)(nil)).
+			Index("tx_access_list_tx_id_idx").
+			Column("tx_id").
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		// TxAuthorization
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.TxAuthorization)(nil)).
+			Index("tx_authorization_height_idx").
+			Column("height").
+			Using("BRIN").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.TxAuthorization)(nil)).
+			Index("tx_authorization_tx_id_idx").
+			Column("tx_id").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.TxAuthorization)(nil)).
+			Index("tx_authorization_authority_id_idx").
+			Column("authority_id").
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		// Delegation
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.Delegation)(nil)).
+			Index("delegation_delegate_id_idx").
+			Column("delegate_id").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.Delegation)(nil)).
+			Index("delegation_last_height_idx").
+			Column("last_height").
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		// Blob
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.Blob)(nil)).
+			Index("blob_height_idx").
+			Column("height").
+			Using("BRIN").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.Blob)(nil)).
+			Index("blob_tx_id_idx").
+			Column("tx_id").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.Blob)(nil)).
+			Index("blob_sender_id_idx").
+			Column("sender_id").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.Blob)(nil)).
+			Index("blob_versioned_hash_idx").
+			Column("versioned_hash").
+			Using("HASH").
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		// ProxyUpgrade
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.ProxyUpgrade)(nil)).
+			Index("proxy_upgrade_height_idx").
+			Column("height").
+			Using("BRIN").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.ProxyUpgrade)(nil)).
+			Index("proxy_upgrade_proxy_id_idx").
+			Column("proxy_id", "id").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.ProxyContract)(nil)).
+			Index("proxy_contract_beacon_idx").
+			Column("beacon_id").
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		// DiamondCut
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.DiamondCut)(nil)).
+			Index("diamond_cut_height_idx").
+			Column("height").
+			Using("BRIN").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.DiamondCut)(nil)).
+			Index("diamond_cut_diamond_id_selector_idx").
+			Column("diamond_id", "selector", "id").
+			Exec(ctx); err != nil {
+			return err
+		}
+
 		return nil
 	})
 }
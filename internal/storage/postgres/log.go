@@ -4,8 +4,10 @@ import (
 	"context"
 
 	"github.com/NobleScope/noble-indexer/internal/storage"
+	"github.com/NobleScope/noble-indexer/internal/storage/types"
 	"github.com/dipdup-net/go-lib/database"
 	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+	"github.com/uptrace/bun"
 )
 
 type Log struct {
@@ -35,9 +37,7 @@ func (l *Log) Filter(ctx context.Context, filter storage.LogListFilter) (logs []
 		Join("LEFT JOIN address ON address.id = log.address_id")
 
 	if filter.WithABI {
-		outerQuery = outerQuery.
-			ColumnExpr("log_contract.abi AS contract_abi").
-			Join("LEFT JOIN contract AS log_contract ON log_contract.id = log.address_id")
+		outerQuery = logABIsScope(l.DB(), outerQuery)
 	}
 
 	if filter.Sort != "" {
@@ -48,3 +48,61 @@ func (l *Log) Filter(ctx context.Context, filter storage.LogListFilter) (logs []
 
 	return
 }
+
+// ByRange - returns logs ordered by height and position in the block. Logs removed during the reorg are skipped.
+func (l *Log) ByRange(ctx context.Context, filter storage.LogRangeFilter) (logs []storage.Log, err error) {
+	query := l.DB().NewSelect().
+		Model(&logs)
+
+	query = logRangeFilter(query, filter)
+
+	err = l.DB().NewSelect().
+		ColumnExpr("log.*").
+		ColumnExpr("tx.hash AS tx__hash, tx.index AS tx__index").
+		ColumnExpr("address.hash AS address__hash").
+		ColumnExpr("block.hash AS block_hash").
+		TableExpr("(?) AS log", query).
+		Join("LEFT JOIN tx ON tx.id = log.tx_id").
+		Join("LEFT JOIN address ON address.id = log.address_id").
+		Join("LEFT JOIN block ON block.height = log.height AND block.time = log.time").
+		OrderExpr("log.height ASC, log.id ASC").
+		Scan(ctx, &logs)
+
+	return
+}
+
+// Undecoded - returns the oldest logs which were not processed by the log decoder with ABIs to decode them
+func (l *Log) Undecoded(ctx context.Context, limit int) (logs []storage.Log, err error) {
+	query := l.DB().NewSelect().
+		Model(&logs).
+		Column("id", "height", "time", "address_id", "data", "topics", "topic0", "decode_version").
+		Where("decoded = false").
+		OrderExpr("time ASC, id ASC").
+		Limit(limit)
+
+	outerQuery := l.DB().NewSelect().
+		ColumnExpr("log.*").
+		TableExpr("(?) AS log", query).
+		OrderExpr("log.time ASC, log.id ASC")
+
+	err = logABIsScope(l.DB(), outerQuery).Scan(ctx, &logs)
+	return
+}
+
+// logABIsScope adds ABIs which may decode the log: emitter, proxy implementation at the log height, diamond facets
+// and fragments of the signature registry
+func logABIsScope(db bun.IDB, query *bun.SelectQuery) *bun.SelectQuery {
+	// any facet of the diamond may emit the log, so all facets ABIs are collected
+	facetABIs := db.NewSelect().
+		TableExpr("contract AS facet_contract").
+		ColumnExpr("jsonb_agg(facet_contract.abi)").
+		Where("facet_contract.abi IS NOT NULL").
+		Where("facet_contract.id IN (SELECT DISTINCT facet_id FROM diamond_facet WHERE diamond_id = log.address_id)")
+
+	return query.
+		ColumnExpr("log_contract.abi AS contract_abi").
+		ColumnExpr("(?) AS facet_abis", facetABIs).
+		ColumnExpr("(?) AS implementation_abi", implementationABIQuery(db, "log.address_id", "log.height")).
+		ColumnExpr("(?) AS signatures", signatureABIsQuery(db, "log.topic0", types.Event)).
+		Join("LEFT JOIN contract AS log_contract ON log_contract.id = log.address_id")
+}
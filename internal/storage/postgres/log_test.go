@@ -2,9 +2,11 @@ package postgres
 
 import (
 	"context"
+	"encoding/json"
 	"time"
 
 	"github.com/NobleScope/noble-indexer/internal/storage"
+	pkgTypes "github.com/NobleScope/noble-indexer/pkg/types"
 	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
 )
 
@@ -505,3 +507,199 @@ func (s *StorageTestSuite) TestLogFilterWithABI() {
 		s.Require().NotNil(log.ContractABI)
 	}
 }
+
+func (s *StorageTestSuite) TestLogFilterWithFacetABIs() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	// address id=4 is a diamond with facet id=3
+	logs, err := s.storage.Logs.Filter(ctx, storage.LogListFilter{
+		Limit:     10,
+		Offset:    0,
+		Sort:      sdk.SortOrderAsc,
+		WithABI:   true,
+		AddressId: uint64Ptr(4),
+	})
+	s.Require().NoError(err)
+	s.Require().Len(logs, 1)
+	s.Require().JSONEq(`[{}]`, string(logs[0].FacetABIs))
+
+	logs, err = s.storage.Logs.Filter(ctx, storage.LogListFilter{
+		Limit:     10,
+		Offset:    0,
+		Sort:      sdk.SortOrderAsc,
+		WithABI:   true,
+		AddressId: uint64Ptr(3),
+	})
+	s.Require().NoError(err)
+	s.Require().Len(logs, 1)
+	s.Require().Nil(logs[0].FacetABIs)
+}
+
+func (s *StorageTestSuite) TestLogFilterWithImplementationABI() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	// address id=6 is a proxy upgraded to implementation id=3 at height 300
+	logs, err := s.storage.Logs.Filter(ctx, storage.LogListFilter{
+		Limit:     10,
+		Offset:    0,
+		Sort:      sdk.SortOrderAsc,
+		WithABI:   true,
+		AddressId: uint64Ptr(6),
+	})
+	s.Require().NoError(err)
+	s.Require().Len(logs, 1)
+	s.Require().JSONEq(`{}`, string(logs[0].ImplementationABI))
+	s.Require().Nil(logs[0].ContractABI)
+}
+
+func (s *StorageTestSuite) TestLogFilterWithSignatures() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	// log id=4 is the Transfer event of unverified contract id=4
+	logs, err := s.storage.Logs.Filter(ctx, storage.LogListFilter{
+		Limit:     10,
+		Offset:    0,
+		Sort:      sdk.SortOrderAsc,
+		WithABI:   true,
+		AddressId: uint64Ptr(4),
+	})
+	s.Require().NoError(err)
+	s.Require().NotEmpty(logs)
+	s.Require().EqualValues(4, logs[0].Id)
+	s.Require().Nil(logs[0].ContractABI)
+
+	var fragments []map[string]any
+	s.Require().NoError(json.Unmarshal(logs[0].Signatures, &fragments))
+	s.Require().Len(fragments, 1)
+	s.Require().Equal("Transfer", fragments[0]["name"])
+}
+
+func (s *StorageTestSuite) TestLogFilterByTopic0() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	logs, err := s.storage.Logs.Filter(ctx, storage.LogListFilter{
+		Limit:  10,
+		Offset: 0,
+		Sort:   sdk.SortOrderAsc,
+		Topic0: []pkgTypes.Hex{
+			pkgTypes.MustDecodeHex("0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925"),
+		},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(logs, 1)
+	s.Require().EqualValues(6, logs[0].Id)
+	s.Require().EqualValues("0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925", logs[0].Topic0.Hex())
+}
+
+func (s *StorageTestSuite) TestLogFilterByTopicsOrList() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	logs, err := s.storage.Logs.Filter(ctx, storage.LogListFilter{
+		Limit:  10,
+		Offset: 0,
+		Sort:   sdk.SortOrderAsc,
+		Topic0: []pkgTypes.Hex{
+			pkgTypes.MustDecodeHex("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"),
+			pkgTypes.MustDecodeHex("0x7ecd84343f76a23d2227290e0288da3251b045541698e575a5515af4f04197a3"),
+		},
+		Topic1: []pkgTypes.Hex{
+			pkgTypes.MustDecodeHex("0x000000000000000000000000ba6a2c1142cf73fc171f8a20fd9e98572eb2ac42"),
+			pkgTypes.MustDecodeHex("0x000000000000000000000000af52695e1bb01a16d33d7194c28c42b10e0dbec2"),
+		},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(logs, 2)
+	s.Require().EqualValues(1, logs[0].Id)
+	s.Require().EqualValues(3, logs[1].Id)
+}
+
+func (s *StorageTestSuite) TestLogFilterByTopic2NoMatch() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	logs, err := s.storage.Logs.Filter(ctx, storage.LogListFilter{
+		Limit:  10,
+		Offset: 0,
+		Sort:   sdk.SortOrderAsc,
+		Topic2: []pkgTypes.Hex{
+			pkgTypes.MustDecodeHex("0x000000000000000000000000af52695e1bb01a16d33d7194c28c42b10e0dbec2"),
+		},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(logs, 0)
+}
+
+func (s *StorageTestSuite) TestLogFilterByEventName() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	logs, err := s.storage.Logs.Filter(ctx, storage.LogListFilter{
+		Limit:     10,
+		Offset:    0,
+		Sort:      sdk.SortOrderAsc,
+		EventName: "Transfer",
+	})
+	s.Require().NoError(err)
+	s.Require().Len(logs, 2)
+	s.Require().EqualValues(1, logs[0].Id)
+	s.Require().EqualValues(2, logs[1].Id)
+	s.Require().Equal("Transfer(address,address,uint256)", logs[0].EventSignature)
+
+	var args map[string]any
+	s.Require().NoError(json.Unmarshal(logs[0].EventArgs, &args))
+	s.Require().Equal("522107696184505304162042", args["value"])
+}
+
+func (s *StorageTestSuite) TestLogFilterByEventArgs() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	logs, err := s.storage.Logs.Filter(ctx, storage.LogListFilter{
+		Limit:     10,
+		Offset:    0,
+		Sort:      sdk.SortOrderAsc,
+		EventName: "Transfer",
+		EventArgs: map[string]string{
+			"from":  "0x3aAf77ba7Da262e34dFfb9B10fC6777BfDA79Ab7",
+			"value": "677240441",
+		},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(logs, 1)
+	s.Require().EqualValues(2, logs[0].Id)
+
+	logs, err = s.storage.Logs.Filter(ctx, storage.LogListFilter{
+		Limit: 10,
+		EventArgs: map[string]string{
+			"from": "0x0000000000000000000000000000000000000001",
+		},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(logs, 0)
+}
+
+func (s *StorageTestSuite) TestLogUndecoded() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	logs, err := s.storage.Logs.Undecoded(ctx, 2)
+	s.Require().NoError(err)
+	s.Require().Len(logs, 2)
+	s.Require().EqualValues(3, logs[0].Id)
+	s.Require().EqualValues(4, logs[1].Id)
+	s.Require().False(logs[0].Decoded)
+	s.Require().NotEmpty(logs[0].Topics)
+
+	// log id=4 is the Transfer event of unverified contract id=4
+	s.Require().Nil(logs[1].ContractABI)
+	s.Require().NotNil(logs[1].Signatures)
+
+	logs, err = s.storage.Logs.Undecoded(ctx, 100)
+	s.Require().NoError(err)
+	s.Require().Len(logs, 6)
+}
@@ -0,0 +1,51 @@
+package migrations
+
+import (
+	"context"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(upLogTopics, downLogTopics)
+}
+
+func upLogTopics(ctx context.Context, db *bun.DB) error {
+	for _, column := range []struct {
+		name    string
+		comment string
+	}{
+		{"topic0", "First log topic (event signature)"},
+		{"topic1", "Second log topic"},
+		{"topic2", "Third log topic"},
+		{"topic3", "Fourth log topic"},
+	} {
+		if _, err := db.ExecContext(ctx, `ALTER TABLE public."log" ADD COLUMN IF NOT EXISTS ? bytea`, bun.Ident(column.name)); err != nil {
+			return err
+		}
+		if _, err := db.ExecContext(ctx, `COMMENT ON COLUMN public."log".? IS ?`, bun.Ident(column.name), column.comment); err != nil {
+			return err
+		}
+	}
+
+	// topics are stored as a JSON array of hex strings with the leading zero nibble trimmed
+	if _, err := db.ExecContext(ctx, `
+		UPDATE public."log" SET
+			topic0 = decode(lpad(substring(convert_from(topics, 'UTF8')::jsonb ->> 0 from 3), 64, '0'), 'hex'),
+			topic1 = decode(lpad(substring(convert_from(topics, 'UTF8')::jsonb ->> 1 from 3), 64, '0'), 'hex'),
+			topic2 = decode(lpad(substring(convert_from(topics, 'UTF8')::jsonb ->> 2 from 3), 64, '0'), 'hex'),
+			topic3 = decode(lpad(substring(convert_from(topics, 'UTF8')::jsonb ->> 3 from 3), 64, '0'), 'hex')
+		WHERE topics IS NOT NULL AND topic0 IS NULL`); err != nil {
+		return err
+	}
+	return nil
+}
+
+func downLogTopics(ctx context.Context, db *bun.DB) error {
+	for _, column := range []string{"topic0", "topic1", "topic2", "topic3"} {
+		if _, err := db.ExecContext(ctx, `ALTER TABLE public."log" DROP COLUMN IF EXISTS ?`, bun.Ident(column)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package migrations
+
+import (
+	"context"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(upTxTypedFields, downTxTypedFields)
+}
+
+func upTxTypedFields(ctx context.Context, db *bun.DB) error {
+	if _, err := db.ExecContext(ctx, `ALTER TYPE tx_type ADD VALUE IF NOT EXISTS 'TxTypeAccessList' AFTER 'TxTypeLegacy'`); err != nil {
+		return err
+	}
+
+	for _, column := range []struct {
+		name    string
+		typ     string
+		comment string
+	}{
+		{"max_fee_per_gas", "numeric", "Max fee per gas (EIP-1559)"},
+		{"max_priority_fee_per_gas", "numeric", "Max priority fee per gas (EIP-1559)"},
+		{"max_fee_per_blob_gas", "numeric", "Max fee per blob gas (EIP-4844)"},
+		{"blob_versioned_hashes", "bytea", "Blob versioned hashes (EIP-4844)"},
+	} {
+		if _, err := db.ExecContext(ctx, `ALTER TABLE public."tx" ADD COLUMN IF NOT EXISTS ? ?`, bun.Ident(column.name), bun.Safe(column.typ)); err != nil {
+			return err
+		}
+		if _, err := db.ExecContext(ctx, `COMMENT ON COLUMN public."tx".? IS ?`, bun.Ident(column.name), column.comment); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func downTxTypedFields(ctx context.Context, db *bun.DB) error {
+	for _, column := range []string{"max_fee_per_gas", "max_priority_fee_per_gas", "max_fee_per_blob_gas", "blob_versioned_hashes"} {
+		if _, err := db.ExecContext(ctx, `ALTER TABLE public."tx" DROP COLUMN IF EXISTS ?`, bun.Ident(column)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package migrations
+
+import (
+	"context"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(upTxAuthorizationAuthority, downTxAuthorizationAuthority)
+}
+
+func upTxAuthorizationAuthority(ctx context.Context, db *bun.DB) error {
+	var exists bool
+	if err := db.NewRaw(`SELECT to_regclass('public.tx_authorization') IS NOT NULL`).Scan(ctx, &exists); err != nil {
+		return err
+	}
+	if !exists {
+		// table will be created with the new columns
+		return nil
+	}
+
+	for _, column := range []struct {
+		name    string
+		typ     string
+		comment string
+	}{
+		{"authority_id", "bigint", "Recovered authority address id"},
+		{"delegate_id", "bigint", "Delegate address id, empty if the delegation is cleared"},
+		{"valid", "boolean", "Authorization passed signature and chain id checks"},
+	} {
+		if _, err := db.ExecContext(ctx, `ALTER TABLE public."tx_authorization" ADD COLUMN IF NOT EXISTS ? ?`, bun.Ident(column.name), bun.Safe(column.typ)); err != nil {
+			return err
+		}
+		if _, err := db.ExecContext(ctx, `COMMENT ON COLUMN public."tx_authorization".? IS ?`, bun.Ident(column.name), column.comment); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func downTxAuthorizationAuthority(ctx context.Context, db *bun.DB) error {
+	for _, column := range []string{"authority_id", "delegate_id", "valid"} {
+		if _, err := db.ExecContext(ctx, `ALTER TABLE IF EXISTS public."tx_authorization" DROP COLUMN IF EXISTS ?`, bun.Ident(column)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package migrations
+
+import (
+	"context"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(upBlobGas, downBlobGas)
+}
+
+type blobGasColumn struct {
+	table   string
+	name    string
+	typ     string
+	comment string
+}
+
+var blobGasColumns = []blobGasColumn{
+	{"block", "blob_gas_used", "numeric", "Total blob gas used by the transactions in the block (EIP-4844)"},
+	{"block", "excess_blob_gas", "numeric", "Excess blob gas (EIP-4844)"},
+	{"block", "blob_gas_price", "numeric", "Blob gas price (EIP-4844)"},
+	{"tx", "blob_gas_used", "numeric", "Blob gas used (EIP-4844)"},
+	{"tx", "blob_gas_price", "numeric", "Blob gas price (EIP-4844)"},
+	{"tx", "blob_fee", "numeric", "Blob fee in Wei, burned completely (EIP-4844)"},
+	{"block_stats", "blobs_count", "bigint NOT NULL DEFAULT 0", "Count of blobs in block (EIP-4844)"},
+	{"block_stats", "blob_gas_used", "numeric NOT NULL DEFAULT 0", "Blob gas used in block (EIP-4844)"},
+	{"block_stats", "blob_fee", "numeric NOT NULL DEFAULT 0", "Burned blob fee in Wei (EIP-4844)"},
+}
+
+func upBlobGas(ctx context.Context, db *bun.DB) error {
+	for _, column := range blobGasColumns {
+		if _, err := db.ExecContext(ctx, `ALTER TABLE public.? ADD COLUMN IF NOT EXISTS ? ?`, bun.Ident(column.table), bun.Ident(column.name), bun.Safe(column.typ)); err != nil {
+			return err
+		}
+		if _, err := db.ExecContext(ctx, `COMMENT ON COLUMN public.?.? IS ?`, bun.Ident(column.table), bun.Ident(column.name), column.comment); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func downBlobGas(ctx context.Context, db *bun.DB) error {
+	for _, column := range blobGasColumns {
+		if _, err := db.ExecContext(ctx, `ALTER TABLE public.? DROP COLUMN IF EXISTS ?`, bun.Ident(column.table), bun.Ident(column.name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
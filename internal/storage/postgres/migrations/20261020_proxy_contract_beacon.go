@@ -0,0 +1,33 @@
+package migrations
+
+import (
+	"context"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(upProxyContractBeacon, downProxyContractBeacon)
+}
+
+func upProxyContractBeacon(ctx context.Context, db *bun.DB) error {
+	var exists bool
+	if err := db.NewRaw(`SELECT to_regclass('public.proxy_contract') IS NOT NULL`).Scan(ctx, &exists); err != nil {
+		return err
+	}
+	if !exists {
+		// table will be created with the new column
+		return nil
+	}
+
+	if _, err := db.ExecContext(ctx, `ALTER TABLE public."proxy_contract" ADD COLUMN IF NOT EXISTS beacon_id bigint`); err != nil {
+		return err
+	}
+	_, err := db.ExecContext(ctx, `COMMENT ON COLUMN public."proxy_contract".beacon_id IS ?`, "Internal beacon contract ID, empty if proxy is not a beacon proxy")
+	return err
+}
+
+func downProxyContractBeacon(ctx context.Context, db *bun.DB) error {
+	_, err := db.ExecContext(ctx, `ALTER TABLE IF EXISTS public."proxy_contract" DROP COLUMN IF EXISTS beacon_id`)
+	return err
+}
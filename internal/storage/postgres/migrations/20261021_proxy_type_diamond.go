@@ -0,0 +1,29 @@
+package migrations
+
+import (
+	"context"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(upProxyTypeDiamond, downProxyTypeDiamond)
+}
+
+func upProxyTypeDiamond(ctx context.Context, db *bun.DB) error {
+	var exists bool
+	if err := db.NewRaw(`SELECT EXISTS (SELECT 1 FROM pg_type WHERE typname = 'proxy_type')`).Scan(ctx, &exists); err != nil {
+		return err
+	}
+	if !exists {
+		// type will be created with the new value
+		return nil
+	}
+
+	_, err := db.ExecContext(ctx, `ALTER TYPE proxy_type ADD VALUE IF NOT EXISTS 'diamond'`)
+	return err
+}
+
+func downProxyTypeDiamond(ctx context.Context, db *bun.DB) error {
+	return nil
+}
@@ -0,0 +1,43 @@
+package migrations
+
+import (
+	"context"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(upLogEvents, downLogEvents)
+}
+
+var logEventsColumns = []struct {
+	name    string
+	typ     string
+	comment string
+}{
+	{"event_name", "text", "Decoded event name"},
+	{"event_signature", "text", "Decoded event signature"},
+	{"event_args", "jsonb", "Decoded event arguments"},
+	{"decoded", "boolean NOT NULL DEFAULT false", "Log was processed by the log decoder"},
+}
+
+func upLogEvents(ctx context.Context, db *bun.DB) error {
+	for _, column := range logEventsColumns {
+		if _, err := db.ExecContext(ctx, `ALTER TABLE public."log" ADD COLUMN IF NOT EXISTS ? ?`, bun.Ident(column.name), bun.Safe(column.typ)); err != nil {
+			return err
+		}
+		if _, err := db.ExecContext(ctx, `COMMENT ON COLUMN public."log".? IS ?`, bun.Ident(column.name), column.comment); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func downLogEvents(ctx context.Context, db *bun.DB) error {
+	for _, column := range logEventsColumns {
+		if _, err := db.ExecContext(ctx, `ALTER TABLE public."log" DROP COLUMN IF EXISTS ?`, bun.Ident(column.name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
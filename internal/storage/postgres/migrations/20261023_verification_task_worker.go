@@ -0,0 +1,41 @@
+package migrations
+
+import (
+	"context"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(upVerificationTaskWorker, downVerificationTaskWorker)
+}
+
+var verificationTaskWorkerColumns = []struct {
+	name    string
+	typ     string
+	comment string
+}{
+	{"start_time", "timestamptz", "Time when the task was claimed by a verification worker"},
+	{"compiler_output", "text", "Compiler output of the failed compilation"},
+}
+
+func upVerificationTaskWorker(ctx context.Context, db *bun.DB) error {
+	for _, column := range verificationTaskWorkerColumns {
+		if _, err := db.ExecContext(ctx, `ALTER TABLE public.verification_task ADD COLUMN IF NOT EXISTS ? ?`, bun.Ident(column.name), bun.Safe(column.typ)); err != nil {
+			return err
+		}
+		if _, err := db.ExecContext(ctx, `COMMENT ON COLUMN public.verification_task.? IS ?`, bun.Ident(column.name), column.comment); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func downVerificationTaskWorker(ctx context.Context, db *bun.DB) error {
+	for _, column := range verificationTaskWorkerColumns {
+		if _, err := db.ExecContext(ctx, `ALTER TABLE public.verification_task DROP COLUMN IF EXISTS ?`, bun.Ident(column.name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
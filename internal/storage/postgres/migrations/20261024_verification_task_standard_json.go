@@ -0,0 +1,30 @@
+package migrations
+
+import (
+	"context"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(upVerificationTaskStandardJSON, downVerificationTaskStandardJSON)
+}
+
+func upVerificationTaskStandardJSON(ctx context.Context, db *bun.DB) error {
+	if _, err := db.ExecContext(ctx, `ALTER TABLE public.verification_task ADD COLUMN IF NOT EXISTS standard_json bool NOT NULL DEFAULT false`); err != nil {
+		return err
+	}
+	if _, err := db.ExecContext(ctx, `COMMENT ON COLUMN public.verification_task.standard_json IS ?`, "Task contains a single compiler standard JSON input file"); err != nil {
+		return err
+	}
+	_, err := db.ExecContext(ctx, `COMMENT ON COLUMN public.verification_task.contract_name IS ?`, "Contract name in Solidity source or fully-qualified name for standard JSON input")
+	return err
+}
+
+func downVerificationTaskStandardJSON(ctx context.Context, db *bun.DB) error {
+	if _, err := db.ExecContext(ctx, `ALTER TABLE public.verification_task DROP COLUMN IF EXISTS standard_json`); err != nil {
+		return err
+	}
+	_, err := db.ExecContext(ctx, `COMMENT ON COLUMN public.verification_task.contract_name IS ?`, "Contract name in Solidity source")
+	return err
+}
@@ -0,0 +1,46 @@
+package migrations
+
+import (
+	"context"
+
+	"github.com/NobleScope/noble-indexer/internal/storage/types"
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(upVerificationTaskLanguage, downVerificationTaskLanguage)
+}
+
+func upVerificationTaskLanguage(ctx context.Context, db *bun.DB) error {
+	if _, err := db.ExecContext(ctx, `DO $$
+	BEGIN
+		IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 'verification_language') THEN
+			CREATE TYPE verification_language AS ENUM (?);
+		END IF;
+	END$$;`, bun.In(types.LanguageValues())); err != nil {
+		return err
+	}
+
+	if _, err := db.ExecContext(ctx, `ALTER TABLE public.verification_task ADD COLUMN IF NOT EXISTS language verification_language NOT NULL DEFAULT 'solidity'`); err != nil {
+		return err
+	}
+	if _, err := db.ExecContext(ctx, `COMMENT ON COLUMN public.verification_task.language IS ?`, "Source code language"); err != nil {
+		return err
+	}
+
+	if _, err := db.ExecContext(ctx, `ALTER TABLE public.verification_task ADD COLUMN IF NOT EXISTS optimization_mode text`); err != nil {
+		return err
+	}
+	_, err := db.ExecContext(ctx, `COMMENT ON COLUMN public.verification_task.optimization_mode IS ?`, "Vyper optimization mode")
+	return err
+}
+
+func downVerificationTaskLanguage(ctx context.Context, db *bun.DB) error {
+	for _, column := range []string{"language", "optimization_mode"} {
+		if _, err := db.ExecContext(ctx, `ALTER TABLE public.verification_task DROP COLUMN IF EXISTS ?`, bun.Ident(column)); err != nil {
+			return err
+		}
+	}
+	_, err := db.ExecContext(ctx, `DROP TYPE IF EXISTS verification_language`)
+	return err
+}
@@ -0,0 +1,46 @@
+package migrations
+
+import (
+	"context"
+
+	"github.com/NobleScope/noble-indexer/internal/storage/types"
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(upContractConstructorArgs, downContractConstructorArgs)
+}
+
+func upContractConstructorArgs(ctx context.Context, db *bun.DB) error {
+	if _, err := db.ExecContext(ctx, `DO $$
+	BEGIN
+		IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 'match_type') THEN
+			CREATE TYPE match_type AS ENUM (?);
+		END IF;
+	END$$;`, bun.In(types.MatchTypeValues())); err != nil {
+		return err
+	}
+
+	if _, err := db.ExecContext(ctx, `ALTER TABLE public.contract ADD COLUMN IF NOT EXISTS constructor_args bytea`); err != nil {
+		return err
+	}
+	if _, err := db.ExecContext(ctx, `COMMENT ON COLUMN public.contract.constructor_args IS ?`, "ABI-encoded constructor arguments extracted from the creation code"); err != nil {
+		return err
+	}
+
+	if _, err := db.ExecContext(ctx, `ALTER TABLE public.contract ADD COLUMN IF NOT EXISTS match_type match_type`); err != nil {
+		return err
+	}
+	_, err := db.ExecContext(ctx, `COMMENT ON COLUMN public.contract.match_type IS ?`, "Verification match type")
+	return err
+}
+
+func downContractConstructorArgs(ctx context.Context, db *bun.DB) error {
+	for _, column := range []string{"constructor_args", "match_type"} {
+		if _, err := db.ExecContext(ctx, `ALTER TABLE public.contract DROP COLUMN IF EXISTS ?`, bun.Ident(column)); err != nil {
+			return err
+		}
+	}
+	_, err := db.ExecContext(ctx, `DROP TYPE IF EXISTS match_type`)
+	return err
+}
@@ -0,0 +1,46 @@
+package migrations
+
+import (
+	"context"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(upContractSimilarMatch, downContractSimilarMatch)
+}
+
+var contractSimilarMatchColumns = []struct {
+	name    string
+	typ     string
+	comment string
+}{
+	{"code_hash", "bytea", "Hash of the code without metadata and immutable values"},
+	{"immutable_refs", "bigint[]", "Offsets of immutable values in the code of the verified contract"},
+	{"similar_checked", "boolean NOT NULL DEFAULT false", "Contract was processed by the similar match"},
+}
+
+func upContractSimilarMatch(ctx context.Context, db *bun.DB) error {
+	if _, err := db.ExecContext(ctx, `ALTER TYPE match_type ADD VALUE IF NOT EXISTS 'similar'`); err != nil {
+		return err
+	}
+
+	for _, column := range contractSimilarMatchColumns {
+		if _, err := db.ExecContext(ctx, `ALTER TABLE public.contract ADD COLUMN IF NOT EXISTS ? ?`, bun.Ident(column.name), bun.Safe(column.typ)); err != nil {
+			return err
+		}
+		if _, err := db.ExecContext(ctx, `COMMENT ON COLUMN public.contract.? IS ?`, bun.Ident(column.name), column.comment); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func downContractSimilarMatch(ctx context.Context, db *bun.DB) error {
+	for _, column := range contractSimilarMatchColumns {
+		if _, err := db.ExecContext(ctx, `ALTER TABLE public.contract DROP COLUMN IF EXISTS ?`, bun.Ident(column.name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,34 @@
+package migrations
+
+import (
+	"context"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(upContractLibraries, downContractLibraries)
+}
+
+func upContractLibraries(ctx context.Context, db *bun.DB) error {
+	if _, err := db.ExecContext(ctx, `ALTER TABLE public.verification_task ADD COLUMN IF NOT EXISTS libraries jsonb`); err != nil {
+		return err
+	}
+	if _, err := db.ExecContext(ctx, `COMMENT ON COLUMN public.verification_task.libraries IS ?`, "Addresses of linked libraries by library name"); err != nil {
+		return err
+	}
+
+	if _, err := db.ExecContext(ctx, `ALTER TABLE public.source ADD COLUMN IF NOT EXISTS libraries jsonb`); err != nil {
+		return err
+	}
+	_, err := db.ExecContext(ctx, `COMMENT ON COLUMN public.source.libraries IS ?`, "Addresses of libraries of the source linked to the contract")
+	return err
+}
+
+func downContractLibraries(ctx context.Context, db *bun.DB) error {
+	if _, err := db.ExecContext(ctx, `ALTER TABLE public.verification_task DROP COLUMN IF EXISTS libraries`); err != nil {
+		return err
+	}
+	_, err := db.ExecContext(ctx, `ALTER TABLE public.source DROP COLUMN IF EXISTS libraries`)
+	return err
+}
@@ -0,0 +1,34 @@
+package migrations
+
+import (
+	"context"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(upStateRange, downStateRange)
+}
+
+func upStateRange(ctx context.Context, db *bun.DB) error {
+	if _, err := db.ExecContext(ctx, `ALTER TABLE public."state" ADD COLUMN IF NOT EXISTS "start_height" int8 NOT NULL DEFAULT 0`); err != nil {
+		return err
+	}
+	if _, err := db.ExecContext(ctx, `COMMENT ON COLUMN public."state"."start_height" IS ?`, "First block height of the range indexer"); err != nil {
+		return err
+	}
+
+	if _, err := db.ExecContext(ctx, `ALTER TABLE public."state" ADD COLUMN IF NOT EXISTS "end_height" int8 NOT NULL DEFAULT 0`); err != nil {
+		return err
+	}
+	_, err := db.ExecContext(ctx, `COMMENT ON COLUMN public."state"."end_height" IS ?`, "Last block height of the range indexer, zero for the canonical state")
+	return err
+}
+
+func downStateRange(ctx context.Context, db *bun.DB) error {
+	if _, err := db.ExecContext(ctx, `ALTER TABLE public."state" DROP COLUMN IF EXISTS "start_height"`); err != nil {
+		return err
+	}
+	_, err := db.ExecContext(ctx, `ALTER TABLE public."state" DROP COLUMN IF EXISTS "end_height"`)
+	return err
+}
@@ -0,0 +1,56 @@
+package migrations
+
+import (
+	"context"
+
+	"github.com/NobleScope/noble-indexer/internal/storage/types"
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(upBlockFinality, downBlockFinality)
+}
+
+func upBlockFinality(ctx context.Context, db *bun.DB) error {
+	if _, err := db.ExecContext(ctx, `DO $$
+	BEGIN
+		IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 'finality') THEN
+			CREATE TYPE finality AS ENUM (?);
+		END IF;
+	END$$;`, bun.In(types.FinalityValues())); err != nil {
+		return err
+	}
+
+	if _, err := db.ExecContext(ctx, `ALTER TABLE public.block ADD COLUMN IF NOT EXISTS finality finality NOT NULL DEFAULT 'latest'`); err != nil {
+		return err
+	}
+	if _, err := db.ExecContext(ctx, `COMMENT ON COLUMN public.block.finality IS ?`, "Finality of the block: latest, safe or finalized"); err != nil {
+		return err
+	}
+
+	if _, err := db.ExecContext(ctx, `ALTER TABLE public."state" ADD COLUMN IF NOT EXISTS "safe_height" int8 NOT NULL DEFAULT 0`); err != nil {
+		return err
+	}
+	if _, err := db.ExecContext(ctx, `COMMENT ON COLUMN public."state"."safe_height" IS ?`, "Height of the last safe block of the node"); err != nil {
+		return err
+	}
+
+	if _, err := db.ExecContext(ctx, `ALTER TABLE public."state" ADD COLUMN IF NOT EXISTS "finalized_height" int8 NOT NULL DEFAULT 0`); err != nil {
+		return err
+	}
+	_, err := db.ExecContext(ctx, `COMMENT ON COLUMN public."state"."finalized_height" IS ?`, "Height of the last finalized block of the node")
+	return err
+}
+
+func downBlockFinality(ctx context.Context, db *bun.DB) error {
+	for _, column := range []string{"safe_height", "finalized_height"} {
+		if _, err := db.ExecContext(ctx, `ALTER TABLE public."state" DROP COLUMN IF EXISTS ?`, bun.Ident(column)); err != nil {
+			return err
+		}
+	}
+	if _, err := db.ExecContext(ctx, `ALTER TABLE public.block DROP COLUMN IF EXISTS finality`); err != nil {
+		return err
+	}
+	_, err := db.ExecContext(ctx, `DROP TYPE IF EXISTS finality`)
+	return err
+}
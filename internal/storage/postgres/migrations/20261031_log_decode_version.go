@@ -0,0 +1,24 @@
+package migrations
+
+import (
+	"context"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(upLogDecodeVersion, downLogDecodeVersion)
+}
+
+func upLogDecodeVersion(ctx context.Context, db *bun.DB) error {
+	if _, err := db.ExecContext(ctx, `ALTER TABLE public."log" ADD COLUMN IF NOT EXISTS decode_version int8 NOT NULL DEFAULT 0`); err != nil {
+		return err
+	}
+	_, err := db.ExecContext(ctx, `COMMENT ON COLUMN public."log".decode_version IS ?`, "Incremented on every reset of the decoded event")
+	return err
+}
+
+func downLogDecodeVersion(ctx context.Context, db *bun.DB) error {
+	_, err := db.ExecContext(ctx, `ALTER TABLE public."log" DROP COLUMN IF EXISTS decode_version`)
+	return err
+}
@@ -0,0 +1,43 @@
+package migrations
+
+import (
+	"context"
+
+	"github.com/NobleScope/noble-indexer/internal/storage"
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(upBalanceHistoryBackfill, downBalanceHistoryBackfill)
+}
+
+// upBalanceHistoryBackfill - saves the current balance of addresses indexed before the balance history was introduced
+// as the snapshot at the last height of the address. Migrations run before tables are created, so the hypertable is created here.
+func upBalanceHistoryBackfill(ctx context.Context, db *bun.DB) error {
+	if _, err := db.NewCreateTable().
+		Model((*storage.BalanceHistory)(nil)).
+		IfNotExists().
+		Exec(ctx); err != nil {
+		return err
+	}
+	if _, err := db.ExecContext(ctx,
+		`SELECT create_hypertable('balance_history', 'time', chunk_time_interval => INTERVAL '1 month', if_not_exists => TRUE)`,
+	); err != nil {
+		return err
+	}
+
+	_, err := db.ExecContext(ctx, `
+		INSERT INTO public.balance_history (height, "time", address_id, "value", delta)
+		SELECT address.last_height, block."time", balance.id, balance."value", balance."value"
+		FROM public.balance
+		JOIN public.address ON address.id = balance.id
+		JOIN public.block ON block.height = address.last_height
+		WHERE balance."value" != 0
+			AND NOT EXISTS (SELECT 1 FROM public.balance_history WHERE balance_history.address_id = balance.id)
+	`)
+	return err
+}
+
+func downBalanceHistoryBackfill(ctx context.Context, db *bun.DB) error {
+	return nil
+}
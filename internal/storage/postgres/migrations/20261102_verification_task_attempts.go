@@ -0,0 +1,24 @@
+package migrations
+
+import (
+	"context"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(upVerificationTaskAttempts, downVerificationTaskAttempts)
+}
+
+func upVerificationTaskAttempts(ctx context.Context, db *bun.DB) error {
+	if _, err := db.ExecContext(ctx, `ALTER TABLE public.verification_task ADD COLUMN IF NOT EXISTS attempts int4 NOT NULL DEFAULT 0`); err != nil {
+		return err
+	}
+	_, err := db.ExecContext(ctx, `COMMENT ON COLUMN public.verification_task.attempts IS ?`, "Count of claims of the task by verification workers")
+	return err
+}
+
+func downVerificationTaskAttempts(ctx context.Context, db *bun.DB) error {
+	_, err := db.ExecContext(ctx, `ALTER TABLE public.verification_task DROP COLUMN IF EXISTS attempts`)
+	return err
+}
@@ -26,6 +26,7 @@ func (p *ProxyContract) NotResolved(ctx context.Context) (contracts []storage.Pr
 	err = p.DB().NewSelect().
 		Model(&contracts).
 		Relation("Contract.Address").
+		Relation("Beacon").
 		Where("proxy_contract.status = ?", types.New).
 		Order("height DESC").
 		Limit(100).
@@ -73,8 +74,10 @@ func (p *ProxyContract) FilteredList(
 		ColumnExpr("proxy.*").
 		ColumnExpr("impl_addr.hash AS implementation__address__hash").
 		ColumnExpr("contract_addr.hash AS contract__address__hash").
+		ColumnExpr("beacon_addr.hash AS beacon__hash").
 		Join("LEFT JOIN address AS impl_addr ON impl_addr.id = proxy.implementation_id").
-		Join("LEFT JOIN address AS contract_addr ON contract_addr.id = proxy.id")
+		Join("LEFT JOIN address AS contract_addr ON contract_addr.id = proxy.id").
+		Join("LEFT JOIN address AS beacon_addr ON beacon_addr.id = proxy.beacon_id")
 
 	if filters.Sort != "" {
 		outerQuery = sortScope(outerQuery, "proxy."+sortField, filters.Sort)
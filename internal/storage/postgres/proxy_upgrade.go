@@ -0,0 +1,60 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/NobleScope/noble-indexer/internal/storage"
+	"github.com/dipdup-net/go-lib/database"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+	"github.com/uptrace/bun"
+)
+
+type ProxyUpgrade struct {
+	*postgres.Table[*storage.ProxyUpgrade]
+}
+
+// NewProxyUpgrade -
+func NewProxyUpgrade(db *database.Bun) *ProxyUpgrade {
+	return &ProxyUpgrade{
+		Table: postgres.NewTable[*storage.ProxyUpgrade](db),
+	}
+}
+
+// Filter - returns upgrade history of the proxy contract
+func (p *ProxyUpgrade) Filter(ctx context.Context, filter storage.ProxyUpgradeListFilter) (upgrades []storage.ProxyUpgrade, err error) {
+	query := p.DB().NewSelect().
+		Model(&upgrades).
+		Where("proxy_id = ?", filter.ProxyId)
+
+	if len(filter.Type) > 0 {
+		query = query.Where("type IN (?)", bun.In(filter.Type))
+	}
+
+	if filter.CursorID > 0 {
+		query = cursorIDScope(query, filter.Sort, filter.CursorID)
+	} else {
+		query = query.Offset(filter.Offset)
+	}
+	query = limitScope(query, filter.Limit)
+	query = sortScope(query, "id", filter.Sort)
+
+	outerQuery := p.DB().NewSelect().
+		ColumnExpr("proxy_upgrade.*").
+		ColumnExpr("proxy.hash AS proxy__hash").
+		ColumnExpr("implementation.hash AS implementation__hash").
+		ColumnExpr("beacon.hash AS beacon__hash").
+		ColumnExpr("previous_admin.hash AS previous_admin__hash").
+		ColumnExpr("admin.hash AS admin__hash").
+		ColumnExpr("tx.hash AS tx__hash").
+		TableExpr("(?) AS proxy_upgrade", query).
+		Join("LEFT JOIN address AS proxy ON proxy.id = proxy_upgrade.proxy_id").
+		Join("LEFT JOIN address AS implementation ON implementation.id = proxy_upgrade.implementation_id").
+		Join("LEFT JOIN address AS beacon ON beacon.id = proxy_upgrade.beacon_id").
+		Join("LEFT JOIN address AS previous_admin ON previous_admin.id = proxy_upgrade.previous_admin_id").
+		Join("LEFT JOIN address AS admin ON admin.id = proxy_upgrade.admin_id").
+		Join("LEFT JOIN tx ON tx.id = proxy_upgrade.tx_id")
+
+	outerQuery = sortScope(outerQuery, "proxy_upgrade.id", filter.Sort)
+	err = outerQuery.Scan(ctx, &upgrades)
+	return
+}
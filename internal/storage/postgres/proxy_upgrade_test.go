@@ -0,0 +1,86 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/NobleScope/noble-indexer/internal/storage"
+	"github.com/NobleScope/noble-indexer/internal/storage/types"
+	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
+)
+
+func (s *StorageTestSuite) TestProxyUpgradeFilter() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	upgrades, err := s.storage.ProxyUpgrades.Filter(ctx, storage.ProxyUpgradeListFilter{
+		Limit:   10,
+		Sort:    sdk.SortOrderDesc,
+		ProxyId: 6,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(upgrades, 3)
+	s.Require().EqualValues(4, upgrades[0].Id)
+	s.Require().EqualValues(2, upgrades[1].Id)
+	s.Require().EqualValues(1, upgrades[2].Id)
+
+	upgrade := upgrades[0]
+	s.Require().EqualValues(300, upgrade.Height)
+	s.Require().Equal(types.Upgraded, upgrade.Type)
+	s.Require().Equal("0x60f055506ba543ea0942dc8ca03f596ab75bc882", upgrade.Proxy.Hash.Hex())
+	s.Require().NotNil(upgrade.Implementation)
+	s.Require().Equal("0x30f055506ba543ea0942dc8ca03f596ab75bc879", upgrade.Implementation.Hash.Hex())
+	s.Require().Nil(upgrade.Beacon)
+	s.Require().Equal("0xf92f9397aab724f0022ed986011ffd1300ca1d77b38c5d432bbeceb597ad1a21", upgrade.Tx.Hash.Hex())
+
+	admin := upgrades[1]
+	s.Require().Equal(types.AdminChanged, admin.Type)
+	s.Require().Nil(admin.Implementation)
+	s.Require().NotNil(admin.PreviousAdmin)
+	s.Require().Equal("0xa63d581a7fdab643c09f0524904b046cdb9ad9d2", admin.PreviousAdmin.Hash.Hex())
+	s.Require().NotNil(admin.Admin)
+	s.Require().Equal("0xaa725ef35d90060a8cdfb77e324a9b770ca7e127", admin.Admin.Hash.Hex())
+}
+
+func (s *StorageTestSuite) TestProxyUpgradeFilterByType() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	upgrades, err := s.storage.ProxyUpgrades.Filter(ctx, storage.ProxyUpgradeListFilter{
+		Limit:   10,
+		Sort:    sdk.SortOrderAsc,
+		ProxyId: 6,
+		Type:    []types.ProxyUpgradeType{types.Upgraded},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(upgrades, 2)
+	s.Require().EqualValues(1, upgrades[0].Id)
+	s.Require().EqualValues(4, upgrades[1].Id)
+
+	upgrades, err = s.storage.ProxyUpgrades.Filter(ctx, storage.ProxyUpgradeListFilter{
+		Limit:   10,
+		Sort:    sdk.SortOrderAsc,
+		ProxyId: 11,
+		Type:    []types.ProxyUpgradeType{types.BeaconUpgraded},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(upgrades, 1)
+	s.Require().NotNil(upgrades[0].Beacon)
+	s.Require().Equal("0x80f055506ba543ea0942dc8ca03f596ab75bc884", upgrades[0].Beacon.Hash.Hex())
+}
+
+func (s *StorageTestSuite) TestProxyUpgradeFilterCursor() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	upgrades, err := s.storage.ProxyUpgrades.Filter(ctx, storage.ProxyUpgradeListFilter{
+		Limit:    10,
+		Sort:     sdk.SortOrderDesc,
+		ProxyId:  6,
+		CursorID: 4,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(upgrades, 2)
+	s.Require().EqualValues(2, upgrades[0].Id)
+	s.Require().EqualValues(1, upgrades[1].Id)
+}
@@ -1,9 +1,13 @@
 package postgres
 
 import (
+	"encoding/json"
+	"fmt"
 	"time"
 
 	"github.com/NobleScope/noble-indexer/internal/storage"
+	"github.com/NobleScope/noble-indexer/internal/storage/types"
+	pkgTypes "github.com/NobleScope/noble-indexer/pkg/types"
 	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
 	"github.com/uptrace/bun"
 )
@@ -157,6 +161,9 @@ func traceListFilter(query *bun.SelectQuery, fltrs storage.TraceListFilter) *bun
 	if fltrs.Height != nil {
 		query = query.Where("height = ?", *fltrs.Height)
 	}
+	if fltrs.MaxHeight != nil {
+		query = query.Where("height <= ?", *fltrs.MaxHeight)
+	}
 
 	if len(fltrs.Type) > 0 {
 		query = query.Where("type IN (?)", bun.In(fltrs.Type))
@@ -217,6 +224,9 @@ func transferListFilter(query *bun.SelectQuery, fltrs storage.TransferListFilter
 	if fltrs.Height != nil {
 		query = query.Where("height = ?", *fltrs.Height)
 	}
+	if fltrs.MaxHeight != nil {
+		query = query.Where("height <= ?", *fltrs.MaxHeight)
+	}
 	if len(fltrs.Type) > 0 {
 		query = query.Where("type IN (?)", bun.In(fltrs.Type))
 	}
@@ -261,6 +271,26 @@ func tokenBalanceListFilter(query *bun.SelectQuery, fltrs storage.TokenBalanceLi
 	return query
 }
 
+// eventArgScope filters logs by the decoded event argument. Arguments are stored as strings, numbers or booleans,
+// so the value is matched by containment in the string and the scalar forms to use the GIN index of arguments.
+func eventArgScope(query *bun.SelectQuery, name, value string) *bun.SelectQuery {
+	asString, _ := json.Marshal(map[string]string{name: value})
+
+	var scalar any
+	if err := json.Unmarshal([]byte(value), &scalar); err == nil {
+		switch scalar.(type) {
+		case float64, bool:
+			asScalar, _ := json.Marshal(map[string]any{name: scalar})
+			return query.WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+				return q.
+					Where("event_args @> ?::jsonb", string(asString)).
+					WhereOr("event_args @> ?::jsonb", string(asScalar))
+			})
+		}
+	}
+	return query.Where("event_args @> ?::jsonb", string(asString))
+}
+
 func logListFilter(query *bun.SelectQuery, fltrs storage.LogListFilter) *bun.SelectQuery {
 	if fltrs.TxId != nil {
 		query = query.Where("tx_id = ?", *fltrs.TxId)
@@ -271,6 +301,17 @@ func logListFilter(query *bun.SelectQuery, fltrs storage.LogListFilter) *bun.Sel
 	if fltrs.Height != nil {
 		query = query.Where("height = ?", *fltrs.Height)
 	}
+	if fltrs.MaxHeight != nil {
+		query = query.Where("height <= ?", *fltrs.MaxHeight)
+	}
+	query = logTopicsScope(query, fltrs.Topic0, fltrs.Topic1, fltrs.Topic2, fltrs.Topic3)
+
+	if fltrs.EventName != "" {
+		query = query.Where("event_name = ?", fltrs.EventName)
+	}
+	for name, value := range fltrs.EventArgs {
+		query = eventArgScope(query, name, value)
+	}
 
 	if !fltrs.TimeFrom.IsZero() {
 		query = query.Where("time >= ?", fltrs.TimeFrom)
@@ -291,6 +332,99 @@ func logListFilter(query *bun.SelectQuery, fltrs storage.LogListFilter) *bun.Sel
 	return query
 }
 
+func logRangeFilter(query *bun.SelectQuery, fltrs storage.LogRangeFilter) *bun.SelectQuery {
+	query = query.Where("height >= ?", fltrs.HeightFrom).
+		Where("height <= ?", fltrs.HeightTo).
+		Where("removed = false")
+
+	if fltrs.TxId != nil {
+		query = query.Where("tx_id = ?", *fltrs.TxId)
+	}
+	if len(fltrs.AddressIds) > 0 {
+		query = query.Where("address_id IN (?)", bun.In(fltrs.AddressIds))
+	}
+	query = logTopicsScope(query, fltrs.Topic0, fltrs.Topic1, fltrs.Topic2, fltrs.Topic3)
+
+	if fltrs.Limit > 0 {
+		query = query.Limit(fltrs.Limit)
+	}
+	return query.OrderExpr("height ASC, id ASC")
+}
+
+// logTopicsScope applies eth_getLogs-like topic matching: values at one position are OR-ed, positions are AND-ed.
+func logTopicsScope(query *bun.SelectQuery, topics ...[]pkgTypes.Hex) *bun.SelectQuery {
+	for i := range topics {
+		if len(topics[i]) == 0 {
+			continue
+		}
+		query = query.Where("? IN (?)", bun.Ident(fmt.Sprintf("topic%d", i)), bun.In(topics[i]))
+	}
+	return query
+}
+
+// implementationABIQuery - selects ABI of the implementation which was used by the proxy at the height.
+// The implementation is taken from the last Upgraded event of the proxy or from the beacon of the last BeaconUpgraded event.
+// It is empty before the first upgrade. Proxies without upgrade events use the current implementation.
+func implementationABIQuery(db bun.IDB, addressColumn, heightColumn string) *bun.SelectQuery {
+	upgradeTypes := bun.In([]types.ProxyUpgradeType{types.Upgraded, types.BeaconUpgraded})
+
+	lastUpgrade := db.NewSelect().
+		Model((*storage.ProxyUpgrade)(nil)).
+		Column("type", "implementation_id", "beacon_id").
+		Where("proxy_upgrade.proxy_id = proxy.id").
+		Where("proxy_upgrade.type IN (?)", upgradeTypes).
+		Where("proxy_upgrade.height <= ?", bun.Ident(heightColumn)).
+		OrderExpr("proxy_upgrade.height DESC, proxy_upgrade.id DESC").
+		Limit(1)
+
+	hasUpgrades := db.NewSelect().
+		Model((*storage.ProxyUpgrade)(nil)).
+		ColumnExpr("1").
+		Where("proxy_upgrade.proxy_id = proxy.id").
+		Where("proxy_upgrade.type IN (?)", upgradeTypes)
+
+	beaconHasUpgrades := db.NewSelect().
+		Model((*storage.ProxyUpgrade)(nil)).
+		ColumnExpr("1").
+		Where("proxy_upgrade.proxy_id = last_upgrade.beacon_id").
+		Where("proxy_upgrade.type = ?", types.Upgraded)
+
+	beaconUpgrade := db.NewSelect().
+		Model((*storage.ProxyUpgrade)(nil)).
+		Column("implementation_id").
+		Where("proxy_upgrade.proxy_id = last_upgrade.beacon_id").
+		Where("proxy_upgrade.type = ?", types.Upgraded).
+		Where("proxy_upgrade.height <= ?", bun.Ident(heightColumn)).
+		OrderExpr("proxy_upgrade.height DESC, proxy_upgrade.id DESC").
+		Limit(1)
+
+	beaconCurrent := db.NewSelect().
+		TableExpr("proxy_contract AS beacon").
+		Column("implementation_id").
+		Where("beacon.id = last_upgrade.beacon_id")
+
+	return db.NewSelect().
+		TableExpr("proxy_contract AS proxy").
+		ColumnExpr("implementation.abi").
+		Join("LEFT JOIN LATERAL (?) AS last_upgrade ON TRUE", lastUpgrade).
+		Join(`INNER JOIN contract AS implementation ON implementation.id = CASE
+			WHEN last_upgrade.type = ? THEN last_upgrade.implementation_id
+			WHEN last_upgrade.type = ? THEN CASE WHEN EXISTS (?) THEN (?) ELSE (?) END
+			WHEN NOT EXISTS (?) THEN proxy.implementation_id
+		END`, types.Upgraded, types.BeaconUpgraded, beaconHasUpgrades, beaconUpgrade, beaconCurrent, hasUpgrades).
+		Where("proxy.id = ?", bun.Ident(addressColumn))
+}
+
+// signatureABIsQuery - selects ABI fragments of all known signatures of the selector.
+// It is used as a fallback to decode calls and logs of unverified contracts.
+func signatureABIsQuery(db bun.IDB, selectorExpr string, typ types.SignatureType) *bun.SelectQuery {
+	return db.NewSelect().
+		Model((*storage.Signature)(nil)).
+		ColumnExpr("jsonb_agg(signature.abi ORDER BY signature.id)").
+		Where("signature.selector = ?", bun.Safe(selectorExpr)).
+		Where("signature.type = ?", typ)
+}
+
 func erc4337UserOpsListFilter(query *bun.SelectQuery, fltrs storage.ERC4337UserOpsListFilter) *bun.SelectQuery {
 	if fltrs.Height != nil {
 		query = query.Where("height = ?", *fltrs.Height)
@@ -346,6 +480,9 @@ func txListFilter(query *bun.SelectQuery, fltrs storage.TxListFilter) *bun.Selec
 	if fltrs.Height != nil {
 		query = query.Where("height = ?", *fltrs.Height)
 	}
+	if fltrs.MaxHeight != nil {
+		query = query.Where("height <= ?", *fltrs.MaxHeight)
+	}
 	if !fltrs.TimeFrom.IsZero() {
 		query = query.Where("time >= ?", fltrs.TimeFrom)
 	}
@@ -371,3 +508,31 @@ func txListFilter(query *bun.SelectQuery, fltrs storage.TxListFilter) *bun.Selec
 
 	return query
 }
+
+func allowanceListFilter(query *bun.SelectQuery, fltrs storage.AllowanceListFilter) *bun.SelectQuery {
+	query = query.Where("owner_id = ?", fltrs.OwnerId)
+
+	if fltrs.SpenderId != nil {
+		query = query.Where("spender_id = ?", *fltrs.SpenderId)
+	}
+	if fltrs.ContractId != nil {
+		query = query.Where("contract_id = ?", *fltrs.ContractId)
+	}
+	if len(fltrs.Type) > 0 {
+		query = query.Where("type IN (?)", bun.In(fltrs.Type))
+	}
+	if !fltrs.IncludeRevoked {
+		query = query.Where("approved = true")
+	}
+
+	if fltrs.CursorID > 0 {
+		query = cursorIDScope(query, fltrs.Sort, fltrs.CursorID)
+	} else {
+		query = query.Offset(fltrs.Offset)
+	}
+
+	query = limitScope(query, fltrs.Limit)
+	query = sortScope(query, "id", fltrs.Sort)
+
+	return query
+}
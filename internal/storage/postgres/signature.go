@@ -0,0 +1,32 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/NobleScope/noble-indexer/internal/storage"
+	"github.com/dipdup-net/go-lib/database"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+)
+
+type Signature struct {
+	*postgres.Table[*storage.Signature]
+}
+
+// NewSignature -
+func NewSignature(db *database.Bun) *Signature {
+	return &Signature{
+		Table: postgres.NewTable[*storage.Signature](db),
+	}
+}
+
+// Filter - returns text signatures of the function selector or the event topic
+func (s *Signature) Filter(ctx context.Context, filter storage.SignatureListFilter) (signatures []storage.Signature, err error) {
+	query := s.DB().NewSelect().
+		Model(&signatures).
+		Where("selector = ?", filter.Selector).
+		Offset(filter.Offset)
+
+	query = limitScope(query, filter.Limit)
+	err = query.Order("id ASC").Scan(ctx)
+	return
+}
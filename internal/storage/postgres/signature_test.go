@@ -0,0 +1,52 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/NobleScope/noble-indexer/internal/storage"
+	"github.com/NobleScope/noble-indexer/internal/storage/types"
+	pkgTypes "github.com/NobleScope/noble-indexer/pkg/types"
+)
+
+func (s *StorageTestSuite) TestSignatureFilter() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	signatures, err := s.storage.Signatures.Filter(ctx, storage.SignatureListFilter{
+		Limit:    10,
+		Selector: pkgTypes.MustDecodeHex("0xa9059cbb"),
+	})
+	s.Require().NoError(err)
+	s.Require().Len(signatures, 2)
+	s.Require().Equal("transfer(address,uint256)", signatures[0].Signature)
+	s.Require().Equal(types.Function, signatures[0].Type)
+	s.Require().Equal("many_msg_babbage(bytes1)", signatures[1].Signature)
+	s.Require().NotEmpty(signatures[1].ABI)
+}
+
+func (s *StorageTestSuite) TestSignatureFilterEvent() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	signatures, err := s.storage.Signatures.Filter(ctx, storage.SignatureListFilter{
+		Limit:    10,
+		Selector: pkgTypes.MustDecodeHex("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"),
+	})
+	s.Require().NoError(err)
+	s.Require().Len(signatures, 1)
+	s.Require().Equal("Transfer(address,address,uint256)", signatures[0].Signature)
+	s.Require().Equal(types.Event, signatures[0].Type)
+}
+
+func (s *StorageTestSuite) TestSignatureFilterUnknown() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	signatures, err := s.storage.Signatures.Filter(ctx, storage.SignatureListFilter{
+		Limit:    10,
+		Selector: pkgTypes.MustDecodeHex("0xdeadbeef"),
+	})
+	s.Require().NoError(err)
+	s.Require().Len(signatures, 0)
+}
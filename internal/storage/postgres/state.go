@@ -25,3 +25,13 @@ func (s *State) ByName(ctx context.Context, name string) (state storage.State, e
 	err = s.DB().NewSelect().Model(&state).Where("name = ?", name).Scan(ctx)
 	return
 }
+
+// Ranges - returns states of range indexers of the indexer ordered by the range start
+func (s *State) Ranges(ctx context.Context, name string) (states []storage.State, err error) {
+	err = s.DB().NewSelect().Model(&states).
+		Where("starts_with(name, ?)", storage.RangeStatePrefix(name)).
+		Where("end_height > 0").
+		Order("start_height asc").
+		Scan(ctx)
+	return
+}
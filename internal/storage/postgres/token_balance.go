@@ -6,6 +6,7 @@ import (
 	"github.com/NobleScope/noble-indexer/internal/storage"
 	"github.com/dipdup-net/go-lib/database"
 	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+	"github.com/uptrace/bun"
 )
 
 type TokenBalance struct {
@@ -24,6 +25,9 @@ func (t *TokenBalance) Filter(ctx context.Context, filter storage.TokenBalanceLi
 	query := t.DB().NewSelect().
 		Model(&tb)
 
+	if filter.MaxHeight != nil {
+		query = t.atHeight(query, *filter.MaxHeight)
+	}
 	query = tokenBalanceListFilter(query, filter)
 
 	outerQuery := t.DB().NewSelect().TableExpr("(?) AS token_balance", query).
@@ -45,3 +49,26 @@ func (t *TokenBalance) Filter(ctx context.Context, filter storage.TokenBalanceLi
 
 	return
 }
+
+// atHeight replaces balances with the last snapshots at or before the height. Balances which were changed only after
+// the height are zero. Balances without snapshots were saved before the history was tracked and are returned as is.
+func (t *TokenBalance) atHeight(query *bun.SelectQuery, height uint64) *bun.SelectQuery {
+	snapshots := func() *bun.SelectQuery {
+		return t.DB().NewSelect().
+			TableExpr("token_balance_history AS history").
+			Where("history.address_id = token_balance.address_id").
+			Where("history.contract_id = token_balance.contract_id").
+			Where("history.token_id = token_balance.token_id")
+	}
+
+	lastSnapshot := snapshots().
+		Column("balance").
+		Where("history.height <= ?", height).
+		OrderExpr("history.time DESC, history.id DESC").
+		Limit(1)
+
+	return query.
+		Column("id", "token_id", "contract_id", "address_id").
+		ColumnExpr("CASE WHEN snapshot.balance IS NOT NULL THEN snapshot.balance WHEN EXISTS (?) THEN 0 ELSE token_balance.balance END AS balance", snapshots().ColumnExpr("1")).
+		Join("LEFT JOIN LATERAL (?) AS snapshot ON TRUE", lastSnapshot)
+}
@@ -0,0 +1,82 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/NobleScope/noble-indexer/internal/storage"
+	pkgTypes "github.com/NobleScope/noble-indexer/pkg/types"
+	"github.com/dipdup-net/go-lib/database"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+	"github.com/shopspring/decimal"
+	"github.com/uptrace/bun"
+)
+
+type TokenBalanceHistory struct {
+	*postgres.Table[*storage.TokenBalanceHistory]
+}
+
+// NewTokenBalanceHistory -
+func NewTokenBalanceHistory(db *database.Bun) *TokenBalanceHistory {
+	return &TokenBalanceHistory{
+		Table: postgres.NewTable[*storage.TokenBalanceHistory](db),
+	}
+}
+
+// Filter -
+func (t *TokenBalanceHistory) Filter(ctx context.Context, filter storage.TokenBalanceHistoryListFilter) (history []storage.TokenBalanceHistory, err error) {
+	query := t.DB().NewSelect().
+		Model(&history)
+
+	if filter.AddressId != nil {
+		query = query.Where("address_id = ?", *filter.AddressId)
+	}
+	if filter.ContractId != nil {
+		query = query.Where("contract_id = ?", *filter.ContractId)
+	}
+	if filter.TokenId != nil {
+		query = query.Where("token_id = ?", *filter.TokenId)
+	}
+	if filter.MaxHeight != nil {
+		query = query.Where("height <= ?", *filter.MaxHeight)
+	}
+
+	if filter.CursorID > 0 {
+		query = cursorTimeIDScope(query, filter.Sort, filter.CursorTime, filter.CursorID)
+	} else {
+		query = query.Offset(filter.Offset)
+	}
+
+	query = limitScope(query, filter.Limit)
+	query = sortTimeIDScope(query, filter.Sort)
+
+	outerQuery := t.withAddresses(query)
+	outerQuery = sortTimeIDScope(outerQuery, filter.Sort)
+
+	err = outerQuery.Scan(ctx, &history)
+	return
+}
+
+// AtHeight - returns the last token balance snapshot at or before the height
+func (t *TokenBalanceHistory) AtHeight(ctx context.Context, addressId, contractId uint64, tokenId decimal.Decimal, height pkgTypes.Level) (history storage.TokenBalanceHistory, err error) {
+	query := t.DB().NewSelect().
+		Model((*storage.TokenBalanceHistory)(nil)).
+		Where("address_id = ?", addressId).
+		Where("contract_id = ?", contractId).
+		Where("token_id = ?", tokenId).
+		Where("height <= ?", height).
+		OrderExpr("time DESC, id DESC").
+		Limit(1)
+
+	err = t.withAddresses(query).Scan(ctx, &history)
+	return
+}
+
+func (t *TokenBalanceHistory) withAddresses(query *bun.SelectQuery) *bun.SelectQuery {
+	return t.DB().NewSelect().
+		TableExpr("(?) AS token_balance_history", query).
+		ColumnExpr("token_balance_history.*").
+		ColumnExpr("contract_address.hash AS contract__address__hash").
+		ColumnExpr("address.hash AS address__hash").
+		Join("LEFT JOIN address AS contract_address ON contract_address.id = token_balance_history.contract_id").
+		Join("LEFT JOIN address ON address.id = token_balance_history.address_id")
+}
@@ -0,0 +1,95 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/NobleScope/noble-indexer/internal/storage"
+	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/shopspring/decimal"
+)
+
+func (s *StorageTestSuite) TestTokenBalanceHistoryFilter() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	addressId := uint64(1)
+	contractId := uint64(3)
+	tokenId := decimal.Zero
+
+	history, err := s.storage.TokenBalanceHistory.Filter(ctx, storage.TokenBalanceHistoryListFilter{
+		AddressId:  &addressId,
+		ContractId: &contractId,
+		TokenId:    &tokenId,
+		Limit:      10,
+		Sort:       sdk.SortOrderAsc,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(history, 2)
+
+	s.Require().EqualValues(1, history[0].Id)
+	s.Require().EqualValues(100, history[0].Height)
+	s.Require().True(history[0].Balance.Equal(decimal.RequireFromString("1500000000000000000")))
+	s.Require().Equal("0xa63d581a7fdab643c09f0524904b046cdb9ad9d2", history[0].Address.Hash.Hex())
+	s.Require().Equal("0x30f055506ba543ea0942dc8ca03f596ab75bc879", history[0].Contract.Address.Hash.Hex())
+
+	s.Require().EqualValues(3, history[1].Id)
+	s.Require().True(history[1].Delta.Equal(decimal.RequireFromString("-500000000000000000")))
+}
+
+func (s *StorageTestSuite) TestTokenBalanceHistoryFilterByContractDesc() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	contractId := uint64(3)
+	history, err := s.storage.TokenBalanceHistory.Filter(ctx, storage.TokenBalanceHistoryListFilter{
+		ContractId: &contractId,
+		Limit:      10,
+		Sort:       sdk.SortOrderDesc,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(history, 4)
+	s.Require().EqualValues(4, history[0].Id)
+	s.Require().EqualValues(3, history[1].Id)
+	s.Require().EqualValues(2, history[2].Id)
+	s.Require().EqualValues(1, history[3].Id)
+}
+
+func (s *StorageTestSuite) TestTokenBalanceHistoryFilterCursor() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	addressId := uint64(1)
+	history, err := s.storage.TokenBalanceHistory.Filter(ctx, storage.TokenBalanceHistoryListFilter{
+		AddressId:  &addressId,
+		Limit:      10,
+		Sort:       sdk.SortOrderAsc,
+		CursorTime: time.Date(2024, 1, 1, 18, 0, 0, 0, time.UTC),
+		CursorID:   3,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(history, 2)
+	s.Require().EqualValues(4, history[0].Id)
+	s.Require().EqualValues(5, history[1].Id)
+}
+
+func (s *StorageTestSuite) TestTokenBalanceHistoryAtHeight() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	history, err := s.storage.TokenBalanceHistory.AtHeight(ctx, 1, 3, decimal.Zero, 199)
+	s.Require().NoError(err)
+	s.Require().EqualValues(3, history.Id)
+	s.Require().EqualValues(150, history.Height)
+	s.Require().True(history.Balance.Equal(decimal.RequireFromString("1000000000000000000")))
+	s.Require().Equal("0x30f055506ba543ea0942dc8ca03f596ab75bc879", history.Contract.Address.Hash.Hex())
+}
+
+func (s *StorageTestSuite) TestTokenBalanceHistoryAtHeightBeforeFirstChange() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	_, err := s.storage.TokenBalanceHistory.AtHeight(ctx, 1, 3, decimal.Zero, 99)
+	s.Require().Error(err)
+	s.Require().True(s.storage.TokenBalanceHistory.IsNoRows(err))
+}
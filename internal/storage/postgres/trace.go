@@ -4,8 +4,10 @@ import (
 	"context"
 
 	"github.com/NobleScope/noble-indexer/internal/storage"
+	"github.com/NobleScope/noble-indexer/internal/storage/types"
 	"github.com/dipdup-net/go-lib/database"
 	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+	"github.com/uptrace/bun"
 )
 
 type Trace struct {
@@ -42,7 +44,12 @@ func (t *Trace) Filter(ctx context.Context, filters storage.TraceListFilter) (tr
 	if filters.WithABI {
 		outerQuery = outerQuery.
 			ColumnExpr("to_contract.abi AS to_contract_abi").
-			Join("LEFT JOIN contract AS to_contract ON to_contract.id = trace.to_address_id")
+			ColumnExpr("to_facet.abi AS to_facet_abi").
+			ColumnExpr("(?) AS to_implementation_abi", implementationABIQuery(t.DB(), "trace.to_address_id", "trace.height")).
+			ColumnExpr("(?) AS to_signatures", signatureABIsQuery(t.DB(), "substring(trace.input FROM 1 FOR 4)", types.Function)).
+			Join("LEFT JOIN contract AS to_contract ON to_contract.id = trace.to_address_id").
+			Join("LEFT JOIN diamond_facet AS facet ON facet.diamond_id = trace.to_address_id AND facet.selector = substring(trace.input FROM 1 FOR 4)").
+			Join("LEFT JOIN contract AS to_facet ON to_facet.id = facet.facet_id")
 	}
 
 	outerQuery = sortTimeIDScope(outerQuery, filters.Sort)
@@ -74,10 +81,27 @@ func (t *Trace) ByTxId(ctx context.Context, txId uint64, withABI bool) (traces [
 	if withABI {
 		query = query.
 			ColumnExpr("to_contract.abi AS to_contract_abi").
-			Join("LEFT JOIN contract AS to_contract ON to_contract.id = trace.to_address_id")
+			ColumnExpr("to_facet.abi AS to_facet_abi").
+			ColumnExpr("(?) AS to_implementation_abi", implementationABIQuery(t.DB(), "trace.to_address_id", "trace.height")).
+			ColumnExpr("(?) AS to_signatures", signatureABIsQuery(t.DB(), "substring(trace.input FROM 1 FOR 4)", types.Function)).
+			Join("LEFT JOIN contract AS to_contract ON to_contract.id = trace.to_address_id").
+			Join("LEFT JOIN diamond_facet AS facet ON facet.diamond_id = trace.to_address_id AND facet.selector = substring(trace.input FROM 1 FOR 4)").
+			Join("LEFT JOIN contract AS to_facet ON to_facet.id = facet.facet_id")
 	}
 
 	err = query.Scan(ctx, &traces)
 
 	return
 }
+
+// CreationByContractId - returns the trace which created the contract
+func (t *Trace) CreationByContractId(ctx context.Context, contractId uint64) (trace storage.Trace, err error) {
+	err = t.DB().NewSelect().
+		Model(&trace).
+		Where("contract_id = ?", contractId).
+		Where("type IN (?)", bun.In([]types.TraceType{types.Create, types.Create2})).
+		Order("id asc").
+		Limit(1).
+		Scan(ctx)
+	return
+}
@@ -610,3 +610,18 @@ func (s *StorageTestSuite) TestTraceFilterWithABI() {
 		}
 	}
 }
+
+func (s *StorageTestSuite) TestTraceCreationByContractId() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	trace, err := s.storage.Trace.CreationByContractId(ctx, 5)
+	s.Require().NoError(err)
+	s.Require().Equal(types.Create, trace.Type)
+	s.Require().NotNil(trace.InitHash)
+	s.Require().Equal([]byte{0x11, 0x22, 0x33, 0x44, 0x55, 0x66, 0x77, 0x88}, trace.InitHash.Bytes())
+
+	_, err = s.storage.Trace.CreationByContractId(ctx, 3)
+	s.Require().Error(err)
+	s.Require().True(s.storage.Trace.IsNoRows(err))
+}
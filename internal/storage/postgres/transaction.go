@@ -1,15 +1,44 @@
 package postgres
 
 import (
+	"bytes"
+	"cmp"
 	"context"
 	"errors"
+	"fmt"
+	"slices"
+	"time"
 
 	models "github.com/NobleScope/noble-indexer/internal/storage"
+	storageTypes "github.com/NobleScope/noble-indexer/internal/storage/types"
 	"github.com/NobleScope/noble-indexer/pkg/types"
 	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/shopspring/decimal"
 	"github.com/uptrace/bun"
 )
 
+// copiable converts rows to COPY them. COPY doesn't return identities of rows, so it's used only for tables
+// whose identities are not needed after saving: logs and traces of the whole batch of blocks are copied at once.
+func copiable[T storage.Copiable](data []T) []storage.Copiable {
+	result := make([]storage.Copiable, len(data))
+	for i := range data {
+		result[i] = data[i]
+	}
+	return result
+}
+
+// sortedByKey returns the copy of rows sorted by the conflict key of the upsert. Rows are locked in the order of insertion,
+// so concurrent transactions of range indexers upserting the same rows lock them in the same order and don't deadlock.
+func sortedByKey[T any](rows []T, compare func(a, b T) int) []T {
+	sorted := slices.Clone(rows)
+	slices.SortFunc(sorted, compare)
+	return sorted
+}
+
+func compareTokenKeys(contractA, contractB uint64, tokenA, tokenB decimal.Decimal) int {
+	return cmp.Or(cmp.Compare(contractA, contractB), tokenA.Cmp(tokenB))
+}
+
 type Transaction struct {
 	storage.Transaction
 }
@@ -38,9 +67,136 @@ func (tx Transaction) SaveLogs(ctx context.Context, logs ...*models.Log) error {
 	case 1:
 		return tx.Add(ctx, logs[0])
 	default:
-		_, err := tx.Tx().NewInsert().Model(&logs).Returning("id").Exec(ctx)
-		return err
+		return tx.CopyFrom(ctx, models.Log{}.TableName(), copiable(logs))
+	}
+}
+
+// SaveDecodedLogs - updates decoded events of the logs and marks them as decoded. Logs which were reset after
+// they had been read by the decoder have another decode version, they are skipped to be decoded again.
+func (tx Transaction) SaveDecodedLogs(ctx context.Context, logs ...*models.Log) error {
+	if len(logs) == 0 {
+		return nil
+	}
+
+	for i := range logs {
+		logs[i].Decoded = true
 	}
+
+	_, err := tx.Tx().NewUpdate().
+		Model(&logs).
+		Column("event_name", "event_signature", "event_args", "decoded").
+		Bulk().
+		Where("log.decode_version = _data.decode_version").
+		Exec(ctx)
+	return err
+}
+
+// ResetDecodedLogs - marks logs emitted by the contracts, by proxies which have ever used them as implementations
+// and by diamonds which use them as facets as undecoded to decode them again with the new ABI
+func (tx Transaction) ResetDecodedLogs(ctx context.Context, contractIds ...uint64) error {
+	if len(contractIds) == 0 {
+		return nil
+	}
+
+	proxies := tx.Tx().NewSelect().
+		Model((*models.ProxyContract)(nil)).
+		Column("id").
+		Where("implementation_id IN (?)", bun.In(contractIds))
+	upgradedProxies := tx.Tx().NewSelect().
+		Model((*models.ProxyUpgrade)(nil)).
+		Column("proxy_id").
+		Where("implementation_id IN (?)", bun.In(contractIds))
+	diamonds := tx.Tx().NewSelect().
+		Model((*models.DiamondFacet)(nil)).
+		Column("diamond_id").
+		Where("facet_id IN (?)", bun.In(contractIds))
+
+	// logs which are being decoded are reset too, so the stale result of their decoding isn't saved
+	_, err := tx.Tx().NewUpdate().
+		Model((*models.Log)(nil)).
+		Set("decoded = false").
+		Set("decode_version = decode_version + 1").
+		WhereGroup(" AND ", func(q *bun.UpdateQuery) *bun.UpdateQuery {
+			return q.
+				Where("address_id IN (?)", bun.In(contractIds)).
+				WhereOr("address_id IN (?)", proxies).
+				WhereOr("address_id IN (?)", upgradedProxies).
+				WhereOr("address_id IN (?)", diamonds)
+		}).
+		Exec(ctx)
+	return err
+}
+
+// ResetUnknownLogs - marks logs which were not decoded and have topics of the event signatures as undecoded
+// to decode them again with the new signatures
+func (tx Transaction) ResetUnknownLogs(ctx context.Context, signatures ...*models.Signature) error {
+	topics := make([]types.Hex, 0, len(signatures))
+	for i := range signatures {
+		if signatures[i].Type == storageTypes.Event {
+			topics = append(topics, signatures[i].Selector)
+		}
+	}
+	if len(topics) == 0 {
+		return nil
+	}
+
+	_, err := tx.Tx().NewUpdate().
+		Model((*models.Log)(nil)).
+		Set("decoded = false").
+		Set("decode_version = decode_version + 1").
+		Where("event_name IS NULL").
+		Where("topic0 IN (?)", bun.In(topics)).
+		Exec(ctx)
+	return err
+}
+
+// SaveSimilarChecked - saves code hashes of the contracts and marks them as processed by the similar match
+func (tx Transaction) SaveSimilarChecked(ctx context.Context, contracts ...*models.Contract) error {
+	if len(contracts) == 0 {
+		return nil
+	}
+
+	for i := range contracts {
+		contracts[i].SimilarChecked = true
+	}
+
+	_, err := tx.Tx().NewUpdate().
+		Model(&contracts).
+		Column("code_hash", "similar_checked").
+		Bulk().
+		Exec(ctx)
+	return err
+}
+
+// ResetSimilarChecked - marks contracts as unprocessed by the similar match to match them again,
+// e.g. after the contract verification
+func (tx Transaction) ResetSimilarChecked(ctx context.Context, contractIds ...uint64) error {
+	if len(contractIds) == 0 {
+		return nil
+	}
+
+	_, err := tx.Tx().NewUpdate().
+		Model((*models.Contract)(nil)).
+		Set("similar_checked = false").
+		Where("id IN (?)", bun.In(contractIds)).
+		Exec(ctx)
+	return err
+}
+
+// CopySources - copies sources of the verified contract to the contracts
+func (tx Transaction) CopySources(ctx context.Context, sourceContractId uint64, contractIds ...uint64) error {
+	if len(contractIds) == 0 {
+		return nil
+	}
+
+	_, err := tx.Tx().NewRaw(`INSERT INTO source (name, license, urls, content, libraries, contract_id)
+		SELECT source.name, source.license, source.urls, source.content, source.libraries, target.id
+		FROM source
+		CROSS JOIN unnest(ARRAY[?]::bigint[]) AS target(id)
+		WHERE source.contract_id = ?
+		ORDER BY target.id, source.id`, bun.In(contractIds), sourceContractId).
+		Exec(ctx)
+	return err
 }
 
 func (tx Transaction) SaveSources(ctx context.Context, sources ...*models.Source) error {
@@ -71,11 +227,16 @@ func (tx Transaction) SaveAddresses(ctx context.Context, addresses ...*models.Ad
 	for i := range addresses {
 		addr[i].Address = addresses[i]
 	}
+	slices.SortFunc(addr, func(a, b addedAddress) int {
+		return bytes.Compare(a.Hash, b.Hash)
+	})
 
 	_, err := tx.Tx().NewInsert().Model(&addr).
 		Column("hash", "first_height", "last_height", "is_contract", "txs_count", "contracts_count", "interactions").
 		On("CONFLICT (hash) DO UPDATE").
+		Set("first_height = LEAST(EXCLUDED.first_height, added_address.first_height)").
 		Set("last_height = GREATEST(EXCLUDED.last_height, added_address.last_height)").
+		Set("is_contract = EXCLUDED.is_contract OR added_address.is_contract").
 		Set("txs_count = EXCLUDED.txs_count + added_address.txs_count").
 		Set("contracts_count = EXCLUDED.contracts_count + added_address.contracts_count").
 		Set("interactions = EXCLUDED.interactions + added_address.interactions").
@@ -95,17 +256,32 @@ func (tx Transaction) SaveAddresses(ctx context.Context, addresses ...*models.Ad
 	return count, err
 }
 
-func (tx Transaction) SaveBalances(ctx context.Context, balances ...*models.Balance) error {
+func (tx Transaction) SaveBalances(ctx context.Context, balances ...*models.Balance) ([]models.Balance, error) {
 	if len(balances) == 0 {
-		return nil
+		return nil, nil
 	}
 
-	_, err := tx.Tx().NewInsert().Model(&balances).
+	balances = sortedByKey(balances, func(a, b *models.Balance) int {
+		return cmp.Compare(a.Id, b.Id)
+	})
+
+	var result []models.Balance
+	err := tx.Tx().NewInsert().Model(&balances).
 		Column("id", "value").
 		On("CONFLICT (id) DO UPDATE").
 		Set("value = EXCLUDED.value + balance.value").
-		Exec(ctx)
+		Returning("id, value").
+		Scan(ctx, &result)
 
+	return result, err
+}
+
+func (tx Transaction) SaveBalanceHistory(ctx context.Context, history ...*models.BalanceHistory) error {
+	if len(history) == 0 {
+		return nil
+	}
+
+	_, err := tx.Tx().NewInsert().Model(&history).Exec(ctx)
 	return err
 }
 
@@ -125,9 +301,12 @@ func (tx Transaction) SaveContracts(ctx context.Context, contracts ...*models.Co
 	for i := range contracts {
 		cs[i].Contract = contracts[i]
 	}
+	slices.SortFunc(cs, func(a, b addedContract) int {
+		return cmp.Compare(a.Id, b.Id)
+	})
 
 	_, err := tx.Tx().NewInsert().Model(&cs).
-		Column("id", "height", "code", "verified", "tx_id", "abi", "compiler_version", "metadata_link", "language", "optimizer_enabled", "tags", "status", "retry_count", "error", "updated_at", "deployer_id").
+		Column("id", "height", "code", "verified", "tx_id", "abi", "compiler_version", "metadata_link", "language", "optimizer_enabled", "tags", "status", "retry_count", "error", "updated_at", "deployer_id", "constructor_args", "match_type", "code_hash", "immutable_refs").
 		On("CONFLICT (id) DO UPDATE").
 		Set("verified = CASE WHEN EXCLUDED.verified THEN EXCLUDED.verified ELSE added_contract.verified END").
 		Set("abi = CASE WHEN EXCLUDED.abi IS NOT NULL THEN EXCLUDED.abi ELSE added_contract.abi END").
@@ -139,6 +318,10 @@ func (tx Transaction) SaveContracts(ctx context.Context, contracts ...*models.Co
 		Set("status = CASE WHEN EXCLUDED.status IS NOT NULL THEN EXCLUDED.status ELSE added_contract.status END").
 		Set("retry_count = CASE WHEN EXCLUDED.retry_count != 0 THEN EXCLUDED.retry_count ELSE added_contract.retry_count END").
 		Set("error = CASE WHEN EXCLUDED.error != '' THEN EXCLUDED.error ELSE added_contract.error END").
+		Set("constructor_args = CASE WHEN EXCLUDED.constructor_args IS NOT NULL THEN EXCLUDED.constructor_args ELSE added_contract.constructor_args END").
+		Set("match_type = CASE WHEN EXCLUDED.match_type IS NOT NULL THEN EXCLUDED.match_type ELSE added_contract.match_type END").
+		Set("code_hash = CASE WHEN EXCLUDED.code_hash IS NOT NULL THEN EXCLUDED.code_hash ELSE added_contract.code_hash END").
+		Set("immutable_refs = CASE WHEN EXCLUDED.immutable_refs IS NOT NULL THEN EXCLUDED.immutable_refs ELSE added_contract.immutable_refs END").
 		Set("updated_at = now()").
 		Returning("xmax, id").
 		Exec(ctx)
@@ -163,8 +346,7 @@ func (tx Transaction) SaveTraces(ctx context.Context, traces ...*models.Trace) e
 	case 1:
 		return tx.Add(ctx, traces[0])
 	default:
-		_, err := tx.Tx().NewInsert().Model(&traces).Returning("id").Exec(ctx)
-		return err
+		return tx.CopyFrom(ctx, models.Trace{}.TableName(), copiable(traces))
 	}
 }
 
@@ -196,13 +378,17 @@ func (tx Transaction) SaveTokens(ctx context.Context, tokens ...*models.Token) (
 	for i := range tokens {
 		ts[i].Token = tokens[i]
 	}
+	slices.SortFunc(ts, func(a, b addedToken) int {
+		return compareTokenKeys(a.ContractId, b.ContractId, a.TokenID, b.TokenID)
+	})
 
 	_, err := tx.Tx().NewInsert().Model(&ts).
 		Column("token_id", "contract_id", "type", "height", "last_height", "name", "symbol", "decimals", "transfers_count", "supply", "metadata_link", "status", "retry_count", "error", "metadata", "updated_at", "logo").
 		On("CONFLICT (token_id, contract_id) DO UPDATE").
 		Set("transfers_count = added_token.transfers_count + EXCLUDED.transfers_count").
 		Set("supply = added_token.supply + EXCLUDED.supply").
-		Set("last_height = EXCLUDED.last_height").
+		Set("height = LEAST(EXCLUDED.height, added_token.height)").
+		Set("last_height = GREATEST(EXCLUDED.last_height, added_token.last_height)").
 		Returning("xmax, id").
 		Exec(ctx)
 	if err != nil {
@@ -247,6 +433,13 @@ func (tx Transaction) SaveTokenBalances(ctx context.Context, tokens ...*models.T
 		return nil, nil
 	}
 
+	tokens = sortedByKey(tokens, func(a, b *models.TokenBalance) int {
+		return cmp.Or(
+			cmp.Compare(a.AddressID, b.AddressID),
+			compareTokenKeys(a.ContractID, b.ContractID, a.TokenID, b.TokenID),
+		)
+	})
+
 	var tbs []models.TokenBalance
 	err := tx.Tx().NewInsert().Model(&tokens).
 		On("CONFLICT (address_id, contract_id, token_id) DO UPDATE").
@@ -261,11 +454,24 @@ func (tx Transaction) SaveTokenBalances(ctx context.Context, tokens ...*models.T
 	return tbs, nil
 }
 
+func (tx Transaction) SaveTokenBalanceHistory(ctx context.Context, history ...*models.TokenBalanceHistory) error {
+	if len(history) == 0 {
+		return nil
+	}
+
+	_, err := tx.Tx().NewInsert().Model(&history).Exec(ctx)
+	return err
+}
+
 func (tx Transaction) SaveProxyContracts(ctx context.Context, contracts ...*models.ProxyContract) error {
 	if len(contracts) == 0 {
 		return nil
 	}
 
+	contracts = sortedByKey(contracts, func(a, b *models.ProxyContract) int {
+		return cmp.Compare(a.Id, b.Id)
+	})
+
 	_, err := tx.Tx().NewInsert().Model(&contracts).
 		On("CONFLICT (id) DO UPDATE").
 		Set(`implementation_id = CASE
@@ -273,6 +479,11 @@ func (tx Transaction) SaveProxyContracts(ctx context.Context, contracts ...*mode
 				THEN EXCLUDED.implementation_id
 				ELSE proxy_contract.implementation_id
 			END`).
+		Set(`beacon_id = CASE
+				WHEN proxy_contract.implementation_id IS NULL OR EXCLUDED.height > proxy_contract.height
+				THEN EXCLUDED.beacon_id
+				ELSE proxy_contract.beacon_id
+			END`).
 		Set("height = GREATEST(EXCLUDED.height, proxy_contract.height)").
 		Set(`status = CASE
 				WHEN proxy_contract.implementation_id IS NULL OR EXCLUDED.height > proxy_contract.height
@@ -285,6 +496,92 @@ func (tx Transaction) SaveProxyContracts(ctx context.Context, contracts ...*mode
 	return err
 }
 
+func (tx Transaction) SaveProxyUpgrades(ctx context.Context, upgrades ...*models.ProxyUpgrade) error {
+	if len(upgrades) == 0 {
+		return nil
+	}
+
+	_, err := tx.Tx().NewInsert().Model(&upgrades).Returning("id").Exec(ctx)
+	return err
+}
+
+// UpdateBeaconProxies - sets implementation of the beacons to the proxies which use them
+func (tx Transaction) UpdateBeaconProxies(ctx context.Context, beaconIds ...uint64) error {
+	if len(beaconIds) == 0 {
+		return nil
+	}
+
+	_, err := tx.Tx().NewUpdate().
+		TableExpr("proxy_contract AS proxy").
+		TableExpr("proxy_contract AS beacon").
+		Set("implementation_id = beacon.implementation_id").
+		Set("status = ?", storageTypes.Resolved).
+		Where("proxy.beacon_id = beacon.id").
+		Where("beacon.id IN (?)", bun.In(beaconIds)).
+		Where("beacon.implementation_id IS NOT NULL").
+		Where("proxy.implementation_id IS DISTINCT FROM beacon.implementation_id").
+		Exec(ctx)
+	return err
+}
+
+func (tx Transaction) SaveDiamondCuts(ctx context.Context, cuts ...*models.DiamondCut) error {
+	if len(cuts) == 0 {
+		return nil
+	}
+
+	_, err := tx.Tx().NewInsert().Model(&cuts).Returning("id").Exec(ctx)
+	return err
+}
+
+// SaveDiamondFacets - saves facets of selectors. Facet changed at the higher block isn't replaced, since ranges of blocks
+// may be saved out of order.
+func (tx Transaction) SaveDiamondFacets(ctx context.Context, facets ...*models.DiamondFacet) error {
+	if len(facets) == 0 {
+		return nil
+	}
+
+	facets = sortedByKey(facets, func(a, b *models.DiamondFacet) int {
+		return cmp.Or(
+			cmp.Compare(a.DiamondId, b.DiamondId),
+			bytes.Compare(a.Selector, b.Selector),
+		)
+	})
+
+	_, err := tx.Tx().NewInsert().Model(&facets).
+		On("CONFLICT (diamond_id, selector) DO UPDATE").
+		Set("facet_id = EXCLUDED.facet_id").
+		Set("height = EXCLUDED.height").
+		Where("EXCLUDED.height >= diamond_facet.height").
+		Exec(ctx)
+	return err
+}
+
+// DeleteDiamondFacets - deletes facets of removed selectors. Facet changed above the height of the removal is kept.
+func (tx Transaction) DeleteDiamondFacets(ctx context.Context, facets ...*models.DiamondFacet) error {
+	for i := range facets {
+		if _, err := tx.Tx().NewDelete().
+			Model((*models.DiamondFacet)(nil)).
+			Where("diamond_id = ?", facets[i].DiamondId).
+			Where("selector = ?", facets[i].Selector).
+			Where("height <= ?", facets[i].Height).
+			Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (tx Transaction) SaveSignatures(ctx context.Context, signatures ...*models.Signature) error {
+	if len(signatures) == 0 {
+		return nil
+	}
+
+	_, err := tx.Tx().NewInsert().Model(&signatures).
+		On("CONFLICT (selector, signature) DO NOTHING").
+		Exec(ctx)
+	return err
+}
+
 func (tx Transaction) SaveERC4337UserOps(ctx context.Context, userOps ...*models.ERC4337UserOp) error {
 	switch len(userOps) {
 	case 0:
@@ -306,9 +603,109 @@ func (tx Transaction) SaveBeaconWithdrawals(ctx context.Context, withdrawals ...
 	return err
 }
 
+func (tx Transaction) SaveApprovals(ctx context.Context, approvals ...*models.Approval) error {
+	if len(approvals) == 0 {
+		return nil
+	}
+
+	_, err := tx.Tx().NewInsert().Model(&approvals).Returning("id").Exec(ctx)
+	return err
+}
+
+func (tx Transaction) SaveAllowances(ctx context.Context, allowances ...*models.Allowance) error {
+	if len(allowances) == 0 {
+		return nil
+	}
+
+	allowances = sortedByKey(allowances, func(a, b *models.Allowance) int {
+		return cmp.Or(
+			cmp.Compare(a.Type, b.Type),
+			cmp.Compare(a.OwnerId, b.OwnerId),
+			cmp.Compare(a.SpenderId, b.SpenderId),
+			compareTokenKeys(a.ContractId, b.ContractId, a.TokenID, b.TokenID),
+		)
+	})
+
+	for _, allowance := range allowances {
+		if allowance.Type != storageTypes.TokenApproval {
+			continue
+		}
+		// NFT can have only one approved address, so the new approval resets the previous one
+		if _, err := tx.Tx().NewUpdate().
+			Model((*models.Allowance)(nil)).
+			Set("approved = false").
+			Set("last_height = ?", allowance.LastHeight).
+			Where("type = ?", storageTypes.TokenApproval).
+			Where("contract_id = ?", allowance.ContractId).
+			Where("token_id = ?", allowance.TokenID).
+			Where("owner_id = ?", allowance.OwnerId).
+			Where("spender_id != ?", allowance.SpenderId).
+			Where("approved = true").
+			Where("last_height <= ?", allowance.LastHeight).
+			Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	_, err := tx.Tx().NewInsert().Model(&allowances).
+		On("CONFLICT (type, contract_id, owner_id, spender_id, token_id) DO UPDATE").
+		Set("amount = CASE WHEN EXCLUDED.last_height >= allowance.last_height THEN EXCLUDED.amount ELSE allowance.amount END").
+		Set("approved = CASE WHEN EXCLUDED.last_height >= allowance.last_height THEN EXCLUDED.approved ELSE allowance.approved END").
+		Set("height = LEAST(EXCLUDED.height, allowance.height)").
+		Set("last_height = GREATEST(EXCLUDED.last_height, allowance.last_height)").
+		Exec(ctx)
+	return err
+}
+
+func (tx Transaction) SaveTxAccessLists(ctx context.Context, accessLists ...*models.TxAccessList) error {
+	if len(accessLists) == 0 {
+		return nil
+	}
+
+	_, err := tx.Tx().NewInsert().Model(&accessLists).Returning("id").Exec(ctx)
+	return err
+}
+
+func (tx Transaction) SaveTxAuthorizations(ctx context.Context, authorizations ...*models.TxAuthorization) error {
+	if len(authorizations) == 0 {
+		return nil
+	}
+
+	_, err := tx.Tx().NewInsert().Model(&authorizations).Returning("id").Exec(ctx)
+	return err
+}
+
+func (tx Transaction) SaveDelegations(ctx context.Context, delegations ...*models.Delegation) error {
+	if len(delegations) == 0 {
+		return nil
+	}
+
+	delegations = sortedByKey(delegations, func(a, b *models.Delegation) int {
+		return cmp.Compare(a.Id, b.Id)
+	})
+
+	_, err := tx.Tx().NewInsert().Model(&delegations).
+		On("CONFLICT (id) DO UPDATE").
+		Set("delegate_id = CASE WHEN EXCLUDED.last_height >= delegation.last_height THEN EXCLUDED.delegate_id ELSE delegation.delegate_id END").
+		Set("tx_id = CASE WHEN EXCLUDED.last_height >= delegation.last_height THEN EXCLUDED.tx_id ELSE delegation.tx_id END").
+		Set("height = LEAST(EXCLUDED.height, delegation.height)").
+		Set("last_height = GREATEST(EXCLUDED.last_height, delegation.last_height)").
+		Exec(ctx)
+	return err
+}
+
+func (tx Transaction) SaveBlobs(ctx context.Context, blobs ...*models.Blob) error {
+	if len(blobs) == 0 {
+		return nil
+	}
+
+	_, err := tx.Tx().NewInsert().Model(&blobs).Returning("id").Exec(ctx)
+	return err
+}
+
 func (tx Transaction) AddVerificationTask(ctx context.Context, task *models.VerificationTask) error {
 	_, err := tx.Tx().NewInsert().Model(task).
-		Column("status", "creation_time", "contract_id", "contract_name", "compiler_version", "license_type", "optimization_enabled", "optimization_runs", "evm_version", "via_ir").
+		Column("status", "creation_time", "contract_id", "contract_name", "compiler_version", "license_type", "optimization_enabled", "optimization_runs", "evm_version", "via_ir", "standard_json", "language", "optimization_mode", "libraries").
 		Returning("id").
 		Exec(ctx)
 
@@ -329,12 +726,49 @@ func (tx Transaction) UpdateVerificationTask(ctx context.Context, task *models.V
 		Set("status = ?", task.Status).
 		Set("completion_time = now()").
 		Set("error = ?", task.Error).
+		Set("compiler_output = ?", task.CompilerOutput).
 		Where("id = ?", task.Id).
 		Exec(ctx)
 
 	return err
 }
 
+// ClaimVerificationTasks - marks the oldest new tasks as pending, increments their attempts and returns them. Tasks which
+// are pending since staleBefore are claimed again, since their worker was likely stopped. Tasks locked by other workers are skipped.
+func (tx Transaction) ClaimVerificationTasks(ctx context.Context, limit int, staleBefore time.Time) (tasks []models.VerificationTask, err error) {
+	claimable := tx.Tx().NewSelect().
+		Model((*models.VerificationTask)(nil)).
+		Column("id").
+		WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.
+				Where("status = ?", storageTypes.VerificationStatusNew).
+				WhereOr("status = ? AND (start_time IS NULL OR start_time < ?)", storageTypes.VerificationStatusPending, staleBefore)
+		}).
+		Order("creation_time ASC").
+		Limit(limit).
+		For("UPDATE SKIP LOCKED")
+
+	_, err = tx.Tx().NewUpdate().
+		Model((*models.VerificationTask)(nil)).
+		Set("status = ?", storageTypes.VerificationStatusPending).
+		Set("start_time = now()").
+		Set("attempts = attempts + 1").
+		Where("id IN (?)", claimable).
+		Returning("*").
+		Exec(ctx, &tasks)
+	return
+}
+
+// IncrementVerifiedContracts - increments the verified contracts counter of the indexer state
+func (tx Transaction) IncrementVerifiedContracts(ctx context.Context, indexerName string, count int) error {
+	_, err := tx.Tx().NewUpdate().
+		Model((*models.State)(nil)).
+		Set("total_verified_contracts = total_verified_contracts + ?", count).
+		Where("name = ?", indexerName).
+		Exec(ctx)
+	return err
+}
+
 func (tx Transaction) RollbackBlock(ctx context.Context, height types.Level) error {
 	_, err := tx.Tx().NewDelete().
 		Model((*models.Block)(nil)).
@@ -396,6 +830,14 @@ func (tx Transaction) RollbackTransfers(ctx context.Context, height types.Level)
 	return
 }
 
+func (tx Transaction) RollbackTokenBalanceHistory(ctx context.Context, height types.Level) (err error) {
+	_, err = tx.Tx().NewDelete().
+		Model((*models.TokenBalanceHistory)(nil)).
+		Where("height = ?", height).
+		Exec(ctx)
+	return
+}
+
 func (tx Transaction) RollbackTokens(ctx context.Context, height types.Level) (tokens []models.Token, err error) {
 	_, err = tx.Tx().NewDelete().
 		Model(&tokens).
@@ -429,6 +871,294 @@ func (tx Transaction) RollbackBeaconWithdrawals(ctx context.Context, height type
 	return
 }
 
+func (tx Transaction) RollbackBalanceHistory(ctx context.Context, height types.Level) (err error) {
+	_, err = tx.Tx().NewDelete().
+		Model((*models.BalanceHistory)(nil)).
+		Where("height = ?", height).
+		Exec(ctx)
+	return
+}
+
+func (tx Transaction) RollbackApprovals(ctx context.Context, height types.Level) (err error) {
+	_, err = tx.Tx().NewDelete().
+		Model((*models.Approval)(nil)).
+		Where("height = ?", height).
+		Exec(ctx)
+	return
+}
+
+func (tx Transaction) RollbackTxAccessLists(ctx context.Context, height types.Level) (err error) {
+	_, err = tx.Tx().NewDelete().
+		Model((*models.TxAccessList)(nil)).
+		Where("height = ?", height).
+		Exec(ctx)
+	return
+}
+
+func (tx Transaction) RollbackTxAuthorizations(ctx context.Context, height types.Level) (err error) {
+	_, err = tx.Tx().NewDelete().
+		Model((*models.TxAuthorization)(nil)).
+		Where("height = ?", height).
+		Exec(ctx)
+	return
+}
+
+func (tx Transaction) RollbackBlobs(ctx context.Context, height types.Level) (err error) {
+	_, err = tx.Tx().NewDelete().
+		Model((*models.Blob)(nil)).
+		Where("height = ?", height).
+		Exec(ctx)
+	return
+}
+
+// RollbackProxyUpgrades - removes upgrade events of the height and restores implementations of the upgraded proxies from the events below the height
+func (tx Transaction) RollbackProxyUpgrades(ctx context.Context, height types.Level) error {
+	var deleted []models.ProxyUpgrade
+	if _, err := tx.Tx().NewDelete().
+		Model(&deleted).
+		Where("height = ?", height).
+		Returning("proxy_id, type").
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	ids := make([]uint64, 0, len(deleted))
+	for i := range deleted {
+		if deleted[i].Type != storageTypes.AdminChanged {
+			ids = append(ids, deleted[i].ProxyId)
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	var restored []models.ProxyContract
+	if err := tx.Tx().NewSelect().
+		Model((*models.ProxyUpgrade)(nil)).
+		DistinctOn("proxy_id").
+		ColumnExpr("proxy_id AS id").
+		Column("height", "implementation_id", "beacon_id").
+		ColumnExpr("CASE WHEN implementation_id IS NULL THEN ? ELSE ? END AS status", storageTypes.New, storageTypes.Resolved).
+		Where("height < ?", height).
+		Where("type != ?", storageTypes.AdminChanged).
+		Where("proxy_id IN (?)", bun.In(ids)).
+		OrderExpr("proxy_id, time DESC, id DESC").
+		Scan(ctx, &restored); err != nil {
+		return err
+	}
+
+	if len(restored) > 0 {
+		if _, err := tx.Tx().NewUpdate().
+			Model(&restored).
+			Column("height", "implementation_id", "beacon_id", "status").
+			Bulk().
+			Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	// proxies without upgrades below the height become unresolved and are resolved again from the deployment height
+	restoredIds := make(map[uint64]struct{}, len(restored))
+	for i := range restored {
+		restoredIds[restored[i].Id] = struct{}{}
+	}
+	reset := make([]uint64, 0, len(ids))
+	for _, id := range ids {
+		if _, ok := restoredIds[id]; !ok {
+			reset = append(reset, id)
+		}
+	}
+	if len(reset) > 0 {
+		if _, err := tx.Tx().NewUpdate().
+			Model((*models.ProxyContract)(nil)).
+			Set("implementation_id = NULL").
+			Set("beacon_id = NULL").
+			Set("status = ?", storageTypes.New).
+			Set("resolving_attempts = 0").
+			Set("height = COALESCE((SELECT contract.height FROM contract WHERE contract.id = proxy_contract.id), proxy_contract.height)").
+			Where("id IN (?)", bun.In(reset)).
+			Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	// implementations of the rolled back beacons were propagated to their proxies by UpdateBeaconProxies
+	_, err := tx.Tx().NewUpdate().
+		TableExpr("proxy_contract AS proxy").
+		TableExpr("proxy_contract AS beacon").
+		Set("implementation_id = beacon.implementation_id").
+		Set("status = CASE WHEN beacon.implementation_id IS NULL THEN ?::proxy_status ELSE ?::proxy_status END", storageTypes.New, storageTypes.Resolved).
+		Where("proxy.beacon_id = beacon.id").
+		WhereGroup(" AND ", func(q *bun.UpdateQuery) *bun.UpdateQuery {
+			return q.Where("beacon.id IN (?)", bun.In(ids)).WhereOr("proxy.id IN (?)", bun.In(ids))
+		}).
+		Where("proxy.implementation_id IS DISTINCT FROM beacon.implementation_id").
+		Exec(ctx)
+	return err
+}
+
+// RollbackDiamondCuts - removes diamond cuts at the height and restores facets of the affected selectors from the cuts below the height
+func (tx Transaction) RollbackDiamondCuts(ctx context.Context, height types.Level) error {
+	var deleted []models.DiamondCut
+	if _, err := tx.Tx().NewDelete().
+		Model(&deleted).
+		Where("height = ?", height).
+		Returning("diamond_id, selector").
+		Exec(ctx); err != nil {
+		return err
+	}
+	if len(deleted) == 0 {
+		return nil
+	}
+
+	keys := make(map[string]struct{}, len(deleted))
+	facets := make([]*models.DiamondFacet, 0, len(deleted))
+	diamondIds := make([]uint64, 0, len(deleted))
+	selectors := make([]types.Hex, 0, len(deleted))
+	for i := range deleted {
+		key := diamondFacetKey(deleted[i].DiamondId, deleted[i].Selector)
+		if _, ok := keys[key]; ok {
+			continue
+		}
+		keys[key] = struct{}{}
+		facets = append(facets, &models.DiamondFacet{
+			DiamondId: deleted[i].DiamondId,
+			Selector:  deleted[i].Selector,
+			Height:    height,
+		})
+		diamondIds = append(diamondIds, deleted[i].DiamondId)
+		selectors = append(selectors, deleted[i].Selector)
+	}
+
+	if err := tx.DeleteDiamondFacets(ctx, facets...); err != nil {
+		return err
+	}
+
+	var previous []models.DiamondCut
+	if err := tx.Tx().NewSelect().
+		Model(&previous).
+		DistinctOn("diamond_id, selector").
+		Column("diamond_id", "selector", "facet_id", "action", "height").
+		Where("height < ?", height).
+		Where("diamond_id IN (?)", bun.In(diamondIds)).
+		Where("selector IN (?)", bun.In(selectors)).
+		OrderExpr("diamond_id, selector, time DESC, id DESC").
+		Scan(ctx); err != nil {
+		return err
+	}
+
+	restored := make([]*models.DiamondFacet, 0, len(previous))
+	for i := range previous {
+		if _, ok := keys[diamondFacetKey(previous[i].DiamondId, previous[i].Selector)]; !ok {
+			continue
+		}
+		if previous[i].Action == storageTypes.Remove || previous[i].FacetId == nil {
+			continue
+		}
+		restored = append(restored, &models.DiamondFacet{
+			DiamondId: previous[i].DiamondId,
+			Selector:  previous[i].Selector,
+			FacetId:   *previous[i].FacetId,
+			Height:    previous[i].Height,
+		})
+	}
+
+	return tx.SaveDiamondFacets(ctx, restored...)
+}
+
+func diamondFacetKey(diamondId uint64, selector types.Hex) string {
+	return fmt.Sprintf("%d:%s", diamondId, selector.String())
+}
+
+// RollbackDelegations - removes delegations changed at the height and restores their previous state from the valid authorizations below the height
+func (tx Transaction) RollbackDelegations(ctx context.Context, height types.Level) error {
+	var deleted []models.Delegation
+	if _, err := tx.Tx().NewDelete().
+		Model(&deleted).
+		Where("last_height = ?", height).
+		Returning("*").
+		Exec(ctx); err != nil {
+		return err
+	}
+	if len(deleted) == 0 {
+		return nil
+	}
+
+	ids := make([]uint64, len(deleted))
+	for i := range deleted {
+		ids[i] = deleted[i].Id
+	}
+
+	var restored []models.Delegation
+	if err := tx.Tx().NewSelect().
+		Model((*models.TxAuthorization)(nil)).
+		DistinctOn("authority_id").
+		ColumnExpr("authority_id AS id").
+		ColumnExpr("min(height) OVER (PARTITION BY authority_id) AS height").
+		ColumnExpr("height AS last_height").
+		Column("delegate_id", "tx_id").
+		Where("height < ?", height).
+		Where("valid = true").
+		Where("authority_id IN (?)", bun.In(ids)).
+		OrderExpr("authority_id, time DESC, id DESC").
+		Scan(ctx, &restored); err != nil {
+		return err
+	}
+	if len(restored) == 0 {
+		return nil
+	}
+
+	_, err := tx.Tx().NewInsert().Model(&restored).Exec(ctx)
+	return err
+}
+
+// RollbackAllowances - removes allowances changed at the height and restores their previous state from the approval events below the height
+func (tx Transaction) RollbackAllowances(ctx context.Context, height types.Level) error {
+	var deleted []models.Allowance
+	if _, err := tx.Tx().NewDelete().
+		Model(&deleted).
+		Where("last_height = ?", height).
+		Returning("*").
+		Exec(ctx); err != nil {
+		return err
+	}
+	if len(deleted) == 0 {
+		return nil
+	}
+
+	var restored []models.Allowance
+	if err := tx.Tx().NewSelect().
+		Model((*models.Approval)(nil)).
+		DistinctOn("type, contract_id, owner_id, spender_id, token_id").
+		Column("type", "contract_id", "owner_id", "spender_id", "token_id", "amount", "approved").
+		ColumnExpr("min(height) OVER (PARTITION BY type, contract_id, owner_id, spender_id, token_id) AS height").
+		ColumnExpr("height AS last_height").
+		Where("height < ?", height).
+		WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			for i := range deleted {
+				q = q.WhereOr(
+					"(type = ? AND contract_id = ? AND owner_id = ? AND spender_id = ? AND token_id = ?::numeric)",
+					deleted[i].Type,
+					deleted[i].ContractId,
+					deleted[i].OwnerId,
+					deleted[i].SpenderId,
+					deleted[i].TokenID,
+				)
+			}
+			return q
+		}).
+		OrderExpr("type, contract_id, owner_id, spender_id, token_id, time DESC, id DESC").
+		Scan(ctx, &restored); err != nil {
+		return err
+	}
+	if len(restored) == 0 {
+		return nil
+	}
+
+	_, err := tx.Tx().NewInsert().Model(&restored).Exec(ctx)
+	return err
+}
+
 func (tx Transaction) DeleteBalances(ctx context.Context, ids []uint64) error {
 	if len(ids) == 0 {
 		return nil
@@ -484,6 +1214,71 @@ func (tx Transaction) DeleteVerificationFiles(ctx context.Context, taskId uint64
 	return err
 }
 
+// RecalculateBalanceHistory - sets balances of the history in the height range to the sum of deltas up to the block.
+// Concurrently indexed ranges save balances changed by blocks of other ranges, so the history is fixed when ranges are merged.
+func (tx Transaction) RecalculateBalanceHistory(ctx context.Context, from, to types.Level) error {
+	_, err := tx.Tx().NewRaw(`UPDATE balance_history AS bh SET value = sums.value
+		FROM (
+			SELECT id, time, SUM(delta) OVER (PARTITION BY address_id ORDER BY height, id) AS value
+			FROM balance_history
+			WHERE height <= ? AND address_id IN (SELECT address_id FROM balance_history WHERE height BETWEEN ? AND ?)
+		) AS sums
+		WHERE bh.id = sums.id AND bh.time = sums.time AND bh.height BETWEEN ? AND ?`, to, from, to, from, to).
+		Exec(ctx)
+	return err
+}
+
+// RecalculateTokenBalanceHistory - the same as RecalculateBalanceHistory for token balances
+func (tx Transaction) RecalculateTokenBalanceHistory(ctx context.Context, from, to types.Level) error {
+	_, err := tx.Tx().NewRaw(`UPDATE token_balance_history AS tbh SET balance = sums.balance
+		FROM (
+			SELECT id, time, SUM(delta) OVER (PARTITION BY address_id, contract_id, token_id ORDER BY height, id) AS balance
+			FROM token_balance_history
+			WHERE height <= ? AND (address_id, contract_id, token_id) IN (
+				SELECT address_id, contract_id, token_id FROM token_balance_history WHERE height BETWEEN ? AND ?
+			)
+		) AS sums
+		WHERE tbh.id = sums.id AND tbh.time = sums.time AND tbh.height BETWEEN ? AND ?`, to, from, to, from, to).
+		Exec(ctx)
+	return err
+}
+
+// UpdateBlockTime - sets the time between the block and the previous one in block stats. The first block of the range
+// indexer doesn't know the time of the previous block.
+func (tx Transaction) UpdateBlockTime(ctx context.Context, height types.Level) error {
+	if height == 0 {
+		return nil
+	}
+	_, err := tx.Tx().NewRaw(`UPDATE block_stats AS bs
+		SET block_time = (EXTRACT(EPOCH FROM bs.time - prev.time) * 1000)::bigint
+		FROM block_stats AS prev
+		WHERE bs.height = ? AND prev.height = ?`, height, height-1).
+		Exec(ctx)
+	return err
+}
+
+// UpdateBlockFinality sets the finality of blocks in the height range (from, to]
+func (tx Transaction) UpdateBlockFinality(ctx context.Context, finality storageTypes.Finality, from, to types.Level) error {
+	if from >= to {
+		return nil
+	}
+	_, err := tx.Tx().NewUpdate().
+		Model((*models.Block)(nil)).
+		Set("finality = ?", finality).
+		Where("height > ?", from).
+		Where("height <= ?", to).
+		Exec(ctx)
+	return err
+}
+
+func (tx Transaction) DeleteState(ctx context.Context, name string) error {
+	_, err := tx.Tx().NewDelete().
+		Model((*models.State)(nil)).
+		Where("name = ?", name).
+		Exec(ctx)
+	return err
+}
+
 func (tx Transaction) State(ctx context.Context, name string) (state models.State, err error) {
 	err = tx.Tx().NewSelect().
 		Model(&state).
@@ -500,3 +1295,18 @@ func (tx Transaction) LastBlock(ctx context.Context) (block models.Block, err er
 		Scan(ctx)
 	return
 }
+
+// LastBlockInRange returns the highest block between start and end heights. The range is not bounded above if end is 0.
+func (tx Transaction) LastBlockInRange(ctx context.Context, start, end types.Level) (block models.Block, err error) {
+	query := tx.Tx().NewSelect().
+		Model(&block).
+		Where("height >= ?", start)
+	if end > 0 {
+		query = query.Where("height <= ?", end)
+	}
+	err = query.
+		Order("height DESC").
+		Limit(1).
+		Scan(ctx)
+	return
+}
@@ -175,8 +175,19 @@ func (s *TransactionTestSuite) TestSaveBalances() {
 		},
 	}
 
-	err = tx.SaveBalances(ctx, balances...)
-	s.Require().NoError(err)
+	updated, err := tx.SaveBalances(ctx, balances...)
+	s.Require().NoError(err)
+	s.Require().Len(updated, 2)
+	for i := range updated {
+		switch updated[i].Id {
+		case 1:
+			s.Require().Equal(decimal.RequireFromString("1500000"), updated[i].Value)
+		case 2:
+			s.Require().Equal(decimal.RequireFromString("1000000"), updated[i].Value)
+		default:
+			s.T().Errorf("unexpected balance id: %d", updated[i].Id)
+		}
+	}
 
 	s.Require().NoError(tx.Flush(ctx))
 	s.Require().NoError(tx.Close(ctx))
@@ -257,6 +268,39 @@ func (s *TransactionTestSuite) TestSaveContractsUpdateMetadata() {
 	s.Require().Equal("Solidity", contract.Language)
 }
 
+func (s *TransactionTestSuite) TestSaveContractsConstructorArgs() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	args := pkgTypes.MustDecodeHex("0x00000000000000000000000000000000000000000000000000000000000003e8")
+	_, err = tx.SaveContracts(ctx, &storage.Contract{
+		Id:              4,
+		Height:          200,
+		Verified:        true,
+		ConstructorArgs: args,
+		MatchType:       types.Full,
+	})
+	s.Require().NoError(err)
+
+	// empty values should not overwrite verification results
+	_, err = tx.SaveContracts(ctx, &storage.Contract{
+		Id:     4,
+		Height: 200,
+	})
+	s.Require().NoError(err)
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	contract, err := s.storage.Contracts.GetByID(ctx, 4)
+	s.Require().NoError(err)
+	s.Require().Equal(pkgTypes.Hex(args), contract.ConstructorArgs)
+	s.Require().Equal(types.Full, contract.MatchType)
+}
+
 func (s *TransactionTestSuite) TestSaveContractsNoOverwriteMetadata() {
 	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer ctxCancel()
@@ -592,6 +636,160 @@ func (s *TransactionTestSuite) TestRollbackTraces() {
 	s.Require().Empty(tracesAfter)
 }
 
+func (s *TransactionTestSuite) TestSaveLogsCopy() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer ctxCancel()
+
+	blockTime := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	const count = 500
+	logs := make([]*storage.Log, count)
+	for i := range logs {
+		logs[i] = &storage.Log{
+			Height:    1000,
+			Time:      blockTime,
+			Index:     int64(i),
+			TxId:      1,
+			AddressId: 1,
+			Data:      pkgTypes.Hex{0x01, 0x02},
+			Topics:    []pkgTypes.Hex{{0xaa}, {0xbb}},
+			Topic0:    pkgTypes.Hex{0xaa},
+			Topic1:    pkgTypes.Hex{0xbb},
+		}
+	}
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	s.Require().NoError(tx.SaveLogs(ctx, logs...))
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	height := uint64(1000)
+	saved, err := s.storage.Logs.Filter(ctx, storage.LogListFilter{Height: &height, Limit: count})
+	s.Require().NoError(err)
+	s.Require().Len(saved, count)
+
+	for i := range saved {
+		s.Require().NotZero(saved[i].Id)
+		s.Require().Equal(pkgTypes.Hex{0x01, 0x02}, saved[i].Data)
+		s.Require().Equal([]pkgTypes.Hex{{0xaa}, {0xbb}}, saved[i].Topics)
+		s.Require().Equal(pkgTypes.Hex{0xbb}, saved[i].Topic1)
+		s.Require().Empty(saved[i].Topic2)
+		s.Require().Empty(saved[i].EventName)
+		s.Require().False(saved[i].Decoded)
+	}
+}
+
+func (s *TransactionTestSuite) TestSaveTracesCopy() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer ctxCancel()
+
+	blockTime := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	amount := decimal.NewFromInt(100)
+	const count = 500
+	traces := make([]*storage.Trace, count)
+	for i := range traces {
+		traces[i] = &storage.Trace{
+			Height:       1000,
+			Time:         blockTime,
+			TxId:         uint64Ptr(1),
+			From:         uint64Ptr(1),
+			To:           uint64Ptr(2),
+			GasLimit:     decimal.NewFromInt(21000),
+			Amount:       &amount,
+			Input:        []byte{0x01},
+			TxPosition:   uint64Ptr(0),
+			TraceAddress: []uint64{0, uint64(i)},
+			Type:         types.Call,
+			GasUsed:      decimal.NewFromInt(21000),
+		}
+	}
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	s.Require().NoError(tx.SaveTraces(ctx, traces...))
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	height := uint64(1000)
+	saved, err := s.storage.Trace.Filter(ctx, storage.TraceListFilter{Height: &height, Limit: count})
+	s.Require().NoError(err)
+	s.Require().Len(saved, count)
+
+	for i := range saved {
+		s.Require().Len(saved[i].TraceAddress, 2)
+		s.Require().Equal(types.Call, saved[i].Type)
+		s.Require().Nil(saved[i].CallType)
+		s.Require().Nil(saved[i].InitHash)
+		s.Require().Nil(saved[i].Output)
+		s.Require().Equal("100", saved[i].Amount.String())
+	}
+}
+
+// TestSaveLogsAndTracesOfBlocksBatch tests that logs and traces of several small blocks are copied at once
+func (s *TransactionTestSuite) TestSaveLogsAndTracesOfBlocksBatch() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer ctxCancel()
+
+	const (
+		blocksCount    = 3
+		logsPerBlock   = 10
+		tracesPerBlock = 5
+	)
+
+	blockTime := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	logs := make([]*storage.Log, 0, blocksCount*logsPerBlock)
+	traces := make([]*storage.Trace, 0, blocksCount*tracesPerBlock)
+	for b := range blocksCount {
+		height := pkgTypes.Level(2000 + b)
+		for i := range logsPerBlock {
+			logs = append(logs, &storage.Log{
+				Height:    height,
+				Time:      blockTime.Add(time.Duration(b) * time.Second),
+				Index:     int64(i),
+				TxId:      1,
+				AddressId: 1,
+				Topics:    []pkgTypes.Hex{{0xaa}},
+				Topic0:    pkgTypes.Hex{0xaa},
+			})
+		}
+		for i := range tracesPerBlock {
+			traces = append(traces, &storage.Trace{
+				Height:       height,
+				Time:         blockTime.Add(time.Duration(b) * time.Second),
+				TxId:         uint64Ptr(1),
+				From:         uint64Ptr(1),
+				To:           uint64Ptr(2),
+				GasLimit:     decimal.NewFromInt(21000),
+				TxPosition:   uint64Ptr(0),
+				TraceAddress: []uint64{uint64(i)},
+				Type:         types.Call,
+				GasUsed:      decimal.NewFromInt(21000),
+			})
+		}
+	}
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	s.Require().NoError(tx.SaveLogs(ctx, logs...))
+	s.Require().NoError(tx.SaveTraces(ctx, traces...))
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	saved, err := s.storage.Logs.ByRange(ctx, storage.LogRangeFilter{HeightFrom: 2000, HeightTo: 2000 + blocksCount - 1})
+	s.Require().NoError(err)
+	s.Require().Len(saved, blocksCount*logsPerBlock)
+
+	for b := range blocksCount {
+		height := uint64(2000 + b)
+		savedTraces, err := s.storage.Trace.Filter(ctx, storage.TraceListFilter{Height: &height, Limit: 100})
+		s.Require().NoError(err)
+		s.Require().Len(savedTraces, tracesPerBlock)
+	}
+}
+
 func (s *TransactionTestSuite) TestRollbackTransfers() {
 	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer ctxCancel()
@@ -693,308 +891,1437 @@ func (s *TransactionTestSuite) TestRollbackBeaconWithdrawals() {
 	s.Require().Empty(beaconWithdrawalsAfter)
 }
 
-func (s *TransactionTestSuite) TestDeleteBalances() {
+func (s *TransactionTestSuite) TestSaveBalanceHistory() {
 	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer ctxCancel()
 
-	// Verify balances exist before delete
-	var balance1Before storage.Balance
-	err := s.storage.Connection().DB().NewSelect().Model(&balance1Before).Where("id = ?", 1).Scan(ctx)
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
 	s.Require().NoError(err)
 
-	var balance3Before storage.Balance
-	err = s.storage.Connection().DB().NewSelect().Model(&balance3Before).Where("id = ?", 3).Scan(ctx)
+	err = tx.SaveBalanceHistory(ctx, &storage.BalanceHistory{
+		Height:    500,
+		Time:      time.Date(2024, 1, 5, 10, 0, 0, 0, time.UTC),
+		AddressId: 3,
+		Value:     decimal.RequireFromString("5000100"),
+		Delta:     decimal.RequireFromString("100"),
+	})
+	s.Require().NoError(err)
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	history, err := s.storage.BalanceHistory.AtHeight(ctx, 3, 500)
 	s.Require().NoError(err)
+	s.Require().EqualValues(500, history.Height)
+	s.Require().True(history.Value.Equal(decimal.RequireFromString("5000100")))
+	s.Require().True(history.Delta.Equal(decimal.RequireFromString("100")))
+}
+
+func (s *TransactionTestSuite) TestRollbackBalanceHistory() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
 
 	tx, err := BeginTransaction(ctx, s.storage.Transactable)
 	s.Require().NoError(err)
 
-	err = tx.DeleteBalances(ctx, []uint64{1, 3})
+	err = tx.RollbackBalanceHistory(ctx, 100)
 	s.Require().NoError(err)
 
 	s.Require().NoError(tx.Flush(ctx))
 	s.Require().NoError(tx.Close(ctx))
 
-	// Verify balances were deleted
-	var balance1After storage.Balance
-	err = s.storage.Connection().DB().NewSelect().Model(&balance1After).Where("id = ?", 1).Scan(ctx)
-	s.Require().Error(err)
+	var historyAfter []storage.BalanceHistory
+	err = s.storage.Connection().DB().NewSelect().Model(&historyAfter).Where("height = ?", 100).Scan(ctx)
+	s.Require().NoError(err)
+	s.Require().Empty(historyAfter)
 
-	var balance3After storage.Balance
-	err = s.storage.Connection().DB().NewSelect().Model(&balance3After).Where("id = ?", 3).Scan(ctx)
-	s.Require().Error(err)
+	count, err := s.storage.Connection().DB().NewSelect().Model((*storage.BalanceHistory)(nil)).Count(ctx)
+	s.Require().NoError(err)
+	s.Require().EqualValues(3, count)
 }
 
-func (s *TransactionTestSuite) TestDeleteBalancesEmpty() {
+func (s *TransactionTestSuite) TestSaveTokenBalanceHistory() {
 	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer ctxCancel()
 
 	tx, err := BeginTransaction(ctx, s.storage.Transactable)
 	s.Require().NoError(err)
 
-	err = tx.DeleteBalances(ctx, []uint64{})
+	err = tx.SaveTokenBalanceHistory(ctx, &storage.TokenBalanceHistory{
+		Height:     500,
+		Time:       time.Date(2024, 1, 5, 10, 0, 0, 0, time.UTC),
+		TokenID:    decimal.Zero,
+		ContractID: 4,
+		AddressID:  3,
+		Balance:    decimal.RequireFromString("200000100"),
+		Delta:      decimal.RequireFromString("100"),
+	})
 	s.Require().NoError(err)
 
 	s.Require().NoError(tx.Flush(ctx))
 	s.Require().NoError(tx.Close(ctx))
+
+	history, err := s.storage.TokenBalanceHistory.AtHeight(ctx, 3, 4, decimal.Zero, 500)
+	s.Require().NoError(err)
+	s.Require().EqualValues(500, history.Height)
+	s.Require().True(history.Balance.Equal(decimal.RequireFromString("200000100")))
+	s.Require().True(history.Delta.Equal(decimal.RequireFromString("100")))
 }
 
-func (s *TransactionTestSuite) TestDeleteTokenBalances() {
+func (s *TransactionTestSuite) TestRollbackTokenBalanceHistory() {
 	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer ctxCancel()
 
-	// Verify token balances exist before delete (token_id=0, contract_id=3)
-	tokenId := decimal.NewFromInt(0)
-	contractId := uint64(3)
-	balancesBefore, err := s.storage.TokenBalance.Filter(ctx, storage.TokenBalanceListFilter{
-		TokenId:    &tokenId,
-		ContractId: &contractId,
-		Limit:      100,
-	})
-	s.Require().NoError(err)
-	s.Require().NotEmpty(balancesBefore)
-
 	tx, err := BeginTransaction(ctx, s.storage.Transactable)
 	s.Require().NoError(err)
 
-	err = tx.DeleteTokenBalances(ctx, []string{"0"}, []uint64{3}, nil)
+	err = tx.RollbackTokenBalanceHistory(ctx, 200)
 	s.Require().NoError(err)
 
 	s.Require().NoError(tx.Flush(ctx))
 	s.Require().NoError(tx.Close(ctx))
 
-	// Verify token balances were deleted
-	balancesAfter, err := s.storage.TokenBalance.Filter(ctx, storage.TokenBalanceListFilter{
-		TokenId:    &tokenId,
-		ContractId: &contractId,
-		Limit:      100,
-	})
+	var historyAfter []storage.TokenBalanceHistory
+	err = s.storage.Connection().DB().NewSelect().Model(&historyAfter).Where("height = ?", 200).Scan(ctx)
 	s.Require().NoError(err)
-	s.Require().Empty(balancesAfter)
+	s.Require().Empty(historyAfter)
+
+	count, err := s.storage.Connection().DB().NewSelect().Model((*storage.TokenBalanceHistory)(nil)).Count(ctx)
+	s.Require().NoError(err)
+	s.Require().EqualValues(3, count)
 }
 
-func (s *TransactionTestSuite) TestDeleteTokenBalancesWithZeroBalances() {
+func (s *TransactionTestSuite) TestSaveApprovals() {
 	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer ctxCancel()
 
-	// Verify token balance exists before delete (token_id=1, contract_id=3, address_id=1)
-	tokenId := decimal.NewFromInt(1)
-	contractId := uint64(3)
-	addressId := uint64(1)
-	balancesBefore, err := s.storage.TokenBalance.Filter(ctx, storage.TokenBalanceListFilter{
-		TokenId:    &tokenId,
-		ContractId: &contractId,
-		AddressId:  &addressId,
-		Limit:      100,
-	})
-	s.Require().NoError(err)
-	s.Require().NotEmpty(balancesBefore)
-
 	tx, err := BeginTransaction(ctx, s.storage.Transactable)
 	s.Require().NoError(err)
 
-	zeroBalances := []*storage.TokenBalance{
-		{
-			TokenID:    decimal.NewFromInt(1),
-			ContractID: 3,
-			AddressID:  1,
-		},
+	approval := &storage.Approval{
+		Height:     500,
+		Time:       time.Date(2024, 1, 5, 10, 0, 0, 0, time.UTC),
+		Type:       types.Allowance,
+		ContractId: 3,
+		OwnerId:    3,
+		SpenderId:  1,
+		TokenID:    decimal.Zero,
+		Amount:     decimal.NewFromInt(100),
+		Approved:   true,
+		TxID:       1,
 	}
-
-	err = tx.DeleteTokenBalances(ctx, []string{}, []uint64{}, zeroBalances)
+	err = tx.SaveApprovals(ctx, approval)
 	s.Require().NoError(err)
+	s.Require().NotZero(approval.Id)
 
 	s.Require().NoError(tx.Flush(ctx))
 	s.Require().NoError(tx.Close(ctx))
 
-	// Verify token balance was deleted
-	balancesAfter, err := s.storage.TokenBalance.Filter(ctx, storage.TokenBalanceListFilter{
-		TokenId:    &tokenId,
-		ContractId: &contractId,
-		AddressId:  &addressId,
-		Limit:      100,
-	})
+	count, err := s.storage.Connection().DB().NewSelect().Model((*storage.Approval)(nil)).Count(ctx)
 	s.Require().NoError(err)
-	s.Require().Empty(balancesAfter)
+	s.Require().EqualValues(6, count)
 }
 
-func (s *TransactionTestSuite) TestDeleteTokenBalancesMismatchedLengths() {
+func (s *TransactionTestSuite) TestSaveAllowances() {
 	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer ctxCancel()
 
 	tx, err := BeginTransaction(ctx, s.storage.Transactable)
 	s.Require().NoError(err)
 
-	err = tx.DeleteTokenBalances(ctx, []string{"0", "1"}, []uint64{3}, nil)
-	s.Require().Error(err)
-	s.Require().Contains(err.Error(), "tokenIds and contractIds must have same length")
+	err = tx.SaveAllowances(ctx,
+		&storage.Allowance{
+			Height:     500,
+			LastHeight: 500,
+			Type:       types.Allowance,
+			ContractId: 3,
+			OwnerId:    1,
+			SpenderId:  2,
+			TokenID:    decimal.Zero,
+			Amount:     decimal.NewFromInt(0),
+			Approved:   false,
+		},
+		&storage.Allowance{
+			Height:     500,
+			LastHeight: 500,
+			Type:       types.TokenApproval,
+			ContractId: 4,
+			OwnerId:    1,
+			SpenderId:  6,
+			TokenID:    decimal.NewFromInt(7),
+			Amount:     decimal.Zero,
+			Approved:   true,
+		},
+	)
+	s.Require().NoError(err)
 
+	s.Require().NoError(tx.Flush(ctx))
 	s.Require().NoError(tx.Close(ctx))
-}
 
-func (s *TransactionTestSuite) TestState() {
-	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
-	defer ctxCancel()
-
-	// First create a state
-	_, err := s.storage.Connection().DB().ExecContext(ctx, `
-		INSERT INTO state (name, last_height, last_hash, last_time, total_tx, total_accounts, chain_id)
-		VALUES ('indexer', 1000, '0x123', NOW(), 100, 50, 1)
-		ON CONFLICT (name) DO UPDATE SET last_height = 1000
-	`)
+	var updated storage.Allowance
+	err = s.storage.Connection().DB().NewSelect().Model(&updated).Where("id = ?", 1).Scan(ctx)
 	s.Require().NoError(err)
+	s.Require().EqualValues(100, updated.Height)
+	s.Require().EqualValues(500, updated.LastHeight)
+	s.Require().True(updated.Amount.IsZero())
+	s.Require().False(updated.Approved)
 
-	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	// previous approval of the same token is reset
+	var previous storage.Allowance
+	err = s.storage.Connection().DB().NewSelect().Model(&previous).Where("id = ?", 2).Scan(ctx)
 	s.Require().NoError(err)
+	s.Require().EqualValues(500, previous.LastHeight)
+	s.Require().False(previous.Approved)
 
-	state, err := tx.State(ctx, "indexer")
+	var created storage.Allowance
+	err = s.storage.Connection().DB().NewSelect().Model(&created).
+		Where("type = ?", types.TokenApproval).
+		Where("spender_id = ?", 6).
+		Scan(ctx)
 	s.Require().NoError(err)
-	s.Require().EqualValues(1000, state.LastHeight)
-
-	s.Require().NoError(tx.Close(ctx))
+	s.Require().EqualValues(500, created.Height)
+	s.Require().True(created.TokenID.Equal(decimal.NewFromInt(7)))
+	s.Require().True(created.Approved)
 }
 
-func (s *TransactionTestSuite) TestLastBlock() {
+func (s *TransactionTestSuite) TestRollbackApprovals() {
 	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer ctxCancel()
 
 	tx, err := BeginTransaction(ctx, s.storage.Transactable)
 	s.Require().NoError(err)
 
-	block, err := tx.LastBlock(ctx)
+	err = tx.RollbackApprovals(ctx, 200)
 	s.Require().NoError(err)
-	s.Require().Greater(block.Id, uint64(0))
 
+	s.Require().NoError(tx.Flush(ctx))
 	s.Require().NoError(tx.Close(ctx))
+
+	count, err := s.storage.Connection().DB().NewSelect().Model((*storage.Approval)(nil)).Count(ctx)
+	s.Require().NoError(err)
+	s.Require().EqualValues(3, count)
 }
 
-func (s *TransactionTestSuite) TestSaveTransactionsEmpty() {
+func (s *TransactionTestSuite) TestRollbackAllowances() {
 	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer ctxCancel()
 
 	tx, err := BeginTransaction(ctx, s.storage.Transactable)
 	s.Require().NoError(err)
 
-	err = tx.SaveTransactions(ctx)
+	err = tx.RollbackAllowances(ctx, 150)
 	s.Require().NoError(err)
 
+	s.Require().NoError(tx.Flush(ctx))
 	s.Require().NoError(tx.Close(ctx))
+
+	// allowance is restored from the previous approval event
+	var restored storage.Allowance
+	err = s.storage.Connection().DB().NewSelect().Model(&restored).
+		Where("type = ?", types.Allowance).
+		Where("contract_id = ?", 3).
+		Where("owner_id = ?", 1).
+		Where("spender_id = ?", 2).
+		Scan(ctx)
+	s.Require().NoError(err)
+	s.Require().EqualValues(100, restored.Height)
+	s.Require().EqualValues(100, restored.LastHeight)
+	s.Require().True(restored.Amount.Equal(decimal.NewFromInt(1000)))
+	s.Require().True(restored.Approved)
+
+	count, err := s.storage.Connection().DB().NewSelect().Model((*storage.Allowance)(nil)).Count(ctx)
+	s.Require().NoError(err)
+	s.Require().EqualValues(4, count)
 }
 
-func (s *TransactionTestSuite) TestSaveLogsEmpty() {
+func (s *TransactionTestSuite) TestRollbackAllowancesWithoutHistory() {
 	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer ctxCancel()
 
 	tx, err := BeginTransaction(ctx, s.storage.Transactable)
 	s.Require().NoError(err)
 
-	err = tx.SaveLogs(ctx)
+	err = tx.RollbackAllowances(ctx, 200)
 	s.Require().NoError(err)
 
+	s.Require().NoError(tx.Flush(ctx))
 	s.Require().NoError(tx.Close(ctx))
+
+	count, err := s.storage.Connection().DB().NewSelect().Model((*storage.Allowance)(nil)).Count(ctx)
+	s.Require().NoError(err)
+	s.Require().EqualValues(2, count)
 }
 
-func (s *TransactionTestSuite) TestSaveSourcesEmpty() {
+func (s *TransactionTestSuite) TestSaveTxAccessLists() {
 	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer ctxCancel()
 
 	tx, err := BeginTransaction(ctx, s.storage.Transactable)
 	s.Require().NoError(err)
 
-	err = tx.SaveSources(ctx)
+	accessList := &storage.TxAccessList{
+		Height:      300,
+		Time:        time.Date(2024, 1, 3, 10, 0, 0, 0, time.UTC),
+		TxId:        8,
+		Index:       0,
+		Address:     pkgTypes.MustDecodeHex("0x60f055506ba543ea0942dc8ca03f596ab75bc882"),
+		StorageKeys: []pkgTypes.Hex{pkgTypes.MustDecodeHex("0x0000000000000000000000000000000000000000000000000000000000000001")},
+	}
+	err = tx.SaveTxAccessLists(ctx, accessList)
 	s.Require().NoError(err)
+	s.Require().NotZero(accessList.Id)
 
+	s.Require().NoError(tx.Flush(ctx))
 	s.Require().NoError(tx.Close(ctx))
+
+	saved, err := s.storage.TxAccessList.ByTxId(ctx, 8)
+	s.Require().NoError(err)
+	s.Require().Len(saved, 1)
+	s.Require().Equal("0x60f055506ba543ea0942dc8ca03f596ab75bc882", saved[0].Address.Hex())
+	s.Require().Len(saved[0].StorageKeys, 1)
+	s.Require().Equal(accessList.StorageKeys[0], saved[0].StorageKeys[0])
 }
 
-func (s *TransactionTestSuite) TestSaveTracesEmpty() {
+func (s *TransactionTestSuite) TestSaveTxAuthorizations() {
 	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer ctxCancel()
 
 	tx, err := BeginTransaction(ctx, s.storage.Transactable)
 	s.Require().NoError(err)
 
-	err = tx.SaveTraces(ctx)
+	authorization := &storage.TxAuthorization{
+		Height:  300,
+		Time:    time.Date(2024, 1, 3, 10, 0, 0, 0, time.UTC),
+		TxId:    9,
+		Index:   0,
+		ChainId: decimal.NewFromInt(1),
+		Address: pkgTypes.MustDecodeHex("0x60f055506ba543ea0942dc8ca03f596ab75bc882"),
+		Nonce:   decimal.NewFromInt(3),
+		YParity: 1,
+		R:       pkgTypes.MustDecodeHex("0x1111111111111111111111111111111111111111111111111111111111111111"),
+		S:       pkgTypes.MustDecodeHex("0x2222222222222222222222222222222222222222222222222222222222222222"),
+	}
+	err = tx.SaveTxAuthorizations(ctx, authorization)
 	s.Require().NoError(err)
+	s.Require().NotZero(authorization.Id)
 
+	s.Require().NoError(tx.Flush(ctx))
 	s.Require().NoError(tx.Close(ctx))
+
+	saved, err := s.storage.TxAuthorization.ByTxId(ctx, 9)
+	s.Require().NoError(err)
+	s.Require().Len(saved, 1)
+	s.Require().Equal("0x60f055506ba543ea0942dc8ca03f596ab75bc882", saved[0].Address.Hex())
+	s.Require().Equal("3", saved[0].Nonce.String())
 }
 
-func (s *TransactionTestSuite) TestSaveTransfersEmpty() {
+func (s *TransactionTestSuite) TestRollbackTxAccessLists() {
 	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer ctxCancel()
 
 	tx, err := BeginTransaction(ctx, s.storage.Transactable)
 	s.Require().NoError(err)
 
-	err = tx.SaveTransfers(ctx)
+	err = tx.RollbackTxAccessLists(ctx, 100)
 	s.Require().NoError(err)
 
+	s.Require().NoError(tx.Flush(ctx))
 	s.Require().NoError(tx.Close(ctx))
+
+	count, err := s.storage.Connection().DB().NewSelect().Model((*storage.TxAccessList)(nil)).Count(ctx)
+	s.Require().NoError(err)
+	s.Require().EqualValues(1, count)
 }
 
-func (s *TransactionTestSuite) TestSaveAddressesEmpty() {
+func (s *TransactionTestSuite) TestRollbackTxAuthorizations() {
 	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer ctxCancel()
 
 	tx, err := BeginTransaction(ctx, s.storage.Transactable)
 	s.Require().NoError(err)
 
-	count, err := tx.SaveAddresses(ctx)
+	err = tx.RollbackTxAuthorizations(ctx, 200)
 	s.Require().NoError(err)
-	s.Require().EqualValues(0, count)
 
+	s.Require().NoError(tx.Flush(ctx))
 	s.Require().NoError(tx.Close(ctx))
+
+	count, err := s.storage.Connection().DB().NewSelect().Model((*storage.TxAuthorization)(nil)).Count(ctx)
+	s.Require().NoError(err)
+	s.Require().EqualValues(2, count)
+
+	count, err = s.storage.Connection().DB().NewSelect().Model((*storage.TxAuthorization)(nil)).Where("height = 200").Count(ctx)
+	s.Require().NoError(err)
+	s.Require().Zero(count)
 }
 
-func (s *TransactionTestSuite) TestSaveBalancesEmpty() {
+func (s *TransactionTestSuite) TestSaveDelegations() {
 	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer ctxCancel()
 
 	tx, err := BeginTransaction(ctx, s.storage.Transactable)
 	s.Require().NoError(err)
 
-	err = tx.SaveBalances(ctx)
+	delegateId := uint64(6)
+	err = tx.SaveDelegations(ctx,
+		&storage.Delegation{
+			Id:         1,
+			Height:     300,
+			LastHeight: 300,
+			DelegateId: &delegateId,
+			TxId:       9,
+		},
+		&storage.Delegation{
+			Id:         3,
+			Height:     300,
+			LastHeight: 300,
+			TxId:       9,
+		},
+	)
 	s.Require().NoError(err)
 
+	s.Require().NoError(tx.Flush(ctx))
 	s.Require().NoError(tx.Close(ctx))
+
+	// existing delegation keeps the first height and changes the delegate
+	updated, err := s.storage.Delegation.ByAuthorityId(ctx, 1)
+	s.Require().NoError(err)
+	s.Require().EqualValues(100, updated.Height)
+	s.Require().EqualValues(300, updated.LastHeight)
+	s.Require().NotNil(updated.DelegateId)
+	s.Require().EqualValues(6, *updated.DelegateId)
+	s.Require().EqualValues(9, updated.TxId)
+
+	created, err := s.storage.Delegation.ByAuthorityId(ctx, 3)
+	s.Require().NoError(err)
+	s.Require().EqualValues(300, created.Height)
+	s.Require().Nil(created.DelegateId)
 }
 
-func (s *TransactionTestSuite) TestSaveContractsEmpty() {
+func (s *TransactionTestSuite) TestRollbackDelegations() {
 	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer ctxCancel()
 
 	tx, err := BeginTransaction(ctx, s.storage.Transactable)
 	s.Require().NoError(err)
 
-	_, err = tx.SaveContracts(ctx)
+	err = tx.RollbackDelegations(ctx, 200)
 	s.Require().NoError(err)
 
+	s.Require().NoError(tx.Flush(ctx))
 	s.Require().NoError(tx.Close(ctx))
+
+	// delegation is restored from the previous valid authorization
+	restored, err := s.storage.Delegation.ByAuthorityId(ctx, 1)
+	s.Require().NoError(err)
+	s.Require().EqualValues(100, restored.Height)
+	s.Require().EqualValues(100, restored.LastHeight)
+	s.Require().NotNil(restored.DelegateId)
+	s.Require().EqualValues(5, *restored.DelegateId)
+	s.Require().EqualValues(3, restored.TxId)
+
+	// previous authorization of the second authority is invalid, so there is nothing to restore
+	_, err = s.storage.Delegation.ByAuthorityId(ctx, 2)
+	s.Require().True(s.storage.Delegation.IsNoRows(err))
+
+	count, err := s.storage.Connection().DB().NewSelect().Model((*storage.Delegation)(nil)).Count(ctx)
+	s.Require().NoError(err)
+	s.Require().EqualValues(1, count)
 }
 
-func (s *TransactionTestSuite) TestSaveTokensEmpty() {
+func (s *TransactionTestSuite) TestSaveBlobs() {
 	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer ctxCancel()
 
 	tx, err := BeginTransaction(ctx, s.storage.Transactable)
 	s.Require().NoError(err)
 
-	_, err = tx.SaveTokens(ctx)
-	s.Require().NoError(err)
+	blob := &storage.Blob{
+		Height:        200,
+		Time:          time.Date(2024, 1, 2, 10, 0, 0, 0, time.UTC),
+		TxId:          6,
+		SenderId:      1,
+		Index:         0,
+		VersionedHash: pkgTypes.MustDecodeHex("0x01c7d7f7ebe3dedf3f5f6f4edd3c5f43d4df6f43e99eaf7f3f6eddf7d7f8f9ba"),
+	}
+	s.Require().NoError(tx.SaveBlobs(ctx, blob))
 
+	s.Require().NoError(tx.Flush(ctx))
 	s.Require().NoError(tx.Close(ctx))
+	s.Require().Greater(blob.Id, uint64(0))
+
+	height := uint64(200)
+	blobs, err := s.storage.Blob.Filter(ctx, storage.BlobListFilter{
+		Limit:  10,
+		Height: &height,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(blobs, 1)
+	s.Require().EqualValues(6, blobs[0].TxId)
+	s.Require().EqualValues(1, blobs[0].SenderId)
+	s.Require().Equal("0x01c7d7f7ebe3dedf3f5f6f4edd3c5f43d4df6f43e99eaf7f3f6eddf7d7f8f9ba", blobs[0].VersionedHash.Hex())
 }
 
-func (s *TransactionTestSuite) TestSaveTokenMetadataEmpty() {
+func (s *TransactionTestSuite) TestRollbackBlobs() {
 	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer ctxCancel()
 
 	tx, err := BeginTransaction(ctx, s.storage.Transactable)
 	s.Require().NoError(err)
 
-	err = tx.SaveTokenMetadata(ctx)
-	s.Require().NoError(err)
+	s.Require().NoError(tx.RollbackBlobs(ctx, 300))
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	count, err := s.storage.Connection().DB().NewSelect().Model((*storage.Blob)(nil)).Count(ctx)
+	s.Require().NoError(err)
+	s.Require().EqualValues(0, count)
+}
+
+func (s *TransactionTestSuite) TestSaveProxyUpgrades() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	implementationId := uint64(5)
+	upgrade := &storage.ProxyUpgrade{
+		Height:           300,
+		Time:             time.Date(2024, 1, 3, 10, 0, 0, 0, time.UTC),
+		Type:             types.Upgraded,
+		ProxyId:          8,
+		ImplementationId: &implementationId,
+		TxId:             9,
+	}
+	s.Require().NoError(tx.SaveProxyUpgrades(ctx, upgrade))
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+	s.Require().Greater(upgrade.Id, uint64(0))
+
+	upgrades, err := s.storage.ProxyUpgrades.Filter(ctx, storage.ProxyUpgradeListFilter{
+		Limit:   10,
+		ProxyId: 8,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(upgrades, 1)
+	s.Require().Equal(types.Upgraded, upgrades[0].Type)
+	s.Require().NotNil(upgrades[0].ImplementationId)
+	s.Require().EqualValues(5, *upgrades[0].ImplementationId)
+	s.Require().EqualValues(9, upgrades[0].TxId)
+}
+
+func (s *TransactionTestSuite) TestUpdateBeaconProxies() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	s.Require().NoError(tx.UpdateBeaconProxies(ctx, 8))
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	var proxy storage.ProxyContract
+	err = s.storage.Connection().DB().NewSelect().Model(&proxy).Where("id = ?", 11).Scan(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(types.Resolved, proxy.Status)
+	s.Require().NotNil(proxy.ImplementationID)
+	s.Require().EqualValues(4, *proxy.ImplementationID)
+	s.Require().NotNil(proxy.BeaconId)
+	s.Require().EqualValues(8, *proxy.BeaconId)
+}
+
+func (s *TransactionTestSuite) TestRollbackProxyUpgrades() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	s.Require().NoError(tx.RollbackProxyUpgrades(ctx, 300))
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	count, err := s.storage.Connection().DB().NewSelect().Model((*storage.ProxyUpgrade)(nil)).Count(ctx)
+	s.Require().NoError(err)
+	s.Require().EqualValues(3, count)
+
+	var proxy storage.ProxyContract
+	err = s.storage.Connection().DB().NewSelect().Model(&proxy).Where("id = ?", 6).Scan(ctx)
+	s.Require().NoError(err)
+	s.Require().EqualValues(100, proxy.Height)
+	s.Require().Equal(types.Resolved, proxy.Status)
+	s.Require().NotNil(proxy.ImplementationID)
+	s.Require().EqualValues(4, *proxy.ImplementationID)
+}
+
+func (s *TransactionTestSuite) TestRollbackProxyUpgradesWithoutHistory() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	s.Require().NoError(tx.RollbackProxyUpgrades(ctx, 200))
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	var proxy storage.ProxyContract
+	err = s.storage.Connection().DB().NewSelect().Model(&proxy).Where("id = ?", 11).Scan(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(types.New, proxy.Status)
+	s.Require().Nil(proxy.ImplementationID)
+	s.Require().Nil(proxy.BeaconId)
+	s.Require().EqualValues(0, proxy.ResolvingAttempts)
+
+	var admin storage.ProxyContract
+	err = s.storage.Connection().DB().NewSelect().Model(&admin).Where("id = ?", 6).Scan(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(types.Resolved, admin.Status)
+	s.Require().NotNil(admin.ImplementationID)
+	s.Require().EqualValues(3, *admin.ImplementationID)
+}
+
+func (s *TransactionTestSuite) TestImplementationABIByUpgradesHistory() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	implementationId := uint64(3)
+	s.Require().NoError(tx.SaveProxyUpgrades(ctx,
+		&storage.ProxyUpgrade{
+			Height:           250,
+			Time:             time.Date(2024, 1, 2, 20, 0, 0, 0, time.UTC),
+			Type:             types.Upgraded,
+			ProxyId:          8,
+			ImplementationId: &implementationId,
+			TxId:             7,
+		},
+		&storage.ProxyUpgrade{
+			Height:           400,
+			Time:             time.Date(2024, 1, 4, 10, 0, 0, 0, time.UTC),
+			Type:             types.Upgraded,
+			ProxyId:          12,
+			ImplementationId: &implementationId,
+			TxId:             8,
+		},
+	))
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	// proxy id=11 uses beacon id=8 which is upgraded to implementation id=3 before the tx
+	hash := pkgTypes.MustDecodeHex("a22b6e91a5495977370580087a6298d1ef6e3459a22da12551150e2ebd788884bc")
+	call, err := s.storage.Tx.ByHash(ctx, hash, true)
+	s.Require().NoError(err)
+	s.Require().EqualValues(10, call.Id)
+	s.Require().JSONEq(`{}`, string(call.ToImplementationABI))
+
+	// proxy id=12 is called before its first upgrade
+	hash = pkgTypes.MustDecodeHex("cabf2872ac9d2e9b9ac153491bb581e4be337fc5a14e6b58e747720bab2fb3ca")
+	call, err = s.storage.Tx.ByHash(ctx, hash, true)
+	s.Require().NoError(err)
+	s.Require().EqualValues(11, call.Id)
+	s.Require().Nil(call.ToImplementationABI)
+}
+
+func (s *TransactionTestSuite) TestSaveDiamondCuts() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	facetId := uint64(5)
+	cut := &storage.DiamondCut{
+		Height:    300,
+		Time:      time.Date(2024, 1, 3, 10, 0, 0, 0, time.UTC),
+		DiamondId: 4,
+		FacetId:   &facetId,
+		Action:    types.Replace,
+		Selector:  pkgTypes.MustDecodeHex("0xa9059cbb"),
+		TxId:      9,
+	}
+	s.Require().NoError(tx.SaveDiamondCuts(ctx, cut))
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+	s.Require().Greater(cut.Id, uint64(0))
+
+	var saved storage.DiamondCut
+	err = s.storage.Connection().DB().NewSelect().Model(&saved).Where("id = ?", cut.Id).Scan(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(types.Replace, saved.Action)
+	s.Require().EqualValues(4, saved.DiamondId)
+	s.Require().NotNil(saved.FacetId)
+	s.Require().EqualValues(5, *saved.FacetId)
+	s.Require().Equal("0xa9059cbb", saved.Selector.Hex())
+}
+
+func (s *TransactionTestSuite) TestSaveDiamondFacets() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	s.Require().NoError(tx.SaveDiamondFacets(ctx,
+		&storage.DiamondFacet{
+			DiamondId: 4,
+			Selector:  pkgTypes.MustDecodeHex("0xa9059cbb"),
+			FacetId:   5,
+			Height:    300,
+		},
+		&storage.DiamondFacet{
+			DiamondId: 4,
+			Selector:  pkgTypes.MustDecodeHex("0x23b872dd"),
+			FacetId:   5,
+			Height:    300,
+		},
+	))
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	var facets []storage.DiamondFacet
+	err = s.storage.Connection().DB().NewSelect().Model(&facets).Where("diamond_id = ?", 4).Order("selector").Scan(ctx)
+	s.Require().NoError(err)
+	s.Require().Len(facets, 2)
+	s.Require().Equal("0x23b872dd", facets[0].Selector.Hex())
+	s.Require().EqualValues(5, facets[0].FacetId)
+	s.Require().Equal("0xa9059cbb", facets[1].Selector.Hex())
+	s.Require().EqualValues(5, facets[1].FacetId)
+	s.Require().EqualValues(300, facets[1].Height)
+}
+
+func (s *TransactionTestSuite) TestDeleteDiamondFacets() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	s.Require().NoError(tx.DeleteDiamondFacets(ctx, &storage.DiamondFacet{
+		DiamondId: 4,
+		Selector:  pkgTypes.MustDecodeHex("0xa9059cbb"),
+		Height:    300,
+	}))
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	count, err := s.storage.Connection().DB().NewSelect().Model((*storage.DiamondFacet)(nil)).Count(ctx)
+	s.Require().NoError(err)
+	s.Require().EqualValues(0, count)
+}
+
+func (s *TransactionTestSuite) TestDiamondFacetsOutOfOrder() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	// the facet changed at 200 isn't replaced or removed by older cuts
+	s.Require().NoError(tx.SaveDiamondFacets(ctx, &storage.DiamondFacet{
+		DiamondId: 4,
+		Selector:  pkgTypes.MustDecodeHex("0xa9059cbb"),
+		FacetId:   5,
+		Height:    100,
+	}))
+	s.Require().NoError(tx.DeleteDiamondFacets(ctx, &storage.DiamondFacet{
+		DiamondId: 4,
+		Selector:  pkgTypes.MustDecodeHex("0xa9059cbb"),
+		Height:    150,
+	}))
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	var facet storage.DiamondFacet
+	err = s.storage.Connection().DB().NewSelect().Model(&facet).Where("diamond_id = ?", 4).Scan(ctx)
+	s.Require().NoError(err)
+	s.Require().EqualValues(3, facet.FacetId)
+	s.Require().EqualValues(200, facet.Height)
+}
+
+func (s *TransactionTestSuite) TestSaveDecodedLogs() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	s.Require().NoError(tx.SaveDecodedLogs(ctx,
+		&storage.Log{
+			Id:             3,
+			Time:           time.Date(2024, 1, 2, 0, 0, 1, 0, time.UTC),
+			EventName:      "OwnershipTransferred",
+			EventSignature: "OwnershipTransferred(address)",
+			EventArgs:      []byte(`{"owner":"0xaF52695E1bB01A16D33D7194C28C42b10e0Dbec2"}`),
+		},
+		&storage.Log{
+			Id:   5,
+			Time: time.Date(2024, 1, 3, 0, 0, 1, 0, time.UTC),
+		},
+	))
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	var logs []storage.Log
+	err = s.storage.Connection().DB().NewSelect().Model(&logs).Where("id IN (3, 5)").Order("id").Scan(ctx)
+	s.Require().NoError(err)
+	s.Require().Len(logs, 2)
+
+	s.Require().True(logs[0].Decoded)
+	s.Require().Equal("OwnershipTransferred", logs[0].EventName)
+	s.Require().Equal("OwnershipTransferred(address)", logs[0].EventSignature)
+	s.Require().JSONEq(`{"owner":"0xaF52695E1bB01A16D33D7194C28C42b10e0Dbec2"}`, string(logs[0].EventArgs))
+
+	s.Require().True(logs[1].Decoded)
+	s.Require().Empty(logs[1].EventName)
+	s.Require().Nil(logs[1].EventArgs)
+}
+
+func (s *TransactionTestSuite) TestResetDecodedLogs() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	s.Require().NoError(tx.ResetDecodedLogs(ctx, 1))
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	var logs []storage.Log
+	err = s.storage.Connection().DB().NewSelect().Model(&logs).Where("id IN (1, 2)").Order("id").Scan(ctx)
+	s.Require().NoError(err)
+	s.Require().Len(logs, 2)
+	s.Require().False(logs[0].Decoded)
+	s.Require().True(logs[1].Decoded)
+}
+
+func (s *TransactionTestSuite) TestSaveDecodedLogsAfterReset() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	// the log was reset after it had been read by the decoder
+	s.Require().NoError(tx.SaveDecodedLogs(ctx, &storage.Log{
+		Id:            3,
+		Time:          time.Date(2024, 1, 2, 0, 0, 1, 0, time.UTC),
+		EventName:     "OwnershipTransferred",
+		DecodeVersion: 1,
+	}))
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	var log storage.Log
+	err = s.storage.Connection().DB().NewSelect().Model(&log).Where("id = 3").Scan(ctx)
+	s.Require().NoError(err)
+	s.Require().False(log.Decoded)
+	s.Require().Empty(log.EventName)
+}
+
+func (s *TransactionTestSuite) TestResetUnknownLogs() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	s.Require().NoError(tx.SaveDecodedLogs(ctx, &storage.Log{
+		Id:   3,
+		Time: time.Date(2024, 1, 2, 0, 0, 1, 0, time.UTC),
+	}))
+	s.Require().NoError(tx.ResetUnknownLogs(ctx,
+		&storage.Signature{
+			Selector: pkgTypes.MustDecodeHex("0x7ecd84343f76a23d2227290e0288da3251b045541698e575a5515af4f04197a3"),
+			Type:     types.Event,
+		},
+		&storage.Signature{
+			Selector: pkgTypes.MustDecodeHex("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"),
+			Type:     types.Event,
+		},
+	))
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	var logs []storage.Log
+	err = s.storage.Connection().DB().NewSelect().Model(&logs).Where("id IN (1, 3)").Order("id").Scan(ctx)
+	s.Require().NoError(err)
+	s.Require().Len(logs, 2)
+	// decoded log isn't reset
+	s.Require().True(logs[0].Decoded)
+	s.Require().EqualValues(0, logs[0].DecodeVersion)
+	s.Require().False(logs[1].Decoded)
+	s.Require().EqualValues(1, logs[1].DecodeVersion)
+}
+
+func (s *TransactionTestSuite) TestSaveSimilarChecked() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	codeHash := pkgTypes.MustDecodeHex("0xbb")
+	s.Require().NoError(tx.SaveSimilarChecked(ctx, &storage.Contract{
+		Id:       5,
+		CodeHash: codeHash,
+	}))
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	contract, err := s.storage.Contracts.GetByID(ctx, 5)
+	s.Require().NoError(err)
+	s.Require().True(contract.SimilarChecked)
+	s.Require().Equal(codeHash, contract.CodeHash)
+
+	pending, err := s.storage.Contracts.SimilarPending(ctx, 10)
+	s.Require().NoError(err)
+	s.Require().Len(pending, 3)
+}
+
+func (s *TransactionTestSuite) TestResetSimilarChecked() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	s.Require().NoError(tx.ResetSimilarChecked(ctx, 7))
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	contract, err := s.storage.Contracts.GetByID(ctx, 7)
+	s.Require().NoError(err)
+	s.Require().False(contract.SimilarChecked)
+}
+
+func (s *TransactionTestSuite) TestCopySources() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	s.Require().NoError(tx.CopySources(ctx, 3, 5, 6))
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	for _, contractId := range []uint64{5, 6} {
+		var sources []storage.Source
+		err = s.storage.Connection().DB().NewSelect().Model(&sources).Where("contract_id = ?", contractId).Order("id").Scan(ctx)
+		s.Require().NoError(err)
+		s.Require().Len(sources, 2)
+		s.Require().Equal("Token.sol", sources[0].Name)
+		s.Require().Equal("contract Token { ... }", sources[0].Content)
+		s.Require().Equal("TokenStorage.sol", sources[1].Name)
+		s.Require().Equal(map[string]string{"Math": "0x742d35cc6634c0532925a3b844bc9e7595f0beb0"}, sources[1].Libraries)
+	}
+}
+
+func (s *TransactionTestSuite) TestSaveSignatures() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	s.Require().NoError(tx.SaveSignatures(ctx,
+		&storage.Signature{
+			Selector:  pkgTypes.MustDecodeHex("0xa9059cbb"),
+			Signature: "transfer(address,uint256)",
+			Type:      types.Function,
+			ABI:       []byte(`{"type":"function","name":"transfer","inputs":[{"name":"arg0","type":"address"},{"name":"arg1","type":"uint256"}]}`),
+		},
+		&storage.Signature{
+			Selector:  pkgTypes.MustDecodeHex("0x095ea7b3"),
+			Signature: "approve(address,uint256)",
+			Type:      types.Function,
+			ABI:       []byte(`{"type":"function","name":"approve","inputs":[{"name":"arg0","type":"address"},{"name":"arg1","type":"uint256"}]}`),
+		},
+	))
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	var signatures []storage.Signature
+	err = s.storage.Connection().DB().NewSelect().Model(&signatures).Order("id").Scan(ctx)
+	s.Require().NoError(err)
+	s.Require().Len(signatures, 4)
+	s.Require().Equal("approve(address,uint256)", signatures[3].Signature)
+	s.Require().Equal("0x095ea7b3", signatures[3].Selector.Hex())
+
+	// the known signature keeps its ABI with argument names
+	s.Require().Equal("transfer(address,uint256)", signatures[0].Signature)
+	s.Require().Contains(string(signatures[0].ABI), `"to"`)
+}
+
+func (s *TransactionTestSuite) TestRollbackDiamondCuts() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	s.Require().NoError(tx.RollbackDiamondCuts(ctx, 300))
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	count, err := s.storage.Connection().DB().NewSelect().Model((*storage.DiamondCut)(nil)).Count(ctx)
+	s.Require().NoError(err)
+	s.Require().EqualValues(2, count)
+
+	// selector removed at the height is restored from the previous cut
+	var facets []storage.DiamondFacet
+	err = s.storage.Connection().DB().NewSelect().Model(&facets).Where("diamond_id = ?", 4).Order("selector").Scan(ctx)
+	s.Require().NoError(err)
+	s.Require().Len(facets, 2)
+	s.Require().Equal("0x095ea7b3", facets[0].Selector.Hex())
+	s.Require().EqualValues(3, facets[0].FacetId)
+	s.Require().EqualValues(200, facets[0].Height)
+	s.Require().Equal("0xa9059cbb", facets[1].Selector.Hex())
+}
+
+func (s *TransactionTestSuite) TestDeleteBalances() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	// Verify balances exist before delete
+	var balance1Before storage.Balance
+	err := s.storage.Connection().DB().NewSelect().Model(&balance1Before).Where("id = ?", 1).Scan(ctx)
+	s.Require().NoError(err)
+
+	var balance3Before storage.Balance
+	err = s.storage.Connection().DB().NewSelect().Model(&balance3Before).Where("id = ?", 3).Scan(ctx)
+	s.Require().NoError(err)
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	err = tx.DeleteBalances(ctx, []uint64{1, 3})
+	s.Require().NoError(err)
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	// Verify balances were deleted
+	var balance1After storage.Balance
+	err = s.storage.Connection().DB().NewSelect().Model(&balance1After).Where("id = ?", 1).Scan(ctx)
+	s.Require().Error(err)
+
+	var balance3After storage.Balance
+	err = s.storage.Connection().DB().NewSelect().Model(&balance3After).Where("id = ?", 3).Scan(ctx)
+	s.Require().Error(err)
+}
+
+func (s *TransactionTestSuite) TestDeleteBalancesEmpty() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	err = tx.DeleteBalances(ctx, []uint64{})
+	s.Require().NoError(err)
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+}
+
+func (s *TransactionTestSuite) TestDeleteTokenBalances() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	// Verify token balances exist before delete (token_id=0, contract_id=3)
+	tokenId := decimal.NewFromInt(0)
+	contractId := uint64(3)
+	balancesBefore, err := s.storage.TokenBalance.Filter(ctx, storage.TokenBalanceListFilter{
+		TokenId:    &tokenId,
+		ContractId: &contractId,
+		Limit:      100,
+	})
+	s.Require().NoError(err)
+	s.Require().NotEmpty(balancesBefore)
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	err = tx.DeleteTokenBalances(ctx, []string{"0"}, []uint64{3}, nil)
+	s.Require().NoError(err)
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	// Verify token balances were deleted
+	balancesAfter, err := s.storage.TokenBalance.Filter(ctx, storage.TokenBalanceListFilter{
+		TokenId:    &tokenId,
+		ContractId: &contractId,
+		Limit:      100,
+	})
+	s.Require().NoError(err)
+	s.Require().Empty(balancesAfter)
+}
+
+func (s *TransactionTestSuite) TestDeleteTokenBalancesWithZeroBalances() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	// Verify token balance exists before delete (token_id=1, contract_id=3, address_id=1)
+	tokenId := decimal.NewFromInt(1)
+	contractId := uint64(3)
+	addressId := uint64(1)
+	balancesBefore, err := s.storage.TokenBalance.Filter(ctx, storage.TokenBalanceListFilter{
+		TokenId:    &tokenId,
+		ContractId: &contractId,
+		AddressId:  &addressId,
+		Limit:      100,
+	})
+	s.Require().NoError(err)
+	s.Require().NotEmpty(balancesBefore)
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	zeroBalances := []*storage.TokenBalance{
+		{
+			TokenID:    decimal.NewFromInt(1),
+			ContractID: 3,
+			AddressID:  1,
+		},
+	}
+
+	err = tx.DeleteTokenBalances(ctx, []string{}, []uint64{}, zeroBalances)
+	s.Require().NoError(err)
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	// Verify token balance was deleted
+	balancesAfter, err := s.storage.TokenBalance.Filter(ctx, storage.TokenBalanceListFilter{
+		TokenId:    &tokenId,
+		ContractId: &contractId,
+		AddressId:  &addressId,
+		Limit:      100,
+	})
+	s.Require().NoError(err)
+	s.Require().Empty(balancesAfter)
+}
+
+func (s *TransactionTestSuite) TestDeleteTokenBalancesMismatchedLengths() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	err = tx.DeleteTokenBalances(ctx, []string{"0", "1"}, []uint64{3}, nil)
+	s.Require().Error(err)
+	s.Require().Contains(err.Error(), "tokenIds and contractIds must have same length")
+
+	s.Require().NoError(tx.Close(ctx))
+}
+
+func (s *TransactionTestSuite) TestState() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	// First create a state
+	_, err := s.storage.Connection().DB().ExecContext(ctx, `
+		INSERT INTO state (name, last_height, last_hash, last_time, total_tx, total_accounts, chain_id)
+		VALUES ('indexer', 1000, '0x123', NOW(), 100, 50, 1)
+		ON CONFLICT (name) DO UPDATE SET last_height = 1000
+	`)
+	s.Require().NoError(err)
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	state, err := tx.State(ctx, "indexer")
+	s.Require().NoError(err)
+	s.Require().EqualValues(1000, state.LastHeight)
+
+	s.Require().NoError(tx.Close(ctx))
+}
+
+func (s *TransactionTestSuite) TestRangeStates() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	_, err := s.storage.Connection().DB().ExecContext(ctx, `
+		INSERT INTO state (name, last_height, start_height, end_height)
+		VALUES ('indexer:1001-2000', 1500, 1001, 2000), ('indexer:1-1000', 1000, 1, 1000), ('other:1-1000', 1000, 1, 1000)
+		ON CONFLICT (name) DO NOTHING
+	`)
+	s.Require().NoError(err)
+
+	ranges, err := s.storage.State.Ranges(ctx, "indexer")
+	s.Require().NoError(err)
+	s.Require().Len(ranges, 2)
+	s.Require().Equal("indexer:1-1000", ranges[0].Name)
+	s.Require().True(ranges[0].IsRangeIndexed())
+	s.Require().Equal("indexer:1001-2000", ranges[1].Name)
+	s.Require().False(ranges[1].IsRangeIndexed())
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+	s.Require().NoError(tx.DeleteState(ctx, "indexer:1-1000"))
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	ranges, err = s.storage.State.Ranges(ctx, "indexer")
+	s.Require().NoError(err)
+	s.Require().Len(ranges, 1)
+}
+
+func (s *TransactionTestSuite) TestUpdateBlockFinality() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+	s.Require().NoError(tx.UpdateBlockFinality(ctx, types.Safe, 0, 400))
+	s.Require().NoError(tx.UpdateBlockFinality(ctx, types.Finalized, 0, 200))
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	expected := map[pkgTypes.Level]types.Finality{
+		100: types.Finalized,
+		200: types.Finalized,
+		300: types.Safe,
+		400: types.Safe,
+		500: types.Latest,
+	}
+	for height, finality := range expected {
+		block, err := s.storage.Blocks.ByHeight(ctx, height, false)
+		s.Require().NoError(err)
+		s.Require().Equal(finality, block.Finality, "height %d", height)
+	}
+}
+
+func (s *TransactionTestSuite) TestSaveAddressesOutOfOrder() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	// the block of the earlier range is saved after the address was seen in later blocks
+	_, err = tx.SaveAddresses(ctx, &storage.Address{
+		Hash:        pkgTypes.MustDecodeHex("0xa63d581a7fdab643c09f0524904b046cdb9ad9d2"),
+		FirstHeight: 10,
+		LastHeight:  10,
+		IsContract:  true,
+	})
+	s.Require().NoError(err)
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	addr, err := s.storage.Addresses.ByHash(ctx, pkgTypes.MustDecodeHex("0xa63d581a7fdab643c09f0524904b046cdb9ad9d2"))
+	s.Require().NoError(err)
+	s.Require().EqualValues(10, addr.FirstHeight)
+	s.Require().EqualValues(550, addr.LastHeight)
+	s.Require().True(addr.IsContract)
+}
+
+func (s *TransactionTestSuite) TestRecalculateBalanceHistory() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	// the block of the earlier range is saved after blocks of the later range
+	err = tx.SaveBalanceHistory(ctx, &storage.BalanceHistory{
+		Height:    120,
+		Time:      time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+		AddressId: 1,
+		Value:     decimal.RequireFromString("100050"),
+		Delta:     decimal.RequireFromString("50"),
+	})
+	s.Require().NoError(err)
+	s.Require().NoError(tx.RecalculateBalanceHistory(ctx, 101, 200))
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	for height, value := range map[pkgTypes.Level]string{
+		100: "1000",
+		120: "1050",
+		150: "750",
+		200: "950",
+		300: "100000", // out of the range
+	} {
+		history, err := s.storage.BalanceHistory.AtHeight(ctx, 1, height)
+		s.Require().NoError(err)
+		s.Require().True(history.Value.Equal(decimal.RequireFromString(value)), "height %d: %s", height, history.Value)
+	}
+}
+
+func (s *TransactionTestSuite) TestLastBlock() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	block, err := tx.LastBlock(ctx)
+	s.Require().NoError(err)
+	s.Require().Greater(block.Id, uint64(0))
+
+	s.Require().NoError(tx.Close(ctx))
+}
+
+func (s *TransactionTestSuite) TestSaveTransactionsEmpty() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	err = tx.SaveTransactions(ctx)
+	s.Require().NoError(err)
+
+	s.Require().NoError(tx.Close(ctx))
+}
+
+func (s *TransactionTestSuite) TestSaveLogsEmpty() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	err = tx.SaveLogs(ctx)
+	s.Require().NoError(err)
+
+	s.Require().NoError(tx.Close(ctx))
+}
+
+func (s *TransactionTestSuite) TestSaveSourcesEmpty() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	err = tx.SaveSources(ctx)
+	s.Require().NoError(err)
+
+	s.Require().NoError(tx.Close(ctx))
+}
+
+func (s *TransactionTestSuite) TestSaveTracesEmpty() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	err = tx.SaveTraces(ctx)
+	s.Require().NoError(err)
+
+	s.Require().NoError(tx.Close(ctx))
+}
+
+func (s *TransactionTestSuite) TestSaveTransfersEmpty() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	err = tx.SaveTransfers(ctx)
+	s.Require().NoError(err)
+
+	s.Require().NoError(tx.Close(ctx))
+}
+
+func (s *TransactionTestSuite) TestSaveAddressesEmpty() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	count, err := tx.SaveAddresses(ctx)
+	s.Require().NoError(err)
+	s.Require().EqualValues(0, count)
+
+	s.Require().NoError(tx.Close(ctx))
+}
+
+func (s *TransactionTestSuite) TestSaveBalancesEmpty() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	updated, err := tx.SaveBalances(ctx)
+	s.Require().NoError(err)
+	s.Require().Empty(updated)
+
+	s.Require().NoError(tx.Close(ctx))
+}
+
+func (s *TransactionTestSuite) TestSaveContractsEmpty() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	_, err = tx.SaveContracts(ctx)
+	s.Require().NoError(err)
+
+	s.Require().NoError(tx.Close(ctx))
+}
+
+func (s *TransactionTestSuite) TestSaveTokensEmpty() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	_, err = tx.SaveTokens(ctx)
+	s.Require().NoError(err)
+
+	s.Require().NoError(tx.Close(ctx))
+}
+
+func (s *TransactionTestSuite) TestSaveTokenMetadataEmpty() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	err = tx.SaveTokenMetadata(ctx)
+	s.Require().NoError(err)
 
 	s.Require().NoError(tx.Close(ctx))
 }
@@ -1187,6 +2514,91 @@ func (s *TransactionTestSuite) TestSaveVerificationFilesEmpty() {
 	s.Require().NoError(tx.Close(ctx))
 }
 
+func (s *TransactionTestSuite) TestAddVerificationTaskStandardJSON() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	task := &storage.VerificationTask{
+		Status:          types.VerificationStatusNew,
+		ContractId:      3,
+		ContractName:    "src/NewContract.sol:NewContract",
+		CompilerVersion: "v0.8.25+commit.b61c2a91",
+		LicenseType:     types.Mit,
+		StandardJSON:    true,
+	}
+
+	s.Require().NoError(tx.AddVerificationTask(ctx, task))
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	saved, err := s.storage.VerificationTasks.ById(ctx, task.Id)
+	s.Require().NoError(err)
+	s.Require().True(saved.StandardJSON)
+	s.Require().Equal("src/NewContract.sol:NewContract", saved.ContractName)
+	s.Require().Equal(types.Solidity, saved.Language)
+}
+
+func (s *TransactionTestSuite) TestAddVerificationTaskVyper() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	mode := "codesize"
+	task := &storage.VerificationTask{
+		Status:           types.VerificationStatusNew,
+		ContractId:       3,
+		ContractName:     "Token",
+		CompilerVersion:  "v0.4.0+commit.e9db8d9f",
+		LicenseType:      types.Mit,
+		Language:         types.Vyper,
+		OptimizationMode: &mode,
+	}
+
+	s.Require().NoError(tx.AddVerificationTask(ctx, task))
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	saved, err := s.storage.VerificationTasks.ById(ctx, task.Id)
+	s.Require().NoError(err)
+	s.Require().Equal(types.Vyper, saved.Language)
+	s.Require().NotNil(saved.OptimizationMode)
+	s.Require().Equal("codesize", *saved.OptimizationMode)
+}
+
+func (s *TransactionTestSuite) TestAddVerificationTaskLibraries() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	libraries := map[string]string{
+		"Math": "0x742d35cc6634c0532925a3b844bc9e7595f0beb0",
+	}
+	task := &storage.VerificationTask{
+		Status:          types.VerificationStatusNew,
+		ContractId:      3,
+		ContractName:    "Token",
+		CompilerVersion: "v0.8.20+commit.a1b79de6",
+		LicenseType:     types.Mit,
+		Language:        types.Solidity,
+		Libraries:       libraries,
+	}
+
+	s.Require().NoError(tx.AddVerificationTask(ctx, task))
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	saved, err := s.storage.VerificationTasks.ById(ctx, task.Id)
+	s.Require().NoError(err)
+	s.Require().Equal(libraries, saved.Libraries)
+}
+
 func (s *TransactionTestSuite) TestUpdateVerificationTask() {
 	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer ctxCancel()
@@ -1224,6 +2636,110 @@ func (s *TransactionTestSuite) TestUpdateVerificationTask() {
 	s.Require().True(found, "updated task not found")
 }
 
+func (s *TransactionTestSuite) TestUpdateVerificationTaskCompilerOutput() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	err = tx.UpdateVerificationTask(ctx, &storage.VerificationTask{
+		Id:             1,
+		Status:         types.VerificationStatusFailed,
+		Error:          "compile contract: compilation failed",
+		CompilerOutput: "Error: Expected ';' but got '}'",
+	})
+	s.Require().NoError(err)
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	task, err := s.storage.VerificationTasks.ById(ctx, 1)
+	s.Require().NoError(err)
+	s.Require().Equal(types.VerificationStatusFailed, task.Status)
+	s.Require().Equal("Error: Expected ';' but got '}'", task.CompilerOutput)
+}
+
+func (s *TransactionTestSuite) TestClaimVerificationTasks() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	// task 1 is new and task 4 is pending without start time
+	tasks, err := tx.ClaimVerificationTasks(ctx, 10, time.Now().Add(-time.Hour))
+	s.Require().NoError(err)
+	s.Require().Len(tasks, 2)
+
+	ids := []uint64{tasks[0].Id, tasks[1].Id}
+	s.Require().ElementsMatch([]uint64{1, 4}, ids)
+	for i := range tasks {
+		s.Require().Equal(types.VerificationStatusPending, tasks[i].Status)
+		s.Require().NotNil(tasks[i].StartTime)
+		s.Require().EqualValues(1, tasks[i].Attempts)
+	}
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	// claimed tasks are not stale yet
+	tx, err = BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	tasks, err = tx.ClaimVerificationTasks(ctx, 10, time.Now().Add(-time.Hour))
+	s.Require().NoError(err)
+	s.Require().Len(tasks, 0)
+
+	s.Require().NoError(tx.Close(ctx))
+}
+
+func (s *TransactionTestSuite) TestClaimVerificationTasksLimit() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	tasks, err := tx.ClaimVerificationTasks(ctx, 1, time.Now().Add(-time.Hour))
+	s.Require().NoError(err)
+	s.Require().Len(tasks, 1)
+	s.Require().EqualValues(1, tasks[0].Id)
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	task, err := s.storage.VerificationTasks.ById(ctx, 4)
+	s.Require().NoError(err)
+	s.Require().Equal(types.VerificationStatusPending, task.Status)
+	s.Require().Nil(task.StartTime)
+}
+
+func (s *TransactionTestSuite) TestIncrementVerifiedContracts() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	_, err := s.storage.Connection().DB().ExecContext(ctx, `
+		INSERT INTO state (name, last_height, last_hash, last_time, total_tx, total_accounts, total_verified_contracts, chain_id)
+		VALUES ('indexer', 1000, '0x123', NOW(), 100, 50, 7, 1)
+		ON CONFLICT (name) DO UPDATE SET total_verified_contracts = 7
+	`)
+	s.Require().NoError(err)
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	s.Require().NoError(tx.IncrementVerifiedContracts(ctx, "indexer", 1))
+	s.Require().NoError(tx.IncrementVerifiedContracts(ctx, "indexer", 3))
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	state, err := s.storage.State.ByName(ctx, "indexer")
+	s.Require().NoError(err)
+	s.Require().EqualValues(11, state.TotalVerifiedContracts)
+}
+
 func (s *TransactionTestSuite) TestDeleteVerificationFiles() {
 	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer ctxCancel()
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/NobleScope/noble-indexer/internal/storage"
+	"github.com/NobleScope/noble-indexer/internal/storage/types"
 	pkgTypes "github.com/NobleScope/noble-indexer/pkg/types"
 	"github.com/dipdup-net/go-lib/database"
 	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
@@ -51,6 +52,25 @@ func (t *Tx) ByHeight(
 	return
 }
 
+// AllByHeight - returns all transactions of the block ordered by index
+func (t *Tx) AllByHeight(ctx context.Context, height pkgTypes.Level) (txs []storage.Tx, err error) {
+	subQuery := t.DB().NewSelect().
+		Model(&txs).
+		Where("tx.height = ?", height)
+
+	err = t.DB().NewSelect().
+		ColumnExpr("tx.*").
+		ColumnExpr("from_addr.id AS from_address__id, from_addr.first_height AS from_address__first_height, from_addr.last_height AS from_address__last_height, from_addr.hash AS from_address__hash, from_addr.is_contract AS from_address__is_contract").
+		ColumnExpr("to_addr.id AS to_address__id, to_addr.first_height AS to_address__first_height, to_addr.last_height AS to_address__last_height, to_addr.hash AS to_address__hash, to_addr.is_contract AS to_address__is_contract").
+		TableExpr("(?) AS tx", subQuery).
+		Join("INNER JOIN address AS from_addr ON from_addr.id = tx.from_address_id").
+		Join("LEFT JOIN address AS to_addr ON to_addr.id = tx.to_address_id").
+		Order("tx.index ASC").
+		Scan(ctx, &txs)
+
+	return
+}
+
 // ByHash -
 func (t *Tx) ByHash(ctx context.Context, hash pkgTypes.Hex, withABI bool) (tx storage.Tx, err error) {
 	subQuery := t.DB().NewSelect().
@@ -68,7 +88,12 @@ func (t *Tx) ByHash(ctx context.Context, hash pkgTypes.Hex, withABI bool) (tx st
 	if withABI {
 		outerQuery = outerQuery.
 			ColumnExpr("to_contract.abi AS to_contract_abi").
-			Join("LEFT JOIN contract AS to_contract ON to_contract.id = tx.to_address_id")
+			ColumnExpr("to_facet.abi AS to_facet_abi").
+			ColumnExpr("(?) AS to_implementation_abi", implementationABIQuery(t.DB(), "tx.to_address_id", "tx.height")).
+			ColumnExpr("(?) AS to_signatures", signatureABIsQuery(t.DB(), "substring(tx.input FROM 1 FOR 4)", types.Function)).
+			Join("LEFT JOIN contract AS to_contract ON to_contract.id = tx.to_address_id").
+			Join("LEFT JOIN diamond_facet AS facet ON facet.diamond_id = tx.to_address_id AND facet.selector = substring(tx.input FROM 1 FOR 4)").
+			Join("LEFT JOIN contract AS to_facet ON to_facet.id = facet.facet_id")
 	}
 
 	err = outerQuery.Scan(ctx, &tx)
@@ -94,7 +119,12 @@ func (t *Tx) Filter(ctx context.Context, filter storage.TxListFilter) (txs []sto
 	if filter.WithABI {
 		outerQuery = outerQuery.
 			ColumnExpr("to_contract.abi AS to_contract_abi").
-			Join("LEFT JOIN contract AS to_contract ON to_contract.id = tx.to_address_id")
+			ColumnExpr("to_facet.abi AS to_facet_abi").
+			ColumnExpr("(?) AS to_implementation_abi", implementationABIQuery(t.DB(), "tx.to_address_id", "tx.height")).
+			ColumnExpr("(?) AS to_signatures", signatureABIsQuery(t.DB(), "substring(tx.input FROM 1 FOR 4)", types.Function)).
+			Join("LEFT JOIN contract AS to_contract ON to_contract.id = tx.to_address_id").
+			Join("LEFT JOIN diamond_facet AS facet ON facet.diamond_id = tx.to_address_id AND facet.selector = substring(tx.input FROM 1 FOR 4)").
+			Join("LEFT JOIN contract AS to_facet ON to_facet.id = facet.facet_id")
 	}
 
 	outerQuery = sortTimeIDScope(outerQuery, filter.Sort)
@@ -0,0 +1,30 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/NobleScope/noble-indexer/internal/storage"
+	"github.com/dipdup-net/go-lib/database"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+)
+
+type TxAccessList struct {
+	*postgres.Table[*storage.TxAccessList]
+}
+
+// NewTxAccessList -
+func NewTxAccessList(db *database.Bun) *TxAccessList {
+	return &TxAccessList{
+		Table: postgres.NewTable[*storage.TxAccessList](db),
+	}
+}
+
+// ByTxId - returns access list of the transaction ordered by index
+func (t *TxAccessList) ByTxId(ctx context.Context, txId uint64) (accessList []storage.TxAccessList, err error) {
+	err = t.DB().NewSelect().
+		Model(&accessList).
+		Where("tx_id = ?", txId).
+		Order("index ASC").
+		Scan(ctx)
+	return
+}
@@ -0,0 +1,35 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	pkgTypes "github.com/NobleScope/noble-indexer/pkg/types"
+)
+
+func (s *StorageTestSuite) TestTxAccessListByTxId() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	accessList, err := s.storage.TxAccessList.ByTxId(ctx, 1)
+	s.Require().NoError(err)
+	s.Require().Len(accessList, 2)
+
+	s.Require().EqualValues(0, accessList[0].Index)
+	s.Require().Equal("0x30f055506ba543ea0942dc8ca03f596ab75bc879", accessList[0].Address.Hex())
+	s.Require().Len(accessList[0].StorageKeys, 1)
+	s.Require().Equal(pkgTypes.Hex(pkgTypes.MustDecodeHex("0xabababababababababababababababababababababababababababababababab")), accessList[0].StorageKeys[0])
+
+	s.Require().EqualValues(1, accessList[1].Index)
+	s.Require().Equal("0xa63d581a7fdab643c09f0524904b046cdb9ad9d2", accessList[1].Address.Hex())
+	s.Require().Empty(accessList[1].StorageKeys)
+}
+
+func (s *StorageTestSuite) TestTxAccessListByTxIdEmpty() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	accessList, err := s.storage.TxAccessList.ByTxId(ctx, 3)
+	s.Require().NoError(err)
+	s.Require().Empty(accessList)
+}
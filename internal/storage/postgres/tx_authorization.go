@@ -0,0 +1,59 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/NobleScope/noble-indexer/internal/storage"
+	"github.com/dipdup-net/go-lib/database"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+)
+
+type TxAuthorization struct {
+	*postgres.Table[*storage.TxAuthorization]
+}
+
+// NewTxAuthorization -
+func NewTxAuthorization(db *database.Bun) *TxAuthorization {
+	return &TxAuthorization{
+		Table: postgres.NewTable[*storage.TxAuthorization](db),
+	}
+}
+
+// ByTxId - returns authorization list of the transaction ordered by index
+func (t *TxAuthorization) ByTxId(ctx context.Context, txId uint64) (authorizations []storage.TxAuthorization, err error) {
+	err = t.DB().NewSelect().
+		Model(&authorizations).
+		Where("tx_id = ?", txId).
+		Order("index ASC").
+		Scan(ctx)
+	return
+}
+
+// Filter - returns authorizations signed by the authority
+func (t *TxAuthorization) Filter(ctx context.Context, filter storage.TxAuthorizationListFilter) (authorizations []storage.TxAuthorization, err error) {
+	query := t.DB().NewSelect().
+		Model(&authorizations).
+		Where("authority_id = ?", filter.AuthorityId)
+
+	if filter.CursorID > 0 {
+		query = cursorIDScope(query, filter.Sort, filter.CursorID)
+	} else {
+		query = query.Offset(filter.Offset)
+	}
+	query = limitScope(query, filter.Limit)
+	query = sortScope(query, "id", filter.Sort)
+
+	outerQuery := t.DB().NewSelect().
+		ColumnExpr("tx_authorization.*").
+		ColumnExpr("authority.hash AS authority__hash").
+		ColumnExpr("delegate.hash AS delegate__hash").
+		ColumnExpr("tx.hash AS tx__hash").
+		TableExpr("(?) AS tx_authorization", query).
+		Join("LEFT JOIN address AS authority ON authority.id = tx_authorization.authority_id").
+		Join("LEFT JOIN address AS delegate ON delegate.id = tx_authorization.delegate_id").
+		Join("LEFT JOIN tx ON tx.id = tx_authorization.tx_id")
+
+	outerQuery = sortScope(outerQuery, "tx_authorization.id", filter.Sort)
+	err = outerQuery.Scan(ctx, &authorizations)
+	return
+}
@@ -0,0 +1,81 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/NobleScope/noble-indexer/internal/storage"
+	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
+)
+
+func (s *StorageTestSuite) TestTxAuthorizationByTxId() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	authorizations, err := s.storage.TxAuthorization.ByTxId(ctx, 6)
+	s.Require().NoError(err)
+	s.Require().Len(authorizations, 2)
+
+	s.Require().EqualValues(0, authorizations[0].Index)
+	s.Require().Equal("1", authorizations[0].ChainId.String())
+	s.Require().Equal("0x40f055506ba543ea0942dc8ca03f596ab75bc880", authorizations[0].Address.Hex())
+	s.Require().Equal("7", authorizations[0].Nonce.String())
+	s.Require().EqualValues(1, authorizations[0].YParity)
+	s.Require().Len(authorizations[0].R, 32)
+	s.Require().Len(authorizations[0].S, 32)
+
+	s.Require().EqualValues(1, authorizations[1].Index)
+	s.Require().True(authorizations[1].ChainId.IsZero())
+	s.Require().EqualValues(0, authorizations[1].YParity)
+}
+
+func (s *StorageTestSuite) TestTxAuthorizationByTxIdEmpty() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	authorizations, err := s.storage.TxAuthorization.ByTxId(ctx, 1)
+	s.Require().NoError(err)
+	s.Require().Empty(authorizations)
+}
+
+func (s *StorageTestSuite) TestTxAuthorizationFilter() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	authorizations, err := s.storage.TxAuthorization.Filter(ctx, storage.TxAuthorizationListFilter{
+		AuthorityId: 1,
+		Limit:       10,
+		Sort:        sdk.SortOrderDesc,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(authorizations, 2)
+
+	s.Require().EqualValues(3, authorizations[0].Id)
+	s.Require().EqualValues(100, authorizations[0].Height)
+	s.Require().True(authorizations[0].Valid)
+	s.Require().NotNil(authorizations[0].Delegate)
+	s.Require().Equal("0x50f055506ba543ea0942dc8ca03f596ab75bc881", authorizations[0].Delegate.Hash.Hex())
+	s.Require().Equal("0x804e70ed6e4802fdca023204cc7788239bd8e9fb93d7151271803a7b56691388", authorizations[0].Tx.Hash.Hex())
+
+	s.Require().EqualValues(1, authorizations[1].Id)
+	s.Require().EqualValues(200, authorizations[1].Height)
+	s.Require().NotNil(authorizations[1].Delegate)
+	s.Require().Equal("0x40f055506ba543ea0942dc8ca03f596ab75bc880", authorizations[1].Delegate.Hash.Hex())
+	s.Require().Equal("0x9fc549127c437a716b577c31c717abfda4c26bc72699480cd28178e745581d5b", authorizations[1].Tx.Hash.Hex())
+}
+
+func (s *StorageTestSuite) TestTxAuthorizationFilterWithCursor() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	authorizations, err := s.storage.TxAuthorization.Filter(ctx, storage.TxAuthorizationListFilter{
+		AuthorityId: 2,
+		Limit:       10,
+		Sort:        sdk.SortOrderAsc,
+		CursorID:    2,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(authorizations, 1)
+	s.Require().EqualValues(4, authorizations[0].Id)
+	s.Require().False(authorizations[0].Valid)
+}
@@ -2,6 +2,7 @@ package postgres
 
 import (
 	"context"
+	"encoding/json"
 	"time"
 
 	"github.com/NobleScope/noble-indexer/internal/storage"
@@ -446,6 +447,11 @@ func (s *StorageTestSuite) TestTxByHash() {
 	s.Require().NoError(err)
 	s.Require().EqualValues(1, tx.Id)
 	s.Require().EqualValues(hash, tx.Hash)
+	s.Require().NotNil(tx.MaxFeePerGas)
+	s.Require().Equal("20000000000", tx.MaxFeePerGas.String())
+	s.Require().NotNil(tx.MaxPriorityFeePerGas)
+	s.Require().Equal("1000000000", tx.MaxPriorityFeePerGas.String())
+	s.Require().Nil(tx.MaxFeePerBlobGas)
 }
 
 // TestTxFilterDifferentHeights tests filtering by different heights
@@ -625,3 +631,62 @@ func (s *StorageTestSuite) TestTxByHashWithoutABI() {
 	s.Require().EqualValues(2, tx.Id)
 	s.Require().Nil(tx.ToContractABI)
 }
+
+// TestTxByHashWithFacetABI tests ByHash returns ABI of the diamond facet implementing the called selector
+func (s *StorageTestSuite) TestTxByHashWithFacetABI() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	// Tx id=3 calls selector 0xa9059cbb of diamond id=4 implemented by facet id=3
+	hash := pkgTypes.MustDecodeHex("804e70ed6e4802fdca023204cc7788239bd8e9fb93d7151271803a7b56691388")
+	tx, err := s.storage.Tx.ByHash(ctx, hash, true)
+	s.Require().NoError(err)
+	s.Require().EqualValues(3, tx.Id)
+	s.Require().NotNil(tx.ToFacetABI)
+
+	// Tx id=2 calls contract id=3 which is not a diamond
+	hash = pkgTypes.MustDecodeHex("31a714df244649c3f7e9297ad1c2f7aaa2ccea799d390e4b150c0e813c2af3c7")
+	tx, err = s.storage.Tx.ByHash(ctx, hash, true)
+	s.Require().NoError(err)
+	s.Require().EqualValues(2, tx.Id)
+	s.Require().Nil(tx.ToFacetABI)
+}
+
+// TestTxByHashWithSignatures tests ByHash returns ABI fragments of all known signatures of the called selector
+func (s *StorageTestSuite) TestTxByHashWithSignatures() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	// Tx id=3 calls selector 0xa9059cbb which has two known signatures
+	hash := pkgTypes.MustDecodeHex("804e70ed6e4802fdca023204cc7788239bd8e9fb93d7151271803a7b56691388")
+	tx, err := s.storage.Tx.ByHash(ctx, hash, true)
+	s.Require().NoError(err)
+	s.Require().EqualValues(3, tx.Id)
+	s.Require().NotNil(tx.ToSignatures)
+
+	var fragments []map[string]any
+	s.Require().NoError(json.Unmarshal(tx.ToSignatures, &fragments))
+	s.Require().Len(fragments, 2)
+	s.Require().Equal("transfer", fragments[0]["name"])
+	s.Require().Equal("many_msg_babbage", fragments[1]["name"])
+}
+
+// TestTxByHashWithImplementationABI tests ByHash returns ABI of the proxy implementation at the tx height
+func (s *StorageTestSuite) TestTxByHashWithImplementationABI() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	// Tx id=11 calls proxy id=12 without upgrades history, current implementation id=3 is used
+	hash := pkgTypes.MustDecodeHex("cabf2872ac9d2e9b9ac153491bb581e4be337fc5a14e6b58e747720bab2fb3ca")
+	tx, err := s.storage.Tx.ByHash(ctx, hash, true)
+	s.Require().NoError(err)
+	s.Require().EqualValues(11, tx.Id)
+	s.Require().JSONEq(`{}`, string(tx.ToImplementationABI))
+
+	// Tx id=5 calls proxy id=6 at height 200 when implementation was id=4 without ABI
+	hash = pkgTypes.MustDecodeHex("518a97ecbe31927fd552cc4c9af117ecdbd1720599b361a36593640d55f0502a")
+	tx, err = s.storage.Tx.ByHash(ctx, hash, true)
+	s.Require().NoError(err)
+	s.Require().EqualValues(5, tx.Id)
+	s.Require().Nil(tx.ToImplementationABI)
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/NobleScope/noble-indexer/internal/storage/types"
 	"github.com/dipdup-net/go-lib/database"
 	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+	"github.com/uptrace/bun"
 )
 
 // VerificationTask -
@@ -42,3 +43,32 @@ func (t *VerificationTask) ByContractId(ctx context.Context, contractId uint64)
 
 	return
 }
+
+// ById - returns the task with the address of the verified contract
+func (t *VerificationTask) ById(ctx context.Context, id uint64) (task storage.VerificationTask, err error) {
+	err = t.DB().NewSelect().
+		Model(&task).
+		Relation("Address", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.Column("hash")
+		}).
+		Where("verification_task.id = ?", id).
+		Limit(1).
+		Scan(ctx)
+
+	return
+}
+
+// LatestByContractId - returns the last created task of the contract
+func (t *VerificationTask) LatestByContractId(ctx context.Context, contractId uint64) (task storage.VerificationTask, err error) {
+	err = t.DB().NewSelect().
+		Model(&task).
+		Relation("Address", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.Column("hash")
+		}).
+		Where("verification_task.contract_id = ?", contractId).
+		Order("verification_task.creation_time DESC", "verification_task.id DESC").
+		Limit(1).
+		Scan(ctx)
+
+	return
+}
@@ -192,3 +192,47 @@ func (s *StorageTestSuite) TestVerificationTaskAllStatuses() {
 		s.Require().EqualValues(expectedStatus, task.Status, "task_id: %d", id)
 	}
 }
+
+func (s *StorageTestSuite) TestVerificationTaskById() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	task, err := s.storage.VerificationTasks.ById(ctx, 3)
+	s.Require().NoError(err)
+	s.Require().EqualValues(3, task.Id)
+	s.Require().EqualValues(types.VerificationStatusFailed, task.Status)
+	s.Require().EqualValues(5, task.ContractId)
+	s.Require().EqualValues(5, task.Address.Id)
+	s.Require().NotEmpty(task.Address.Hash)
+	s.Require().EqualValues("bytecode verification failed: main parts do not match", task.Error)
+}
+
+func (s *StorageTestSuite) TestVerificationTaskByIdNotFound() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	_, err := s.storage.VerificationTasks.ById(ctx, 999)
+	s.Require().Error(err)
+	s.Require().True(s.storage.VerificationTasks.IsNoRows(err))
+}
+
+func (s *StorageTestSuite) TestVerificationTaskLatestByContractId() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	task, err := s.storage.VerificationTasks.LatestByContractId(ctx, 4)
+	s.Require().NoError(err)
+	s.Require().EqualValues(2, task.Id)
+	s.Require().EqualValues(types.VerificationStatusSuccess, task.Status)
+	s.Require().EqualValues(4, task.Address.Id)
+	s.Require().NotNil(task.CompletionTime)
+}
+
+func (s *StorageTestSuite) TestVerificationTaskLatestByContractIdNotFound() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	_, err := s.storage.VerificationTasks.LatestByContractId(ctx, 999)
+	s.Require().Error(err)
+	s.Require().True(s.storage.VerificationTasks.IsNoRows(err))
+}
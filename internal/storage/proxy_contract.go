@@ -39,9 +39,11 @@ type ProxyContract struct {
 	Status            types.ProxyStatus `bun:",type:proxy_status" comment:"Status of resolved implementation"`
 	ResolvingAttempts uint              `bun:"resolving_attempts" comment:"Count of resolving attempts"`
 	ImplementationID  *uint64           `bun:"implementation_id"  comment:"Internal implementation contract ID"`
+	BeaconId          *uint64           `bun:"beacon_id"          comment:"Internal beacon contract ID, empty if proxy is not a beacon proxy"`
 
 	Contract       Contract  `bun:"rel:has-one,join:id=id"`
 	Implementation *Contract `bun:"rel:belongs-to,join:implementation_id=id"`
+	Beacon         *Address  `bun:"rel:belongs-to,join:beacon_id=id"`
 }
 
 // TableName -
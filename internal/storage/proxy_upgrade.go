@@ -0,0 +1,55 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/NobleScope/noble-indexer/internal/storage/types"
+	pkgTypes "github.com/NobleScope/noble-indexer/pkg/types"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/uptrace/bun"
+)
+
+type ProxyUpgradeListFilter struct {
+	Limit    int
+	Offset   int
+	Sort     storage.SortOrder
+	ProxyId  uint64
+	Type     []types.ProxyUpgradeType
+	CursorID uint64
+}
+
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
+type IProxyUpgrade interface {
+	storage.Table[*ProxyUpgrade]
+
+	Filter(ctx context.Context, filter ProxyUpgradeListFilter) ([]ProxyUpgrade, error)
+}
+
+// ProxyUpgrade - EIP-1967 Upgraded, BeaconUpgraded or AdminChanged event emitted by proxy contract
+type ProxyUpgrade struct {
+	bun.BaseModel `bun:"proxy_upgrade" comment:"Table with proxy contracts upgrade history"`
+
+	Id               uint64                 `bun:",pk,autoincrement"        comment:"Unique internal identity"`
+	Height           pkgTypes.Level         `bun:"height"                   comment:"Block height"`
+	Time             time.Time              `bun:"time,pk,notnull"          comment:"Time of block"`
+	Type             types.ProxyUpgradeType `bun:",type:proxy_upgrade_type" comment:"Upgrade event type"`
+	ProxyId          uint64                 `bun:"proxy_id"                 comment:"Proxy contract id"`
+	ImplementationId *uint64                `bun:"implementation_id"        comment:"New implementation contract id"`
+	BeaconId         *uint64                `bun:"beacon_id"                comment:"New beacon contract id"`
+	PreviousAdminId  *uint64                `bun:"previous_admin_id"        comment:"Previous admin address id"`
+	AdminId          *uint64                `bun:"admin_id"                 comment:"New admin address id"`
+	TxId             uint64                 `bun:"tx_id"                    comment:"Transaction id"`
+
+	Proxy          Address  `bun:"rel:belongs-to,join:proxy_id=id"`
+	Implementation *Address `bun:"rel:belongs-to,join:implementation_id=id"`
+	Beacon         *Address `bun:"rel:belongs-to,join:beacon_id=id"`
+	PreviousAdmin  *Address `bun:"rel:belongs-to,join:previous_admin_id=id"`
+	Admin          *Address `bun:"rel:belongs-to,join:admin_id=id"`
+	Tx             Tx       `bun:"rel:belongs-to,join:tx_id=id"`
+}
+
+// TableName -
+func (ProxyUpgrade) TableName() string {
+	return "proxy_upgrade"
+}
@@ -0,0 +1,40 @@
+package storage
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/NobleScope/noble-indexer/internal/storage/types"
+	pkgTypes "github.com/NobleScope/noble-indexer/pkg/types"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/uptrace/bun"
+)
+
+type SignatureListFilter struct {
+	Limit    int
+	Offset   int
+	Selector pkgTypes.Hex
+}
+
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
+type ISignature interface {
+	storage.Table[*Signature]
+
+	Filter(ctx context.Context, filter SignatureListFilter) ([]Signature, error)
+}
+
+// Signature - text signature of the function selector or the event topic
+type Signature struct {
+	bun.BaseModel `bun:"signature" comment:"Table with function and event signatures"`
+
+	Id        uint64              `bun:"id,pk,notnull,autoincrement"                      comment:"Unique internal id"`
+	Selector  pkgTypes.Hex        `bun:"selector,type:bytea,notnull,unique:signature_idx" comment:"Function selector or event topic"`
+	Signature string              `bun:"signature,notnull,unique:signature_idx"           comment:"Text signature"`
+	Type      types.SignatureType `bun:",type:signature_type"                             comment:"Signature type"`
+	ABI       json.RawMessage     `bun:"abi,type:jsonb"                                   comment:"ABI fragment of the function or the event"`
+}
+
+// TableName -
+func (Signature) TableName() string {
+	return "signature"
+}
@@ -26,12 +26,13 @@ type ISource interface {
 type Source struct {
 	bun.BaseModel `bun:"source" comment:"Table with contract sources."`
 
-	Id         uint64   `bun:"id,pk,notnull,autoincrement" comment:"Unique internal identity"`
-	Name       string   `bun:"name"                        comment:"Source name"`
-	License    string   `bun:"license"                     comment:"License"`
-	Urls       []string `bun:"urls,array"                  comment:"Links to sources"`
-	Content    string   `bun:"content,type:text"           comment:"Content"`
-	ContractId uint64   `bun:"contract_id"                 comment:"Contract id"`
+	Id         uint64            `bun:"id,pk,notnull,autoincrement" comment:"Unique internal identity"`
+	Name       string            `bun:"name"                        comment:"Source name"`
+	License    string            `bun:"license"                     comment:"License"`
+	Urls       []string          `bun:"urls,array"                  comment:"Links to sources"`
+	Content    string            `bun:"content,type:text"           comment:"Content"`
+	ContractId uint64            `bun:"contract_id"                 comment:"Contract id"`
+	Libraries  map[string]string `bun:"libraries,type:jsonb"        comment:"Addresses of libraries of the source linked to the contract"`
 }
 
 // TableName -
@@ -2,6 +2,7 @@ package storage
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/NobleScope/noble-indexer/pkg/types"
@@ -14,6 +15,7 @@ type IState interface {
 	storage.Table[*State]
 
 	ByName(ctx context.Context, name string) (State, error)
+	Ranges(ctx context.Context, name string) ([]State, error)
 }
 
 // State -
@@ -31,9 +33,33 @@ type State struct {
 	TotalVerifiedContracts int64       `bun:"total_verified_contracts" comment:"Verified contracts count"`
 	TotalTokens            int64       `bun:"total_tokens"             comment:"Tokens count"`
 	ChainId                int64       `bun:"chain_id"                 comment:"Noble chain id"`
+	StartHeight            types.Level `bun:"start_height"             comment:"First block height of the range indexer"`
+	EndHeight              types.Level `bun:"end_height"               comment:"Last block height of the range indexer, zero for the canonical state"`
+	SafeHeight             types.Level `bun:"safe_height"              comment:"Height of the last safe block of the node"`
+	FinalizedHeight        types.Level `bun:"finalized_height"         comment:"Height of the last finalized block of the node"`
 }
 
 // TableName -
 func (State) TableName() string {
 	return "state"
 }
+
+// RangeStatePrefix returns the prefix of names of range states of the indexer
+func RangeStatePrefix(name string) string {
+	return name + ":"
+}
+
+// RangeStateName returns the name of the state of the range indexer
+func RangeStateName(name string, start, end types.Level) string {
+	return fmt.Sprintf("%s%d-%d", RangeStatePrefix(name), start, end)
+}
+
+// IsRange returns true if the state belongs to the range indexer
+func (s State) IsRange() bool {
+	return s.EndHeight > 0
+}
+
+// IsRangeIndexed returns true if all blocks of the range are indexed
+func (s State) IsRangeIndexed() bool {
+	return s.IsRange() && s.LastHeight >= s.EndHeight
+}
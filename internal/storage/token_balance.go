@@ -16,6 +16,7 @@ type TokenBalanceListFilter struct {
 	AddressId  *uint64
 	ContractId *uint64
 	TokenId    *decimal.Decimal
+	MaxHeight  *uint64
 }
 
 //go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
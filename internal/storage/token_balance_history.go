@@ -0,0 +1,53 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	pkgTypes "github.com/NobleScope/noble-indexer/pkg/types"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/shopspring/decimal"
+	"github.com/uptrace/bun"
+)
+
+type TokenBalanceHistoryListFilter struct {
+	Limit      int
+	Offset     int
+	Sort       storage.SortOrder
+	AddressId  *uint64
+	ContractId *uint64
+	TokenId    *decimal.Decimal
+	MaxHeight  *uint64
+	CursorTime time.Time
+	CursorID   uint64
+}
+
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
+type ITokenBalanceHistory interface {
+	storage.Table[*TokenBalanceHistory]
+
+	Filter(ctx context.Context, filter TokenBalanceHistoryListFilter) ([]TokenBalanceHistory, error)
+	AtHeight(ctx context.Context, addressId, contractId uint64, tokenId decimal.Decimal, height pkgTypes.Level) (TokenBalanceHistory, error)
+}
+
+// TokenBalanceHistory - snapshot of the token balance after the block changing it
+type TokenBalanceHistory struct {
+	bun.BaseModel `bun:"token_balance_history" comment:"Table with historical snapshots of addresses token balances."`
+
+	Id         uint64          `bun:",pk,notnull,autoincrement" comment:"Unique internal identity"`
+	Height     pkgTypes.Level  `bun:"height,notnull"            comment:"The number (height) of block"`
+	Time       time.Time       `bun:"time,pk,notnull"           comment:"The time of block"`
+	TokenID    decimal.Decimal `bun:"token_id,type:numeric"     comment:"Token ID"`
+	ContractID uint64          `bun:"contract_id,notnull"       comment:"Contract address id"`
+	AddressID  uint64          `bun:"address_id,notnull"        comment:"Address ID"`
+	Balance    decimal.Decimal `bun:"balance,type:numeric"      comment:"Token balance after the block"`
+	Delta      decimal.Decimal `bun:"delta,type:numeric"        comment:"Token balance change in the block"`
+
+	Contract Contract `bun:"rel:belongs-to,join:contract_id=id"`
+	Address  Address  `bun:"rel:belongs-to,join:address_id=id"`
+}
+
+// TableName -
+func (TokenBalanceHistory) TableName() string {
+	return "token_balance_history"
+}
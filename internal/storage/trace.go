@@ -17,6 +17,7 @@ type TraceListFilter struct {
 	Offset        int
 	Sort          storage.SortOrder
 	Height        *uint64
+	MaxHeight     *uint64
 	TxId          *uint64
 	AddressFromId *uint64
 	AddressToId   *uint64
@@ -35,6 +36,7 @@ type ITrace interface {
 
 	Filter(ctx context.Context, filter TraceListFilter) (traces []*Trace, err error)
 	ByTxId(ctx context.Context, txId uint64, withABI bool) (traces []*Trace, err error)
+	CreationByContractId(ctx context.Context, contractId uint64) (Trace, error)
 }
 
 // Trace -
@@ -71,10 +73,35 @@ type Trace struct {
 	Contract    *Contract `bun:"rel:belongs-to,join:contract_id=id"`
 	Tx          *Tx       `bun:"rel:belongs-to,join:tx_id=id"`
 
-	ToContractABI json.RawMessage `bun:"to_contract_abi,scanonly"`
+	ToContractABI       json.RawMessage `bun:"to_contract_abi,scanonly"`
+	ToImplementationABI json.RawMessage `bun:"to_implementation_abi,scanonly"`
+	ToFacetABI          json.RawMessage `bun:"to_facet_abi,scanonly"`
+	ToSignatures        json.RawMessage `bun:"to_signatures,scanonly"`
 }
 
 // TableName -
 func (Trace) TableName() string {
 	return "trace"
 }
+
+// Columns - columns of the trace table filled by COPY
+func (Trace) Columns() []string {
+	return []string{
+		"height", "time", "tx_id", "from_address_id", "to_address_id", "gas_limit", "amount", "input",
+		"tx_position", "trace_address", "type", "call_type", "init_hash", "creation_method",
+		"gas_used", "output", "contract_id", "error", "subtraces",
+	}
+}
+
+// Flat - values of the trace in the order of Columns encoded the same way as bun does
+func (t Trace) Flat() []any {
+	var initHash any
+	if t.InitHash != nil {
+		initHash = nullBytes(*t.InitHash)
+	}
+	return []any{
+		t.Height, t.Time, t.TxId, t.From, t.To, t.GasLimit, t.Amount, nullBytes(t.Input),
+		t.TxPosition, nullJSON(marshalSlice(t.TraceAddress)), t.Type, t.CallType, initHash, t.CreationMethod,
+		t.GasUsed, nullBytes(t.Output), t.ContractId, t.Error, t.Subtraces,
+	}
+}
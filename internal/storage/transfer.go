@@ -17,6 +17,7 @@ type TransferListFilter struct {
 	Offset        int
 	Sort          storage.SortOrder
 	Height        *uint64
+	MaxHeight     *uint64
 	TxId          *uint64
 	Type          []types.TransferType
 	AddressFromId *uint64
@@ -17,6 +17,7 @@ type TxListFilter struct {
 	Offset        int
 	Sort          storage.SortOrder
 	Height        *uint64
+	MaxHeight     *uint64
 	Type          []types.TxType
 	Status        []types.TxStatus
 	AddressFromId *uint64
@@ -35,6 +36,7 @@ type ITx interface {
 	storage.Table[*Tx]
 
 	ByHeight(ctx context.Context, height pkgTypes.Level, limit, offset int, order storage.SortOrder) ([]*Tx, error)
+	AllByHeight(ctx context.Context, height pkgTypes.Level) ([]Tx, error)
 	ByHash(ctx context.Context, hash pkgTypes.Hex, withABI bool) (Tx, error)
 	Filter(ctx context.Context, filter TxListFilter) ([]Tx, error)
 }
@@ -56,6 +58,14 @@ type Tx struct {
 	Type     types.TxType    `bun:",type:tx_type"          comment:"Transaction type"`
 	Input    []byte          `bun:"input"                  comment:"Transaction input"`
 
+	MaxFeePerGas         *decimal.Decimal `bun:"max_fee_per_gas,type:numeric"          comment:"Max fee per gas (EIP-1559)"`
+	MaxPriorityFeePerGas *decimal.Decimal `bun:"max_priority_fee_per_gas,type:numeric" comment:"Max priority fee per gas (EIP-1559)"`
+	MaxFeePerBlobGas     *decimal.Decimal `bun:"max_fee_per_blob_gas,type:numeric"     comment:"Max fee per blob gas (EIP-4844)"`
+	BlobVersionedHashes  []pkgTypes.Hex   `bun:"blob_versioned_hashes,type:bytea"      comment:"Blob versioned hashes (EIP-4844)"`
+	BlobGasUsed          *decimal.Decimal `bun:"blob_gas_used,type:numeric"            comment:"Blob gas used (EIP-4844)"`
+	BlobGasPrice         *decimal.Decimal `bun:"blob_gas_price,type:numeric"           comment:"Blob gas price (EIP-4844)"`
+	BlobFee              *decimal.Decimal `bun:"blob_fee,type:numeric"                 comment:"Blob fee in Wei, burned completely (EIP-4844)"`
+
 	CumulativeGasUsed decimal.Decimal `bun:"cumulative_gas_used,type:numeric" comment:"Cumulative gas used"`
 	EffectiveGasPrice decimal.Decimal `bun:"effective_gas_price,type:numeric" comment:"Effective gas price"`
 	FromAddressId     uint64          `bun:"from_address_id"                  comment:"From address id"`
@@ -68,12 +78,21 @@ type Tx struct {
 	LogsCount   int `bun:"logs_count"   comment:"Logs count"`
 	TracesCount int `bun:"traces_count" comment:"Traces count"`
 
-	FromAddress Address     `bun:"rel:belongs-to,join:from_address_id=id"`
-	ToAddress   *Address    `bun:"rel:belongs-to,join:to_address_id=id"`
-	Logs        []*Log      `bun:"rel:has-many"`
-	Transfers   []*Transfer `bun:"rel:has-many"`
+	FromAddress   Address         `bun:"rel:belongs-to,join:from_address_id=id"`
+	ToAddress     *Address        `bun:"rel:belongs-to,join:to_address_id=id"`
+	Logs          []*Log          `bun:"rel:has-many"`
+	Transfers     []*Transfer     `bun:"rel:has-many"`
+	Approvals     []*Approval     `bun:"rel:has-many"`
+	ProxyUpgrades []*ProxyUpgrade `bun:"rel:has-many"`
+	DiamondCuts   []*DiamondCut   `bun:"rel:has-many"`
+
+	AccessList     []*TxAccessList    `bun:"rel:has-many"`
+	Authorizations []*TxAuthorization `bun:"rel:has-many"`
 
-	ToContractABI json.RawMessage `bun:"to_contract_abi,scanonly"`
+	ToContractABI       json.RawMessage `bun:"to_contract_abi,scanonly"`
+	ToImplementationABI json.RawMessage `bun:"to_implementation_abi,scanonly"`
+	ToFacetABI          json.RawMessage `bun:"to_facet_abi,scanonly"`
+	ToSignatures        json.RawMessage `bun:"to_signatures,scanonly"`
 }
 
 // TableName -
@@ -0,0 +1,35 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	pkgTypes "github.com/NobleScope/noble-indexer/pkg/types"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/uptrace/bun"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
+type ITxAccessList interface {
+	storage.Table[*TxAccessList]
+
+	ByTxId(ctx context.Context, txId uint64) ([]TxAccessList, error)
+}
+
+// TxAccessList - EIP-2930 access list entry of the typed transaction
+type TxAccessList struct {
+	bun.BaseModel `bun:"tx_access_list" comment:"Table with transaction access lists."`
+
+	Id          uint64         `bun:"id,pk,notnull,autoincrement" comment:"Unique internal id"`
+	Height      pkgTypes.Level `bun:"height,notnull"              comment:"The number (height) of this block"`
+	Time        time.Time      `bun:"time,pk,notnull"             comment:"The time of block"`
+	TxId        uint64         `bun:"tx_id"                       comment:"Transaction id"`
+	Index       int64          `bun:"index"                       comment:"Index in the access list"`
+	Address     pkgTypes.Hex   `bun:"address,type:bytea"          comment:"Accessed address"`
+	StorageKeys []pkgTypes.Hex `bun:"storage_keys,type:bytea"     comment:"Accessed storage keys"`
+}
+
+// TableName -
+func (TxAccessList) TableName() string {
+	return "tx_access_list"
+}
@@ -0,0 +1,57 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	pkgTypes "github.com/NobleScope/noble-indexer/pkg/types"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/shopspring/decimal"
+	"github.com/uptrace/bun"
+)
+
+type TxAuthorizationListFilter struct {
+	Limit       int
+	Offset      int
+	Sort        storage.SortOrder
+	AuthorityId uint64
+	CursorID    uint64
+}
+
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
+type ITxAuthorization interface {
+	storage.Table[*TxAuthorization]
+
+	ByTxId(ctx context.Context, txId uint64) ([]TxAuthorization, error)
+	Filter(ctx context.Context, filter TxAuthorizationListFilter) ([]TxAuthorization, error)
+}
+
+// TxAuthorization - EIP-7702 authorization tuple of the set code transaction
+type TxAuthorization struct {
+	bun.BaseModel `bun:"tx_authorization" comment:"Table with set code transaction authorizations."`
+
+	Id      uint64          `bun:"id,pk,notnull,autoincrement" comment:"Unique internal id"`
+	Height  pkgTypes.Level  `bun:"height,notnull"              comment:"The number (height) of this block"`
+	Time    time.Time       `bun:"time,pk,notnull"             comment:"The time of block"`
+	TxId    uint64          `bun:"tx_id"                       comment:"Transaction id"`
+	Index   int64           `bun:"index"                       comment:"Index in the authorization list"`
+	ChainId decimal.Decimal `bun:"chain_id,type:numeric"       comment:"Chain id the authorization is valid for"`
+	Address pkgTypes.Hex    `bun:"address,type:bytea"          comment:"Delegate address"`
+	Nonce   decimal.Decimal `bun:"nonce,type:numeric"          comment:"Authority nonce"`
+	YParity int64           `bun:"y_parity"                    comment:"Signature y parity"`
+	R       pkgTypes.Hex    `bun:"r,type:bytea"                comment:"Signature r"`
+	S       pkgTypes.Hex    `bun:"s,type:bytea"                comment:"Signature s"`
+
+	AuthorityId *uint64 `bun:"authority_id" comment:"Recovered authority address id"`
+	DelegateId  *uint64 `bun:"delegate_id"  comment:"Delegate address id, empty if the delegation is cleared"`
+	Valid       bool    `bun:"valid"        comment:"Authorization passed signature and chain id checks"`
+
+	Authority *Address `bun:"rel:belongs-to,join:authority_id=id"`
+	Delegate  *Address `bun:"rel:belongs-to,join:delegate_id=id"`
+	Tx        Tx       `bun:"rel:belongs-to,join:tx_id=id"`
+}
+
+// TableName -
+func (TxAuthorization) TableName() string {
+	return "tx_authorization"
+}
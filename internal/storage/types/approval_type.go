@@ -0,0 +1,12 @@
+package types
+
+// swagger:enum ApprovalType
+/*
+	ENUM(
+		allowance
+		token_approval
+		approval_for_all
+	)
+*/
+//go:generate go-enum --marshal --sql --values --noprefix --names
+type ApprovalType string
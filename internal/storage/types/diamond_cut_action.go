@@ -0,0 +1,12 @@
+package types
+
+// swagger:enum DiamondCutAction
+/*
+	ENUM(
+		add
+		replace
+		remove
+	)
+*/
+//go:generate go-enum --marshal --sql --values --noprefix --names
+type DiamondCutAction string
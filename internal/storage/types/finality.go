@@ -0,0 +1,12 @@
+package types
+
+// swagger:enum Finality
+/*
+	ENUM(
+		latest
+		safe
+		finalized
+	)
+*/
+//go:generate go-enum --marshal --sql --values --noprefix --names
+type Finality string
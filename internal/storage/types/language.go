@@ -0,0 +1,11 @@
+package types
+
+// swagger:enum Language
+/*
+	ENUM(
+		solidity
+		vyper
+	)
+*/
+//go:generate go-enum --marshal --sql --values --noprefix --names
+type Language string
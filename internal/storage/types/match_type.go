@@ -0,0 +1,12 @@
+package types
+
+// swagger:enum MatchType
+/*
+	ENUM(
+		full
+		partial
+		similar
+	)
+*/
+//go:generate go-enum --marshal --sql --values --noprefix --names
+type MatchType string
@@ -9,6 +9,7 @@ package types
 		EIP1967
 		custom
 		clone_with_immutable_args
+		diamond
 	)
 */
 //go:generate go-enum --marshal --sql --values --noprefix --names
@@ -25,6 +25,8 @@ const (
 	Custom ProxyType = "custom"
 	// CloneWithImmutableArgs is a ProxyType of type clone_with_immutable_args.
 	CloneWithImmutableArgs ProxyType = "clone_with_immutable_args"
+	// Diamond is a ProxyType of type diamond.
+	Diamond ProxyType = "diamond"
 )
 
 var ErrInvalidProxyType = fmt.Errorf("not a valid ProxyType, try [%s]", strings.Join(_ProxyTypeNames, ", "))
@@ -36,6 +38,7 @@ var _ProxyTypeNames = []string{
 	string(EIP1967),
 	string(Custom),
 	string(CloneWithImmutableArgs),
+	string(Diamond),
 }
 
 // ProxyTypeNames returns a list of possible string values of ProxyType.
@@ -54,6 +57,7 @@ func ProxyTypeValues() []ProxyType {
 		EIP1967,
 		Custom,
 		CloneWithImmutableArgs,
+		Diamond,
 	}
 }
 
@@ -76,6 +80,7 @@ var _ProxyTypeValue = map[string]ProxyType{
 	"EIP1967":                   EIP1967,
 	"custom":                    Custom,
 	"clone_with_immutable_args": CloneWithImmutableArgs,
+	"diamond":                   Diamond,
 }
 
 // ParseProxyType attempts to convert a string to a ProxyType.
@@ -0,0 +1,12 @@
+package types
+
+// swagger:enum ProxyUpgradeType
+/*
+	ENUM(
+		upgraded
+		beacon_upgraded
+		admin_changed
+	)
+*/
+//go:generate go-enum --marshal --sql --values --noprefix --names
+type ProxyUpgradeType string
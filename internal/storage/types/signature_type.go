@@ -0,0 +1,11 @@
+package types
+
+// swagger:enum SignatureType
+/*
+	ENUM(
+		function
+		event
+	)
+*/
+//go:generate go-enum --marshal --sql --values --noprefix --names
+type SignatureType string
@@ -5,6 +5,7 @@ package types
 	ENUM(
 		TxTypeUnknown,
 		TxTypeLegacy,
+		TxTypeAccessList,
 		TxTypeDynamicFee,
 		TxTypeBlob,
 		TxTypeSetCode
@@ -17,6 +17,8 @@ const (
 	TxTypeUnknown TxType = "TxTypeUnknown"
 	// TxTypeLegacy is a TxType of type TxTypeLegacy.
 	TxTypeLegacy TxType = "TxTypeLegacy"
+	// TxTypeAccessList is a TxType of type TxTypeAccessList.
+	TxTypeAccessList TxType = "TxTypeAccessList"
 	// TxTypeDynamicFee is a TxType of type TxTypeDynamicFee.
 	TxTypeDynamicFee TxType = "TxTypeDynamicFee"
 	// TxTypeBlob is a TxType of type TxTypeBlob.
@@ -30,6 +32,7 @@ var ErrInvalidTxType = fmt.Errorf("not a valid TxType, try [%s]", strings.Join(_
 var _TxTypeNames = []string{
 	string(TxTypeUnknown),
 	string(TxTypeLegacy),
+	string(TxTypeAccessList),
 	string(TxTypeDynamicFee),
 	string(TxTypeBlob),
 	string(TxTypeSetCode),
@@ -47,6 +50,7 @@ func TxTypeValues() []TxType {
 	return []TxType{
 		TxTypeUnknown,
 		TxTypeLegacy,
+		TxTypeAccessList,
 		TxTypeDynamicFee,
 		TxTypeBlob,
 		TxTypeSetCode,
@@ -68,6 +72,7 @@ func (x TxType) IsValid() bool {
 var _TxTypeValue = map[string]TxType{
 	"TxTypeUnknown":    TxTypeUnknown,
 	"TxTypeLegacy":     TxTypeLegacy,
+	"TxTypeAccessList": TxTypeAccessList,
 	"TxTypeDynamicFee": TxTypeDynamicFee,
 	"TxTypeBlob":       TxTypeBlob,
 	"TxTypeSetCode":    TxTypeSetCode,
@@ -15,25 +15,36 @@ type IVerificationTask interface {
 
 	Latest(ctx context.Context) (VerificationTask, error)
 	ByContractId(ctx context.Context, contractId uint64) ([]VerificationTask, error)
+	ById(ctx context.Context, id uint64) (VerificationTask, error)
+	LatestByContractId(ctx context.Context, contractId uint64) (VerificationTask, error)
 }
 
 // VerificationTask -
 type VerificationTask struct {
 	bun.BaseModel `bun:"verification_task" comment:"Table with contract verification tasks"`
 
-	Id                  uint64                       `bun:",pk,autoincrement"                    comment:"Unique internal identity"`
-	Status              types.VerificationTaskStatus `bun:"status,type:verification_task_status" comment:"Verification task status"`
-	CreationTime        time.Time                    `bun:"creation_time,default:now()"          comment:"Task creation time"`
-	CompletionTime      *time.Time                   `bun:"completion_time,nullzero"             comment:"Task completion time"`
-	ContractId          uint64                       `bun:"contract_id"                          comment:"Contract id"`
-	ContractName        string                       `bun:"contract_name,notnull"                comment:"Contract name in Solidity source"`
-	CompilerVersion     string                       `bun:"compiler_version,notnull"             comment:"Compiler version"`
-	LicenseType         types.LicenseType            `bun:"license_type,type:license_type"       comment:"License type"`
-	OptimizationEnabled *bool                        `bun:"optimization_enabled"                 comment:"Optimization enabled"`
-	OptimizationRuns    *uint                        `bun:"optimization_runs"                    comment:"Optimization runs"`
-	EVMVersion          *types.EVMVersion            `bun:"evm_version,type:evm_version"         comment:"EVM version"`
-	ViaIR               bool                         `bun:"via_ir"                               comment:"Compile via Yul IR pipeline"`
-	Error               string                       `bun:"error"                                comment:"Error message if verification failed"`
+	Id                  uint64                       `bun:",pk,autoincrement"                                              comment:"Unique internal identity"`
+	Status              types.VerificationTaskStatus `bun:"status,type:verification_task_status"                           comment:"Verification task status"`
+	CreationTime        time.Time                    `bun:"creation_time,default:now()"                                    comment:"Task creation time"`
+	StartTime           *time.Time                   `bun:"start_time,nullzero"                                            comment:"Time when the task was claimed by a verification worker"`
+	CompletionTime      *time.Time                   `bun:"completion_time,nullzero"                                       comment:"Task completion time"`
+	ContractId          uint64                       `bun:"contract_id"                                                    comment:"Contract id"`
+	ContractName        string                       `bun:"contract_name,notnull"                                          comment:"Contract name in Solidity source or fully-qualified name for standard JSON input"`
+	CompilerVersion     string                       `bun:"compiler_version,notnull"                                       comment:"Compiler version"`
+	LicenseType         types.LicenseType            `bun:"license_type,type:license_type"                                 comment:"License type"`
+	OptimizationEnabled *bool                        `bun:"optimization_enabled"                                           comment:"Optimization enabled"`
+	OptimizationRuns    *uint                        `bun:"optimization_runs"                                              comment:"Optimization runs"`
+	EVMVersion          *types.EVMVersion            `bun:"evm_version,type:evm_version"                                   comment:"EVM version"`
+	ViaIR               bool                         `bun:"via_ir"                                                         comment:"Compile via Yul IR pipeline"`
+	StandardJSON        bool                         `bun:"standard_json,notnull,default:false"                            comment:"Task contains a single compiler standard JSON input file"`
+	Language            types.Language               `bun:"language,type:verification_language,notnull,default:'solidity'" comment:"Source code language"`
+	OptimizationMode    *string                      `bun:"optimization_mode"                                              comment:"Vyper optimization mode"`
+	Libraries           map[string]string            `bun:"libraries,type:jsonb"                                           comment:"Addresses of linked libraries by library name"`
+	Error               string                       `bun:"error"                                                          comment:"Error message if verification failed"`
+	CompilerOutput      string                       `bun:"compiler_output"                                                comment:"Compiler output of the failed compilation"`
+	Attempts            int                          `bun:"attempts,notnull,default:0"                                     comment:"Count of claims of the task by verification workers"`
+
+	Address Address `bun:"rel:belongs-to,join:contract_id=id"`
 }
 
 // TableName -
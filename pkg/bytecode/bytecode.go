@@ -0,0 +1,78 @@
+package bytecode
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const (
+	opPush1  = 0x60
+	opPush32 = 0x7f
+
+	// ImmutableSize is the size of the immutable value in the runtime code, immutables are pushed by PUSH32
+	ImmutableSize = 32
+)
+
+// StripMetadata returns the code without the CBOR-encoded metadata appended by the compiler.
+// The metadata is followed by its 2-byte length, the code is returned as is if it has no valid metadata.
+func StripMetadata(code []byte) []byte {
+	size := len(code)
+	if size < 2 {
+		return code
+	}
+	length := int(binary.BigEndian.Uint16(code[size-2:]))
+	start := size - 2 - length
+	// CBOR metadata is an array or a map
+	if length == 0 || start < 0 || code[start] < 0x80 || code[start] > 0xbf {
+		return code
+	}
+	return code[:start]
+}
+
+// NormalizedHash returns the keccak256 hash of the runtime code without metadata and with zeroed PUSH32 operands,
+// so contracts compiled from the same sources have the same hash regardless of metadata and immutable values.
+// Nil is returned for the empty code.
+func NormalizedHash(code []byte) []byte {
+	code = StripMetadata(code)
+	if len(code) == 0 {
+		return nil
+	}
+
+	normalized := bytes.Clone(code)
+	for i := 0; i < len(normalized); i++ {
+		op := normalized[i]
+		if op < opPush1 || op > opPush32 {
+			continue
+		}
+		size := int(op-opPush1) + 1
+		if op == opPush32 {
+			clear(normalized[i+1 : min(i+1+size, len(normalized))])
+		}
+		i += size
+	}
+	return crypto.Keccak256(normalized)
+}
+
+// Similar checks that codes are equal except metadata and immutable values. Immutables are offsets
+// of immutable values in the code.
+func Similar(a, b []byte, immutables []int64) bool {
+	a, b = StripMetadata(a), StripMetadata(b)
+	if len(a) != len(b) {
+		return false
+	}
+
+	prev := 0
+	for _, offset := range immutables {
+		start, end := int(offset), int(offset)+ImmutableSize
+		if start < prev || end > len(a) {
+			return false
+		}
+		if !bytes.Equal(a[prev:start], b[prev:start]) {
+			return false
+		}
+		prev = end
+	}
+	return bytes.Equal(a[prev:], b[prev:])
+}
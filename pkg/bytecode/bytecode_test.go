@@ -0,0 +1,48 @@
+package bytecode
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// metadata is the CBOR-encoded solc metadata with its length
+func metadata(hash byte) []byte {
+	cbor := append([]byte{0xa2, 0x64, 'i', 'p', 'f', 's', 0x58, 0x22}, bytes.Repeat([]byte{hash}, 34)...)
+	cbor = append(cbor, 0x64, 's', 'o', 'l', 'c', 0x43, 0x00, 0x08, 0x14)
+	return append(cbor, 0x00, byte(len(cbor)))
+}
+
+// code returns runtime code `PUSH1 0x80 PUSH32 <immutable> POP INVALID` with the metadata
+func code(immutable, hash byte) []byte {
+	result := []byte{0x60, 0x80, 0x7f}
+	result = append(result, bytes.Repeat([]byte{immutable}, 32)...)
+	result = append(result, 0x50, 0xfe)
+	return append(result, metadata(hash)...)
+}
+
+func TestStripMetadata(t *testing.T) {
+	require.Equal(t, code(1, 1)[:37], StripMetadata(code(1, 1)))
+	require.Equal(t, []byte{0x60, 0x80}, StripMetadata([]byte{0x60, 0x80}))
+	require.Equal(t, []byte{0x60, 0x80, 0x00, 0x10}, StripMetadata([]byte{0x60, 0x80, 0x00, 0x10}))
+	require.Empty(t, StripMetadata(nil))
+}
+
+func TestNormalizedHash(t *testing.T) {
+	hash := NormalizedHash(code(1, 1))
+	require.Len(t, hash, 32)
+	require.Equal(t, hash, NormalizedHash(code(2, 2)))
+	require.NotEqual(t, hash, NormalizedHash(append([]byte{0x00}, code(1, 1)...)))
+	require.Nil(t, NormalizedHash(nil))
+	require.Nil(t, NormalizedHash(metadata(1)))
+}
+
+func TestSimilar(t *testing.T) {
+	require.True(t, Similar(code(1, 1), code(1, 2), nil))
+	require.True(t, Similar(code(1, 1), code(2, 2), []int64{3}))
+	require.False(t, Similar(code(1, 1), code(2, 1), nil))
+	require.False(t, Similar(code(1, 1), code(2, 1), []int64{4}))
+	require.False(t, Similar(code(1, 1), code(1, 1)[:20], nil))
+	require.False(t, Similar(code(1, 1), code(1, 1), []int64{30}))
+}
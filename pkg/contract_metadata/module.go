@@ -14,6 +14,7 @@ import (
 	"github.com/NobleScope/noble-indexer/internal/storage/postgres"
 	"github.com/NobleScope/noble-indexer/internal/storage/types"
 	"github.com/NobleScope/noble-indexer/pkg/indexer/config"
+	"github.com/NobleScope/noble-indexer/pkg/signatures"
 	"github.com/dipdup-net/indexer-sdk/pkg/modules"
 	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
 	"github.com/pkg/errors"
@@ -212,6 +213,32 @@ func (m *Module) save(ctx context.Context, contracts []*storage.Contract, source
 	}
 	m.Log.Info().Int("count", len(sources)).Msg("contract sources saved")
 
+	contractSignatures := make([]*storage.Signature, 0)
+	contractIds := make([]uint64, 0)
+	for _, c := range contracts {
+		if c.ABI == nil {
+			continue
+		}
+		contractIds = append(contractIds, c.Id)
+
+		abiSignatures, err := signatures.FromABI(c.ABI)
+		if err != nil {
+			m.Log.Warn().Err(err).Str("contract", c.Address.String()).Msg("parse contract signatures")
+			continue
+		}
+		contractSignatures = append(contractSignatures, abiSignatures...)
+	}
+	if err := tx.SaveSignatures(ctx, contractSignatures...); err != nil {
+		return errors.Wrap(err, "save contract signatures")
+	}
+
+	if err := tx.ResetDecodedLogs(ctx, contractIds...); err != nil {
+		return errors.Wrap(err, "reset decoded logs")
+	}
+	if err := tx.ResetUnknownLogs(ctx, contractSignatures...); err != nil {
+		return errors.Wrap(err, "reset unknown logs")
+	}
+
 	if err := tx.Flush(ctx); err != nil {
 		return tx.HandleError(ctx, err)
 	}
@@ -0,0 +1,205 @@
+package compilers
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// ListFile is the name of the build list in the solc-bin format: https://binaries.soliditylang.org/linux-amd64/list.json
+const ListFile = "list.json"
+
+// Build is a compiler build from the build list.
+type Build struct {
+	Path        string `json:"path"`
+	Version     string `json:"version"`
+	Prerelease  string `json:"prerelease,omitempty"`
+	LongVersion string `json:"longVersion"`
+	SHA256      string `json:"sha256"`
+}
+
+// CompilerVersion returns the version in the format of verification tasks: `v0.8.20+commit.a1b79de6`
+func (b Build) CompilerVersion() string {
+	return "v" + b.LongVersion
+}
+
+type list struct {
+	Builds []Build `json:"builds"`
+}
+
+// Registry is a local registry of compiler binaries. Binaries are copied to the directory with the build list
+// or installed by Install and are validated by checksums of the list before the first use.
+type Registry struct {
+	dir    string
+	builds []Build
+
+	mx      sync.Mutex
+	checked map[string]struct{}
+}
+
+// NewRegistry loads the build list from the directory.
+func NewRegistry(dir string) (*Registry, error) {
+	data, err := os.ReadFile(filepath.Join(dir, ListFile))
+	if err != nil {
+		return nil, errors.Wrap(err, "read compiler list")
+	}
+	var l list
+	if err := json.Unmarshal(data, &l); err != nil {
+		return nil, errors.Wrap(err, "decode compiler list")
+	}
+
+	builds := make([]Build, 0, len(l.Builds))
+	for _, build := range l.Builds {
+		// nightly builds are not used for verification
+		if build.Prerelease != "" {
+			continue
+		}
+		if build.Path == "" || filepath.Base(build.Path) != build.Path {
+			return nil, errors.Errorf("invalid path of compiler %s: %q", build.LongVersion, build.Path)
+		}
+		builds = append(builds, build)
+	}
+	slices.SortFunc(builds, func(a, b Build) int {
+		return CompareVersions(b.Version, a.Version)
+	})
+
+	return &Registry{
+		dir:     dir,
+		builds:  builds,
+		checked: make(map[string]struct{}),
+	}, nil
+}
+
+// Versions returns builds installed to the directory from the newest to the oldest.
+func (r *Registry) Versions() []Build {
+	installed := make([]Build, 0, len(r.builds))
+	for _, build := range r.builds {
+		if r.installed(build) {
+			installed = append(installed, build)
+		}
+	}
+	return installed
+}
+
+// Builds returns all builds of the list from the newest to the oldest.
+func (r *Registry) Builds() []Build {
+	return slices.Clone(r.builds)
+}
+
+// Find returns the installed build of the compiler version. The version may contain the `v` prefix and the commit hash:
+// `v0.8.20+commit.a1b79de6`. The commit hash is checked only if it's specified.
+func (r *Registry) Find(compilerVersion string) (Build, bool) {
+	return r.find(compilerVersion, r.installed)
+}
+
+// Pinned returns the build of the compiler version from the build list. The build may be not installed.
+func (r *Registry) Pinned(compilerVersion string) (Build, bool) {
+	return r.find(compilerVersion, func(Build) bool { return true })
+}
+
+func (r *Registry) find(compilerVersion string, match func(Build) bool) (Build, bool) {
+	version, commit, _ := strings.Cut(strings.TrimPrefix(compilerVersion, "v"), "+commit.")
+	for _, build := range r.builds {
+		if build.Version != version {
+			continue
+		}
+		if commit != "" && build.LongVersion != fmt.Sprintf("%s+commit.%s", version, commit) {
+			continue
+		}
+		if match(build) {
+			return build, true
+		}
+	}
+	return Build{}, false
+}
+
+// Install validates the checksum of the downloaded file and moves it to the path of the build.
+// A file with another checksum is never installed.
+func (r *Registry) Install(build Build, file string) error {
+	if err := checksum(file, build.SHA256); err != nil {
+		return errors.Wrapf(err, "compiler %s", build.LongVersion)
+	}
+	if err := os.Chmod(file, 0755); err != nil {
+		return err
+	}
+	return os.Rename(file, filepath.Join(r.dir, build.Path))
+}
+
+// Binary returns the path of the compiler binary. The checksum of the binary is validated on the first call.
+func (r *Registry) Binary(compilerVersion string) (string, error) {
+	build, ok := r.Find(compilerVersion)
+	if !ok {
+		return "", errors.Errorf("compiler %s is not installed", compilerVersion)
+	}
+	binary := filepath.Join(r.dir, build.Path)
+
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	if _, ok := r.checked[binary]; ok {
+		return binary, nil
+	}
+	if err := checksum(binary, build.SHA256); err != nil {
+		return "", errors.Wrapf(err, "compiler %s", build.LongVersion)
+	}
+	r.checked[binary] = struct{}{}
+	return binary, nil
+}
+
+// Dir returns the directory of binaries and the build list.
+func (r *Registry) Dir() string {
+	return r.dir
+}
+
+func (r *Registry) installed(build Build) bool {
+	info, err := os.Stat(filepath.Join(r.dir, build.Path))
+	return err == nil && info.Mode().IsRegular()
+}
+
+func checksum(binary, expected string) error {
+	f, err := os.Open(binary)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return err
+	}
+	if actual := hex.EncodeToString(h.Sum(nil)); actual != strings.TrimPrefix(strings.ToLower(expected), "0x") {
+		return errors.Errorf("checksum mismatch: expected %s, got 0x%s", expected, actual)
+	}
+	return nil
+}
+
+// CompareVersions compares semantic versions `major.minor.patch`. Pre-release suffixes are ignored.
+func CompareVersions(a, b string) int {
+	partsA := strings.SplitN(a, ".", 3)
+	partsB := strings.SplitN(b, ".", 3)
+	for i := 0; i < 3; i++ {
+		var x, y int
+		if i < len(partsA) {
+			_, _ = fmt.Sscanf(partsA[i], "%d", &x)
+		}
+		if i < len(partsB) {
+			_, _ = fmt.Sscanf(partsB[i], "%d", &y)
+		}
+		switch {
+		case x < y:
+			return -1
+		case x > y:
+			return 1
+		}
+	}
+	return 0
+}
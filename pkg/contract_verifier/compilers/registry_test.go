@@ -0,0 +1,144 @@
+package compilers
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestRegistry(t *testing.T) (*Registry, string) {
+	t.Helper()
+	dir := t.TempDir()
+
+	binaries := map[string][]byte{
+		"solc-linux-amd64-v0.8.9+commit.e5eed63a":  []byte("solc 0.8.9"),
+		"solc-linux-amd64-v0.8.20+commit.a1b79de6": []byte("solc 0.8.20"),
+	}
+	for name, content := range binaries {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), content, 0755))
+	}
+	checksum := func(content []byte) string {
+		h := sha256.Sum256(content)
+		return "0x" + hex.EncodeToString(h[:])
+	}
+
+	data, err := json.Marshal(list{Builds: []Build{
+		{Path: "solc-linux-amd64-v0.8.9+commit.e5eed63a", Version: "0.8.9", LongVersion: "0.8.9+commit.e5eed63a", SHA256: checksum(binaries["solc-linux-amd64-v0.8.9+commit.e5eed63a"])},
+		{Path: "solc-linux-amd64-v0.8.19+commit.7dd6d404", Version: "0.8.19", LongVersion: "0.8.19+commit.7dd6d404", SHA256: "0x00"},
+		{Path: "solc-linux-amd64-v0.8.20+commit.a1b79de6", Version: "0.8.20", LongVersion: "0.8.20+commit.a1b79de6", SHA256: checksum(binaries["solc-linux-amd64-v0.8.20+commit.a1b79de6"])},
+		{Path: "solc-linux-amd64-v0.8.21-nightly.2023.5.30+commit.1b4bc4bb", Version: "0.8.21", Prerelease: "nightly.2023.5.30", LongVersion: "0.8.21-nightly.2023.5.30+commit.1b4bc4bb"},
+	}})
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ListFile), data, 0644))
+
+	registry, err := NewRegistry(dir)
+	require.NoError(t, err)
+	return registry, dir
+}
+
+func TestRegistryVersions(t *testing.T) {
+	registry, _ := newTestRegistry(t)
+
+	versions := registry.Versions()
+	require.Len(t, versions, 2)
+	require.Equal(t, "v0.8.20+commit.a1b79de6", versions[0].CompilerVersion())
+	require.Equal(t, "v0.8.9+commit.e5eed63a", versions[1].CompilerVersion())
+}
+
+func TestRegistryBuilds(t *testing.T) {
+	registry, _ := newTestRegistry(t)
+
+	builds := registry.Builds()
+	require.Len(t, builds, 3)
+	require.Equal(t, "0.8.20", builds[0].Version)
+	require.Equal(t, "0.8.19", builds[1].Version)
+	require.Equal(t, "0.8.9", builds[2].Version)
+}
+
+func TestRegistryFind(t *testing.T) {
+	registry, _ := newTestRegistry(t)
+
+	for _, version := range []string{"0.8.20", "v0.8.20", "v0.8.20+commit.a1b79de6"} {
+		build, ok := registry.Find(version)
+		require.True(t, ok, version)
+		require.Equal(t, "0.8.20+commit.a1b79de6", build.LongVersion, version)
+	}
+
+	// not installed, unknown commit, nightly
+	for _, version := range []string{"0.8.19", "v0.8.20+commit.00000000", "0.8.21", "0.7.6"} {
+		_, ok := registry.Find(version)
+		require.False(t, ok, version)
+	}
+}
+
+func TestRegistryBinary(t *testing.T) {
+	registry, dir := newTestRegistry(t)
+
+	binary, err := registry.Binary("v0.8.20+commit.a1b79de6")
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(dir, "solc-linux-amd64-v0.8.20+commit.a1b79de6"), binary)
+
+	_, err = registry.Binary("0.8.19")
+	require.ErrorContains(t, err, "is not installed")
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "solc-linux-amd64-v0.8.9+commit.e5eed63a"), []byte("modified"), 0755))
+	_, err = registry.Binary("0.8.9")
+	require.ErrorContains(t, err, "checksum mismatch")
+}
+
+func TestRegistryPinned(t *testing.T) {
+	registry, _ := newTestRegistry(t)
+
+	build, ok := registry.Pinned("v0.8.19+commit.7dd6d404")
+	require.True(t, ok)
+	require.Equal(t, "solc-linux-amd64-v0.8.19+commit.7dd6d404", build.Path)
+
+	_, ok = registry.Pinned("0.8.18")
+	require.False(t, ok)
+}
+
+func TestRegistryInstall(t *testing.T) {
+	registry, dir := newTestRegistry(t)
+
+	build, ok := registry.Pinned("0.8.19")
+	require.True(t, ok)
+
+	file := filepath.Join(dir, "download")
+	require.NoError(t, os.WriteFile(file, []byte("solc 0.8.19"), 0644))
+	require.ErrorContains(t, registry.Install(build, file), "checksum mismatch")
+	_, ok = registry.Find("0.8.19")
+	require.False(t, ok)
+
+	content := []byte("solc 0.8.19")
+	h := sha256.Sum256(content)
+	build.SHA256 = "0x" + hex.EncodeToString(h[:])
+	require.NoError(t, registry.Install(build, file))
+
+	info, err := os.Stat(filepath.Join(dir, build.Path))
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0755), info.Mode().Perm())
+	_, ok = registry.Find("0.8.19")
+	require.True(t, ok)
+}
+
+func TestNewRegistryInvalidPath(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ListFile), []byte(`{"builds":[{"path":"../solc","version":"0.8.20","longVersion":"0.8.20+commit.a1b79de6"}]}`), 0644))
+
+	_, err := NewRegistry(dir)
+	require.ErrorContains(t, err, "invalid path")
+
+	_, err = NewRegistry(t.TempDir())
+	require.ErrorContains(t, err, "read compiler list")
+}
+
+func TestCompareVersions(t *testing.T) {
+	require.Equal(t, 0, CompareVersions("0.8.20", "0.8.20"))
+	require.Equal(t, -1, CompareVersions("0.8.9", "0.8.20"))
+	require.Equal(t, 1, CompareVersions("0.10.0", "0.8.20"))
+}
@@ -0,0 +1,124 @@
+package contract_verifier
+
+import (
+	"bytes"
+	"encoding/hex"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/NobleScope/noble-indexer/pkg/contract_verifier/standard_json"
+	"github.com/pkg/errors"
+)
+
+// resolveLibraries adds addresses of libraries referenced by links to the map of fully-qualified library names.
+// Requested addresses are looked up by the fully-qualified or the short library name. Addresses of other libraries
+// are detected from the onchain bytecode at positions of their placeholders.
+func resolveLibraries(libraries, requested map[string]string, links []standard_json.Link, onchain []byte) error {
+	for _, link := range links {
+		var linked []byte
+		if link.Offset+link.Length <= len(onchain) {
+			linked = onchain[link.Offset : link.Offset+link.Length]
+		}
+
+		address, ok := libraries[link.Library]
+		if !ok {
+			address, ok = requestedLibrary(requested, link.Library)
+		}
+		if !ok {
+			if linked == nil {
+				return errors.Errorf("address of library %s is unknown", link.Library)
+			}
+			address = "0x" + hex.EncodeToString(linked)
+		}
+
+		if linked != nil {
+			value, err := hex.DecodeString(strings.TrimPrefix(address, "0x"))
+			if err != nil {
+				return errors.Wrapf(err, "invalid address of library %s", link.Library)
+			}
+			if !bytes.Equal(value, linked) {
+				return errors.Errorf("library %s is linked to 0x%x onchain, not to %s", link.Library, linked, address)
+			}
+		}
+		libraries[link.Library] = address
+	}
+	return nil
+}
+
+func requestedLibrary(requested map[string]string, library string) (string, bool) {
+	if address, ok := requested[library]; ok {
+		return address, true
+	}
+	_, name, _ := strings.Cut(library, ":")
+	address, ok := requested[name]
+	return address, ok
+}
+
+// linkRuntime replaces library placeholders of runtime bytecodes with library addresses
+func (c *compilation) linkRuntime(libraries map[string]string) error {
+	if err := link(c.runtime, c.runtimeLinks, libraries); err != nil {
+		return err
+	}
+	return link(c.altRuntime, c.altRuntimeLinks, libraries)
+}
+
+// linkConstructor replaces library placeholders of creation bytecodes with library addresses
+func (c *compilation) linkConstructor(libraries map[string]string) error {
+	if err := link(c.constructor, c.constructorLinks, libraries); err != nil {
+		return err
+	}
+	return link(c.altConstructor, c.altConstructorLinks, libraries)
+}
+
+func link(code []byte, links []standard_json.Link, libraries map[string]string) error {
+	for _, l := range links {
+		address, ok := libraries[l.Library]
+		if !ok {
+			return errors.Errorf("address of library %s is unknown", l.Library)
+		}
+		value, err := hex.DecodeString(strings.TrimPrefix(address, "0x"))
+		if err != nil {
+			return errors.Wrapf(err, "invalid address of library %s", l.Library)
+		}
+		if len(value) != l.Length || l.Offset+l.Length > len(code) {
+			return errors.Errorf("invalid link reference of library %s", l.Library)
+		}
+		copy(code[l.Offset:], value)
+	}
+	return nil
+}
+
+// sourceLibraries groups linked libraries by names of sources. Library paths are source unit names of the compiler
+// input, they are matched with uploaded file names by the base name if there is no source with the same name.
+func sourceLibraries(libraries map[string]string, sources []string) map[string]map[string]string {
+	result := make(map[string]map[string]string)
+	for library, address := range libraries {
+		idx := strings.LastIndex(library, ":")
+		if idx < 0 {
+			continue
+		}
+		libraryPath, name := library[:idx], library[idx+1:]
+
+		source := ""
+		if slices.Contains(sources, libraryPath) {
+			source = libraryPath
+		} else {
+			for i := range sources {
+				if path.Base(sources[i]) == path.Base(libraryPath) {
+					source = sources[i]
+					break
+				}
+			}
+		}
+		if source == "" {
+			continue
+		}
+
+		if result[source] == nil {
+			result[source] = make(map[string]string)
+		}
+		result[source][name] = address
+	}
+	return result
+}
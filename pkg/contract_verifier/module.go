@@ -2,13 +2,17 @@ package contract_verifier
 
 import (
 	"context"
-	"database/sql"
+	"os"
+	"path"
+	"slices"
 	"time"
 
 	"github.com/NobleScope/noble-indexer/internal/storage"
 	"github.com/NobleScope/noble-indexer/internal/storage/postgres"
 	"github.com/NobleScope/noble-indexer/internal/storage/types"
+	"github.com/NobleScope/noble-indexer/pkg/contract_verifier/compilers"
 	"github.com/NobleScope/noble-indexer/pkg/indexer/config"
+	"github.com/NobleScope/noble-indexer/pkg/signatures"
 	"github.com/dipdup-net/indexer-sdk/pkg/modules"
 	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
 	"github.com/pkg/errors"
@@ -17,27 +21,70 @@ import (
 type Module struct {
 	modules.BaseModule
 
-	pg         postgres.Storage
-	storage    sdk.Transactable
-	syncPeriod time.Duration
-	cfg        config.Config
+	pg          postgres.Storage
+	storage     sdk.Transactable
+	syncPeriod  time.Duration
+	workers     int
+	taskTimeout time.Duration
+	maxAttempts int
+	vyperDir    string
+	vyper       *compilers.Registry
+	compilers   *compilers.Registry
+	cfg         config.Config
 }
 
-func NewModule(pg postgres.Storage, cfg config.Config) *Module {
+func NewModule(pg postgres.Storage, cfg config.Config) (*Module, error) {
 	syncPeriod := time.Second * time.Duration(cfg.ContractVerifier.SyncPeriod)
 	if syncPeriod <= 0 {
 		syncPeriod = 30 * time.Second
 	}
+	workers := cfg.ContractVerifier.Workers
+	if workers <= 0 {
+		workers = 1
+	}
+	taskTimeout := time.Second * time.Duration(cfg.ContractVerifier.TaskTimeout)
+	if taskTimeout <= 0 {
+		taskTimeout = 10 * time.Minute
+	}
+	maxAttempts := cfg.ContractVerifier.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = 3
+	}
+	vyperDir := cfg.ContractVerifier.VyperDir
+	if vyperDir == "" {
+		vyperDir = ".vyper"
+	}
 
 	module := &Module{
-		BaseModule: modules.New("contract_verifier"),
-		pg:         pg,
-		storage:    pg.Transactable,
-		cfg:        cfg,
-		syncPeriod: syncPeriod,
+		BaseModule:  modules.New("contract_verifier"),
+		pg:          pg,
+		storage:     pg.Transactable,
+		cfg:         cfg,
+		syncPeriod:  syncPeriod,
+		workers:     workers,
+		taskTimeout: taskTimeout,
+		maxAttempts: maxAttempts,
+		vyperDir:    vyperDir,
+	}
+
+	// vyper binaries are downloaded only if their versions are pinned by the build list
+	vyper, err := compilers.NewRegistry(vyperDir)
+	switch {
+	case err == nil:
+		module.vyper = vyper
+	case !errors.Is(err, os.ErrNotExist):
+		return nil, errors.Wrap(err, "vyper registry")
+	}
+
+	if cfg.ContractVerifier.CompilersDir != "" {
+		registry, err := compilers.NewRegistry(cfg.ContractVerifier.CompilersDir)
+		if err != nil {
+			return nil, errors.Wrap(err, "compiler registry")
+		}
+		module.compilers = registry
 	}
 
-	return module
+	return module, nil
 }
 
 func (m *Module) Close() error {
@@ -48,79 +95,125 @@ func (m *Module) Close() error {
 }
 
 func (m *Module) Start(ctx context.Context) {
-	m.Log.Info().Msg("starting module...")
+	m.Log.Info().Int("workers", m.workers).Msg("starting module...")
 	m.G.GoCtx(ctx, m.receive)
 }
 
 func (m *Module) receive(ctx context.Context) {
-	if err := m.sync(ctx); err != nil {
-		m.Log.Err(err).Msg("sync")
+	seeder := signatures.NewSeeder(m.pg, m.Log)
+	if err := seeder.Seed(ctx, path.Join(m.cfg.Indexer.AssetsDir, "abi"), m.cfg.ContractVerifier.SignaturesDump); err != nil {
+		m.Log.Err(err).Msg("seed signatures")
+	}
+
+	for i := 0; i < m.workers; i++ {
+		m.G.GoCtx(ctx, m.work)
 	}
+	m.G.GoCtx(ctx, m.similar)
+}
 
+// work - verifies claimed tasks one by one and waits for the sync period when there are no tasks
+func (m *Module) work(ctx context.Context) {
 	ticker := time.NewTicker(m.syncPeriod)
 	defer ticker.Stop()
 
 	for {
+		if ctx.Err() != nil {
+			return
+		}
+
+		processed, err := m.sync(ctx)
+		if err != nil {
+			m.Log.Err(err).Msg("sync")
+		}
+		if processed {
+			continue
+		}
+
 		select {
 		case <-ticker.C:
-			if err := m.sync(ctx); err != nil {
-				m.Log.Err(err).Msg("sync")
-			}
 		case <-ctx.Done():
 			return
 		}
 	}
 }
 
-func (m *Module) sync(ctx context.Context) error {
-	task, err := m.pg.VerificationTasks.Latest(ctx)
+func (m *Module) sync(ctx context.Context) (bool, error) {
+	task, ok, err := m.claim(ctx)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			m.Log.Info().Msg("no tasks for contract verification")
-			return nil
-		}
-		return errors.Wrap(err, "get verification task")
+		return false, errors.Wrap(err, "claim verification task")
+	}
+	if !ok {
+		return false, nil
+	}
+
+	// the task is claimed again when its previous worker was stopped or failed to save the result
+	if task.Attempts > m.maxAttempts {
+		m.Log.Warn().
+			Uint64("task_id", task.Id).
+			Int("attempts", task.Attempts).
+			Msg("verification task exceeded max attempts")
+		return true, m.handleVerificationFailure(ctx, task, errors.Errorf("verification is not completed after %d attempts", m.maxAttempts))
 	}
 
 	m.Log.Info().
+		Uint64("task_id", task.Id).
 		Uint64("contract_id", task.ContractId).
 		Time("creation_time", task.CreationTime).
 		Msg("processing verification task")
 
 	files, err := m.pg.VerificationFiles.ByTaskId(ctx, task.Id)
 	if err != nil {
-		return errors.Wrap(err, "get verification files")
+		return true, errors.Wrap(err, "get verification files")
 	}
 
 	if len(files) == 0 {
 		m.Log.Warn().Uint64("task_id", task.Id).Msg("no files found for verification task")
-		if err := m.handleVerificationFailure(ctx, task, "no files found for verification task"); err != nil {
-			return err
-		}
-		return nil
+		return true, m.handleVerificationFailure(ctx, task, errors.New("no files found for verification task"))
 	}
 
+	start := time.Now()
 	result, verifyErr := m.verify(ctx, task, files)
 	if verifyErr != nil {
-		m.Log.Err(verifyErr).Msg("verification failed")
-		if err := m.handleVerificationFailure(ctx, task, verifyErr.Error()); err != nil {
-			return err
-		}
-		return nil
+		m.Log.Err(verifyErr).
+			Uint64("task_id", task.Id).
+			Dur("duration", time.Since(start)).
+			Msg("verification failed")
+		return true, m.handleVerificationFailure(ctx, task, verifyErr)
 	}
 
 	m.Log.Info().
+		Uint64("task_id", task.Id).
 		Uint64("contract_id", task.ContractId).
+		Dur("duration", time.Since(start)).
 		Msg("contract verified successfully")
 
-	if err := m.handleVerificationSuccess(ctx, task, files, result); err != nil {
-		return err
+	return true, m.handleVerificationSuccess(ctx, task, result)
+}
+
+// claim - locks the oldest task and marks it as pending, so other workers skip it
+func (m *Module) claim(ctx context.Context) (storage.VerificationTask, bool, error) {
+	tx, err := postgres.BeginTransaction(ctx, m.storage)
+	if err != nil {
+		return storage.VerificationTask{}, false, err
 	}
+	defer tx.Close(ctx)
 
-	return nil
+	tasks, err := tx.ClaimVerificationTasks(ctx, 1, time.Now().Add(-m.taskTimeout))
+	if err != nil {
+		return storage.VerificationTask{}, false, tx.HandleError(ctx, err)
+	}
+
+	if err := tx.Flush(ctx); err != nil {
+		return storage.VerificationTask{}, false, tx.HandleError(ctx, err)
+	}
+
+	if len(tasks) == 0 {
+		return storage.VerificationTask{}, false, nil
+	}
+	return tasks[0], true, nil
 }
 
-func (m *Module) handleVerificationSuccess(ctx context.Context, task storage.VerificationTask, files []storage.VerificationFile, result *VerificationResult) error {
+func (m *Module) handleVerificationSuccess(ctx context.Context, task storage.VerificationTask, result *VerificationResult) error {
 	tx, err := postgres.BeginTransaction(ctx, m.storage)
 	if err != nil {
 		return err
@@ -136,17 +229,28 @@ func (m *Module) handleVerificationSuccess(ctx context.Context, task storage.Ver
 	contract.ABI = result.ABI
 	contract.CompilerVersion = result.CompilerVersion
 	contract.Language = result.Language
+	contract.ConstructorArgs = result.ConstructorArgs
+	contract.MatchType = result.MatchType
+	contract.ImmutableRefs = result.ImmutableRefs
 	if task.OptimizationEnabled != nil {
 		contract.OptimizerEnabled = *task.OptimizationEnabled
 	}
 
-	sources := make([]*storage.Source, 0, len(files))
-	for _, file := range files {
+	names := make([]string, 0, len(result.Sources))
+	for name := range result.Sources {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	libraries := sourceLibraries(result.Libraries, names)
+	sources := make([]*storage.Source, 0, len(names))
+	for _, name := range names {
 		sources = append(sources, &storage.Source{
-			Name:       file.Name,
+			Name:       name,
 			License:    task.LicenseType.String(),
-			Content:    string(file.File),
+			Content:    result.Sources[name],
 			ContractId: task.ContractId,
+			Libraries:  libraries[name],
 		})
 	}
 
@@ -160,6 +264,25 @@ func (m *Module) handleVerificationSuccess(ctx context.Context, task storage.Ver
 		return errors.Wrap(err, "save contract sources")
 	}
 
+	contractSignatures, err := signatures.FromABI(result.ABI)
+	if err != nil {
+		return errors.Wrap(err, "parse contract signatures")
+	}
+	if err := tx.SaveSignatures(ctx, contractSignatures...); err != nil {
+		return errors.Wrap(err, "save contract signatures")
+	}
+
+	if err := tx.ResetDecodedLogs(ctx, contract.Id); err != nil {
+		return errors.Wrap(err, "reset decoded logs")
+	}
+	if err := tx.ResetUnknownLogs(ctx, contractSignatures...); err != nil {
+		return errors.Wrap(err, "reset unknown logs")
+	}
+
+	if err := tx.ResetSimilarChecked(ctx, contract.Id); err != nil {
+		return errors.Wrap(err, "reset similar checked")
+	}
+
 	task.Status = types.VerificationStatusSuccess
 	if err := tx.UpdateVerificationTask(ctx, &task); err != nil {
 		return errors.Wrap(err, "update task status")
@@ -169,8 +292,8 @@ func (m *Module) handleVerificationSuccess(ctx context.Context, task storage.Ver
 		return errors.Wrap(err, "delete verification files")
 	}
 
-	if err := m.updateState(ctx); err != nil {
-		m.Log.Err(err).Msg("update state")
+	if err := tx.IncrementVerifiedContracts(ctx, m.cfg.Indexer.Name, 1); err != nil {
+		return errors.Wrap(err, "update state")
 	}
 
 	if err := tx.Flush(ctx); err != nil {
@@ -180,7 +303,7 @@ func (m *Module) handleVerificationSuccess(ctx context.Context, task storage.Ver
 	return nil
 }
 
-func (m *Module) handleVerificationFailure(ctx context.Context, task storage.VerificationTask, errorMsg string) error {
+func (m *Module) handleVerificationFailure(ctx context.Context, task storage.VerificationTask, verifyErr error) error {
 	tx, err := postgres.BeginTransaction(ctx, m.storage)
 	if err != nil {
 		return err
@@ -188,7 +311,12 @@ func (m *Module) handleVerificationFailure(ctx context.Context, task storage.Ver
 	defer tx.Close(ctx)
 
 	task.Status = types.VerificationStatusFailed
-	task.Error = errorMsg
+	task.Error = verifyErr.Error()
+
+	var compilationErr *CompilationError
+	if errors.As(verifyErr, &compilationErr) {
+		task.CompilerOutput = compilationErr.Output
+	}
 	if err := tx.UpdateVerificationTask(ctx, &task); err != nil {
 		return errors.Wrap(err, "update task status")
 	}
@@ -203,18 +331,3 @@ func (m *Module) handleVerificationFailure(ctx context.Context, task storage.Ver
 
 	return nil
 }
-
-func (m *Module) updateState(ctx context.Context) error {
-	state, err := m.pg.State.ByName(ctx, m.cfg.Indexer.Name)
-	if err != nil {
-		return errors.Wrap(err, "get state")
-	}
-
-	state.TotalVerifiedContracts++
-
-	if err := m.pg.State.Update(ctx, &state); err != nil {
-		return errors.Wrap(err, "update state")
-	}
-
-	return nil
-}
@@ -0,0 +1,166 @@
+package contract_verifier
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/NobleScope/noble-indexer/internal/storage"
+	"github.com/NobleScope/noble-indexer/internal/storage/postgres"
+	"github.com/NobleScope/noble-indexer/internal/storage/types"
+	"github.com/NobleScope/noble-indexer/pkg/bytecode"
+	"github.com/pkg/errors"
+)
+
+const similarBatchSize = 100
+
+// similar - matches contracts with the same bytecode as verified ones. New contracts and contracts verified
+// by tasks are marked as pending, pending contracts are processed in batches and wait for the sync period
+// when there is nothing to process.
+func (m *Module) similar(ctx context.Context) {
+	ticker := time.NewTicker(m.syncPeriod)
+	defer ticker.Stop()
+
+	for {
+		if ctx.Err() != nil {
+			return
+		}
+
+		count, err := m.syncSimilar(ctx)
+		if err != nil {
+			m.Log.Err(err).Msg("sync similar contracts")
+		}
+		if err == nil && count == similarBatchSize {
+			continue
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (m *Module) syncSimilar(ctx context.Context) (int, error) {
+	contracts, err := m.pg.Contracts.SimilarPending(ctx, similarBatchSize)
+	if err != nil {
+		return 0, errors.Wrap(err, "get pending contracts")
+	}
+
+	for i := range contracts {
+		if err := m.matchSimilar(ctx, &contracts[i]); err != nil {
+			return i, errors.Wrapf(err, "match similar contracts of %d", contracts[i].Id)
+		}
+	}
+	return len(contracts), nil
+}
+
+// matchSimilar - verifies unverified contracts with the same bytecode as the contract if it is verified by sources,
+// otherwise looks for the contract verified by sources with the same bytecode. The normalized code hash is only
+// a filter: candidates are compared byte by byte ignoring metadata and immutables of the verified contract.
+func (m *Module) matchSimilar(ctx context.Context, contract *storage.Contract) error {
+	if contract.CodeHash == nil {
+		contract.CodeHash = bytecode.NormalizedHash(contract.Code)
+	}
+	if contract.CodeHash == nil {
+		return m.saveSimilar(ctx, contract, nil, nil)
+	}
+
+	switch {
+	case contract.Verified && (contract.MatchType == types.Full || contract.MatchType == types.Partial):
+		// candidates are processed in batches, the contract is marked as checked with the last batch
+		var afterId uint64
+		for {
+			candidates, err := m.pg.Contracts.SimilarCandidates(ctx, contract.CodeHash, afterId, similarBatchSize)
+			if err != nil {
+				return errors.Wrap(err, "get similar candidates")
+			}
+
+			matched := make([]*storage.Contract, 0, len(candidates))
+			for i := range candidates {
+				if bytecode.Similar(contract.Code, candidates[i].Code, contract.ImmutableRefs) {
+					matched = append(matched, &candidates[i])
+				}
+			}
+			if len(candidates) < similarBatchSize {
+				return m.saveSimilar(ctx, contract, contract, matched)
+			}
+			if err := m.saveSimilar(ctx, nil, contract, matched); err != nil {
+				return err
+			}
+			afterId = candidates[len(candidates)-1].Id
+		}
+
+	case !contract.Verified:
+		sources, err := m.pg.Contracts.SimilarSources(ctx, contract.CodeHash)
+		if err != nil {
+			return errors.Wrap(err, "get similar sources")
+		}
+
+		for i := range sources {
+			if bytecode.Similar(sources[i].Code, contract.Code, sources[i].ImmutableRefs) {
+				return m.saveSimilar(ctx, contract, &sources[i], []*storage.Contract{contract})
+			}
+		}
+	}
+
+	return m.saveSimilar(ctx, contract, nil, nil)
+}
+
+// saveSimilar - copies verification data and sources of the source contract to the matched contracts
+// and marks the processed contract as checked if it's set.
+func (m *Module) saveSimilar(ctx context.Context, processed, source *storage.Contract, matched []*storage.Contract) error {
+	tx, err := postgres.BeginTransaction(ctx, m.storage)
+	if err != nil {
+		return err
+	}
+	defer tx.Close(ctx)
+
+	if len(matched) > 0 {
+		ids := make([]uint64, len(matched))
+		for i := range matched {
+			matched[i].Verified = true
+			matched[i].ABI = source.ABI
+			matched[i].CompilerVersion = source.CompilerVersion
+			matched[i].Language = source.Language
+			matched[i].OptimizerEnabled = source.OptimizerEnabled
+			matched[i].MatchType = types.Similar
+			matched[i].ImmutableRefs = source.ImmutableRefs
+			ids[i] = matched[i].Id
+		}
+
+		if _, err := tx.SaveContracts(ctx, matched...); err != nil {
+			return errors.Wrap(err, "save contracts")
+		}
+		if err := tx.CopySources(ctx, source.Id, ids...); err != nil {
+			return errors.Wrap(err, "copy contract sources")
+		}
+		if err := tx.ResetDecodedLogs(ctx, ids...); err != nil {
+			return errors.Wrap(err, "reset decoded logs")
+		}
+		if err := tx.IncrementVerifiedContracts(ctx, m.cfg.Indexer.Name, len(matched)); err != nil {
+			return errors.Wrap(err, "update state")
+		}
+
+		m.Log.Info().
+			Uint64("source_id", source.Id).
+			Int("count", len(matched)).
+			Msg("similar contracts are verified")
+	}
+
+	checked := matched
+	if processed != nil && !slices.Contains(matched, processed) {
+		checked = append(checked, processed)
+	}
+	if len(checked) > 0 {
+		if err := tx.SaveSimilarChecked(ctx, checked...); err != nil {
+			return errors.Wrap(err, "save similar checked")
+		}
+	}
+
+	if err := tx.Flush(ctx); err != nil {
+		return tx.HandleError(ctx, err)
+	}
+	return nil
+}
@@ -0,0 +1,110 @@
+package contract_verifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/NobleScope/noble-indexer/pkg/contract_verifier/standard_json"
+	"github.com/lmittmann/go-solc"
+	"github.com/pkg/errors"
+)
+
+var verificationOutputs = []string{"abi", "evm.bytecode.object", "evm.deployedBytecode.object"}
+
+// solidityOutputs also contain positions of unlinked libraries, vyper rejects them since it has no libraries
+var solidityOutputs = append(slices.Clone(verificationOutputs), "evm.bytecode.linkReferences", "evm.deployedBytecode.linkReferences")
+
+// modRoot returns the module root where go-solc keeps downloaded compilers. The root is empty outside of the module,
+// e.g. in the docker image without go toolchain, then go-solc keeps compilers in the working directory.
+var modRoot = sync.OnceValue(func() string {
+	out, err := exec.Command("go", "env", "GOMOD").Output()
+	if err != nil {
+		return ""
+	}
+	root, ok := strings.CutSuffix(strings.TrimSpace(string(out)), "/go.mod")
+	if !ok {
+		return ""
+	}
+	return root
+})
+
+// solcBinary returns the path of the solc binary. Binaries are taken from the compiler registry if it's configured.
+// Otherwise binaries are stored by go-solc in the .solc/bin directory of the module root or of the working directory
+// and a missing binary is downloaded by go-solc with an empty compilation.
+func (m *Module) solcBinary(compilerVersion string) (string, error) {
+	if m.compilers != nil {
+		return m.compilers.Binary(compilerVersion)
+	}
+
+	version := solcVersion(compilerVersion)
+	// the binary runs in the temporary directory, so the relative path has to be resolved
+	binary, err := filepath.Abs(filepath.Join(modRoot(), ".solc", "bin", fmt.Sprintf("solc_v%s", version)))
+	if err != nil {
+		return "", errors.Wrap(err, "resolve solc path")
+	}
+	if _, err := os.Stat(binary); err == nil {
+		return binary, nil
+	}
+
+	tmpDir, err := os.MkdirTemp("", "contract-verify-")
+	if err != nil {
+		return "", errors.Wrap(err, "create temp directory")
+	}
+	defer os.RemoveAll(tmpDir)
+
+	_, compileErr := solc.New(solc.Version(version)).Compile(tmpDir, "")
+	if _, err := os.Stat(binary); err != nil {
+		if compileErr != nil {
+			return "", errors.Wrap(compileErr, "download solc")
+		}
+		return "", errors.Wrap(err, "download solc")
+	}
+	return binary, nil
+}
+
+// runSolc runs solc with the raw standard JSON input.
+func (m *Module) runSolc(ctx context.Context, compilerVersion string, input []byte) (standard_json.Output, error) {
+	binary, err := m.solcBinary(compilerVersion)
+	if err != nil {
+		return standard_json.Output{}, err
+	}
+	return runStandardJSON(ctx, binary, input)
+}
+
+// runStandardJSON runs the compiler binary in the standard JSON mode. The compiler runs in an empty directory,
+// so it can't read files outside the input.
+func runStandardJSON(ctx context.Context, binary string, input []byte) (standard_json.Output, error) {
+	var output standard_json.Output
+
+	workDir, err := os.MkdirTemp("", "contract-verify-")
+	if err != nil {
+		return output, errors.Wrap(err, "create temp directory")
+	}
+	defer os.RemoveAll(workDir)
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, binary, "--standard-json")
+	cmd.Dir = workDir
+	cmd.Stdin = bytes.NewReader(input)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return output, errors.Wrapf(err, "run %s: %s", filepath.Base(binary), strings.TrimSpace(stderr.String()))
+	}
+
+	if err := json.Unmarshal(stdout.Bytes(), &output); err != nil {
+		return output, errors.Wrapf(err, "decode %s output", filepath.Base(binary))
+	}
+	if messages := output.Messages(); len(messages) > 0 {
+		return output, &CompilationError{Output: strings.Join(messages, "\n")}
+	}
+	return output, nil
+}
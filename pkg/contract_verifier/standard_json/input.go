@@ -0,0 +1,216 @@
+package standard_json
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/NobleScope/noble-indexer/internal/storage/types"
+	"github.com/pkg/errors"
+)
+
+const (
+	LanguageSolidity = "Solidity"
+	LanguageVyper    = "Vyper"
+)
+
+// vyper optimization modes
+const (
+	OptimizationModeGas      = "gas"
+	OptimizationModeCodesize = "codesize"
+	OptimizationModeNone     = "none"
+)
+
+// Input is a solc or vyper standard JSON input produced by Hardhat, Foundry, Ape and other frameworks.
+// Only fields required for verification are parsed, the raw input is passed to the compiler as is.
+type Input struct {
+	Language string            `json:"language"`
+	Sources  map[string]Source `json:"sources"`
+	Settings Settings          `json:"settings"`
+}
+
+type Source struct {
+	Content *string  `json:"content,omitempty"`
+	Urls    []string `json:"urls,omitempty"`
+}
+
+type Settings struct {
+	Remappings []string                     `json:"remappings,omitempty"`
+	Optimizer  *Optimizer                   `json:"optimizer,omitempty"`
+	EVMVersion string                       `json:"evmVersion,omitempty"`
+	ViaIR      bool                         `json:"viaIR,omitempty"`
+	Libraries  map[string]map[string]string `json:"libraries,omitempty"`
+	// Optimize is the vyper optimization mode: boolean before 0.3.10 and `gas`, `codesize` or `none` since 0.3.10
+	Optimize json.RawMessage `json:"optimize,omitempty"`
+}
+
+type Optimizer struct {
+	Enabled bool    `json:"enabled"`
+	Runs    *uint64 `json:"runs,omitempty"`
+}
+
+// Parse decodes and validates the standard JSON input. Sources have to be passed by content,
+// since the compiler must not read files from the verifier host.
+func Parse(data []byte) (Input, error) {
+	var input Input
+	if err := json.Unmarshal(data, &input); err != nil {
+		return input, errors.Wrap(err, "invalid standard JSON input")
+	}
+	if input.Language != LanguageSolidity && input.Language != LanguageVyper {
+		return input, errors.Errorf("unsupported standard JSON input language: %q", input.Language)
+	}
+	if len(input.Sources) == 0 {
+		return input, errors.New("standard JSON input does not contain sources")
+	}
+	for name, source := range input.Sources {
+		if source.Content == nil {
+			return input, errors.Errorf("source %s has no content: sources must be passed by content", name)
+		}
+		if len(source.Urls) > 0 {
+			return input, errors.Errorf("source %s contains urls: sources must be passed by content", name)
+		}
+	}
+	return input, nil
+}
+
+// OptimizationMode returns the vyper optimization mode of the input. Boolean values of old compilers are converted
+// to `gas` and `none` modes. Empty string is returned if the mode is not set.
+func (s Settings) OptimizationMode() (string, error) {
+	if len(s.Optimize) == 0 {
+		return "", nil
+	}
+	var enabled bool
+	if err := json.Unmarshal(s.Optimize, &enabled); err == nil {
+		if enabled {
+			return OptimizationModeGas, nil
+		}
+		return OptimizationModeNone, nil
+	}
+	var mode string
+	if err := json.Unmarshal(s.Optimize, &mode); err != nil {
+		return "", errors.Wrap(err, "invalid optimization mode")
+	}
+	switch mode {
+	case OptimizationModeGas, OptimizationModeCodesize, OptimizationModeNone:
+		return mode, nil
+	default:
+		return "", errors.Errorf("invalid optimization mode: %s", mode)
+	}
+}
+
+// LinkedLibraries returns addresses of libraries linked by the compiler by fully-qualified library names.
+func (s Settings) LinkedLibraries() map[string]string {
+	libraries := make(map[string]string)
+	for path, names := range s.Libraries {
+		for name, address := range names {
+			libraries[path+":"+name] = strings.ToLower(address)
+		}
+	}
+	return libraries
+}
+
+// SplitContractName splits the fully-qualified contract name `path:Contract`.
+func SplitContractName(name string) (string, string, error) {
+	idx := strings.LastIndex(name, ":")
+	if idx <= 0 {
+		return "", "", errors.Errorf("contract name must be fully-qualified (path:Contract): %s", name)
+	}
+	path, contract := name[:idx], name[idx+1:]
+	if !types.ContractNameRe.MatchString(contract) {
+		return "", "", errors.Errorf("invalid contract name: %s", contract)
+	}
+	return path, contract, nil
+}
+
+// HasContract checks that the source of the fully-qualified contract name is in the input.
+func (input Input) HasContract(name string) error {
+	path, _, err := SplitContractName(name)
+	if err != nil {
+		return err
+	}
+	if _, ok := input.Sources[path]; !ok {
+		return errors.Errorf("source %s is not found in standard JSON input", path)
+	}
+	return nil
+}
+
+// WithOutputSelection replaces output selection of the raw standard JSON input with outputs
+// required for verification. Output selection does not affect the bytecode, so other fields are kept unmodified.
+func WithOutputSelection(data []byte, outputs ...string) ([]byte, error) {
+	var input map[string]json.RawMessage
+	if err := json.Unmarshal(data, &input); err != nil {
+		return nil, errors.Wrap(err, "decode standard JSON input")
+	}
+
+	settings := make(map[string]json.RawMessage)
+	if raw, ok := input["settings"]; ok {
+		if err := json.Unmarshal(raw, &settings); err != nil {
+			return nil, errors.Wrap(err, "decode standard JSON settings")
+		}
+	}
+
+	selection, err := json.Marshal(map[string]map[string][]string{
+		"*": {"*": outputs},
+	})
+	if err != nil {
+		return nil, err
+	}
+	settings["outputSelection"] = selection
+
+	rawSettings, err := json.Marshal(settings)
+	if err != nil {
+		return nil, err
+	}
+	input["settings"] = rawSettings
+
+	return json.Marshal(input)
+}
+
+// AppendToSource appends the suffix to the source content of the raw standard JSON input.
+func AppendToSource(data []byte, path, suffix string) ([]byte, error) {
+	var input map[string]json.RawMessage
+	if err := json.Unmarshal(data, &input); err != nil {
+		return nil, errors.Wrap(err, "decode standard JSON input")
+	}
+
+	var sources map[string]map[string]json.RawMessage
+	if err := json.Unmarshal(input["sources"], &sources); err != nil {
+		return nil, errors.Wrap(err, "decode standard JSON sources")
+	}
+	source, ok := sources[path]
+	if !ok {
+		return nil, errors.Errorf("source %s is not found in standard JSON input", path)
+	}
+
+	var content string
+	if err := json.Unmarshal(source["content"], &content); err != nil {
+		return nil, errors.Wrapf(err, "decode content of %s", path)
+	}
+	rawContent, err := json.Marshal(content + suffix)
+	if err != nil {
+		return nil, err
+	}
+	source["content"] = rawContent
+
+	rawSources, err := json.Marshal(sources)
+	if err != nil {
+		return nil, err
+	}
+	input["sources"] = rawSources
+
+	return json.Marshal(input)
+}
+
+// NewInput builds the raw standard JSON input from source files and compiler settings.
+func NewInput(language string, sources map[string]string, settings map[string]any) ([]byte, error) {
+	inputSources := make(map[string]Source, len(sources))
+	for name := range sources {
+		content := sources[name]
+		inputSources[name] = Source{Content: &content}
+	}
+
+	return json.Marshal(map[string]any{
+		"language": language,
+		"sources":  inputSources,
+		"settings": settings,
+	})
+}
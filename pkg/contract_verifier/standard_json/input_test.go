@@ -0,0 +1,179 @@
+package standard_json
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testInput = `{
+	"language": "Solidity",
+	"sources": {
+		"src/Token.sol": {"content": "pragma solidity ^0.8.20;\nimport \"@oz/ERC20.sol\";\ncontract Token {}"},
+		"lib/openzeppelin/ERC20.sol": {"content": "pragma solidity ^0.8.20;\ncontract ERC20 {}"}
+	},
+	"settings": {
+		"remappings": ["@oz/=lib/openzeppelin/"],
+		"optimizer": {"enabled": true, "runs": 1000},
+		"evmVersion": "cancun",
+		"viaIR": true,
+		"metadata": {"bytecodeHash": "none"},
+		"outputSelection": {"*": {"*": ["storageLayout"]}}
+	}
+}`
+
+func TestParse(t *testing.T) {
+	t.Run("valid input", func(t *testing.T) {
+		input, err := Parse([]byte(testInput))
+		require.NoError(t, err)
+		require.Equal(t, LanguageSolidity, input.Language)
+		require.Len(t, input.Sources, 2)
+		require.Equal(t, []string{"@oz/=lib/openzeppelin/"}, input.Settings.Remappings)
+		require.NotNil(t, input.Settings.Optimizer)
+		require.True(t, input.Settings.Optimizer.Enabled)
+		require.NotNil(t, input.Settings.Optimizer.Runs)
+		require.EqualValues(t, 1000, *input.Settings.Optimizer.Runs)
+		require.Equal(t, "cancun", input.Settings.EVMVersion)
+		require.True(t, input.Settings.ViaIR)
+	})
+
+	t.Run("vyper input", func(t *testing.T) {
+		input, err := Parse([]byte(`{"language":"Vyper","sources":{"Token.vy":{"content":"x: uint256"}},"settings":{"optimize":"codesize"}}`))
+		require.NoError(t, err)
+		require.Equal(t, LanguageVyper, input.Language)
+
+		mode, err := input.Settings.OptimizationMode()
+		require.NoError(t, err)
+		require.Equal(t, OptimizationModeCodesize, mode)
+	})
+
+	t.Run("invalid json", func(t *testing.T) {
+		_, err := Parse([]byte(`{`))
+		require.Error(t, err)
+	})
+
+	t.Run("unsupported language", func(t *testing.T) {
+		_, err := Parse([]byte(`{"language":"Yul","sources":{"a.yul":{"content":"{}"}}}`))
+		require.ErrorContains(t, err, "unsupported standard JSON input language")
+	})
+
+	t.Run("no sources", func(t *testing.T) {
+		_, err := Parse([]byte(`{"language":"Solidity","sources":{}}`))
+		require.ErrorContains(t, err, "does not contain sources")
+	})
+
+	t.Run("source by url", func(t *testing.T) {
+		_, err := Parse([]byte(`{"language":"Solidity","sources":{"a.sol":{"urls":["/etc/passwd"]}}}`))
+		require.ErrorContains(t, err, "sources must be passed by content")
+	})
+}
+
+func TestSplitContractName(t *testing.T) {
+	path, name, err := SplitContractName("src/Token.sol:Token")
+	require.NoError(t, err)
+	require.Equal(t, "src/Token.sol", path)
+	require.Equal(t, "Token", name)
+
+	path, name, err = SplitContractName("C:/project/Token.sol:Token")
+	require.NoError(t, err)
+	require.Equal(t, "C:/project/Token.sol", path)
+	require.Equal(t, "Token", name)
+
+	for _, invalid := range []string{"Token", ":Token", "src/Token.sol:", "src/Token.sol:Tok;en"} {
+		_, _, err := SplitContractName(invalid)
+		require.Error(t, err, invalid)
+	}
+}
+
+func TestHasContract(t *testing.T) {
+	input, err := Parse([]byte(testInput))
+	require.NoError(t, err)
+
+	require.NoError(t, input.HasContract("src/Token.sol:Token"))
+	require.ErrorContains(t, input.HasContract("src/Unknown.sol:Token"), "is not found")
+	require.Error(t, input.HasContract("Token"))
+}
+
+func TestWithOutputSelection(t *testing.T) {
+	data, err := WithOutputSelection([]byte(testInput), "abi", "evm.deployedBytecode.object")
+	require.NoError(t, err)
+
+	var input struct {
+		Sources  map[string]Source `json:"sources"`
+		Settings struct {
+			Remappings      []string                       `json:"remappings"`
+			Metadata        map[string]string              `json:"metadata"`
+			OutputSelection map[string]map[string][]string `json:"outputSelection"`
+		} `json:"settings"`
+	}
+	require.NoError(t, json.Unmarshal(data, &input))
+	require.Len(t, input.Sources, 2)
+	require.Equal(t, []string{"@oz/=lib/openzeppelin/"}, input.Settings.Remappings)
+	require.Equal(t, map[string]string{"bytecodeHash": "none"}, input.Settings.Metadata)
+	require.Equal(t, map[string]map[string][]string{
+		"*": {"*": {"abi", "evm.deployedBytecode.object"}},
+	}, input.Settings.OutputSelection)
+}
+
+func TestAppendToSource(t *testing.T) {
+	data, err := AppendToSource([]byte(testInput), "src/Token.sol", "\n")
+	require.NoError(t, err)
+
+	input, err := Parse(data)
+	require.NoError(t, err)
+	require.Equal(t, "pragma solidity ^0.8.20;\nimport \"@oz/ERC20.sol\";\ncontract Token {}\n", *input.Sources["src/Token.sol"].Content)
+	require.Equal(t, "pragma solidity ^0.8.20;\ncontract ERC20 {}", *input.Sources["lib/openzeppelin/ERC20.sol"].Content)
+
+	_, err = AppendToSource([]byte(testInput), "src/Unknown.sol", "\n")
+	require.Error(t, err)
+}
+
+func TestOptimizationMode(t *testing.T) {
+	for raw, want := range map[string]string{
+		``:           "",
+		`true`:       OptimizationModeGas,
+		`false`:      OptimizationModeNone,
+		`"gas"`:      OptimizationModeGas,
+		`"codesize"`: OptimizationModeCodesize,
+		`"none"`:     OptimizationModeNone,
+	} {
+		mode, err := Settings{Optimize: json.RawMessage(raw)}.OptimizationMode()
+		require.NoError(t, err, raw)
+		require.Equal(t, want, mode, raw)
+	}
+
+	for _, invalid := range []string{`"fast"`, `1`} {
+		_, err := Settings{Optimize: json.RawMessage(invalid)}.OptimizationMode()
+		require.Error(t, err, invalid)
+	}
+}
+
+func TestNewInput(t *testing.T) {
+	data, err := NewInput(LanguageVyper, map[string]string{
+		"Token.vy": "x: uint256",
+	}, map[string]any{
+		"optimize": OptimizationModeGas,
+	})
+	require.NoError(t, err)
+
+	input, err := Parse(data)
+	require.NoError(t, err)
+	require.Equal(t, LanguageVyper, input.Language)
+	require.Equal(t, "x: uint256", *input.Sources["Token.vy"].Content)
+
+	mode, err := input.Settings.OptimizationMode()
+	require.NoError(t, err)
+	require.Equal(t, OptimizationModeGas, mode)
+}
+
+func TestLinkedLibraries(t *testing.T) {
+	settings := Settings{
+		Libraries: map[string]map[string]string{
+			"src/Math.sol": {"Math": "0x742D35CC6634c0532925A3b844BC9E7595F0BEb0"},
+		},
+	}
+	require.Equal(t, map[string]string{
+		"src/Math.sol:Math": "0x742d35cc6634c0532925a3b844bc9e7595f0beb0",
+	}, settings.LinkedLibraries())
+}
@@ -0,0 +1,138 @@
+package standard_json
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/hex"
+	"encoding/json"
+	"maps"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Output is a compiler standard JSON output.
+type Output struct {
+	Errors    []Error                        `json:"errors"`
+	Contracts map[string]map[string]Contract `json:"contracts"`
+}
+
+type Error struct {
+	Severity         string `json:"severity"`
+	Message          string `json:"message"`
+	FormattedMessage string `json:"formattedMessage"`
+}
+
+type Contract struct {
+	ABI []json.RawMessage `json:"abi"`
+	EVM EVM               `json:"evm"`
+}
+
+type EVM struct {
+	Bytecode         Bytecode `json:"bytecode"`
+	DeployedBytecode Bytecode `json:"deployedBytecode"`
+}
+
+type Bytecode struct {
+	Object         string                                `json:"object"`
+	LinkReferences map[string]map[string][]LinkReference `json:"linkReferences"`
+}
+
+// LinkReference is a position of the library address placeholder in the unlinked bytecode.
+type LinkReference struct {
+	Start  int `json:"start"`
+	Length int `json:"length"`
+}
+
+// Link is a reference to the library by the fully-qualified name `path:Library`.
+type Link struct {
+	Library string
+	Offset  int
+	Length  int
+}
+
+// Bytes decodes the hex bytecode object. Placeholders of unlinked libraries are replaced by zero bytes.
+func (b Bytecode) Bytes() ([]byte, error) {
+	object := []byte(strings.TrimPrefix(b.Object, "0x"))
+	for _, link := range b.Links() {
+		start, end := 2*link.Offset, 2*(link.Offset+link.Length)
+		if start < 0 || end > len(object) {
+			return nil, errors.Errorf("link reference of %s is out of bytecode", link.Library)
+		}
+		copy(object[start:end], bytes.Repeat([]byte{'0'}, end-start))
+	}
+	code := make([]byte, hex.DecodedLen(len(object)))
+	if _, err := hex.Decode(code, object); err != nil {
+		return nil, err
+	}
+	return code, nil
+}
+
+// Links returns references to unlinked libraries sorted by offset.
+func (b Bytecode) Links() []Link {
+	var links []Link
+	for path, libraries := range b.LinkReferences {
+		for name, refs := range libraries {
+			for _, ref := range refs {
+				links = append(links, Link{
+					Library: path + ":" + name,
+					Offset:  ref.Start,
+					Length:  ref.Length,
+				})
+			}
+		}
+	}
+	slices.SortFunc(links, func(a, b Link) int {
+		return cmp.Compare(a.Offset, b.Offset)
+	})
+	return links
+}
+
+// Messages returns formatted messages of errors with error severity. The output is compiled
+// successfully if there are no such messages.
+func (o Output) Messages() []string {
+	var messages []string
+	for i := range o.Errors {
+		if !strings.EqualFold(o.Errors[i].Severity, "error") {
+			continue
+		}
+		message := o.Errors[i].FormattedMessage
+		if message == "" {
+			message = o.Errors[i].Message
+		}
+		messages = append(messages, message)
+	}
+	return messages
+}
+
+// Contract returns the compiled contract by the fully-qualified name `path:Contract`.
+func (o Output) Contract(name string) (Contract, error) {
+	path, contractName, err := SplitContractName(name)
+	if err != nil {
+		return Contract{}, err
+	}
+	contract, ok := o.Contracts[path][contractName]
+	if !ok {
+		return Contract{}, errors.Errorf("contract %s is not found in compiler output", name)
+	}
+	return contract, nil
+}
+
+// ContractByName finds the contract by the name and returns its fully-qualified name. Contracts of the main file
+// are preferred, other files are searched in the alphabetical order.
+func (o Output) ContractByName(mainFile, name string) (string, error) {
+	paths := slices.Sorted(maps.Keys(o.Contracts))
+	for _, mainOnly := range []bool{true, false} {
+		for _, p := range paths {
+			if mainOnly && path.Base(p) != mainFile {
+				continue
+			}
+			if _, ok := o.Contracts[p][name]; ok {
+				return p + ":" + name, nil
+			}
+		}
+	}
+	return "", errors.Errorf("contract %s is not found in compiler output", name)
+}
@@ -0,0 +1,101 @@
+package standard_json
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOutput(t *testing.T) {
+	var output Output
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"errors": [
+			{"severity": "warning", "message": "unused variable", "formattedMessage": "Warning: unused variable"}
+		],
+		"contracts": {
+			"src/Token.sol": {
+				"Token": {
+					"abi": [{"type":"function","name":"name","inputs":[],"outputs":[{"name":"","type":"string"}],"stateMutability":"view"}],
+					"evm": {
+						"bytecode": {"object": "6080604052"},
+						"deployedBytecode": {"object": "0x60806040"}
+					}
+				}
+			}
+		}
+	}`), &output))
+	require.Empty(t, output.Messages())
+
+	contract, err := output.Contract("src/Token.sol:Token")
+	require.NoError(t, err)
+	require.Len(t, contract.ABI, 1)
+
+	runtime, err := contract.EVM.DeployedBytecode.Bytes()
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x60, 0x80, 0x60, 0x40}, runtime)
+
+	creation, err := contract.EVM.Bytecode.Bytes()
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x60, 0x80, 0x60, 0x40, 0x52}, creation)
+
+	_, err = output.Contract("src/Token.sol:Unknown")
+	require.ErrorContains(t, err, "is not found")
+}
+
+func TestOutputMessages(t *testing.T) {
+	output := Output{
+		Errors: []Error{
+			{Severity: "warning", FormattedMessage: "Warning: shadowing"},
+			{Severity: "error", FormattedMessage: "ParserError: Expected ';' but got '}'"},
+			{Severity: "error", Message: "Source not found"},
+		},
+	}
+	require.Equal(t, []string{"ParserError: Expected ';' but got '}'", "Source not found"}, output.Messages())
+}
+
+func TestOutputLinkReferences(t *testing.T) {
+	var output Output
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"contracts": {
+			"src/Token.sol": {
+				"Token": {
+					"evm": {
+						"deployedBytecode": {
+							"object": "6073__$f6e1b6f3ba1c64b0ea7e5ef6a0a4d4c9e2$__6000",
+							"linkReferences": {"src/Math.sol": {"Math": [{"start": 2, "length": 20}]}}
+						}
+					}
+				}
+			},
+			"src/Main.sol": {
+				"Token": {}
+			}
+		}
+	}`), &output))
+
+	name, err := output.ContractByName("Token.sol", "Token")
+	require.NoError(t, err)
+	require.Equal(t, "src/Token.sol:Token", name)
+
+	name, err = output.ContractByName("Other.sol", "Token")
+	require.NoError(t, err)
+	require.Equal(t, "src/Main.sol:Token", name)
+
+	_, err = output.ContractByName("Token.sol", "Unknown")
+	require.ErrorContains(t, err, "is not found")
+
+	contract, err := output.Contract("src/Token.sol:Token")
+	require.NoError(t, err)
+
+	runtime, err := contract.EVM.DeployedBytecode.Bytes()
+	require.NoError(t, err)
+	require.Len(t, runtime, 24)
+	require.Equal(t, []byte{0x60, 0x73}, runtime[:2])
+	require.Equal(t, make([]byte, 20), runtime[2:22])
+	require.Equal(t, []byte{0x60, 0x00}, runtime[22:])
+
+	require.Equal(t, []Link{
+		{Library: "src/Math.sol:Math", Offset: 2, Length: 20},
+	}, contract.EVM.DeployedBytecode.Links())
+}
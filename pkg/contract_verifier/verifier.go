@@ -5,22 +5,37 @@ import (
 	"context"
 	"encoding/hex"
 	"encoding/json"
-	"os"
 	"path/filepath"
 	"regexp"
 	"strings"
 
 	"github.com/NobleScope/noble-indexer/internal/storage"
 	"github.com/NobleScope/noble-indexer/internal/storage/types"
+	"github.com/NobleScope/noble-indexer/pkg/bytecode"
+	"github.com/NobleScope/noble-indexer/pkg/contract_verifier/standard_json"
 	"github.com/ethereum/go-ethereum/accounts/abi"
-	"github.com/lmittmann/go-solc"
 	"github.com/pkg/errors"
 )
 
 const (
 	defaultOptimizationRuns = 200
+
+	// metadataHashLength is the length of the metadata hash embedded into the bytecode
+	metadataHashLength = 32
+
+	languageSolidity = "Solidity"
+	languageVyper    = "Vyper"
 )
 
+// CompilationError is returned when the compiler rejects the sources. Output contains formatted compiler messages.
+type CompilationError struct {
+	Output string
+}
+
+func (e *CompilationError) Error() string {
+	return "compilation failed"
+}
+
 type BytecodeParts struct {
 	main     []byte
 	metadata []byte
@@ -30,6 +45,54 @@ type VerificationResult struct {
 	ABI             json.RawMessage
 	CompilerVersion string
 	Language        string
+	Sources         map[string]string
+	ConstructorArgs []byte
+	MatchType       types.MatchType
+	ImmutableRefs   []int64
+	Libraries       map[string]string
+}
+
+// compilation contains the compiled contract. Solidity contract is compiled twice: as is and with a newline
+// appended to the main source, runtime bytecodes of compilations differ only by the metadata hash.
+// Vyper bytecode does not depend on the source text, so it's compiled once and compared entirely.
+// Placeholders of unlinked libraries are zeroed in bytecodes, their positions are kept in links.
+type compilation struct {
+	language       string
+	abi            []json.RawMessage
+	runtime        []byte
+	constructor    []byte
+	altRuntime     []byte
+	altConstructor []byte
+	sources        map[string]string
+
+	runtimeLinks        []standard_json.Link
+	constructorLinks    []standard_json.Link
+	altRuntimeLinks     []standard_json.Link
+	altConstructorLinks []standard_json.Link
+	// libraries are linked by the compiler
+	libraries map[string]string
+}
+
+func (c compilation) parts() BytecodeParts {
+	if c.altRuntime == nil {
+		return BytecodeParts{main: c.runtime}
+	}
+	return splitBytecode(c.runtime, c.altRuntime)
+}
+
+// metadataRanges returns byte ranges of the metadata in the creation code. Solidity ranges are found by comparing
+// both compilations. Vyper appends CBOR-encoded metadata followed by its 2-byte length to the creation code.
+func (c compilation) metadataRanges() [][2]int {
+	if c.altConstructor != nil {
+		return diffRanges(c.constructor, c.altConstructor)
+	}
+
+	size := len(c.constructor)
+	start := len(bytecode.StripMetadata(c.constructor))
+	if start == size {
+		return nil
+	}
+	return [][2]int{{start, size - 2}}
 }
 
 func (m *Module) verify(ctx context.Context, task storage.VerificationTask, files []storage.VerificationFile) (*VerificationResult, error) {
@@ -38,7 +101,11 @@ func (m *Module) verify(ctx context.Context, task storage.VerificationTask, file
 		return nil, errors.New("no source code files found for verification task")
 	}
 
-	if !types.ContractNameRe.MatchString(task.ContractName) {
+	if task.StandardJSON {
+		if _, _, err := standard_json.SplitContractName(task.ContractName); err != nil {
+			return nil, err
+		}
+	} else if !types.ContractNameRe.MatchString(task.ContractName) {
 		return nil, errors.Errorf("invalid contract name: %s", task.ContractName)
 	}
 
@@ -48,66 +115,38 @@ func (m *Module) verify(ctx context.Context, task storage.VerificationTask, file
 		return nil, errors.Wrap(err, "get contract by id")
 	}
 
-	var evmVersion string
-	if task.EVMVersion != nil {
-		evmVersion = task.EVMVersion.String()
-		m.Log.Info().Str("evm_version", evmVersion).Msg("using EVM version from task")
-	} else {
-		evmVersion = detectEVMVersion(contract.Code.Bytes()).String()
-		m.Log.Info().Str("evm_version", evmVersion).Msg("auto-detected EVM version from onchain bytecode")
-	}
-
-	compilerVersion := strings.TrimPrefix(task.CompilerVersion, "v")
-	if idx := strings.Index(compilerVersion, "+"); idx != -1 {
-		compilerVersion = compilerVersion[:idx]
-	}
-
-	compiler := solc.New(solc.Version(compilerVersion))
-
-	var opts []solc.Option
-	opts = append(opts, solc.WithEVMVersion(solc.EVMVersion(evmVersion)))
-
-	if task.OptimizationEnabled != nil && *task.OptimizationEnabled {
-		runs := uint64(defaultOptimizationRuns)
-		if task.OptimizationRuns != nil {
-			runs = uint64(*task.OptimizationRuns)
-		}
-		opts = append(opts, solc.WithOptimizer(&solc.Optimizer{
-			Enabled: true,
-			Runs:    runs,
-		}))
-	}
-
-	if task.ViaIR {
-		opts = append(opts, solc.WithViaIR(true))
+	var compiled *compilation
+	switch {
+	case task.StandardJSON:
+		compiled, err = m.compileStandardJSON(ctx, task, files)
+	case task.Language == types.Vyper:
+		compiled, err = m.compileVyperSources(ctx, task, files)
+	default:
+		compiled, err = m.compileSources(ctx, task, contract, files)
 	}
-
-	mainContractFileName := task.ContractName + ".sol"
-	sources := buildSourceMap(files)
-
-	contract1, clean1, err := compileFromSources(compiler, sources, task.ContractName, opts, mainContractFileName, false)
 	if err != nil {
 		return nil, err
 	}
-	defer clean1()
 
-	if len(contract1.Runtime) == 0 {
+	if len(compiled.runtime) == 0 {
 		m.Log.Error().Uint64("contract_id", task.ContractId).Msg("contract does not contain any runtimes")
 		return nil, errors.New("compilation produced no runtime bytecode")
 	}
 
+	libraries := make(map[string]string)
+	if err := resolveLibraries(libraries, task.Libraries, compiled.runtimeLinks, contract.Code.Bytes()); err != nil {
+		return nil, errors.Wrap(err, "link libraries")
+	}
+	if err := compiled.linkRuntime(libraries); err != nil {
+		return nil, errors.Wrap(err, "link libraries")
+	}
+
 	m.Log.Info().
 		Uint64("task_id", task.Id).
-		Int("files_count", len(files)).
+		Int("files_count", len(compiled.sources)).
 		Msg("contract compiled successfully")
 
-	contract2, clean2, err := compileFromSources(compiler, sources, task.ContractName, opts, mainContractFileName, true)
-	if err != nil {
-		return nil, err
-	}
-	defer clean2()
-
-	runtimeParts := splitBytecode(contract1.Runtime, contract2.Runtime)
+	runtimeParts := compiled.parts()
 	if len(runtimeParts.main) == 0 {
 		return nil, errors.New("bytecode verification failed: compiled bytecodes diverge from byte 0, cannot determine main part")
 	}
@@ -132,7 +171,7 @@ func (m *Module) verify(ctx context.Context, task storage.VerificationTask, file
 		Uint64("contract_id", task.ContractId).
 		Msg("bytecode verification successfully: main parts match")
 
-	abiJSON, err := json.Marshal(contract1.ABI)
+	abiJSON, err := json.Marshal(compiled.abi)
 	if err != nil {
 		m.Log.Err(err).Uint64("contract_id", task.ContractId).Msg("failed to marshal ABI")
 		return nil, errors.Wrap(err, "marshal contract ABI")
@@ -144,28 +183,343 @@ func (m *Module) verify(ctx context.Context, task storage.VerificationTask, file
 		return nil, errors.Wrap(err, "parse contract ABI")
 	}
 
-	if contract.TxId == nil && contract.Height > 0 {
-		return nil, errors.New("deployment transaction not found")
+	result := &VerificationResult{
+		ABI:             abiJSON,
+		CompilerVersion: task.CompilerVersion,
+		Language:        compiled.language,
+		Sources:         compiled.sources,
+		MatchType:       types.Partial,
+		ImmutableRefs:   immutableOffsets(runtimeParts.main),
+		Libraries:       libraries,
+	}
+	for name, address := range compiled.libraries {
+		result.Libraries[name] = address
 	}
 
-	if contract.TxId != nil {
-		deployTx, err := m.pg.Tx.GetByID(ctx, *contract.TxId)
-		if err != nil {
-			m.Log.Err(err).Uint64("contract_id", task.ContractId).Msg("failed to get deployment transaction")
-			return nil, errors.Wrap(err, "get deployment transaction")
+	creationCode, err := m.creationCode(ctx, contract)
+	if err != nil {
+		m.Log.Err(err).Uint64("contract_id", task.ContractId).Msg("failed to get creation code")
+		return nil, err
+	}
+	if creationCode == nil {
+		m.Log.Info().
+			Uint64("contract_id", task.ContractId).
+			Msg("creation code is unknown, only runtime bytecode is verified")
+		return result, nil
+	}
+
+	if err := resolveLibraries(result.Libraries, task.Libraries, compiled.constructorLinks, creationCode); err != nil {
+		return nil, errors.Wrap(err, "link libraries")
+	}
+	if err := compiled.linkConstructor(result.Libraries); err != nil {
+		return nil, errors.Wrap(err, "link libraries")
+	}
+
+	matchType, constructorArgs, err := verifyCreationCode(compiled, creationCode)
+	if err != nil {
+		m.Log.Err(err).Uint64("contract_id", task.ContractId).Msg("creation bytecode does not match")
+		return nil, errors.Wrap(err, "verify creation bytecode")
+	}
+
+	if err := m.verifyConstructorArgs(parsedABI, constructorArgs); err != nil {
+		m.Log.Err(err).Uint64("contract_id", task.ContractId).Msg("failed to verify contract constructor args")
+		return nil, errors.Wrap(err, "verify constructor arguments")
+	}
+
+	m.Log.Info().
+		Uint64("contract_id", task.ContractId).
+		Str("match_type", matchType.String()).
+		Msg("bytecode verification successfully: creation code matches")
+
+	result.MatchType = matchType
+	if len(constructorArgs) > 0 {
+		result.ConstructorArgs = constructorArgs
+	}
+	return result, nil
+}
+
+// creationCode returns the init code of the contract from its creation trace. The input of the deployment transaction
+// is used if traces are not indexed, it's the init code only for direct deployments. Nil is returned if the creation
+// code is unknown: for genesis contracts, for contracts without the deployment transaction and for contracts deployed
+// by factories without traces. The runtime bytecode is verified only then.
+func (m *Module) creationCode(ctx context.Context, contract *storage.Contract) ([]byte, error) {
+	trace, err := m.pg.Trace.CreationByContractId(ctx, contract.Id)
+	switch {
+	case err == nil:
+		if trace.InitHash != nil {
+			return trace.InitHash.Bytes(), nil
 		}
+	case !m.pg.Trace.IsNoRows(err):
+		return nil, errors.Wrap(err, "get creation trace")
+	}
+
+	if contract.TxId == nil {
+		return nil, nil
+	}
+
+	deployTx, err := m.pg.Tx.GetByID(ctx, *contract.TxId)
+	if err != nil {
+		return nil, errors.Wrap(err, "get deployment transaction")
+	}
+	if deployTx.ToAddressId != nil {
+		return nil, nil
+	}
+	return deployTx.Input, nil
+}
+
+// verifyCreationCode compares the compiled creation code with the onchain one and returns the match type and
+// constructor arguments appended to the creation code. The match is full if the metadata hash matches too.
+func verifyCreationCode(compiled *compilation, onchain []byte) (types.MatchType, []byte, error) {
+	if len(onchain) < len(compiled.constructor) {
+		return "", nil, errors.Errorf("onchain creation code is shorter than compiled: %d < %d",
+			len(onchain), len(compiled.constructor))
+	}
+
+	code, args := onchain[:len(compiled.constructor)], onchain[len(compiled.constructor):]
+	switch {
+	case bytes.Equal(compiled.constructor, code):
+		return types.Full, args, nil
+	case bytesEqualExceptRanges(compiled.constructor, code, compiled.metadataRanges()):
+		return types.Partial, args, nil
+	default:
+		return "", nil, errors.New("creation bytecode does not match")
+	}
+}
+
+// compileSources compiles uploaded source files with settings of the task. Sources are compiled in the standard JSON mode,
+// so unlinked library placeholders and their positions are available in the output.
+func (m *Module) compileSources(ctx context.Context, task storage.VerificationTask, contract *storage.Contract, files []storage.VerificationFile) (*compilation, error) {
+	var evmVersion string
+	if task.EVMVersion != nil {
+		evmVersion = task.EVMVersion.String()
+		m.Log.Info().Str("evm_version", evmVersion).Msg("using EVM version from task")
+	} else {
+		evmVersion = detectEVMVersion(contract.Code.Bytes()).String()
+		m.Log.Info().Str("evm_version", evmVersion).Msg("auto-detected EVM version from onchain bytecode")
+	}
 
-		if err := m.verifyConstructorArgs(parsedABI, deployTx.Input, len(contract1.Constructor)); err != nil {
-			m.Log.Err(err).Uint64("contract_id", task.ContractId).Msg("failed to verify contract constructor args")
-			return nil, errors.Wrap(err, "verify constructor arguments")
+	settings := map[string]any{
+		"evmVersion": evmVersion,
+	}
+	// only optimizer settings of the task are passed, the compiler defaults are used for the rest
+	if task.OptimizationEnabled != nil || task.OptimizationRuns != nil {
+		var optimizer standard_json.Optimizer
+		if task.OptimizationEnabled != nil {
+			optimizer.Enabled = *task.OptimizationEnabled
+		}
+		if optimizer.Enabled || task.OptimizationRuns != nil {
+			runs := uint64(defaultOptimizationRuns)
+			if task.OptimizationRuns != nil {
+				runs = uint64(*task.OptimizationRuns)
+			}
+			optimizer.Runs = &runs
 		}
+		settings["optimizer"] = optimizer
+	}
+	if task.ViaIR {
+		settings["viaIR"] = true
 	}
 
-	return &VerificationResult{
-		ABI:             abiJSON,
-		CompilerVersion: task.CompilerVersion,
-		Language:        "Solidity",
-	}, nil
+	sources := buildSourceMap(files)
+	input, err := standard_json.NewInput(standard_json.LanguageSolidity, sources, settings)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := standard_json.WithOutputSelection(input, solidityOutputs...)
+	if err != nil {
+		return nil, err
+	}
+
+	// the newline is appended to the main contract file, the compilation differs only by the metadata hash
+	mainContractFileName := task.ContractName + ".sol"
+	altRaw := raw
+	for path := range sources {
+		if filepath.Base(path) != mainContractFileName {
+			continue
+		}
+		if altRaw, err = standard_json.AppendToSource(altRaw, path, "\n"); err != nil {
+			return nil, err
+		}
+	}
+
+	uploaded := make(map[string]string, len(files))
+	for i := range files {
+		uploaded[files[i].Name] = string(files[i].File)
+	}
+
+	return m.compileSolidity(ctx, task.CompilerVersion, raw, altRaw, func(output standard_json.Output) (string, error) {
+		return output.ContractByName(mainContractFileName, task.ContractName)
+	}, uploaded)
+}
+
+// compileStandardJSON compiles the uploaded standard JSON input. Only output selection of the input is replaced,
+// so remappings, libraries and metadata settings are passed to the compiler unmodified.
+func (m *Module) compileStandardJSON(ctx context.Context, task storage.VerificationTask, files []storage.VerificationFile) (*compilation, error) {
+	if len(files) != 1 {
+		return nil, errors.Errorf("standard JSON verification task must contain a single input file, got %d", len(files))
+	}
+
+	input, err := standard_json.Parse(files[0].File)
+	if err != nil {
+		return nil, err
+	}
+	if err := input.HasContract(task.ContractName); err != nil {
+		return nil, err
+	}
+
+	sources := make(map[string]string, len(input.Sources))
+	for name, source := range input.Sources {
+		sources[name] = *source.Content
+	}
+
+	if input.Language == standard_json.LanguageVyper {
+		raw, err := standard_json.WithOutputSelection(files[0].File, verificationOutputs...)
+		if err != nil {
+			return nil, err
+		}
+		return m.compileVyper(ctx, task, raw, sources)
+	}
+
+	raw, err := standard_json.WithOutputSelection(files[0].File, solidityOutputs...)
+	if err != nil {
+		return nil, err
+	}
+
+	path, _, err := standard_json.SplitContractName(task.ContractName)
+	if err != nil {
+		return nil, err
+	}
+	// the input with appended newline differs only by the metadata hash, it's used to find the main part of the bytecode
+	altRaw, err := standard_json.AppendToSource(raw, path, "\n")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := m.compileSolidity(ctx, task.CompilerVersion, raw, altRaw, func(standard_json.Output) (string, error) {
+		return task.ContractName, nil
+	}, sources)
+	if err != nil {
+		return nil, err
+	}
+	// libraries of the input are linked by the compiler
+	result.libraries = input.Settings.LinkedLibraries()
+	return result, nil
+}
+
+// compileSolidity compiles the standard JSON input and the input with appended newline. The contract is found
+// in the output by the fully-qualified name returned by contractName.
+func (m *Module) compileSolidity(ctx context.Context, compilerVersion string, raw, altRaw []byte, contractName func(standard_json.Output) (string, error), sources map[string]string) (*compilation, error) {
+	output, err := m.runSolc(ctx, compilerVersion, raw)
+	if err != nil {
+		return nil, errors.Wrap(err, "compile contract")
+	}
+	altOutput, err := m.runSolc(ctx, compilerVersion, altRaw)
+	if err != nil {
+		return nil, errors.Wrap(err, "compile contract")
+	}
+
+	name, err := contractName(output)
+	if err != nil {
+		return nil, err
+	}
+	result, err := newCompilation(languageSolidity, output, name, sources)
+	if err != nil {
+		return nil, err
+	}
+	altContract, err := altOutput.Contract(name)
+	if err != nil {
+		return nil, err
+	}
+	if result.altRuntime, err = altContract.EVM.DeployedBytecode.Bytes(); err != nil {
+		return nil, errors.Wrap(err, "decode runtime bytecode")
+	}
+	if result.altConstructor, err = altContract.EVM.Bytecode.Bytes(); err != nil {
+		return nil, errors.Wrap(err, "decode creation bytecode")
+	}
+	result.altRuntimeLinks = altContract.EVM.DeployedBytecode.Links()
+	result.altConstructorLinks = altContract.EVM.Bytecode.Links()
+	return result, nil
+}
+
+// compileVyperSources compiles uploaded vyper files with settings of the task. The main contract is `<ContractName>.vy`.
+func (m *Module) compileVyperSources(ctx context.Context, task storage.VerificationTask, files []storage.VerificationFile) (*compilation, error) {
+	sources := make(map[string]string, len(files))
+	for i := range files {
+		sources[files[i].Name] = string(files[i].File)
+	}
+
+	mainFile := task.ContractName + ".vy"
+	if _, ok := sources[mainFile]; !ok {
+		return nil, errors.Errorf("main contract file %s is not found", mainFile)
+	}
+
+	var evmVersion *string
+	if task.EVMVersion != nil {
+		v := task.EVMVersion.String()
+		evmVersion = &v
+	}
+	version, _ := vyperVersion(task.CompilerVersion)
+
+	input, err := standard_json.NewInput(
+		standard_json.LanguageVyper,
+		sources,
+		vyperSettings(version, evmVersion, task.OptimizationMode),
+	)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := standard_json.WithOutputSelection(input, verificationOutputs...)
+	if err != nil {
+		return nil, err
+	}
+
+	vyperTask := task
+	vyperTask.ContractName = mainFile + ":" + task.ContractName
+	return m.compileVyper(ctx, vyperTask, raw, sources)
+}
+
+func (m *Module) compileVyper(ctx context.Context, task storage.VerificationTask, input []byte, sources map[string]string) (*compilation, error) {
+	binary, err := m.vyperBinary(ctx, task.CompilerVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := runStandardJSON(ctx, binary, input)
+	if err != nil {
+		return nil, errors.Wrap(err, "compile contract")
+	}
+	return newCompilation(languageVyper, output, task.ContractName, sources)
+}
+
+func newCompilation(language string, output standard_json.Output, contractName string, sources map[string]string) (*compilation, error) {
+	contract, err := output.Contract(contractName)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &compilation{
+		language: language,
+		abi:      contract.ABI,
+		sources:  sources,
+	}
+	if result.runtime, err = contract.EVM.DeployedBytecode.Bytes(); err != nil {
+		return nil, errors.Wrap(err, "decode runtime bytecode")
+	}
+	if result.constructor, err = contract.EVM.Bytecode.Bytes(); err != nil {
+		return nil, errors.Wrap(err, "decode creation bytecode")
+	}
+	result.runtimeLinks = contract.EVM.DeployedBytecode.Links()
+	result.constructorLinks = contract.EVM.Bytecode.Links()
+	return result, nil
+}
+
+// solcVersion trims the prefix and the commit hash of the compiler version
+func solcVersion(compilerVersion string) string {
+	version := strings.TrimPrefix(compilerVersion, "v")
+	if idx := strings.Index(version, "+"); idx != -1 {
+		version = version[:idx]
+	}
+	return version
 }
 
 // detectEVMVersion detects the minimum required EVM version based on opcodes present in bytecode.
@@ -245,41 +599,22 @@ func detectEVMVersion(bytecode []byte) types.EVMVersion {
 	return detectedVersion
 }
 
-func (m *Module) verifyConstructorArgs(
-	parsedABI abi.ABI,
-	deployInput []byte,
-	compiledDeployLen int,
-) error {
-	deployInputLen := len(deployInput)
-
-	// If deploy input is shorter than compiled deploy code, the contract was likely deployed via a factory (CREATE/CREATE2)
-	if deployInputLen < compiledDeployLen {
-		m.Log.Info().
-			Int("deploy_input_len", deployInputLen).
-			Int("compiled_deploy_len", compiledDeployLen).
-			Msg("contract deployed via factory, skipping constructor args verification")
-		return nil
-	}
-
-	hasConstructorParams := len(parsedABI.Constructor.Inputs) > 0
-
-	if hasConstructorParams {
-		constructorArgs := deployInput[compiledDeployLen:]
-		decodedArgs, err := parsedABI.Constructor.Inputs.Unpack(constructorArgs)
-		if err != nil {
-			return errors.Wrap(err, "decode constructor arguments")
-		}
-		m.Log.Info().
-			Int("arg_count", len(decodedArgs)).
-			Msg("constructor arguments decoded successfully")
-	} else {
-		extraBytes := deployInputLen - compiledDeployLen
-		if extraBytes >= 32 {
-			return errors.Errorf("deployment input has %d extra bytes after deploy code, but constructor has no parameters", extraBytes)
+func (m *Module) verifyConstructorArgs(parsedABI abi.ABI, constructorArgs []byte) error {
+	if len(parsedABI.Constructor.Inputs) == 0 {
+		if len(constructorArgs) > 0 {
+			return errors.Errorf("creation code has %d bytes after compiled code, but constructor has no parameters", len(constructorArgs))
 		}
 		m.Log.Info().Msg("no constructor parameters verified")
+		return nil
 	}
 
+	decodedArgs, err := parsedABI.Constructor.Inputs.Unpack(constructorArgs)
+	if err != nil {
+		return errors.Wrap(err, "decode constructor arguments")
+	}
+	m.Log.Info().
+		Int("arg_count", len(decodedArgs)).
+		Msg("constructor arguments decoded successfully")
 	return nil
 }
 
@@ -295,24 +630,92 @@ func bytecodeEqualIgnoringImmutables(compiled, onchain []byte) bool {
 			return false
 		}
 		// PUSH32 followed by 32 zero bytes = immutable placeholder, skip value
-		if compiled[i] == 0x7f && i+32 < len(compiled) {
-			isImmutable := true
-			for j := 1; j <= 32; j++ {
-				if compiled[i+j] != 0x00 {
-					isImmutable = false
-					break
-				}
-			}
-			if isImmutable {
-				i += 33
-				continue
-			}
+		if isImmutablePlaceholder(compiled, i) {
+			i += 33
+			continue
 		}
 		i++
 	}
 	return true
 }
 
+// isImmutablePlaceholder checks that PUSH32 (0x7f) followed by 32 zero bytes starts at the position of the compiled bytecode.
+func isImmutablePlaceholder(compiled []byte, i int) bool {
+	if compiled[i] != 0x7f || i+32 >= len(compiled) {
+		return false
+	}
+	for j := 1; j <= 32; j++ {
+		if compiled[i+j] != 0x00 {
+			return false
+		}
+	}
+	return true
+}
+
+// immutableOffsets returns offsets of immutable values in the compiled runtime bytecode.
+// Offsets point to values of PUSH32 placeholders, the same placeholders are skipped by bytecodeEqualIgnoringImmutables.
+func immutableOffsets(compiled []byte) []int64 {
+	var offsets []int64
+	for i := 0; i < len(compiled); {
+		if isImmutablePlaceholder(compiled, i) {
+			offsets = append(offsets, int64(i+1))
+			i += 33
+			continue
+		}
+		i++
+	}
+	return offsets
+}
+
+// bytesEqualExceptRanges compares bytecodes of the same length ignoring bytes in the ranges.
+func bytesEqualExceptRanges(a, b []byte, ranges [][2]int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	prev := 0
+	for _, r := range ranges {
+		if !bytes.Equal(a[prev:r[0]], b[prev:r[0]]) {
+			return false
+		}
+		prev = r[1]
+	}
+	return bytes.Equal(a[prev:], b[prev:])
+}
+
+// diffRanges returns ranges in which bytecodes differ. Differences closer than the metadata hash length
+// are merged and every range is widened to the metadata hash length, since bytes at the edges of hashes
+// may be equal by chance.
+func diffRanges(a, b []byte) [][2]int {
+	n := min(len(a), len(b))
+
+	var ranges [][2]int
+	for i := 0; i < n; i++ {
+		if a[i] == b[i] {
+			continue
+		}
+		start, end := i, i+1
+		for j := end; j < n && j < end+metadataHashLength; j++ {
+			if a[j] != b[j] {
+				end = j + 1
+			}
+		}
+		if pad := metadataHashLength - (end - start); pad > 0 {
+			start = max(0, start-pad)
+			end = min(n, end+pad)
+		}
+		if len(ranges) > 0 && ranges[len(ranges)-1][1] >= start {
+			ranges[len(ranges)-1][1] = end
+		} else {
+			ranges = append(ranges, [2]int{start, end})
+		}
+		i = end
+	}
+	if len(a) > n {
+		ranges = append(ranges, [2]int{n, len(a)})
+	}
+	return ranges
+}
+
 func splitBytecode(bytecode1, bytecode2 []byte) BytecodeParts {
 	minLen := len(bytecode1)
 	if len(bytecode2) < minLen {
@@ -423,54 +826,3 @@ func buildSourceMap(files []storage.VerificationFile) map[string]string {
 	}
 	return sources
 }
-
-func compileFromSources(compiler *solc.Compiler, sources map[string]string, contractName string, opts []solc.Option, mainFileName string, appendNewline bool) (*solc.Contract, func(), error) {
-	tmpDir, err := os.MkdirTemp("", "contract-verify-")
-	if err != nil {
-		return nil, func() {}, errors.Wrap(err, "create temp directory")
-	}
-	cleanup := func() { _ = os.RemoveAll(tmpDir) }
-
-	writeSources := sources
-	if appendNewline {
-		writeSources = make(map[string]string, len(sources))
-		for path, content := range sources {
-			if filepath.Base(path) == mainFileName {
-				content += "\n"
-			}
-			writeSources[path] = content
-		}
-	}
-
-	if err := writeSourceFiles(tmpDir, writeSources); err != nil {
-		cleanup()
-		return nil, func() {}, err
-	}
-
-	contract, err := compiler.Compile(tmpDir, contractName, opts...)
-	if err != nil {
-		cleanup()
-		return nil, func() {}, errors.Wrap(err, "compile contract")
-	}
-
-	return contract, cleanup, nil
-}
-
-func writeSourceFiles(dir string, sources map[string]string) error {
-	cleanDir := filepath.Clean(dir) + string(os.PathSeparator)
-	for path, content := range sources {
-		filePath := filepath.Join(dir, path)
-		if !strings.HasPrefix(filePath, cleanDir) {
-			return errors.Errorf("path traversal detected: %s", path)
-		}
-		if subDir := filepath.Dir(filePath); subDir != dir {
-			if err := os.MkdirAll(subDir, 0755); err != nil {
-				return errors.Wrapf(err, "create directory for %s", path)
-			}
-		}
-		if err := os.WriteFile(filePath, []byte(content), 0600); err != nil {
-			return errors.Wrapf(err, "write source file %s", path)
-		}
-	}
-	return nil
-}
@@ -0,0 +1,101 @@
+package contract_verifier
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/NobleScope/noble-indexer/pkg/contract_verifier/compilers"
+	"github.com/NobleScope/noble-indexer/pkg/contract_verifier/standard_json"
+	"github.com/pkg/errors"
+)
+
+const vyperReleasesURL = "https://github.com/vyperlang/vyper/releases/download"
+
+var vyperDownloadMx sync.Mutex
+
+// vyperVersion splits the compiler version `v0.3.10+commit.91361694` to the version and the commit hash
+func vyperVersion(compilerVersion string) (string, string) {
+	version, commit, _ := strings.Cut(strings.TrimPrefix(compilerVersion, "v"), "+commit.")
+	return version, commit
+}
+
+// vyperBinary returns the path of the vyper binary in the configured directory. Versions and their checksums are pinned
+// by the build list of the directory. A missing binary of the pinned version is downloaded from GitHub releases:
+// the path of the build is the name of the release asset for the platform, e.g. `vyper.0.3.10+commit.91361694.linux`.
+func (m *Module) vyperBinary(ctx context.Context, compilerVersion string) (string, error) {
+	if m.vyper == nil {
+		return "", errors.Errorf("vyper versions are not pinned: %s is missing in %s", compilers.ListFile, m.vyperDir)
+	}
+
+	vyperDownloadMx.Lock()
+	defer vyperDownloadMx.Unlock()
+
+	if _, ok := m.vyper.Find(compilerVersion); !ok {
+		build, ok := m.vyper.Pinned(compilerVersion)
+		if !ok {
+			return "", errors.Errorf("vyper %s is not pinned in %s", compilerVersion, filepath.Join(m.vyperDir, compilers.ListFile))
+		}
+
+		url := fmt.Sprintf("%s/v%s/%s", vyperReleasesURL, build.Version, build.Path)
+		m.Log.Info().Str("url", url).Msg("downloading vyper")
+
+		if err := downloadVyper(ctx, url, m.vyper, build); err != nil {
+			return "", errors.Wrapf(err, "download vyper %s", build.Version)
+		}
+	}
+	return m.vyper.Binary(compilerVersion)
+}
+
+func downloadVyper(ctx context.Context, url string, registry *compilers.Registry, build compilers.Build) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	tmp, err := os.CreateTemp(registry.Dir(), build.Path+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := io.Copy(tmp, resp.Body); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	// the binary is installed after the download and the checksum validation, so an interrupted download is never used
+	return registry.Install(build, tmp.Name())
+}
+
+// vyperSettings builds compiler settings of the task. Compilers before 0.3.10 accept only boolean optimization flag.
+func vyperSettings(version string, evmVersion, optimizationMode *string) map[string]any {
+	settings := make(map[string]any)
+	if evmVersion != nil {
+		settings["evmVersion"] = *evmVersion
+	}
+	if optimizationMode != nil {
+		if compilers.CompareVersions(version, "0.3.10") < 0 {
+			settings["optimize"] = *optimizationMode != standard_json.OptimizationModeNone
+		} else {
+			settings["optimize"] = *optimizationMode
+		}
+	}
+	return settings
+}
@@ -0,0 +1,405 @@
+package decoder
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+
+	pkgTypes "github.com/NobleScope/noble-indexer/pkg/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rs/zerolog/log"
+)
+
+// DecodedLogTopic represents a single decoded indexed event parameter.
+type DecodedLogTopic struct {
+	Name  string `json:"name"`
+	Value any    `json:"value"`
+}
+
+// DecodedLog represents the decoded output for an event log.
+// Signature is set when the log was decoded with the signature registry instead of the contract ABI.
+// Candidates lists all registry signatures matching the log if there are several of them.
+type DecodedLog struct {
+	Name       string            `json:"name"`
+	Topics     []DecodedLogTopic `json:"topics"`
+	Data       map[string]any    `json:"data"`
+	Signature  string            `json:"signature,omitempty"`
+	Candidates []string          `json:"candidates,omitempty"`
+}
+
+// DecodedTrace represents the decoded output for a trace input.
+// Signature is set when the input was decoded with the signature registry instead of the contract ABI.
+// Candidates lists all registry signatures matching the input if there are several of them.
+type DecodedTrace struct {
+	Method     string         `json:"method"`
+	Args       map[string]any `json:"args"`
+	Signature  string         `json:"signature,omitempty"`
+	Candidates []string       `json:"candidates,omitempty"`
+}
+
+// parseABI parses a JSON ABI into a go-ethereum ABI struct.
+// Returns nil if the input is empty or parsing fails.
+func parseABI(abiJSON json.RawMessage) *abi.ABI {
+	if len(abiJSON) == 0 {
+		return nil
+	}
+	parsed, err := abi.JSON(strings.NewReader(string(abiJSON)))
+	if err != nil {
+		return nil
+	}
+	return &parsed
+}
+
+// SplitABIs splits a JSON array of ABIs (e.g. ABIs of all diamond facets) into separate ABIs.
+// Returns nil if the input is empty or is not an array.
+func SplitABIs(abisJSON json.RawMessage) []json.RawMessage {
+	if len(abisJSON) == 0 {
+		return nil
+	}
+	var result []json.RawMessage
+	if err := json.Unmarshal(abisJSON, &result); err != nil {
+		return nil
+	}
+	return result
+}
+
+// DecodeLog decodes a log with the first ABI which contains the event.
+// ABIs are passed by priority: emitter, proxy implementation, diamond facets.
+func DecodeLog(data []byte, topics []pkgTypes.Hex, abis ...json.RawMessage) *DecodedLog {
+	for i := range abis {
+		if decoded := decodeLogWithABI(parseABI(abis[i]), data, topics); decoded != nil {
+			return decoded
+		}
+	}
+	return nil
+}
+
+// DecodeInput decodes a call input with the first ABI which contains the method.
+// ABIs are passed by priority: callee, proxy implementation, diamond facet of the called selector.
+func DecodeInput(input []byte, abis ...json.RawMessage) *DecodedTrace {
+	for i := range abis {
+		if decoded := decodeTxArgs(parseABI(abis[i]), input); decoded != nil {
+			return decoded
+		}
+	}
+	return nil
+}
+
+// DecodeConstructorArgs decodes ABI-encoded constructor arguments with the contract ABI.
+// Returns nil if the ABI has no constructor inputs or decoding fails.
+func DecodeConstructorArgs(args []byte, abiJSON json.RawMessage) map[string]any {
+	contractABI := parseABI(abiJSON)
+	if contractABI == nil || len(contractABI.Constructor.Inputs) == 0 {
+		return nil
+	}
+
+	values, err := contractABI.Constructor.Inputs.Unpack(args)
+	if err != nil {
+		return nil
+	}
+
+	result := make(map[string]any, len(values))
+	for i, inp := range contractABI.Constructor.Inputs {
+		if i < len(values) {
+			result[inp.Name] = FormatABIValue(values[i])
+		}
+	}
+	return result
+}
+
+// parseFragment parses a single ABI entry (e.g. from the signature registry) into a go-ethereum ABI struct.
+// Returns nil if the input is empty or parsing fails.
+func parseFragment(fragment json.RawMessage) *abi.ABI {
+	if len(fragment) == 0 {
+		return nil
+	}
+	return parseABI(json.RawMessage("[" + string(fragment) + "]"))
+}
+
+// DecodeInputBySignatures decodes a call input with ABI fragments of the signature registry.
+// It is a best-effort fallback for unverified contracts: the first fragment which unpacks the input is used
+// and all matching signatures are reported as candidates in case of selector collision.
+func DecodeInputBySignatures(input []byte, signatures json.RawMessage) *DecodedTrace {
+	if len(input) < 4 {
+		return nil
+	}
+
+	var (
+		result     *DecodedTrace
+		candidates = make([]string, 0)
+	)
+	for _, fragment := range SplitABIs(signatures) {
+		fragmentABI := parseFragment(fragment)
+		if fragmentABI == nil {
+			continue
+		}
+		method, err := fragmentABI.MethodById(input[:4])
+		if err != nil {
+			continue
+		}
+		values, err := method.Inputs.Unpack(input[4:])
+		if err != nil {
+			continue
+		}
+
+		candidates = append(candidates, method.Sig)
+		if result != nil {
+			continue
+		}
+
+		args := make(map[string]any, len(method.Inputs))
+		for i, inp := range method.Inputs {
+			if i < len(values) {
+				args[inp.Name] = FormatABIValue(values[i])
+			}
+		}
+		result = &DecodedTrace{
+			Method:    method.Name,
+			Args:      args,
+			Signature: method.Sig,
+		}
+	}
+
+	if result != nil && len(candidates) > 1 {
+		result.Candidates = candidates
+	}
+	return result
+}
+
+// DecodeLogBySignatures decodes a log with ABI fragments of the signature registry.
+// It is a best-effort fallback for unverified contracts: the first fragment which unpacks the log is used
+// and all matching signatures are reported as candidates in case of topic collision.
+func DecodeLogBySignatures(data []byte, topics []pkgTypes.Hex, signatures json.RawMessage) *DecodedLog {
+	if len(topics) == 0 {
+		return nil
+	}
+
+	var (
+		result     *DecodedLog
+		candidates = make([]string, 0)
+	)
+	for _, fragment := range SplitABIs(signatures) {
+		fragmentABI := parseFragment(fragment)
+		if fragmentABI == nil {
+			continue
+		}
+		event, err := fragmentABI.EventByID(common.BytesToHash(topics[0]))
+		if err != nil {
+			continue
+		}
+		decoded, err := unpackEvent(event, data, topics)
+		if err != nil {
+			continue
+		}
+
+		candidates = append(candidates, event.Sig)
+		if result == nil {
+			decoded.Signature = event.Sig
+			result = decoded
+		}
+	}
+
+	if result != nil && len(candidates) > 1 {
+		result.Candidates = candidates
+	}
+	return result
+}
+
+// unpackEvent strictly decodes a log with the event and fails if topics or data do not match its arguments.
+// Text signatures do not know which arguments are indexed, so if the event has no indexed arguments
+// the leading arguments are treated as indexed according to the topics count.
+func unpackEvent(event *abi.Event, data []byte, topics []pkgTypes.Hex) (*DecodedLog, error) {
+	inputs := make(abi.Arguments, len(event.Inputs))
+	copy(inputs, event.Inputs)
+
+	indexedCount := 0
+	for i := range inputs {
+		if inputs[i].Indexed {
+			indexedCount++
+		}
+	}
+	if indexedCount == 0 && len(topics) > 1 {
+		if len(topics)-1 > len(inputs) {
+			return nil, fmt.Errorf("too many topics for event %s", event.Sig)
+		}
+		for i := 0; i < len(topics)-1; i++ {
+			inputs[i].Indexed = true
+		}
+	}
+
+	indexedArgs := make(abi.Arguments, 0, len(topics)-1)
+	for i := range inputs {
+		if inputs[i].Indexed {
+			indexedArgs = append(indexedArgs, inputs[i])
+		}
+	}
+	if len(indexedArgs) != len(topics)-1 {
+		return nil, fmt.Errorf("topics count mismatch for event %s", event.Sig)
+	}
+
+	topicHashes := make([]common.Hash, 0, len(topics)-1)
+	for _, t := range topics[1:] {
+		topicHashes = append(topicHashes, common.BytesToHash(t))
+	}
+
+	indexedValues := make(map[string]any, len(indexedArgs))
+	if err := abi.ParseTopicsIntoMap(indexedValues, indexedArgs, topicHashes); err != nil {
+		return nil, err
+	}
+
+	result := &DecodedLog{
+		Name:   event.Name,
+		Topics: make([]DecodedLogTopic, 0, len(indexedArgs)),
+		Data:   make(map[string]any),
+	}
+	for i := range indexedArgs {
+		result.Topics = append(result.Topics, DecodedLogTopic{
+			Name:  indexedArgs[i].Name,
+			Value: FormatABIValue(indexedValues[indexedArgs[i].Name]),
+		})
+	}
+
+	if err := inputs.NonIndexed().UnpackIntoMap(result.Data, data); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// decodeLogWithABI decodes a log using an already-parsed ABI.
+// Returns nil if abi is nil, topics are empty, or decoding fails.
+func decodeLogWithABI(contractABI *abi.ABI, data []byte, topics []pkgTypes.Hex) *DecodedLog {
+	if contractABI == nil || len(topics) == 0 {
+		return nil
+	}
+
+	topic0 := common.BytesToHash(topics[0])
+	event, err := contractABI.EventByID(topic0)
+	if err != nil {
+		return nil
+	}
+
+	// Decode indexed parameters from topics[1:]
+	indexedArgs := make(abi.Arguments, 0)
+	for i := range event.Inputs {
+		if event.Inputs[i].Indexed {
+			indexedArgs = append(indexedArgs, event.Inputs[i])
+		}
+	}
+
+	topicHashes := make([]common.Hash, 0, len(topics)-1)
+	for _, t := range topics[1:] {
+		topicHashes = append(topicHashes, common.BytesToHash(t))
+	}
+
+	result := &DecodedLog{
+		Name:   event.Name,
+		Topics: make([]DecodedLogTopic, 0, len(indexedArgs)),
+		Data:   make(map[string]any),
+	}
+
+	indexedValues := make(map[string]any, len(indexedArgs))
+	if err := abi.ParseTopicsIntoMap(indexedValues, indexedArgs, topicHashes); err == nil {
+		for i := range indexedArgs {
+			if val, ok := indexedValues[indexedArgs[i].Name]; ok {
+				result.Topics = append(result.Topics, DecodedLogTopic{
+					Name:  indexedArgs[i].Name,
+					Value: FormatABIValue(val),
+				})
+			}
+		}
+	}
+
+	// Decode non-indexed parameters from data
+	if len(data) > 0 {
+		if err := contractABI.UnpackIntoMap(result.Data, event.Name, data); err != nil {
+			log.Err(err).Msg("unpack into map")
+		}
+	}
+
+	return result
+}
+
+// decodeTxArgs decodes a trace's input data using an already-parsed ABI.
+// Returns nil if abi is nil, input is shorter than 4 bytes, or decoding fails.
+func decodeTxArgs(contractABI *abi.ABI, input []byte) *DecodedTrace {
+	if contractABI == nil || len(input) < 4 {
+		return nil
+	}
+
+	method, err := contractABI.MethodById(input[:4])
+	if err != nil {
+		return nil
+	}
+
+	args := make(map[string]any, len(method.Inputs))
+
+	if len(input) > 4 {
+		values, err := method.Inputs.Unpack(input[4:])
+		if err != nil {
+			return &DecodedTrace{
+				Method: method.Name,
+				Args:   args,
+			}
+		}
+
+		for i, inp := range method.Inputs {
+			if i < len(values) {
+				args[inp.Name] = FormatABIValue(values[i])
+			}
+		}
+	}
+
+	return &DecodedTrace{
+		Method: method.Name,
+		Args:   args,
+	}
+}
+
+// FormatABIValue converts ABI-decoded Go values to JSON-friendly representations.
+func FormatABIValue(v any) any {
+	switch val := v.(type) {
+	case common.Address:
+		return val.Hex()
+	case *common.Address:
+		if val == nil {
+			return nil
+		}
+		return val.Hex()
+	case common.Hash:
+		return val.Hex()
+	case *big.Int:
+		if val == nil {
+			return "0"
+		}
+		return val.String()
+	case []byte:
+		return fmt.Sprintf("0x%x", val)
+	case [32]byte:
+		return fmt.Sprintf("0x%x", val[:])
+	case bool:
+		return val
+	case string:
+		return val
+	case uint8:
+		return val
+	case uint16:
+		return val
+	case uint32:
+		return val
+	case uint64:
+		return val
+	case int8:
+		return val
+	case int16:
+		return val
+	case int32:
+		return val
+	case int64:
+		return val
+	default:
+		return fmt.Sprintf("%v", val)
+	}
+}
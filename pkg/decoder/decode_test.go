@@ -0,0 +1,272 @@
+package decoder
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	pkgTypes "github.com/NobleScope/noble-indexer/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+// ERC20 transfer(address,uint256) ABI
+var erc20ABI = json.RawMessage(`[{"inputs":[{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],"name":"transfer","outputs":[{"name":"","type":"bool"}],"stateMutability":"nonpayable","type":"function"}]`)
+
+func TestParseABI(t *testing.T) {
+	t.Run("valid ABI", func(t *testing.T) {
+		parsed := parseABI(erc20ABI)
+		require.NotNil(t, parsed)
+		require.Contains(t, parsed.Methods, "transfer")
+	})
+
+	t.Run("nil ABI", func(t *testing.T) {
+		parsed := parseABI(nil)
+		require.Nil(t, parsed)
+	})
+
+	t.Run("empty ABI", func(t *testing.T) {
+		parsed := parseABI(json.RawMessage{})
+		require.Nil(t, parsed)
+	})
+
+	t.Run("invalid JSON", func(t *testing.T) {
+		parsed := parseABI(json.RawMessage(`invalid`))
+		require.Nil(t, parsed)
+	})
+}
+
+func TestDecodeTxArgs(t *testing.T) {
+	parsed := parseABI(erc20ABI)
+	require.NotNil(t, parsed)
+
+	t.Run("valid transfer input", func(t *testing.T) {
+		// transfer(address,uint256) selector = 0xa9059cbb
+		// to = 0x000000000000000000000000dead000000000000000000000000000000000000
+		// amount = 1000 (0x3e8)
+		input := pkgTypes.MustDecodeHex(
+			"a9059cbb" +
+				"000000000000000000000000dead000000000000000000000000000000000000" +
+				"00000000000000000000000000000000000000000000000000000000000003e8",
+		)
+		decoded := decodeTxArgs(parsed, input)
+		require.NotNil(t, decoded)
+		require.Equal(t, "transfer", decoded.Method)
+		require.Contains(t, decoded.Args, "to")
+		require.Contains(t, decoded.Args, "amount")
+		require.Equal(t, "0xdEad000000000000000000000000000000000000", decoded.Args["to"])
+		require.Equal(t, "1000", decoded.Args["amount"])
+	})
+
+	t.Run("nil ABI", func(t *testing.T) {
+		input := []byte{0xa9, 0x05, 0x9c, 0xbb}
+		decoded := decodeTxArgs(nil, input)
+		require.Nil(t, decoded)
+	})
+
+	t.Run("input shorter than 4 bytes", func(t *testing.T) {
+		decoded := decodeTxArgs(parsed, []byte{0xa9, 0x05})
+		require.Nil(t, decoded)
+	})
+
+	t.Run("unknown selector", func(t *testing.T) {
+		decoded := decodeTxArgs(parsed, []byte{0x00, 0x00, 0x00, 0x00})
+		require.Nil(t, decoded)
+	})
+
+	t.Run("only selector without args", func(t *testing.T) {
+		decoded := decodeTxArgs(parsed, []byte{0xa9, 0x05, 0x9c, 0xbb})
+		require.NotNil(t, decoded)
+		require.Equal(t, "transfer", decoded.Method)
+		require.Empty(t, decoded.Args)
+	})
+}
+
+func TestDecodeLogWithABI(t *testing.T) {
+	transferEventABI := json.RawMessage(`[{"anonymous":false,"inputs":[{"indexed":true,"name":"from","type":"address"},{"indexed":true,"name":"to","type":"address"},{"indexed":false,"name":"value","type":"uint256"}],"name":"Transfer","type":"event"}]`)
+
+	parsed := parseABI(transferEventABI)
+	require.NotNil(t, parsed)
+
+	t.Run("nil ABI", func(t *testing.T) {
+		decoded := decodeLogWithABI(nil, []byte{}, []pkgTypes.Hex{{0x01}})
+		require.Nil(t, decoded)
+	})
+
+	t.Run("empty topics", func(t *testing.T) {
+		decoded := decodeLogWithABI(parsed, []byte{}, nil)
+		require.Nil(t, decoded)
+	})
+}
+
+func TestDecodeInput(t *testing.T) {
+	// transfer is not a part of the proxy or diamond ABI, it is implemented by the implementation or facet
+	proxyABI := json.RawMessage(`[{"inputs":[{"name":"newImplementation","type":"address"}],"name":"upgradeTo","outputs":[],"stateMutability":"nonpayable","type":"function"}]`)
+	input := pkgTypes.MustDecodeHex(
+		"a9059cbb" +
+			"000000000000000000000000dead000000000000000000000000000000000000" +
+			"00000000000000000000000000000000000000000000000000000000000003e8",
+	)
+
+	t.Run("contract ABI", func(t *testing.T) {
+		decoded := DecodeInput(input, erc20ABI, nil, nil)
+		require.NotNil(t, decoded)
+		require.Equal(t, "transfer", decoded.Method)
+	})
+
+	t.Run("implementation ABI", func(t *testing.T) {
+		decoded := DecodeInput(input, proxyABI, erc20ABI, nil)
+		require.NotNil(t, decoded)
+		require.Equal(t, "transfer", decoded.Method)
+		require.Equal(t, "1000", decoded.Args["amount"])
+	})
+
+	t.Run("implementation ABI of unverified proxy", func(t *testing.T) {
+		decoded := DecodeInput(input, nil, erc20ABI, nil)
+		require.NotNil(t, decoded)
+		require.Equal(t, "transfer", decoded.Method)
+	})
+
+	t.Run("facet ABI", func(t *testing.T) {
+		decoded := DecodeInput(input, proxyABI, nil, erc20ABI)
+		require.NotNil(t, decoded)
+		require.Equal(t, "transfer", decoded.Method)
+		require.Equal(t, "1000", decoded.Args["amount"])
+	})
+
+	t.Run("no ABI", func(t *testing.T) {
+		decoded := DecodeInput(input, nil, nil, nil)
+		require.Nil(t, decoded)
+	})
+}
+
+func TestDecodeConstructorArgs(t *testing.T) {
+	tokenABI := json.RawMessage(`[{"inputs":[{"name":"owner","type":"address"},{"name":"supply","type":"uint256"}],"stateMutability":"nonpayable","type":"constructor"}]`)
+	args := pkgTypes.MustDecodeHex("000000000000000000000000742d35cc6634c0532925a3b844bc9e7595f0beb0" +
+		"00000000000000000000000000000000000000000000000000000000000003e8")
+
+	t.Run("decoded", func(t *testing.T) {
+		decoded := DecodeConstructorArgs(args, tokenABI)
+		require.Equal(t, map[string]any{
+			"owner":  "0x742D35CC6634c0532925A3b844BC9E7595F0BEb0",
+			"supply": "1000",
+		}, decoded)
+	})
+
+	t.Run("no constructor", func(t *testing.T) {
+		require.Nil(t, DecodeConstructorArgs(args, erc20ABI))
+	})
+
+	t.Run("invalid args", func(t *testing.T) {
+		require.Nil(t, DecodeConstructorArgs(args[:16], tokenABI))
+	})
+}
+
+func TestDecodeLog(t *testing.T) {
+	transferEventABI := json.RawMessage(`[{"anonymous":false,"inputs":[{"indexed":true,"name":"from","type":"address"},{"indexed":true,"name":"to","type":"address"},{"indexed":false,"name":"value","type":"uint256"}],"name":"Transfer","type":"event"}]`)
+	topics := []pkgTypes.Hex{
+		pkgTypes.MustDecodeHex("ddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"),
+		pkgTypes.MustDecodeHex("000000000000000000000000dead000000000000000000000000000000000000"),
+		pkgTypes.MustDecodeHex("000000000000000000000000beef000000000000000000000000000000000000"),
+	}
+	data := pkgTypes.MustDecodeHex("00000000000000000000000000000000000000000000000000000000000003e8")
+
+	t.Run("implementation ABI", func(t *testing.T) {
+		decoded := DecodeLog(data, topics, erc20ABI, transferEventABI)
+		require.NotNil(t, decoded)
+		require.Equal(t, "Transfer", decoded.Name)
+		require.Len(t, decoded.Topics, 2)
+		require.Equal(t, "1000", decoded.Data["value"].(fmt.Stringer).String())
+	})
+
+	t.Run("facets ABIs", func(t *testing.T) {
+		facetABIs := json.RawMessage(`[` + string(erc20ABI) + `,` + string(transferEventABI) + `]`)
+		decoded := DecodeLog(data, topics, append([]json.RawMessage{nil, nil}, SplitABIs(facetABIs)...)...)
+		require.NotNil(t, decoded)
+		require.Equal(t, "Transfer", decoded.Name)
+	})
+
+	t.Run("no ABI", func(t *testing.T) {
+		decoded := DecodeLog(data, topics, nil, nil)
+		require.Nil(t, decoded)
+	})
+}
+
+func TestSplitABIs(t *testing.T) {
+	require.Len(t, SplitABIs(json.RawMessage(`[[],[{"type":"fallback"}]]`)), 2)
+	require.Nil(t, SplitABIs(nil))
+	require.Nil(t, SplitABIs(json.RawMessage(`{}`)))
+}
+
+func TestDecodeInputBySignatures(t *testing.T) {
+	transfer := `{"type":"function","name":"transfer","inputs":[{"name":"arg0","type":"address"},{"name":"arg1","type":"uint256"}]}`
+	collision := `{"type":"function","name":"many_msg_babbage","inputs":[{"name":"arg0","type":"bytes1"}]}`
+	input := pkgTypes.MustDecodeHex(
+		"a9059cbb" +
+			"000000000000000000000000dead000000000000000000000000000000000000" +
+			"00000000000000000000000000000000000000000000000000000000000003e8",
+	)
+
+	t.Run("single signature", func(t *testing.T) {
+		decoded := DecodeInputBySignatures(input, json.RawMessage(`[`+transfer+`]`))
+		require.NotNil(t, decoded)
+		require.Equal(t, "transfer", decoded.Method)
+		require.Equal(t, "transfer(address,uint256)", decoded.Signature)
+		require.Equal(t, "1000", decoded.Args["arg1"])
+		require.Empty(t, decoded.Candidates)
+	})
+
+	t.Run("selector collision", func(t *testing.T) {
+		decoded := DecodeInputBySignatures(input, json.RawMessage(`[`+transfer+`,`+collision+`]`))
+		require.NotNil(t, decoded)
+		require.Equal(t, "transfer", decoded.Method)
+		require.Equal(t, []string{"transfer(address,uint256)", "many_msg_babbage(bytes1)"}, decoded.Candidates)
+	})
+
+	t.Run("signature does not match arguments", func(t *testing.T) {
+		decoded := DecodeInputBySignatures(input[:20], json.RawMessage(`[`+transfer+`,`+collision+`]`))
+		require.Nil(t, decoded)
+	})
+
+	t.Run("no signatures", func(t *testing.T) {
+		require.Nil(t, DecodeInputBySignatures(input, nil))
+	})
+}
+
+func TestDecodeLogBySignatures(t *testing.T) {
+	topics := []pkgTypes.Hex{
+		pkgTypes.MustDecodeHex("ddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"),
+		pkgTypes.MustDecodeHex("000000000000000000000000dead000000000000000000000000000000000000"),
+		pkgTypes.MustDecodeHex("000000000000000000000000beef000000000000000000000000000000000000"),
+	}
+	data := pkgTypes.MustDecodeHex("00000000000000000000000000000000000000000000000000000000000003e8")
+
+	t.Run("event from ABI", func(t *testing.T) {
+		signatures := json.RawMessage(`[{"anonymous":false,"inputs":[{"indexed":true,"name":"from","type":"address"},{"indexed":true,"name":"to","type":"address"},{"indexed":false,"name":"value","type":"uint256"}],"name":"Transfer","type":"event"}]`)
+		decoded := DecodeLogBySignatures(data, topics, signatures)
+		require.NotNil(t, decoded)
+		require.Equal(t, "Transfer", decoded.Name)
+		require.Equal(t, "Transfer(address,address,uint256)", decoded.Signature)
+		require.Len(t, decoded.Topics, 2)
+		require.Equal(t, "from", decoded.Topics[0].Name)
+		require.Equal(t, "0xdEad000000000000000000000000000000000000", decoded.Topics[0].Value)
+		require.Equal(t, "1000", decoded.Data["value"].(fmt.Stringer).String())
+	})
+
+	t.Run("event from text signature without indexed flags", func(t *testing.T) {
+		signatures := json.RawMessage(`[{"type":"event","name":"Transfer","inputs":[{"name":"arg0","type":"address"},{"name":"arg1","type":"address"},{"name":"arg2","type":"uint256"}]}]`)
+		decoded := DecodeLogBySignatures(data, topics, signatures)
+		require.NotNil(t, decoded)
+		require.Len(t, decoded.Topics, 2)
+		require.Equal(t, "0xbeeF000000000000000000000000000000000000", decoded.Topics[1].Value)
+		require.Equal(t, "1000", decoded.Data["arg2"].(fmt.Stringer).String())
+	})
+
+	t.Run("topics count mismatch", func(t *testing.T) {
+		signatures := json.RawMessage(`[{"anonymous":false,"inputs":[{"indexed":true,"name":"from","type":"address"},{"indexed":false,"name":"to","type":"address"},{"indexed":false,"name":"value","type":"uint256"}],"name":"Transfer","type":"event"}]`)
+		require.Nil(t, DecodeLogBySignatures(data, topics, signatures))
+	})
+
+	t.Run("no signatures", func(t *testing.T) {
+		require.Nil(t, DecodeLogBySignatures(data, topics, nil))
+	})
+}
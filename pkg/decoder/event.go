@@ -0,0 +1,62 @@
+package decoder
+
+import (
+	"encoding/json"
+
+	pkgTypes "github.com/NobleScope/noble-indexer/pkg/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Event represents a decoded event which is stored with the log.
+// Args contains both indexed and non-indexed arguments by their names.
+type Event struct {
+	Name      string
+	Signature string
+	Args      map[string]any
+}
+
+// DecodeEvent strictly decodes a log with the first ABI which contains the event.
+// ABIs are passed by priority: emitter, proxy implementation, diamond facets.
+// If no ABI decodes the log, the first matching fragment of the signature registry is used.
+func DecodeEvent(data []byte, topics []pkgTypes.Hex, signatures json.RawMessage, abis ...json.RawMessage) *Event {
+	if len(topics) == 0 {
+		return nil
+	}
+
+	topic0 := common.BytesToHash(topics[0])
+	for i := range abis {
+		contractABI := parseABI(abis[i])
+		if contractABI == nil {
+			continue
+		}
+		event, err := contractABI.EventByID(topic0)
+		if err != nil {
+			continue
+		}
+		decoded, err := unpackEvent(event, data, topics)
+		if err != nil {
+			continue
+		}
+		return newEvent(event.Sig, decoded)
+	}
+
+	if decoded := DecodeLogBySignatures(data, topics, signatures); decoded != nil {
+		return newEvent(decoded.Signature, decoded)
+	}
+	return nil
+}
+
+func newEvent(signature string, decoded *DecodedLog) *Event {
+	event := &Event{
+		Name:      decoded.Name,
+		Signature: signature,
+		Args:      make(map[string]any, len(decoded.Topics)+len(decoded.Data)),
+	}
+	for _, topic := range decoded.Topics {
+		event.Args[topic.Name] = topic.Value
+	}
+	for name, value := range decoded.Data {
+		event.Args[name] = FormatABIValue(value)
+	}
+	return event
+}
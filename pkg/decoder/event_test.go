@@ -0,0 +1,48 @@
+package decoder
+
+import (
+	"encoding/json"
+	"testing"
+
+	pkgTypes "github.com/NobleScope/noble-indexer/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeEvent(t *testing.T) {
+	transferEventABI := json.RawMessage(`[{"anonymous":false,"inputs":[{"indexed":true,"name":"from","type":"address"},{"indexed":true,"name":"to","type":"address"},{"indexed":false,"name":"value","type":"uint256"}],"name":"Transfer","type":"event"}]`)
+	topics := []pkgTypes.Hex{
+		pkgTypes.MustDecodeHex("ddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"),
+		pkgTypes.MustDecodeHex("000000000000000000000000dead000000000000000000000000000000000000"),
+		pkgTypes.MustDecodeHex("000000000000000000000000beef000000000000000000000000000000000000"),
+	}
+	data := pkgTypes.MustDecodeHex("00000000000000000000000000000000000000000000000000000000000003e8")
+
+	t.Run("contract ABI", func(t *testing.T) {
+		event := DecodeEvent(data, topics, nil, erc20ABI, transferEventABI)
+		require.NotNil(t, event)
+		require.Equal(t, "Transfer", event.Name)
+		require.Equal(t, "Transfer(address,address,uint256)", event.Signature)
+		require.Equal(t, map[string]any{
+			"from":  "0xdEad000000000000000000000000000000000000",
+			"to":    "0xbeeF000000000000000000000000000000000000",
+			"value": "1000",
+		}, event.Args)
+	})
+
+	t.Run("signature registry", func(t *testing.T) {
+		signatures := json.RawMessage(`[{"type":"event","name":"Transfer","inputs":[{"name":"arg0","type":"address"},{"name":"arg1","type":"address"},{"name":"arg2","type":"uint256"}]}]`)
+		event := DecodeEvent(data, topics, signatures, erc20ABI)
+		require.NotNil(t, event)
+		require.Equal(t, "Transfer(address,address,uint256)", event.Signature)
+		require.Equal(t, "1000", event.Args["arg2"])
+	})
+
+	t.Run("ABI does not match topics", func(t *testing.T) {
+		mismatchABI := json.RawMessage(`[{"anonymous":false,"inputs":[{"indexed":true,"name":"from","type":"address"},{"indexed":false,"name":"to","type":"address"},{"indexed":false,"name":"value","type":"uint256"}],"name":"Transfer","type":"event"}]`)
+		require.Nil(t, DecodeEvent(data, topics, nil, mismatchABI))
+	})
+
+	t.Run("no topics", func(t *testing.T) {
+		require.Nil(t, DecodeEvent(data, nil, nil, transferEventABI))
+	})
+}
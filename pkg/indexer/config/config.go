@@ -2,6 +2,8 @@ package config
 
 import (
 	"github.com/NobleScope/noble-indexer/internal/profiler"
+	"github.com/NobleScope/noble-indexer/internal/storage"
+	"github.com/NobleScope/noble-indexer/pkg/types"
 	"github.com/dipdup-net/go-lib/config"
 	"github.com/pkg/errors"
 )
@@ -21,21 +23,50 @@ type Config struct {
 }
 
 type Indexer struct {
-	Name            string         `validate:"omitempty"     yaml:"name"`
-	StartLevel      int64          `validate:"omitempty"     yaml:"start_level"`
-	BlockPeriod     int64          `validate:"omitempty"     yaml:"block_period"`
-	ScriptsDir      string         `validate:"omitempty,dir" yaml:"scripts_dir"`
-	AssetsDir       string         `validate:"omitempty,dir" yaml:"assets_dir"`
-	GenesisFilename string         `validate:"omitempty"     yaml:"genesis_filename"`
-	RequestBulkSize int            `validate:"min=1"         yaml:"request_bulk_size"`
+	Name            string         `validate:"omitempty"                     yaml:"name"`
+	StartLevel      int64          `validate:"omitempty"                     yaml:"start_level"`
+	EndLevel        int64          `validate:"omitempty,gtefield=StartLevel" yaml:"end_level"`
+	BlockPeriod     int64          `validate:"omitempty"                     yaml:"block_period"`
+	FinalityPeriod  int64          `validate:"omitempty,min=0"               yaml:"finality_period"`
+	ScriptsDir      string         `validate:"omitempty,dir"                 yaml:"scripts_dir"`
+	AssetsDir       string         `validate:"omitempty,dir"                 yaml:"assets_dir"`
+	GenesisFilename string         `validate:"omitempty"                     yaml:"genesis_filename"`
+	RequestBulkSize int            `validate:"min=1"                         yaml:"request_bulk_size"`
+	RetryCount      int            `validate:"omitempty,min=0"               yaml:"request_retry_count"`
+	RetryDelay      int64          `validate:"omitempty,min=1"               yaml:"request_retry_delay"`
 	Proxy           ProxyContracts `yaml:"proxy_contracts"`
+	LogDecoder      LogDecoder     `yaml:"log_decoder"`
+	NodePool        NodePool       `yaml:"node_pool"`
+	Backfill        Backfill       `yaml:"backfill"`
+}
+
+// IsRange returns true if the indexer indexes the limited range of blocks [start_level, end_level].
+// Range indexers keep their own state until the range is merged to the canonical state.
+func (cfg Indexer) IsRange() bool {
+	return cfg.EndLevel > 0
+}
+
+// StateName returns the name of the indexer state. The range state is named after the indexer and its range.
+func (cfg Indexer) StateName() string {
+	if !cfg.IsRange() {
+		return cfg.Name
+	}
+	return storage.RangeStateName(cfg.Name, types.Level(cfg.StartLevel), types.Level(cfg.EndLevel))
 }
 
 type API struct {
-	Bind           string `validate:"required"        yaml:"bind"`
-	RateLimit      int    `validate:"omitempty,min=0" yaml:"rate_limit"`
-	RequestTimeout int    `validate:"omitempty,min=1" yaml:"request_timeout"`
-	Websocket      bool   `validate:"omitempty"       yaml:"websocket"`
+	Bind           string  `validate:"required"        yaml:"bind"`
+	RateLimit      int     `validate:"omitempty,min=0" yaml:"rate_limit"`
+	RequestTimeout int     `validate:"omitempty,min=1" yaml:"request_timeout"`
+	Websocket      bool    `validate:"omitempty"       yaml:"websocket"`
+	JsonRpc        JsonRpc `yaml:"json_rpc"`
+}
+
+type JsonRpc struct {
+	Enabled       bool   `validate:"omitempty"       yaml:"enabled"`
+	MaxBlockRange uint64 `validate:"omitempty,min=1" yaml:"max_block_range"`
+	MaxLogs       int    `validate:"omitempty,min=1" yaml:"max_logs"`
+	MaxBatchSize  int    `validate:"omitempty,min=1" yaml:"max_batch_size"`
 }
 
 type Cache struct {
@@ -59,8 +90,34 @@ type ProxyContracts struct {
 	MaxResolvingAttempts uint `validate:"min=1" yaml:"max_resolving_attempts"`
 }
 
+type LogDecoder struct {
+	Enabled           bool `validate:"omitempty"       yaml:"enabled"`
+	SyncPeriodSeconds int  `validate:"omitempty,min=1" yaml:"sync_period_seconds"`
+	BatchSize         int  `validate:"omitempty,min=1" yaml:"batch_size"`
+}
+
+// Backfill is the config of the historical indexing far from the head. Bulks of blocks are requested and parsed by workers
+// concurrently and saved by batches of blocks in a single transaction. Near the head blocks are indexed one by one.
+type Backfill struct {
+	Workers   int `validate:"omitempty,min=1" yaml:"workers"`
+	BatchSize int `validate:"omitempty,min=1" yaml:"batch_size"`
+}
+
+// NodePool is the health check config of node RPC endpoints. Every datasource of `evm_node_rpc` kind is the endpoint of the pool.
+type NodePool struct {
+	HealthCheckPeriod int64   `validate:"omitempty,min=1"      yaml:"health_check_period"`
+	MaxHeadLag        uint64  `validate:"omitempty"            yaml:"max_head_lag"`
+	MaxErrorRate      float64 `validate:"omitempty,gt=0,lte=1" yaml:"max_error_rate"`
+}
+
 type ContractVerifier struct {
-	SyncPeriod int64 `validate:"min=1" yaml:"sync_period"`
+	SyncPeriod     int64  `validate:"min=1"           yaml:"sync_period"`
+	Workers        int    `validate:"omitempty,min=1" yaml:"workers"`
+	TaskTimeout    int64  `validate:"omitempty,min=1" yaml:"task_timeout"`
+	MaxAttempts    int    `validate:"omitempty,min=1" yaml:"max_attempts"`
+	VyperDir       string `validate:"omitempty"       yaml:"vyper_dir"`
+	CompilersDir   string `validate:"omitempty"       yaml:"compilers_dir"`
+	SignaturesDump string `validate:"omitempty,file"  yaml:"signatures_dump"`
 }
 
 // Substitute -
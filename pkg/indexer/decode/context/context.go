@@ -99,6 +99,14 @@ func (ctx *Context) AddProxyContract(proxyContract *storage.ProxyContract) {
 	}
 }
 
+// SetProxyContract - replaces the proxy contract found earlier in the block, so the latest upgrade wins
+func (ctx *Context) SetProxyContract(proxyContract *storage.ProxyContract) {
+	if proxyContract == nil {
+		return
+	}
+	ctx.ProxyContracts.Set(proxyContract.String(), proxyContract)
+}
+
 func (ctx *Context) AddUserOp(userOp *storage.ERC4337UserOp) {
 	if userOp == nil {
 		return
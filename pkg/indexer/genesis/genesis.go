@@ -20,6 +20,7 @@ type Module struct {
 	modules.BaseModule
 	storage     postgres.Storage
 	indexerName string
+	endHeight   types.Level
 }
 
 var _ modules.Module = (*Module)(nil)
@@ -29,7 +30,8 @@ func NewModule(pg postgres.Storage, cfg config.Indexer) Module {
 	m := Module{
 		BaseModule:  modules.New("genesis"),
 		storage:     pg,
-		indexerName: cfg.Name,
+		indexerName: cfg.StateName(),
+		endHeight:   types.Level(cfg.EndLevel),
 	}
 
 	m.CreateInput(InputName)
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/NobleScope/noble-indexer/internal/storage"
+	"github.com/NobleScope/noble-indexer/pkg/bytecode"
 	dCtx "github.com/NobleScope/noble-indexer/pkg/indexer/decode/context"
 	"github.com/NobleScope/noble-indexer/pkg/indexer/parser"
 	pkgTypes "github.com/NobleScope/noble-indexer/pkg/types"
@@ -63,8 +64,9 @@ func (module *Module) parse(genesis pkgTypes.Genesis) (parsedData, error) {
 				Address: storage.Address{
 					Hash: hash,
 				},
-				Code: v.Code,
-				TxId: nil,
+				Code:     v.Code,
+				CodeHash: bytecode.NormalizedHash(v.Code),
+				TxId:     nil,
 			}
 
 			err = parser.ParseEvmContractMetadata(contract)
@@ -41,7 +41,25 @@ func (module *Module) save(ctx context.Context, data parsedData) error {
 		balances = append(balances, addresses[i].Balance)
 	}
 
-	if err := tx.SaveBalances(ctx, balances...); err != nil {
+	updated, err := tx.SaveBalances(ctx, balances...)
+	if err != nil {
+		return tx.HandleError(ctx, err)
+	}
+
+	history := make([]*storage.BalanceHistory, 0, len(updated))
+	for i := range updated {
+		if updated[i].Value.IsZero() {
+			continue
+		}
+		history = append(history, &storage.BalanceHistory{
+			Height:    0,
+			Time:      data.time,
+			AddressId: updated[i].Id,
+			Value:     updated[i].Value,
+			Delta:     updated[i].Value,
+		})
+	}
+	if err := tx.SaveBalanceHistory(ctx, history...); err != nil {
 		return tx.HandleError(ctx, err)
 	}
 
@@ -76,6 +94,7 @@ func (module *Module) save(ctx context.Context, data parsedData) error {
 		TotalContracts:         totalContracts,
 		TotalVerifiedContracts: 0,
 		TotalTokens:            0,
+		EndHeight:              module.endHeight,
 	}); err != nil {
 		return tx.HandleError(ctx, err)
 	}
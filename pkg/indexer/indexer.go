@@ -2,6 +2,8 @@ package indexer
 
 import (
 	"context"
+	"slices"
+	"strings"
 	"sync"
 	"time"
 
@@ -9,13 +11,17 @@ import (
 	"github.com/NobleScope/noble-indexer/internal/storage/postgres"
 	"github.com/NobleScope/noble-indexer/pkg/indexer/config"
 	"github.com/NobleScope/noble-indexer/pkg/indexer/genesis"
+	logDecoder "github.com/NobleScope/noble-indexer/pkg/indexer/log_decoder"
 	"github.com/NobleScope/noble-indexer/pkg/indexer/parser"
 	proxy "github.com/NobleScope/noble-indexer/pkg/indexer/proxy_contracts_resolver"
 	"github.com/NobleScope/noble-indexer/pkg/indexer/receiver"
 	"github.com/NobleScope/noble-indexer/pkg/indexer/rollback"
 	"github.com/NobleScope/noble-indexer/pkg/indexer/storage"
 	"github.com/NobleScope/noble-indexer/pkg/node"
+	"github.com/NobleScope/noble-indexer/pkg/node/pool"
 	"github.com/NobleScope/noble-indexer/pkg/node/rpc"
+	nodeTypes "github.com/NobleScope/noble-indexer/pkg/node/types"
+	"github.com/dipdup-net/go-lib/prometheus"
 	"github.com/dipdup-net/indexer-sdk/pkg/modules"
 	"github.com/dipdup-net/indexer-sdk/pkg/modules/stopper"
 	"github.com/gorilla/websocket"
@@ -24,15 +30,19 @@ import (
 	"github.com/rs/zerolog/log"
 )
 
+const nodeRpcKind = "evm_node_rpc"
+
 type Indexer struct {
 	cfg           config.Config
-	api           node.Api
+	api           *pool.Pool
 	receiver      *receiver.Module
 	parser        *parser.Module
 	proxyResolver *proxy.Module
 	storage       *storage.Module
 	genesis       *genesis.Module
 	rollback      *rollback.Module
+	logDecoder    *logDecoder.Module
+	metrics       *prometheus.Service
 	stopper       modules.Module
 	pg            postgres.Storage
 	wg            *sync.WaitGroup
@@ -60,12 +70,12 @@ func New(ctx context.Context, cfg config.Config, stopperModule modules.Module) (
 		return Indexer{}, errors.Wrap(err, "while creating parser module")
 	}
 
-	proxyResolver, err := createProxyContractsResolver(cfg.Indexer, &api, &pg)
+	proxyResolver, err := createProxyContractsResolver(cfg.Indexer, api, &pg)
 	if err != nil {
 		return Indexer{}, errors.Wrap(err, "while creating parser module")
 	}
 
-	s, err := createStorage(pg, cfg, p, proxyResolver)
+	s, err := createStorage(pg, cfg, r, p, proxyResolver)
 	if err != nil {
 		return Indexer{}, errors.Wrap(err, "while creating storage module")
 	}
@@ -75,18 +85,23 @@ func New(ctx context.Context, cfg config.Config, stopperModule modules.Module) (
 		return Indexer{}, errors.Wrap(err, "while creating genesis module")
 	}
 
-	rb, err := createRollback(r, pg, &api, cfg.Indexer)
+	rb, err := createRollback(r, pg, api, cfg.Indexer)
 	if err != nil {
 		return Indexer{}, errors.Wrap(err, "while creating rollback module")
 	}
 
+	var decoderModule *logDecoder.Module
+	if cfg.Indexer.LogDecoder.Enabled {
+		decoderModule = logDecoder.NewModule(pg, cfg.Indexer.LogDecoder)
+	}
+
 	err = attachStopper(stopperModule, r, p, s, rb, genesisModule, proxyResolver)
 	if err != nil {
 		return Indexer{}, errors.Wrap(err, "while creating stopper module")
 	}
 
 	return Indexer{
-		api:           &api,
+		api:           api,
 		cfg:           cfg,
 		receiver:      r,
 		parser:        p,
@@ -94,6 +109,8 @@ func New(ctx context.Context, cfg config.Config, stopperModule modules.Module) (
 		storage:       s,
 		genesis:       genesisModule,
 		rollback:      rb,
+		logDecoder:    decoderModule,
+		metrics:       prometheus.NewService(cfg.Prometheus),
 		stopper:       stopperModule,
 		pg:            pg,
 		wg:            new(sync.WaitGroup),
@@ -104,12 +121,18 @@ func New(ctx context.Context, cfg config.Config, stopperModule modules.Module) (
 func (i *Indexer) Start(ctx context.Context) {
 	i.log.Info().Msg("starting...")
 
+	i.metrics.Start()
+	i.api.Start(ctx)
 	i.genesis.Start(ctx)
 	i.storage.Start(ctx)
 	i.proxyResolver.Start(ctx)
 	i.parser.Start(ctx)
 	i.rollback.Start(ctx)
 	i.receiver.Start(ctx)
+
+	if i.logDecoder != nil {
+		i.logDecoder.Start(ctx)
+	}
 }
 
 func (i *Indexer) Close() error {
@@ -119,6 +142,9 @@ func (i *Indexer) Close() error {
 	if err := i.receiver.Close(); err != nil {
 		log.Err(err).Msg("closing receiver")
 	}
+	if err := i.api.Close(); err != nil {
+		log.Err(err).Msg("closing node pool")
+	}
 	if err := i.genesis.Close(); err != nil {
 		log.Err(err).Msg("closing genesis")
 	}
@@ -134,40 +160,127 @@ func (i *Indexer) Close() error {
 	if err := i.rollback.Close(); err != nil {
 		log.Err(err).Msg("closing rollback")
 	}
+	if i.logDecoder != nil {
+		if err := i.logDecoder.Close(); err != nil {
+			log.Err(err).Msg("closing log decoder")
+		}
+	}
 	if err := i.pg.Close(); err != nil {
 		log.Err(err).Msg("closing postgres connection")
 	}
+	if err := i.metrics.Close(); err != nil {
+		log.Err(err).Msg("closing metrics server")
+	}
 
 	return nil
 }
 
-func createReceiver(ctx context.Context, cfg config.Config, networkConfig config.Network, pg postgres.Storage) (rpc.API, *receiver.Module, error) {
-	state, err := loadState(pg, ctx, cfg.Indexer.Name)
+func createReceiver(ctx context.Context, cfg config.Config, networkConfig config.Network, pg postgres.Storage) (*pool.Pool, *receiver.Module, error) {
+	if !cfg.Indexer.IsRange() {
+		if err := mergeRanges(ctx, pg, cfg.Indexer.Name); err != nil {
+			return nil, nil, errors.Wrap(err, "while merging ranges")
+		}
+	}
+
+	state, err := loadState(pg, ctx, cfg.Indexer.StateName())
 	if err != nil {
-		return rpc.API{}, nil, errors.Wrap(err, "while loading state")
+		return nil, nil, errors.Wrap(err, "while loading state")
+	}
+	if state == nil && cfg.Indexer.IsRange() && cfg.Indexer.StartLevel > 0 {
+		if state, err = createRangeState(ctx, pg, cfg.Indexer); err != nil {
+			return nil, nil, errors.Wrap(err, "while creating range state")
+		}
+	}
+
+	nodePool, err := createNodePool(ctx, cfg, networkConfig)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "while creating node pool")
+	}
+
+	var ws *websocket.Conn
+	if ds, ok := cfg.DataSources["node_ws"]; ok && ds.URL != "" && ds.Credentials.ApiKey != nil {
+		ws, _, err = websocket.DefaultDialer.Dial(ds.URL, nil)
+		if err != nil {
+			return nodePool, nil, errors.Wrap(err, "create websocket")
+		}
 	}
 
-	var (
-		ws      *websocket.Conn
-		nodeRpc rpc.API
-	)
+	receiverModule := receiver.NewModule(cfg.Indexer, nodePool, ws, state)
+	return nodePool, &receiverModule, nil
+}
 
-	if ds, ok := cfg.DataSources["node_rpc"]; ok && ds.URL != "" {
-		nodeRpc = rpc.NewApi(ds,
+// createNodePool creates the pool of all `evm_node_rpc` datasources. The `node_rpc` datasource goes first,
+// so it's preferred until health checks are done.
+func createNodePool(ctx context.Context, cfg config.Config, networkConfig config.Network) (*pool.Pool, error) {
+	names := make([]string, 0, len(cfg.DataSources))
+	for name, ds := range cfg.DataSources {
+		if ds.Kind == nodeRpcKind && ds.URL != "" {
+			names = append(names, name)
+		}
+	}
+	slices.SortFunc(names, func(a, b string) int {
+		switch {
+		case a == b:
+			return 0
+		case a == "node_rpc":
+			return -1
+		case b == "node_rpc":
+			return 1
+		default:
+			return strings.Compare(a, b)
+		}
+	})
+
+	endpoints := make([]pool.Endpoint, len(names))
+	for i, name := range names {
+		ds := cfg.DataSources[name]
+		// failed requests are retried by the pool, so it fails over to the next endpoint without waiting
+		api := rpc.NewApi(ds,
 			rpc.WithTimeout(time.Second*time.Duration(ds.Timeout)),
 			rpc.WithRateLimit(ds.RequestsPerSecond),
 			rpc.WithTraceMethod(networkConfig.GetTraceMethod()),
+			rpc.WithRetry(0, 0),
 		)
-	}
-	if ds, ok := cfg.DataSources["node_ws"]; ok && ds.URL != "" && ds.Credentials.ApiKey != nil {
-		ws, _, err = websocket.DefaultDialer.Dial(ds.URL, nil)
-		if err != nil {
-			return nodeRpc, nil, errors.Wrap(err, "create websocket")
+		if err := checkNode(ctx, &api); err != nil {
+			return nil, errors.Wrapf(err, "datasource %s", name)
+		}
+		endpoints[i] = pool.Endpoint{
+			Name: name,
+			Api:  &api,
 		}
 	}
 
-	receiverModule := receiver.NewModule(cfg.Indexer, &nodeRpc, ws, state)
-	return nodeRpc, &receiverModule, nil
+	retryDelay := time.Millisecond * 500
+	if cfg.Indexer.RetryDelay > 0 {
+		retryDelay = time.Millisecond * time.Duration(cfg.Indexer.RetryDelay)
+	}
+	opts := []pool.Option{
+		pool.WithRetry(cfg.Indexer.RetryCount, retryDelay),
+	}
+	if cfg.Indexer.NodePool.HealthCheckPeriod > 0 {
+		opts = append(opts, pool.WithHealthCheckPeriod(time.Second*time.Duration(cfg.Indexer.NodePool.HealthCheckPeriod)))
+	}
+	if cfg.Indexer.NodePool.MaxHeadLag > 0 {
+		opts = append(opts, pool.WithMaxHeadLag(cfg.Indexer.NodePool.MaxHeadLag))
+	}
+	if cfg.Indexer.NodePool.MaxErrorRate > 0 {
+		opts = append(opts, pool.WithMaxErrorRate(cfg.Indexer.NodePool.MaxErrorRate))
+	}
+	return pool.NewPool(endpoints, opts...)
+}
+
+// checkNode requests the head block, so the node which doesn't support required methods fails the start
+// instead of endless retries. Other errors are skipped since the node may recover later.
+func checkNode(ctx context.Context, api node.Api) error {
+	head, err := api.Head(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("node is unavailable")
+		return nil
+	}
+	if _, err := api.BlockBulk(ctx, head); errors.Is(err, nodeTypes.ErrMethodNotFound) {
+		return err
+	}
+	return nil
 }
 
 func createParser(cfg config.Indexer, networkConfig config.Network, receiverModule modules.Module) (*parser.Module, error) {
@@ -180,7 +293,7 @@ func createParser(cfg config.Indexer, networkConfig config.Network, receiverModu
 	return &parserModule, nil
 }
 
-func createProxyContractsResolver(cfg config.Indexer, api *rpc.API, pg *postgres.Storage) (*proxy.Module, error) {
+func createProxyContractsResolver(cfg config.Indexer, api node.Api, pg *postgres.Storage) (*proxy.Module, error) {
 	proxyContractsResolver := proxy.NewModule(cfg, api, pg)
 	return &proxyContractsResolver, nil
 }
@@ -188,6 +301,7 @@ func createProxyContractsResolver(cfg config.Indexer, api *rpc.API, pg *postgres
 func createStorage(
 	pg postgres.Storage,
 	cfg config.Config,
+	receiverModule modules.Module,
 	parserModule modules.Module,
 	proxyResolverModule modules.Module,
 ) (*storage.Module, error) {
@@ -205,6 +319,10 @@ func createStorage(
 		return nil, errors.Wrap(err, "while attaching storage to proxy contracts resolver")
 	}
 
+	if err := storageModule.AttachTo(receiverModule, receiver.FinalityOutput, storage.FinalityInput); err != nil {
+		return nil, errors.Wrap(err, "while attaching storage to receiver")
+	}
+
 	return &storageModule, nil
 }
 
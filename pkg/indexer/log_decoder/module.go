@@ -0,0 +1,153 @@
+package log_decoder
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/NobleScope/noble-indexer/internal/storage"
+	"github.com/NobleScope/noble-indexer/internal/storage/postgres"
+	"github.com/NobleScope/noble-indexer/pkg/decoder"
+	"github.com/NobleScope/noble-indexer/pkg/indexer/config"
+	"github.com/dipdup-net/indexer-sdk/pkg/modules"
+	"github.com/pkg/errors"
+)
+
+// Module decodes stored logs with ABIs of emitters, proxy implementations, diamond facets and the signature registry.
+// Decoded event name, signature and arguments are saved to the log to filter logs by them in SQL.
+// Logs are decoded again when they are marked as undecoded, e.g. after the contract verification.
+type Module struct {
+	modules.BaseModule
+
+	pg         postgres.Storage
+	syncPeriod time.Duration
+	batchSize  int
+}
+
+var _ modules.Module = (*Module)(nil)
+
+func NewModule(pg postgres.Storage, cfg config.LogDecoder) *Module {
+	syncPeriod := time.Second * time.Duration(cfg.SyncPeriodSeconds)
+	if syncPeriod <= 0 {
+		syncPeriod = 10 * time.Second
+	}
+	batchSize := cfg.BatchSize
+	if batchSize <= 0 {
+		batchSize = 1000
+	}
+
+	return &Module{
+		BaseModule: modules.New("log_decoder"),
+		pg:         pg,
+		syncPeriod: syncPeriod,
+		batchSize:  batchSize,
+	}
+}
+
+func (m *Module) Start(ctx context.Context) {
+	m.Log.Info().Msg("starting...")
+	m.G.GoCtx(ctx, m.receive)
+}
+
+func (m *Module) Close() error {
+	m.Log.Info().Msg("closing...")
+	m.G.Wait()
+	return nil
+}
+
+func (m *Module) receive(ctx context.Context) {
+	if err := m.sync(ctx); err != nil {
+		m.Log.Err(err).Msg("sync")
+	}
+
+	ticker := time.NewTicker(m.syncPeriod)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := m.sync(ctx); err != nil {
+				m.Log.Err(err).Msg("sync")
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (m *Module) sync(ctx context.Context) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		default:
+		}
+
+		logs, err := m.pg.Logs.Undecoded(ctx, m.batchSize)
+		if err != nil {
+			return errors.Wrap(err, "receive undecoded logs")
+		}
+		if len(logs) == 0 {
+			return nil
+		}
+
+		decoded := decode(logs)
+		if err := m.save(ctx, decoded); err != nil {
+			return errors.Wrap(err, "save decoded logs")
+		}
+
+		m.Log.Debug().
+			Int("count", len(logs)).
+			Uint64("height", uint64(logs[len(logs)-1].Height)).
+			Msg("logs decoded")
+
+		if len(logs) < m.batchSize {
+			return nil
+		}
+	}
+}
+
+func (m *Module) save(ctx context.Context, logs []*storage.Log) error {
+	tx, err := postgres.BeginTransaction(ctx, m.pg.Transactable)
+	if err != nil {
+		return err
+	}
+	defer tx.Close(ctx)
+
+	if err := tx.SaveDecodedLogs(ctx, logs...); err != nil {
+		return tx.HandleError(ctx, err)
+	}
+
+	if err := tx.Flush(ctx); err != nil {
+		return tx.HandleError(ctx, err)
+	}
+	return nil
+}
+
+// decode returns logs with decoded events. Logs which can't be decoded are returned with empty events
+// to mark them as processed.
+func decode(logs []storage.Log) []*storage.Log {
+	result := make([]*storage.Log, len(logs))
+	for i := range logs {
+		result[i] = &storage.Log{
+			Id:            logs[i].Id,
+			Time:          logs[i].Time,
+			DecodeVersion: logs[i].DecodeVersion,
+		}
+
+		abis := append([]json.RawMessage{logs[i].ContractABI, logs[i].ImplementationABI}, decoder.SplitABIs(logs[i].FacetABIs)...)
+		event := decoder.DecodeEvent(logs[i].Data, logs[i].Topics, logs[i].Signatures, abis...)
+		if event == nil {
+			continue
+		}
+		args, err := json.Marshal(event.Args)
+		if err != nil {
+			continue
+		}
+
+		result[i].EventName = event.Name
+		result[i].EventSignature = event.Signature
+		result[i].EventArgs = args
+	}
+	return result
+}
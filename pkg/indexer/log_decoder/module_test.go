@@ -0,0 +1,59 @@
+package log_decoder
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/NobleScope/noble-indexer/internal/storage"
+	pkgTypes "github.com/NobleScope/noble-indexer/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecode(t *testing.T) {
+	transferEventABI := json.RawMessage(`[{"anonymous":false,"inputs":[{"indexed":true,"name":"from","type":"address"},{"indexed":true,"name":"to","type":"address"},{"indexed":false,"name":"value","type":"uint256"}],"name":"Transfer","type":"event"}]`)
+	topics := []pkgTypes.Hex{
+		pkgTypes.MustDecodeHex("ddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"),
+		pkgTypes.MustDecodeHex("000000000000000000000000dead000000000000000000000000000000000000"),
+		pkgTypes.MustDecodeHex("000000000000000000000000beef000000000000000000000000000000000000"),
+	}
+	data := pkgTypes.MustDecodeHex("00000000000000000000000000000000000000000000000000000000000003e8")
+	ts := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	logs := []storage.Log{
+		{
+			Id:                1,
+			Time:              ts,
+			Data:              data,
+			Topics:            topics,
+			ImplementationABI: transferEventABI,
+		},
+		{
+			Id:        2,
+			Time:      ts,
+			Data:      data,
+			Topics:    topics,
+			FacetABIs: json.RawMessage(`[` + string(transferEventABI) + `]`),
+		},
+		{
+			Id:     3,
+			Time:   ts,
+			Data:   data,
+			Topics: topics,
+		},
+	}
+
+	decoded := decode(logs)
+	require.Len(t, decoded, 3)
+
+	for _, l := range decoded[:2] {
+		require.Equal(t, "Transfer", l.EventName)
+		require.Equal(t, "Transfer(address,address,uint256)", l.EventSignature)
+		require.JSONEq(t, `{"from":"0xdEad000000000000000000000000000000000000","to":"0xbeeF000000000000000000000000000000000000","value":"1000"}`, string(l.EventArgs))
+	}
+
+	require.EqualValues(t, 3, decoded[2].Id)
+	require.Equal(t, ts, decoded[2].Time)
+	require.Empty(t, decoded[2].EventName)
+	require.Nil(t, decoded[2].EventArgs)
+}
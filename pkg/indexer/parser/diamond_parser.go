@@ -0,0 +1,84 @@
+package parser
+
+import (
+	"github.com/NobleScope/noble-indexer/internal/storage"
+	"github.com/NobleScope/noble-indexer/internal/storage/types"
+	dCtx "github.com/NobleScope/noble-indexer/pkg/indexer/decode/context"
+	"github.com/NobleScope/noble-indexer/pkg/indexer/parser/types/eip2535"
+	pkgTypes "github.com/NobleScope/noble-indexer/pkg/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// parseDiamondCut - collects EIP-2535 DiamondCut events of the transaction and marks emitters as diamond proxies
+func (p *Module) parseDiamondCut(ctx *dCtx.Context, tx *storage.Tx) {
+	if tx.Status != types.TxStatusSuccess {
+		return
+	}
+
+	for i := range tx.Logs {
+		if len(tx.Logs[i].Topics) != 1 || tx.Logs[i].Topics[0].Hex() != eip2535.EventDiamondCutSignature {
+			continue
+		}
+
+		var event eip2535.DiamondCutEvent
+		if err := p.abi[eip2535.ABIDiamond].UnpackIntoInterface(&event, "DiamondCut", tx.Logs[i].Data); err != nil {
+			p.Log.Warn().Err(err).Str("tx", tx.Hash.String()).Msg("unpacking diamond cut event")
+			continue
+		}
+
+		diamond := storage.Address{
+			Hash: tx.Logs[i].Address.Hash,
+		}
+		for _, facetCut := range event.DiamondCut {
+			action, ok := getDiamondCutAction(facetCut.Action)
+			if !ok {
+				continue
+			}
+
+			var facet *storage.Address
+			if action != types.Remove {
+				facet = &storage.Address{
+					Hash:        facetCut.FacetAddress.Bytes(),
+					FirstHeight: ctx.Block.Height,
+					LastHeight:  ctx.Block.Height,
+					IsContract:  true,
+					Balance:     storage.EmptyBalance(),
+				}
+				ctx.AddAddress(facet)
+			}
+
+			for _, selector := range facetCut.FunctionSelectors {
+				tx.DiamondCuts = append(tx.DiamondCuts, &storage.DiamondCut{
+					Height:   ctx.Block.Height,
+					Time:     ctx.Block.Time,
+					Action:   action,
+					Selector: pkgTypes.Hex(common.CopyBytes(selector[:])),
+					Diamond:  diamond,
+					Facet:    facet,
+				})
+			}
+		}
+
+		ctx.SetProxyContract(&storage.ProxyContract{
+			Height: ctx.Block.Height,
+			Type:   types.Diamond,
+			Status: types.Resolved,
+			Contract: storage.Contract{
+				Address: diamond,
+			},
+		})
+	}
+}
+
+func getDiamondCutAction(action uint8) (types.DiamondCutAction, bool) {
+	switch action {
+	case eip2535.FacetCutActionAdd:
+		return types.Add, true
+	case eip2535.FacetCutActionReplace:
+		return types.Replace, true
+	case eip2535.FacetCutActionRemove:
+		return types.Remove, true
+	default:
+		return "", false
+	}
+}
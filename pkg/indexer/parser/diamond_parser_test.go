@@ -0,0 +1,121 @@
+package parser
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+
+	"github.com/NobleScope/noble-indexer/internal/storage"
+	"github.com/NobleScope/noble-indexer/internal/storage/types"
+	"github.com/NobleScope/noble-indexer/pkg/indexer/parser/types/eip2535"
+	pkgTypes "github.com/NobleScope/noble-indexer/pkg/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+var diamondCutTopic = pkgTypes.MustDecodeHex(eip2535.EventDiamondCutSignature)
+
+func createDiamondTestModule(t *testing.T) *Module {
+	t.Helper()
+
+	module := createTestModule(t, nil)
+
+	diamondJson, err := readJson(filepath.Join("..", "..", "..", "assets", "abi", "EIP2535"), "diamond.json")
+	require.NoError(t, err)
+	diamondABI, err := abi.JSON(bytes.NewReader(diamondJson))
+	require.NoError(t, err)
+	module.abi[eip2535.ABIDiamond] = &diamondABI
+
+	return module
+}
+
+func packDiamondCut(t *testing.T, module *Module, cuts ...eip2535.FacetCut) []byte {
+	t.Helper()
+
+	data, err := module.abi[eip2535.ABIDiamond].Events["DiamondCut"].Inputs.Pack(cuts, common.Address{}, []byte{})
+	require.NoError(t, err)
+	return data
+}
+
+func TestParseDiamondCut(t *testing.T) {
+	module := createDiamondTestModule(t)
+
+	facet := common.BytesToAddress(toAddressPadded)
+	data := packDiamondCut(t, module,
+		eip2535.FacetCut{
+			FacetAddress:      facet,
+			Action:            eip2535.FacetCutActionAdd,
+			FunctionSelectors: [][4]byte{{0xa9, 0x05, 0x9c, 0xbb}, {0x09, 0x5e, 0xa7, 0xb3}},
+		},
+		eip2535.FacetCut{
+			Action:            eip2535.FacetCutActionRemove,
+			FunctionSelectors: [][4]byte{{0x23, 0xb8, 0x72, 0xdd}},
+		},
+	)
+
+	ctx := createApprovalContext(types.TxStatusSuccess, &storage.Log{
+		Address: storage.Address{Hash: contractAddressBytes},
+		Topics:  []pkgTypes.Hex{diamondCutTopic},
+		Data:    data,
+	})
+
+	module.parseDiamondCut(ctx, ctx.Block.Txs[0])
+
+	cuts := ctx.Block.Txs[0].DiamondCuts
+	require.Len(t, cuts, 3)
+
+	require.Equal(t, types.Add, cuts[0].Action)
+	require.EqualValues(t, 100, cuts[0].Height)
+	require.Equal(t, "0xa9059cbb", cuts[0].Selector.Hex())
+	require.EqualValues(t, contractAddressBytes, cuts[0].Diamond.Hash)
+	require.NotNil(t, cuts[0].Facet)
+	require.EqualValues(t, facet.Bytes(), cuts[0].Facet.Hash)
+
+	require.Equal(t, types.Add, cuts[1].Action)
+	require.Equal(t, "0x095ea7b3", cuts[1].Selector.Hex())
+
+	require.Equal(t, types.Remove, cuts[2].Action)
+	require.Equal(t, "0x23b872dd", cuts[2].Selector.Hex())
+	require.Nil(t, cuts[2].Facet)
+
+	proxies := ctx.GetProxyContracts()
+	require.Len(t, proxies, 1)
+	require.Equal(t, types.Diamond, proxies[0].Type)
+	require.Equal(t, types.Resolved, proxies[0].Status)
+	require.Nil(t, proxies[0].Implementation)
+}
+
+func TestParseDiamondCut_Reverted(t *testing.T) {
+	module := createDiamondTestModule(t)
+
+	ctx := createApprovalContext(types.TxStatusRevert, &storage.Log{
+		Address: storage.Address{Hash: contractAddressBytes},
+		Topics:  []pkgTypes.Hex{diamondCutTopic},
+		Data: packDiamondCut(t, module, eip2535.FacetCut{
+			FacetAddress:      common.BytesToAddress(toAddressPadded),
+			Action:            eip2535.FacetCutActionAdd,
+			FunctionSelectors: [][4]byte{{0xa9, 0x05, 0x9c, 0xbb}},
+		}),
+	})
+
+	module.parseDiamondCut(ctx, ctx.Block.Txs[0])
+
+	require.Empty(t, ctx.Block.Txs[0].DiamondCuts)
+	require.Empty(t, ctx.GetProxyContracts())
+}
+
+func TestParseDiamondCut_InvalidData(t *testing.T) {
+	module := createDiamondTestModule(t)
+
+	ctx := createApprovalContext(types.TxStatusSuccess, &storage.Log{
+		Address: storage.Address{Hash: contractAddressBytes},
+		Topics:  []pkgTypes.Hex{diamondCutTopic},
+		Data:    []byte{0x01, 0x02},
+	})
+
+	module.parseDiamondCut(ctx, ctx.Block.Txs[0])
+
+	require.Empty(t, ctx.Block.Txs[0].DiamondCuts)
+	require.Empty(t, ctx.GetProxyContracts())
+}
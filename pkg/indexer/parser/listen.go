@@ -2,7 +2,9 @@ package parser
 
 import (
 	"context"
+	"sync"
 
+	dCtx "github.com/NobleScope/noble-indexer/pkg/indexer/decode/context"
 	"github.com/NobleScope/noble-indexer/pkg/types"
 )
 
@@ -29,16 +31,115 @@ func (p *Module) listen(ctx context.Context) {
 			}
 
 			if parseErr := p.parse(block); parseErr != nil {
-				height, err := block.Number.Uint64()
-				if err != nil {
-					p.Log.Warn().Err(err).Str("num", block.Number.String()).Msg("can't parse block number")
-				}
-				p.Log.Err(parseErr).
-					Uint64("height", height).
-					Msg("block parsing error")
+				p.parseError(block, parseErr)
+				continue
+			}
+		}
+	}
+}
+
+func (p *Module) parseError(block types.BlockData, parseErr error) {
+	height, err := block.Number.Uint64()
+	if err != nil {
+		p.Log.Warn().Err(err).Str("num", block.Number.String()).Msg("can't parse block number")
+	}
+	p.Log.Err(parseErr).
+		Uint64("height", height).
+		Msg("block parsing error")
+	p.MustOutput(StopOutput).Push(struct{}{})
+}
+
+// job is the block decoded by one of workers. The job is done when the done channel is closed.
+type job struct {
+	block  types.BlockData
+	result *dCtx.Context
+	err    error
+	done   chan struct{}
+}
+
+// listenParallel decodes blocks by workers concurrently. Decoded blocks are pushed in the order of receiving,
+// the number of blocks in progress is limited by the capacity of the queue.
+func (p *Module) listenParallel(ctx context.Context) {
+	p.Log.Info().Int("workers", p.cfg.Backfill.Workers).Msg("module started")
+
+	var (
+		input   = p.MustInput(InputName)
+		jobs    = make(chan *job, p.cfg.Backfill.Workers)
+		ordered = make(chan *job, p.cfg.Backfill.Workers*2)
+		wg      sync.WaitGroup
+	)
+
+	for range p.cfg.Backfill.Workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := range jobs {
+				j.result, j.err = p.decode(j.block)
+				close(j.done)
+			}
+		}()
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		p.pushOrdered(ctx, ordered)
+	}()
+
+	defer func() {
+		close(jobs)
+		close(ordered)
+		wg.Wait()
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case msg, ok := <-input.Listen():
+			if !ok {
+				p.Log.Warn().Msg("can't read message from input, it was drained and closed")
 				p.MustOutput(StopOutput).Push(struct{}{})
+				return
+			}
+
+			block, ok := msg.(types.BlockData)
+			if !ok {
+				p.Log.Warn().Msgf("invalid message type: %T", msg)
 				continue
 			}
+
+			j := &job{
+				block: block,
+				done:  make(chan struct{}),
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case ordered <- j:
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case jobs <- j:
+			}
+		}
+	}
+}
+
+func (p *Module) pushOrdered(ctx context.Context, ordered <-chan *job) {
+	output := p.MustOutput(OutputName)
+	for j := range ordered {
+		select {
+		case <-ctx.Done():
+			return
+		case <-j.done:
+		}
+
+		if j.err != nil {
+			p.parseError(j.block, j.err)
+			continue
 		}
+		output.Push(j.result)
 	}
 }
@@ -0,0 +1,60 @@
+package parser
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	dCtx "github.com/NobleScope/noble-indexer/pkg/indexer/decode/context"
+	pkgTypes "github.com/NobleScope/noble-indexer/pkg/types"
+	"github.com/dipdup-net/indexer-sdk/pkg/modules"
+	"github.com/stretchr/testify/require"
+)
+
+func testBlockData(height byte) pkgTypes.BlockData {
+	return pkgTypes.BlockData{
+		Block: pkgTypes.Block{
+			Number:        pkgTypes.Hex{height},
+			Timestamp:     pkgTypes.Hex{0x60, 0x00, 0x00, height},
+			GasLimit:      pkgTypes.Hex{0x01},
+			GasUsed:       pkgTypes.Hex{0x01},
+			BaseFeePerGas: pkgTypes.Hex{0x01},
+		},
+	}
+}
+
+func TestListenParallelKeepsOrder(t *testing.T) {
+	module := createTestModule(t, nil)
+	module.BaseModule = modules.New("parser")
+	module.cfg.Backfill.Workers = 4
+	module.CreateInputWithCapacity(InputName, 128)
+	module.CreateOutput(OutputName)
+	module.CreateOutput(StopOutput)
+
+	sink := modules.New("sink")
+	sink.CreateInputWithCapacity("data", 128)
+	require.NoError(t, sink.AttachTo(module, OutputName, "data"))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	module.Start(ctx)
+
+	input := module.MustInput(InputName)
+	for height := byte(1); height <= 100; height++ {
+		input.Push(testBlockData(height))
+	}
+
+	for height := 1; height <= 100; height++ {
+		select {
+		case <-ctx.Done():
+			t.Fatal("timeout")
+		case msg := <-sink.MustInput("data").Listen():
+			decoded, ok := msg.(*dCtx.Context)
+			require.True(t, ok)
+			require.EqualValues(t, height, decoded.Block.Height)
+		}
+	}
+
+	cancel()
+	require.NoError(t, module.Close())
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/NobleScope/noble-indexer/internal/storage"
 	storageType "github.com/NobleScope/noble-indexer/internal/storage/types"
+	"github.com/NobleScope/noble-indexer/pkg/bytecode"
 	dCtx "github.com/NobleScope/noble-indexer/pkg/indexer/decode/context"
 	"github.com/NobleScope/noble-indexer/pkg/indexer/enum"
 	"github.com/NobleScope/noble-indexer/pkg/types"
@@ -13,12 +14,22 @@ import (
 )
 
 func (p *Module) parse(b types.BlockData) error {
+	decodeCtx, err := p.decode(b)
+	if err != nil {
+		return err
+	}
+	p.MustOutput(OutputName).Push(decodeCtx)
+	return nil
+}
+
+// decode converts the block data to models of the storage.
+func (p *Module) decode(b types.BlockData) (*dCtx.Context, error) {
 	start := time.Now()
 	decodeCtx := dCtx.NewContext()
 
 	height, err := b.Number.Uint64()
 	if err != nil {
-		return err
+		return nil, err
 	}
 	p.Log.Info().
 		Uint64("height", height).
@@ -32,19 +43,27 @@ func (p *Module) parse(b types.BlockData) error {
 	block := b.Block
 	blockTime, err := block.Timestamp.Time()
 	if err != nil {
-		return err
+		return nil, err
 	}
 	gasLimit, err := block.GasLimit.Decimal()
 	if err != nil {
-		return err
+		return nil, err
 	}
 	gasUsed, err := block.GasUsed.Decimal()
 	if err != nil {
-		return err
+		return nil, err
 	}
 	feePerGas, err := block.BaseFeePerGas.Uint64()
 	if err != nil {
-		return err
+		return nil, err
+	}
+	blobGasUsed, err := optionalDecimal(block.BlobGasUsed)
+	if err != nil {
+		return nil, errors.Wrap(err, "parsing block blob gas used")
+	}
+	excessBlobGas, err := optionalDecimal(block.ExcessBlobGas)
+	if err != nil {
+		return nil, errors.Wrap(err, "parsing block excess blob gas")
 	}
 
 	miner := storage.Address{
@@ -61,6 +80,8 @@ func (p *Module) parse(b types.BlockData) error {
 		GasLimit:             gasLimit,
 		GasUsed:              gasUsed,
 		BaseFeePerGas:        feePerGas,
+		BlobGasUsed:          blobGasUsed,
+		ExcessBlobGas:        excessBlobGas,
 		Miner:                miner,
 		DifficultyHash:       b.Difficulty,
 		ExtraDataHash:        b.ExtraData,
@@ -87,28 +108,30 @@ func (p *Module) parse(b types.BlockData) error {
 	for i, tx := range b.Transactions {
 		gas, err := tx.Gas.Decimal()
 		if err != nil {
-			return err
+			return nil, err
 		}
 		gasPrice, err := tx.GasPrice.Decimal()
 		if err != nil {
-			return err
+			return nil, err
 		}
 		nonce, err := tx.Nonce.Int64()
 		if err != nil {
-			return err
+			return nil, err
 		}
 		index, err := tx.TransactionIndex.Int64()
 		if err != nil {
-			return err
+			return nil, err
 		}
 		typ, err := tx.Type.Int64()
 		if err != nil {
-			return err
+			return nil, err
 		}
 		var txType storageType.TxType
 		switch typ {
 		case 0:
 			txType = storageType.TxTypeLegacy
+		case 1:
+			txType = storageType.TxTypeAccessList
 		case 2:
 			txType = storageType.TxTypeDynamicFee
 		case 3:
@@ -119,26 +142,50 @@ func (p *Module) parse(b types.BlockData) error {
 			txType = storageType.TxTypeUnknown
 		}
 
+		maxFeePerGas, err := optionalDecimal(tx.MaxFeePerGas)
+		if err != nil {
+			return nil, err
+		}
+		maxPriorityFeePerGas, err := optionalDecimal(tx.MaxPriorityFeePerGas)
+		if err != nil {
+			return nil, err
+		}
+		maxFeePerBlobGas, err := optionalDecimal(tx.MaxFeePerBlobGas)
+		if err != nil {
+			return nil, err
+		}
+		authorizations, err := parseAuthorizationList(types.Level(height), blockTime, tx.AuthorizationList)
+		if err != nil {
+			return nil, err
+		}
+		if err := recoverAuthorizations(authorizations, tx.ChainId); err != nil {
+			return nil, errors.Wrap(err, "recovering authorizations")
+		}
+
 		cumulativeGasUsed, err := b.Receipts[i].CumulativeGasUsed.Decimal()
 		if err != nil {
-			return err
+			return nil, err
 		}
 		effectiveGasPrice, err := b.Receipts[i].EffectiveGasPrice.Decimal()
 		if err != nil {
-			return err
+			return nil, err
 		}
 		txGasUsed, err := b.Receipts[i].GasUsed.Decimal()
 		if err != nil {
-			return err
+			return nil, err
 		}
 		fee := txGasUsed.Mul(effectiveGasPrice)
+		txBlobGasUsed, blobGasPrice, blobFee, err := parseBlobFee(b.Receipts[i])
+		if err != nil {
+			return nil, err
+		}
 		amount, err := tx.Value.Decimal()
 		if err != nil {
-			return err
+			return nil, err
 		}
 		txStatus, err := b.Receipts[i].Status.Int64()
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		var status storageType.TxStatus
@@ -163,6 +210,16 @@ func (p *Module) parse(b types.BlockData) error {
 			Type:     txType,
 			Input:    tx.Input,
 
+			MaxFeePerGas:         maxFeePerGas,
+			MaxPriorityFeePerGas: maxPriorityFeePerGas,
+			MaxFeePerBlobGas:     maxFeePerBlobGas,
+			BlobVersionedHashes:  tx.BlobVersionedHashes,
+			BlobGasUsed:          txBlobGasUsed,
+			BlobGasPrice:         blobGasPrice,
+			BlobFee:              blobFee,
+			AccessList:           parseAccessList(types.Level(height), blockTime, tx.AccessList),
+			Authorizations:       authorizations,
+
 			CumulativeGasUsed: cumulativeGasUsed,
 			EffectiveGasPrice: effectiveGasPrice,
 			Fee:               fee,
@@ -172,6 +229,7 @@ func (p *Module) parse(b types.BlockData) error {
 			Logs:              make([]*storage.Log, len(b.Receipts[i].Logs)),
 			LogsCount:         len(b.Receipts[i].Logs),
 		}
+		addBlobStats(decodeCtx.Block, decodeCtx.Block.Txs[i])
 
 		decodeCtx.Block.Txs[i].FromAddress = storage.Address{
 			Hash:         b.Receipts[i].From,
@@ -203,7 +261,7 @@ func (p *Module) parse(b types.BlockData) error {
 		for j, log := range b.Receipts[i].Logs {
 			logIndex, err := log.LogIndex.Int64()
 			if err != nil {
-				return err
+				return nil, err
 			}
 
 			var name string
@@ -218,6 +276,10 @@ func (p *Module) parse(b types.BlockData) error {
 				Name:    name,
 				Data:    log.Data,
 				Topics:  log.Topics,
+				Topic0:  topicAt(log.Topics, 0),
+				Topic1:  topicAt(log.Topics, 1),
+				Topic2:  topicAt(log.Topics, 2),
+				Topic3:  topicAt(log.Topics, 3),
 				Removed: log.Removed,
 			}
 
@@ -233,24 +295,25 @@ func (p *Module) parse(b types.BlockData) error {
 		}
 
 		p.parseEIP1967Proxy(decodeCtx, decodeCtx.Block.Txs[i])
+		p.parseDiamondCut(decodeCtx, decodeCtx.Block.Txs[i])
 
 		parseErr := p.parseERC4337(decodeCtx, decodeCtx.Block.Txs[i])
 		if parseErr != nil {
-			return parseErr
+			return nil, parseErr
 		}
 	}
 
 	for i, trace := range b.Traces {
 		typ, err := storageType.ParseTraceType(trace.Type)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		var value decimal.Decimal
 		if trace.Action.Value != nil {
 			value, err = trace.Action.Value.Decimal()
 			if err != nil {
-				return err
+				return nil, err
 			}
 		}
 
@@ -297,7 +360,7 @@ func (p *Module) parse(b types.BlockData) error {
 			if trace.Action.Value == nil && trace.Action.Balance != nil {
 				balance, err := trace.Action.Balance.Decimal()
 				if err != nil {
-					return err
+					return nil, err
 				}
 				newTrace.Amount = &balance
 			}
@@ -343,7 +406,7 @@ func (p *Module) parse(b types.BlockData) error {
 		if trace.Action.CallType != nil {
 			ct, err := storageType.ParseCallType(*trace.Action.CallType)
 			if err != nil {
-				return err
+				return nil, err
 			}
 			newTrace.CallType = &ct
 		}
@@ -355,7 +418,7 @@ func (p *Module) parse(b types.BlockData) error {
 		if trace.Action.Gas != nil {
 			gl, err = trace.Action.Gas.Decimal()
 			if err != nil {
-				return err
+				return nil, err
 			}
 
 			newTrace.GasLimit = gl
@@ -364,7 +427,7 @@ func (p *Module) parse(b types.BlockData) error {
 		if trace.Result.GasUsed != nil {
 			gu, err = trace.Result.GasUsed.Decimal()
 			if err != nil {
-				return err
+				return nil, err
 			}
 
 			newTrace.GasUsed = gu
@@ -407,10 +470,10 @@ func (p *Module) parse(b types.BlockData) error {
 
 		if trace.Result.Address != nil && trace.Result.Code != nil && len(*trace.Result.Code) > 0 {
 			if trace.TxPosition == nil {
-				return errors.New("trace.TxPosition is nil for contract creation")
+				return nil, errors.New("trace.TxPosition is nil for contract creation")
 			}
 			if *trace.TxPosition >= uint64(len(b.Transactions)) {
-				return errors.Errorf(
+				return nil, errors.Errorf(
 					"TxPosition %d out of range, transactions count: %d",
 					*trace.TxPosition,
 					len(b.Transactions),
@@ -437,9 +500,10 @@ func (p *Module) parse(b types.BlockData) error {
 			}
 
 			contract := &storage.Contract{
-				Height:  decodeCtx.Block.Height,
-				Address: contractAddress,
-				Code:    *trace.Result.Code,
+				Height:   decodeCtx.Block.Height,
+				Address:  contractAddress,
+				Code:     *trace.Result.Code,
+				CodeHash: bytecode.NormalizedHash(*trace.Result.Code),
 				Tx: &storage.Tx{
 					Hash: txHash,
 				},
@@ -451,7 +515,7 @@ func (p *Module) parse(b types.BlockData) error {
 			decodeCtx.AddAddress(&contractAddress)
 			decodeCtx.AddContract(contract)
 			if parseErr := p.parseProxyContract(decodeCtx, contract); parseErr != nil {
-				return parseErr
+				return nil, parseErr
 			}
 
 			if parseErr := ParseEvmContractMetadata(contract); parseErr != nil {
@@ -467,25 +531,28 @@ func (p *Module) parse(b types.BlockData) error {
 	}
 
 	if err = p.parseTxs(decodeCtx); err != nil {
-		return err
+		return nil, err
 	}
 
 	if err = p.parseTransfers(decodeCtx); err != nil {
-		return err
+		return nil, err
 	}
 
+	p.parseApprovals(decodeCtx)
+	p.parseDelegations(decodeCtx)
+
 	for i := range b.Withdrawals {
 		amount, err := b.Withdrawals[i].Amount.Decimal()
 		if err != nil {
-			return errors.Wrap(err, "parsing withdrawal amount")
+			return nil, errors.Wrap(err, "parsing withdrawal amount")
 		}
 		validatorIdx, err := b.Withdrawals[i].ValidatorIndex.Int64()
 		if err != nil {
-			return errors.Wrap(err, "parsing withdrawal validator index")
+			return nil, errors.Wrap(err, "parsing withdrawal validator index")
 		}
 		index, err := b.Withdrawals[i].Index.Int64()
 		if err != nil {
-			return errors.Wrap(err, "parsing withdrawal index")
+			return nil, errors.Wrap(err, "parsing withdrawal index")
 		}
 
 		address := storage.Address{
@@ -507,8 +574,77 @@ func (p *Module) parse(b types.BlockData) error {
 		}
 	}
 
-	output := p.MustOutput(OutputName)
-	output.Push(decodeCtx)
+	return decodeCtx, nil
+}
 
+// topicAt returns the log topic at the given position or nil if the log has fewer topics.
+func topicAt(topics []types.Hex, idx int) types.Hex {
+	if idx < len(topics) {
+		return topics[idx]
+	}
 	return nil
 }
+
+func optionalDecimal(value *types.Hex) (*decimal.Decimal, error) {
+	if value == nil {
+		return nil, nil
+	}
+	d, err := value.Decimal()
+	if err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
+
+func parseAccessList(height types.Level, blockTime time.Time, accessList []types.AccessTuple) []*storage.TxAccessList {
+	if len(accessList) == 0 {
+		return nil
+	}
+
+	result := make([]*storage.TxAccessList, len(accessList))
+	for i := range accessList {
+		result[i] = &storage.TxAccessList{
+			Height:      height,
+			Time:        blockTime,
+			Index:       int64(i),
+			Address:     accessList[i].Address,
+			StorageKeys: accessList[i].StorageKeys,
+		}
+	}
+	return result
+}
+
+func parseAuthorizationList(height types.Level, blockTime time.Time, authorizations []types.Authorization) ([]*storage.TxAuthorization, error) {
+	if len(authorizations) == 0 {
+		return nil, nil
+	}
+
+	result := make([]*storage.TxAuthorization, len(authorizations))
+	for i := range authorizations {
+		chainId, err := authorizations[i].ChainId.Decimal()
+		if err != nil {
+			return nil, errors.Wrap(err, "parsing authorization chain id")
+		}
+		nonce, err := authorizations[i].Nonce.Decimal()
+		if err != nil {
+			return nil, errors.Wrap(err, "parsing authorization nonce")
+		}
+		yParity, err := authorizations[i].YParity.Int64()
+		if err != nil {
+			return nil, errors.Wrap(err, "parsing authorization y parity")
+		}
+
+		result[i] = &storage.TxAuthorization{
+			Height:  height,
+			Time:    blockTime,
+			Index:   int64(i),
+			ChainId: chainId,
+			Address: authorizations[i].Address,
+			Nonce:   nonce,
+			YParity: yParity,
+			R:       authorizations[i].R,
+			S:       authorizations[i].S,
+		}
+	}
+	return result, nil
+}
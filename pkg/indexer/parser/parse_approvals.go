@@ -0,0 +1,127 @@
+package parser
+
+import (
+	"math/big"
+
+	"github.com/NobleScope/noble-indexer/internal/storage"
+	"github.com/NobleScope/noble-indexer/internal/storage/types"
+	dCtx "github.com/NobleScope/noble-indexer/pkg/indexer/decode/context"
+	pkgTypes "github.com/NobleScope/noble-indexer/pkg/types"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	ApprovalFirstTopic       = "0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925"
+	ApprovalForAllFirstTopic = "0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31"
+
+	uint256BytesLength = 32
+)
+
+func (p *Module) parseApprovals(ctx *dCtx.Context) {
+	for i := range ctx.Block.Txs {
+		if ctx.Block.Txs[i].Status == types.TxStatusRevert {
+			continue
+		}
+		ctx.Block.Txs[i].Approvals = make([]*storage.Approval, 0)
+
+		for j := range ctx.Block.Txs[i].Logs {
+			log := ctx.Block.Txs[i].Logs[j]
+
+			approval := getApproval(log.Topics, log.Data)
+			if approval == nil {
+				continue
+			}
+			approval.Height = ctx.Block.Height
+			approval.Time = ctx.Block.Time
+			approval.Contract = storage.Contract{
+				Address: storage.Address{
+					Hash: log.Address.Hash,
+				},
+			}
+			approval.Owner = storage.Address{
+				Hash: log.Topics[1][12:],
+			}
+			approval.Spender = storage.Address{
+				Hash: log.Topics[2][12:],
+			}
+
+			setApprovalAddresses(ctx, approval)
+			ctx.Block.Txs[i].Approvals = append(ctx.Block.Txs[i].Approvals, approval)
+		}
+	}
+}
+
+// getApproval - recognizes ERC-20/ERC-721 Approval and ApprovalForAll events. Returns nil for any other log.
+func getApproval(topics []pkgTypes.Hex, data pkgTypes.Hex) *storage.Approval {
+	if len(topics) < 3 || !isAddress(topics[1]) || !isAddress(topics[2]) {
+		return nil
+	}
+
+	switch topics[0].Hex() {
+	case ApprovalFirstTopic:
+		switch {
+		case len(topics) == 3 && len(data) == uint256BytesLength:
+			amount := decimal.NewFromBigInt(new(big.Int).SetBytes(data), 0)
+			return &storage.Approval{
+				Type:     types.Allowance,
+				TokenID:  decimal.Zero,
+				Amount:   amount,
+				Approved: amount.IsPositive(),
+			}
+		case len(topics) == 4 && len(data) == 0:
+			return &storage.Approval{
+				Type:     types.TokenApproval,
+				TokenID:  decimal.NewFromBigInt(new(big.Int).SetBytes(topics[3]), 0),
+				Amount:   decimal.Zero,
+				Approved: !isZeroAddress(topics[2][12:]),
+			}
+		}
+	case ApprovalForAllFirstTopic:
+		if len(topics) == 3 && len(data) == uint256BytesLength {
+			return &storage.Approval{
+				Type:     types.ApprovalForAll,
+				TokenID:  decimal.Zero,
+				Amount:   decimal.Zero,
+				Approved: new(big.Int).SetBytes(data).Sign() != 0,
+			}
+		}
+	}
+
+	return nil
+}
+
+func setApprovalAddresses(ctx *dCtx.Context, approval *storage.Approval) {
+	ctx.AddContract(&storage.Contract{
+		Address: storage.Address{
+			Hash: approval.Contract.Address.Hash,
+		},
+		Height: ctx.Block.Height,
+	})
+	ctx.AddAddress(&storage.Address{
+		Hash:        approval.Contract.Address.Hash,
+		FirstHeight: ctx.Block.Height,
+		LastHeight:  ctx.Block.Height,
+		IsContract:  true,
+	})
+	ctx.AddAddress(&storage.Address{
+		Hash:        approval.Owner.Hash,
+		FirstHeight: ctx.Block.Height,
+		LastHeight:  ctx.Block.Height,
+		Balance:     storage.EmptyBalance(),
+	})
+	ctx.AddAddress(&storage.Address{
+		Hash:        approval.Spender.Hash,
+		FirstHeight: ctx.Block.Height,
+		LastHeight:  ctx.Block.Height,
+		Balance:     storage.EmptyBalance(),
+	})
+}
+
+func isZeroAddress(address pkgTypes.Hex) bool {
+	for i := range address {
+		if address[i] != 0 {
+			return false
+		}
+	}
+	return true
+}
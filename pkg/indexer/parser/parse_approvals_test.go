@@ -0,0 +1,160 @@
+package parser
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/NobleScope/noble-indexer/internal/storage"
+	"github.com/NobleScope/noble-indexer/internal/storage/types"
+	dCtx "github.com/NobleScope/noble-indexer/pkg/indexer/decode/context"
+	pkgTypes "github.com/NobleScope/noble-indexer/pkg/types"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	approvalTopic       = pkgTypes.MustDecodeHex(ApprovalFirstTopic)
+	approvalForAllTopic = pkgTypes.MustDecodeHex(ApprovalForAllFirstTopic)
+)
+
+func createApprovalContext(status types.TxStatus, logs ...*storage.Log) *dCtx.Context {
+	ctx := dCtx.NewContext()
+	ctx.Block = &storage.Block{
+		Height: 100,
+		Time:   time.Now(),
+		Txs: []*storage.Tx{
+			{
+				Status: status,
+				Logs:   logs,
+			},
+		},
+	}
+	return ctx
+}
+
+func TestParseApprovals_ERC20Approval(t *testing.T) {
+	module := createTestModule(t, nil)
+
+	ctx := createApprovalContext(types.TxStatusSuccess, &storage.Log{
+		Address: storage.Address{Hash: contractAddressBytes},
+		Topics:  []pkgTypes.Hex{approvalTopic, fromAddressPadded, toAddressPadded},
+		Data:    encodeUint256(big.NewInt(1000)),
+	})
+
+	module.parseApprovals(ctx)
+
+	approvals := ctx.Block.Txs[0].Approvals
+	require.Len(t, approvals, 1)
+	require.Equal(t, types.Allowance, approvals[0].Type)
+	require.EqualValues(t, 100, approvals[0].Height)
+	require.True(t, approvals[0].Amount.Equal(decimal.NewFromInt(1000)))
+	require.True(t, approvals[0].TokenID.IsZero())
+	require.True(t, approvals[0].Approved)
+	require.EqualValues(t, contractAddressBytes, approvals[0].Contract.Address.Hash)
+	require.EqualValues(t, fromAddressPadded[12:], approvals[0].Owner.Hash)
+	require.EqualValues(t, toAddressPadded[12:], approvals[0].Spender.Hash)
+
+	require.Len(t, ctx.GetAddresses(), 3)
+	require.Len(t, ctx.GetContracts(), 1)
+}
+
+func TestParseApprovals_ERC20Revoke(t *testing.T) {
+	module := createTestModule(t, nil)
+
+	ctx := createApprovalContext(types.TxStatusSuccess, &storage.Log{
+		Address: storage.Address{Hash: contractAddressBytes},
+		Topics:  []pkgTypes.Hex{approvalTopic, fromAddressPadded, toAddressPadded},
+		Data:    encodeUint256(big.NewInt(0)),
+	})
+
+	module.parseApprovals(ctx)
+
+	approvals := ctx.Block.Txs[0].Approvals
+	require.Len(t, approvals, 1)
+	require.True(t, approvals[0].Amount.IsZero())
+	require.False(t, approvals[0].Approved)
+}
+
+func TestParseApprovals_ERC721Approval(t *testing.T) {
+	module := createTestModule(t, nil)
+
+	ctx := createApprovalContext(types.TxStatusSuccess,
+		&storage.Log{
+			Address: storage.Address{Hash: contractAddressBytes},
+			Topics:  []pkgTypes.Hex{approvalTopic, fromAddressPadded, toAddressPadded, encodeUint256(big.NewInt(42))},
+		},
+		&storage.Log{
+			Address: storage.Address{Hash: contractAddressBytes},
+			Topics:  []pkgTypes.Hex{approvalTopic, fromAddressPadded, zeroAddressPadded, encodeUint256(big.NewInt(42))},
+		},
+	)
+
+	module.parseApprovals(ctx)
+
+	approvals := ctx.Block.Txs[0].Approvals
+	require.Len(t, approvals, 2)
+	require.Equal(t, types.TokenApproval, approvals[0].Type)
+	require.True(t, approvals[0].TokenID.Equal(decimal.NewFromInt(42)))
+	require.True(t, approvals[0].Approved)
+
+	require.Equal(t, types.TokenApproval, approvals[1].Type)
+	require.False(t, approvals[1].Approved)
+}
+
+func TestParseApprovals_ApprovalForAll(t *testing.T) {
+	module := createTestModule(t, nil)
+
+	ctx := createApprovalContext(types.TxStatusSuccess,
+		&storage.Log{
+			Address: storage.Address{Hash: contractAddressBytes},
+			Topics:  []pkgTypes.Hex{approvalForAllTopic, fromAddressPadded, toAddressPadded},
+			Data:    encodeUint256(big.NewInt(1)),
+		},
+		&storage.Log{
+			Address: storage.Address{Hash: contractAddressBytes},
+			Topics:  []pkgTypes.Hex{approvalForAllTopic, fromAddressPadded, toAddressPadded},
+			Data:    encodeUint256(big.NewInt(0)),
+		},
+	)
+
+	module.parseApprovals(ctx)
+
+	approvals := ctx.Block.Txs[0].Approvals
+	require.Len(t, approvals, 2)
+	require.Equal(t, types.ApprovalForAll, approvals[0].Type)
+	require.True(t, approvals[0].Approved)
+	require.False(t, approvals[1].Approved)
+}
+
+func TestParseApprovals_RevertedTxSkipped(t *testing.T) {
+	module := createTestModule(t, nil)
+
+	ctx := createApprovalContext(types.TxStatusRevert, &storage.Log{
+		Address: storage.Address{Hash: contractAddressBytes},
+		Topics:  []pkgTypes.Hex{approvalTopic, fromAddressPadded, toAddressPadded},
+		Data:    encodeUint256(big.NewInt(1000)),
+	})
+
+	module.parseApprovals(ctx)
+
+	require.Nil(t, ctx.Block.Txs[0].Approvals)
+	require.Empty(t, ctx.GetAddresses())
+}
+
+func TestParseApprovals_NonApprovalLogIgnored(t *testing.T) {
+	module := createTestModule(t, nil)
+
+	ctx := createApprovalContext(types.TxStatusSuccess,
+		createERC20TransferLog(contractAddressBytes, fromAddressPadded, toAddressPadded, big.NewInt(1000)),
+		&storage.Log{
+			Address: storage.Address{Hash: contractAddressBytes},
+			Topics:  []pkgTypes.Hex{approvalTopic, fromAddressPadded},
+			Data:    encodeUint256(big.NewInt(1000)),
+		},
+	)
+
+	module.parseApprovals(ctx)
+
+	require.Empty(t, ctx.Block.Txs[0].Approvals)
+}
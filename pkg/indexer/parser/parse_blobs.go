@@ -0,0 +1,48 @@
+package parser
+
+import (
+	"github.com/NobleScope/noble-indexer/internal/storage"
+	"github.com/NobleScope/noble-indexer/pkg/types"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+)
+
+// parseBlobFee - returns blob gas used, blob gas price and blob fee of the receipt. All values are nil for non-blob transactions.
+func parseBlobFee(receipt types.Receipt) (used, price, fee *decimal.Decimal, err error) {
+	used, err = optionalDecimal(receipt.BlobGasUsed)
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(err, "parsing blob gas used")
+	}
+	price, err = optionalDecimal(receipt.BlobGasPrice)
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(err, "parsing blob gas price")
+	}
+	if used == nil || price == nil || used.IsZero() {
+		return used, price, nil, nil
+	}
+
+	blobFee := used.Mul(*price)
+	return used, price, &blobFee, nil
+}
+
+// addBlobStats - accumulates blobs of the transaction in the block. Blob fee is burned even if the transaction is reverted.
+func addBlobStats(block *storage.Block, tx *storage.Tx) {
+	if len(tx.BlobVersionedHashes) == 0 {
+		return
+	}
+
+	if block.BlobGasPrice == nil && tx.BlobGasPrice != nil {
+		block.BlobGasPrice = tx.BlobGasPrice
+	}
+	if block.Stats == nil {
+		return
+	}
+
+	block.Stats.BlobsCount += int64(len(tx.BlobVersionedHashes))
+	if tx.BlobGasUsed != nil {
+		block.Stats.BlobGasUsed = block.Stats.BlobGasUsed.Add(*tx.BlobGasUsed)
+	}
+	if tx.BlobFee != nil {
+		block.Stats.BlobFee = block.Stats.BlobFee.Add(*tx.BlobFee)
+	}
+}
@@ -0,0 +1,78 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/NobleScope/noble-indexer/internal/storage"
+	pkgTypes "github.com/NobleScope/noble-indexer/pkg/types"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseBlobFee(t *testing.T) {
+	t.Run("blob transaction", func(t *testing.T) {
+		used, price, fee, err := parseBlobFee(pkgTypes.Receipt{
+			BlobGasUsed:  &pkgTypes.Hex{0x02, 0x00, 0x00},
+			BlobGasPrice: &pkgTypes.Hex{0x03},
+		})
+		require.NoError(t, err)
+		require.NotNil(t, used)
+		require.EqualValues(t, "131072", used.String())
+		require.NotNil(t, price)
+		require.EqualValues(t, "3", price.String())
+		require.NotNil(t, fee)
+		require.EqualValues(t, "393216", fee.String())
+	})
+
+	t.Run("non-blob transaction", func(t *testing.T) {
+		used, price, fee, err := parseBlobFee(pkgTypes.Receipt{})
+		require.NoError(t, err)
+		require.Nil(t, used)
+		require.Nil(t, price)
+		require.Nil(t, fee)
+	})
+
+	t.Run("zero blob gas used", func(t *testing.T) {
+		used, price, fee, err := parseBlobFee(pkgTypes.Receipt{
+			BlobGasUsed:  &pkgTypes.Hex{0x00},
+			BlobGasPrice: &pkgTypes.Hex{0x03},
+		})
+		require.NoError(t, err)
+		require.NotNil(t, used)
+		require.True(t, used.IsZero())
+		require.NotNil(t, price)
+		require.Nil(t, fee)
+	})
+}
+
+func TestAddBlobStats(t *testing.T) {
+	used := decimal.NewFromInt(131072)
+	price := decimal.NewFromInt(3)
+	fee := decimal.NewFromInt(393216)
+
+	block := &storage.Block{
+		Stats: &storage.BlockStats{
+			BlobGasUsed: decimal.Zero,
+			BlobFee:     decimal.Zero,
+		},
+	}
+
+	addBlobStats(block, &storage.Tx{})
+	require.Nil(t, block.BlobGasPrice)
+	require.EqualValues(t, 0, block.Stats.BlobsCount)
+
+	tx := &storage.Tx{
+		BlobVersionedHashes: []pkgTypes.Hex{{0x01, 0x01}},
+		BlobGasUsed:         &used,
+		BlobGasPrice:        &price,
+		BlobFee:             &fee,
+	}
+	addBlobStats(block, tx)
+	addBlobStats(block, tx)
+
+	require.NotNil(t, block.BlobGasPrice)
+	require.EqualValues(t, "3", block.BlobGasPrice.String())
+	require.EqualValues(t, 2, block.Stats.BlobsCount)
+	require.EqualValues(t, "262144", block.Stats.BlobGasUsed.String())
+	require.EqualValues(t, "786432", block.Stats.BlobFee.String())
+}
@@ -0,0 +1,111 @@
+package parser
+
+import (
+	"math"
+	"math/big"
+
+	"github.com/NobleScope/noble-indexer/internal/storage"
+	dCtx "github.com/NobleScope/noble-indexer/pkg/indexer/decode/context"
+	pkgTypes "github.com/NobleScope/noble-indexer/pkg/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/holiman/uint256"
+)
+
+// parseDelegations - registers authority and delegate addresses of the EIP-7702 authorizations.
+// Authorizations are applied before the transaction execution, so reverted transactions are processed too.
+func (p *Module) parseDelegations(ctx *dCtx.Context) {
+	for i := range ctx.Block.Txs {
+		for _, authorization := range ctx.Block.Txs[i].Authorizations {
+			if authorization.Authority == nil {
+				continue
+			}
+			ctx.AddAddress(&storage.Address{
+				Hash:         authorization.Authority.Hash,
+				FirstHeight:  ctx.Block.Height,
+				LastHeight:   ctx.Block.Height,
+				Interactions: 1,
+				Balance:      storage.EmptyBalance(),
+			})
+
+			if authorization.Delegate == nil {
+				continue
+			}
+			ctx.AddAddress(&storage.Address{
+				Hash:        authorization.Delegate.Hash,
+				FirstHeight: ctx.Block.Height,
+				LastHeight:  ctx.Block.Height,
+				Balance:     storage.EmptyBalance(),
+			})
+		}
+	}
+}
+
+// recoverAuthorizations - recovers authorities of the authorization tuples and checks their validity.
+// Authority nonce and code checks require the account state, so they are not performed.
+func recoverAuthorizations(authorizations []*storage.TxAuthorization, txChainId *pkgTypes.Hex) error {
+	if len(authorizations) == 0 {
+		return nil
+	}
+
+	chainId := big.NewInt(0)
+	if txChainId != nil {
+		value, err := txChainId.BigInt()
+		if err != nil {
+			return err
+		}
+		chainId = value
+	}
+
+	for i := range authorizations {
+		authority, ok := recoverAuthority(authorizations[i])
+		if !ok {
+			continue
+		}
+		authorizations[i].Authority = &storage.Address{
+			Hash: authority.Bytes(),
+		}
+		if !isZeroAddress(authorizations[i].Address) {
+			authorizations[i].Delegate = &storage.Address{
+				Hash: authorizations[i].Address,
+			}
+		}
+
+		authChainId := authorizations[i].ChainId.BigInt()
+		authorizations[i].Valid = authChainId.Sign() == 0 || authChainId.Cmp(chainId) == 0
+	}
+	return nil
+}
+
+func recoverAuthority(authorization *storage.TxAuthorization) (common.Address, bool) {
+	if authorization.YParity < 0 || authorization.YParity > math.MaxUint8 {
+		return common.Address{}, false
+	}
+	if len(authorization.Address) != common.AddressLength || len(authorization.R) > 32 || len(authorization.S) > 32 {
+		return common.Address{}, false
+	}
+
+	nonce := authorization.Nonce.BigInt()
+	if !nonce.IsUint64() || nonce.Uint64() == math.MaxUint64 {
+		return common.Address{}, false
+	}
+	chainId, overflow := uint256.FromBig(authorization.ChainId.BigInt())
+	if overflow {
+		return common.Address{}, false
+	}
+
+	setCode := ethTypes.SetCodeAuthorization{
+		ChainID: *chainId,
+		Address: common.BytesToAddress(authorization.Address),
+		Nonce:   nonce.Uint64(),
+		V:       uint8(authorization.YParity),
+	}
+	setCode.R.SetBytes(authorization.R)
+	setCode.S.SetBytes(authorization.S)
+
+	authority, err := setCode.Authority()
+	if err != nil {
+		return common.Address{}, false
+	}
+	return authority, true
+}
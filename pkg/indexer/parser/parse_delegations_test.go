@@ -0,0 +1,140 @@
+package parser
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NobleScope/noble-indexer/internal/storage"
+	dCtx "github.com/NobleScope/noble-indexer/pkg/indexer/decode/context"
+	pkgTypes "github.com/NobleScope/noble-indexer/pkg/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/holiman/uint256"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+const testAuthorityKey = "b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291"
+
+func signTestAuthorization(t *testing.T, chainId, nonce uint64, delegate pkgTypes.Hex) (*storage.TxAuthorization, common.Address) {
+	key, err := crypto.HexToECDSA(testAuthorityKey)
+	require.NoError(t, err)
+
+	signed, err := ethTypes.SignSetCode(key, ethTypes.SetCodeAuthorization{
+		ChainID: *uint256.NewInt(chainId),
+		Address: common.BytesToAddress(delegate),
+		Nonce:   nonce,
+	})
+	require.NoError(t, err)
+
+	return &storage.TxAuthorization{
+		Height:  100,
+		ChainId: decimal.NewFromUint64(chainId),
+		Address: delegate,
+		Nonce:   decimal.NewFromUint64(nonce),
+		YParity: int64(signed.V),
+		R:       signed.R.Bytes(),
+		S:       signed.S.Bytes(),
+	}, crypto.PubkeyToAddress(key.PublicKey)
+}
+
+func TestRecoverAuthorizations_Valid(t *testing.T) {
+	authorization, authority := signTestAuthorization(t, 1, 5, contractAddressBytes)
+
+	err := recoverAuthorizations([]*storage.TxAuthorization{authorization}, &pkgTypes.Hex{0x01})
+	require.NoError(t, err)
+
+	require.True(t, authorization.Valid)
+	require.NotNil(t, authorization.Authority)
+	require.EqualValues(t, authority.Bytes(), authorization.Authority.Hash)
+	require.NotNil(t, authorization.Delegate)
+	require.EqualValues(t, contractAddressBytes, authorization.Delegate.Hash)
+}
+
+func TestRecoverAuthorizations_AnyChain(t *testing.T) {
+	authorization, authority := signTestAuthorization(t, 0, 5, contractAddressBytes)
+
+	err := recoverAuthorizations([]*storage.TxAuthorization{authorization}, &pkgTypes.Hex{0x01})
+	require.NoError(t, err)
+
+	require.True(t, authorization.Valid)
+	require.EqualValues(t, authority.Bytes(), authorization.Authority.Hash)
+}
+
+func TestRecoverAuthorizations_WrongChain(t *testing.T) {
+	authorization, authority := signTestAuthorization(t, 5, 5, contractAddressBytes)
+
+	err := recoverAuthorizations([]*storage.TxAuthorization{authorization}, &pkgTypes.Hex{0x01})
+	require.NoError(t, err)
+
+	require.False(t, authorization.Valid)
+	require.NotNil(t, authorization.Authority)
+	require.EqualValues(t, authority.Bytes(), authorization.Authority.Hash)
+}
+
+func TestRecoverAuthorizations_ClearDelegation(t *testing.T) {
+	authorization, authority := signTestAuthorization(t, 1, 5, make(pkgTypes.Hex, common.AddressLength))
+
+	err := recoverAuthorizations([]*storage.TxAuthorization{authorization}, &pkgTypes.Hex{0x01})
+	require.NoError(t, err)
+
+	require.True(t, authorization.Valid)
+	require.EqualValues(t, authority.Bytes(), authorization.Authority.Hash)
+	require.Nil(t, authorization.Delegate)
+}
+
+func TestRecoverAuthorizations_InvalidSignature(t *testing.T) {
+	authorization, _ := signTestAuthorization(t, 1, 5, contractAddressBytes)
+	authorization.YParity = 5
+
+	err := recoverAuthorizations([]*storage.TxAuthorization{authorization}, &pkgTypes.Hex{0x01})
+	require.NoError(t, err)
+
+	require.False(t, authorization.Valid)
+	require.Nil(t, authorization.Authority)
+	require.Nil(t, authorization.Delegate)
+}
+
+func TestRecoverAuthorizations_MaxNonce(t *testing.T) {
+	authorization, _ := signTestAuthorization(t, 1, 5, contractAddressBytes)
+	authorization.Nonce = decimal.RequireFromString("18446744073709551615")
+
+	err := recoverAuthorizations([]*storage.TxAuthorization{authorization}, &pkgTypes.Hex{0x01})
+	require.NoError(t, err)
+
+	require.False(t, authorization.Valid)
+	require.Nil(t, authorization.Authority)
+}
+
+func TestParseDelegations(t *testing.T) {
+	module := createTestModule(t, nil)
+
+	valid, authority := signTestAuthorization(t, 1, 5, contractAddressBytes)
+	cleared, _ := signTestAuthorization(t, 1, 6, make(pkgTypes.Hex, common.AddressLength))
+	invalid, _ := signTestAuthorization(t, 1, 7, contractAddressBytes)
+	invalid.YParity = 5
+
+	authorizations := []*storage.TxAuthorization{valid, cleared, invalid}
+	require.NoError(t, recoverAuthorizations(authorizations, &pkgTypes.Hex{0x01}))
+
+	ctx := dCtx.NewContext()
+	ctx.Block = &storage.Block{
+		Height: 100,
+		Time:   time.Now(),
+		Txs: []*storage.Tx{
+			{Authorizations: authorizations},
+		},
+	}
+
+	module.parseDelegations(ctx)
+
+	addresses := ctx.GetAddresses()
+	require.Len(t, addresses, 2)
+
+	authorityAddress, ok := ctx.Addresses.Get(pkgTypes.Hex(authority.Bytes()).String())
+	require.True(t, ok)
+	require.EqualValues(t, 2, authorityAddress.Interactions)
+	_, ok = ctx.Addresses.Get(pkgTypes.Hex(contractAddressBytes).String())
+	require.True(t, ok)
+}
@@ -0,0 +1,80 @@
+package parser
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NobleScope/noble-indexer/pkg/types"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOptionalDecimal(t *testing.T) {
+	value, err := optionalDecimal(nil)
+	require.NoError(t, err)
+	require.Nil(t, value)
+
+	fee := types.Hex{0x3b, 0x9a, 0xca, 0x00}
+	value, err = optionalDecimal(&fee)
+	require.NoError(t, err)
+	require.NotNil(t, value)
+	require.True(t, value.Equal(decimal.NewFromInt(1000000000)))
+}
+
+func TestParseAccessList(t *testing.T) {
+	blockTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	require.Nil(t, parseAccessList(100, blockTime, nil))
+
+	storageKey := types.Hex(types.MustDecodeHex("0x0000000000000000000000000000000000000000000000000000000000000001"))
+	accessList := parseAccessList(100, blockTime, []types.AccessTuple{
+		{
+			Address:     contractAddressBytes,
+			StorageKeys: []types.Hex{storageKey},
+		},
+		{
+			Address: fromAddressPadded[12:],
+		},
+	})
+	require.Len(t, accessList, 2)
+
+	require.EqualValues(t, 100, accessList[0].Height)
+	require.Equal(t, blockTime, accessList[0].Time)
+	require.EqualValues(t, 0, accessList[0].Index)
+	require.EqualValues(t, contractAddressBytes, accessList[0].Address)
+	require.Equal(t, []types.Hex{storageKey}, accessList[0].StorageKeys)
+
+	require.EqualValues(t, 1, accessList[1].Index)
+	require.Empty(t, accessList[1].StorageKeys)
+}
+
+func TestParseAuthorizationList(t *testing.T) {
+	blockTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	authorizations, err := parseAuthorizationList(100, blockTime, nil)
+	require.NoError(t, err)
+	require.Nil(t, authorizations)
+
+	authorizations, err = parseAuthorizationList(100, blockTime, []types.Authorization{
+		{
+			ChainId: types.Hex{0x01},
+			Address: contractAddressBytes,
+			Nonce:   types.Hex{0x2a},
+			YParity: types.Hex{0x01},
+			R:       types.Hex{0xaa},
+			S:       types.Hex{0xbb},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, authorizations, 1)
+
+	require.EqualValues(t, 100, authorizations[0].Height)
+	require.Equal(t, blockTime, authorizations[0].Time)
+	require.EqualValues(t, 0, authorizations[0].Index)
+	require.True(t, authorizations[0].ChainId.Equal(decimal.NewFromInt(1)))
+	require.EqualValues(t, contractAddressBytes, authorizations[0].Address)
+	require.True(t, authorizations[0].Nonce.Equal(decimal.NewFromInt(42)))
+	require.EqualValues(t, 1, authorizations[0].YParity)
+	require.Equal(t, types.Hex{0xaa}, authorizations[0].R)
+	require.Equal(t, types.Hex{0xbb}, authorizations[0].S)
+}
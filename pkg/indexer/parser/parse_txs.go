@@ -18,6 +18,10 @@ func (p *Module) parseTxs(context *dCtx.Context) error {
 		}
 
 		totalAmount := context.Block.Txs[i].Amount.Add(context.Block.Txs[i].Fee)
+		if context.Block.Txs[i].BlobFee != nil {
+			// blob fee is burned completely, so the miner does not receive it
+			totalAmount = totalAmount.Add(*context.Block.Txs[i].BlobFee)
+		}
 		updateAddressBalance(context, context.Block.Txs[i].FromAddress.String(), enum.Sub, totalAmount)
 
 		if context.Block.Txs[i].ToAddress != nil {
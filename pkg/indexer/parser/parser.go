@@ -8,6 +8,7 @@ import (
 
 	"github.com/NobleScope/noble-indexer/internal/storage/types"
 	"github.com/NobleScope/noble-indexer/pkg/indexer/config"
+	"github.com/NobleScope/noble-indexer/pkg/indexer/parser/types/eip2535"
 	"github.com/NobleScope/noble-indexer/pkg/indexer/parser/types/erc4337"
 	"github.com/pkg/errors"
 
@@ -59,7 +60,11 @@ func NewModule(cfg config.Indexer, networkConfig config.Network) Module {
 
 func (p *Module) Start(ctx context.Context) {
 	p.Log.Info().Msg("starting parser module...")
-	p.G.GoCtx(ctx, p.listen)
+	if p.cfg.Backfill.Workers > 1 {
+		p.G.GoCtx(ctx, p.listenParallel)
+	} else {
+		p.G.GoCtx(ctx, p.listen)
+	}
 }
 
 func (p *Module) Close() error {
@@ -109,6 +114,10 @@ func (p *Module) createABIs() error {
 	if err != nil {
 		return errors.Wrap(err, "reading ERC4337_paymaster_v07 abi json")
 	}
+	eip2535DiamondJson, err := readJson(filepath.Join(p.cfg.AssetsDir, "abi", "EIP2535"), "diamond.json")
+	if err != nil {
+		return errors.Wrap(err, "reading EIP2535_diamond abi json")
+	}
 
 	erc20ABI, err := abi.JSON(bytes.NewReader(erc20ABIJson))
 	if err != nil {
@@ -150,6 +159,10 @@ func (p *Module) createABIs() error {
 	if err != nil {
 		return errors.Wrap(err, "parsing ERC4337_paymaster_v07 abi")
 	}
+	eip2535DiamondABI, err := abi.JSON(bytes.NewReader(eip2535DiamondJson))
+	if err != nil {
+		return errors.Wrap(err, "parsing EIP2535_diamond abi")
+	}
 
 	p.abi[types.ERC20] = &erc20ABI
 	p.abi[types.ERC721] = &erc721ABI
@@ -161,6 +174,7 @@ func (p *Module) createABIs() error {
 	p.abi[erc4337.ABIAccountV07] = &erc4337AccountV07ABI
 	p.abi[erc4337.ABIPaymasterV06] = &erc4337PaymasterV06ABI
 	p.abi[erc4337.ABIPaymasterV07] = &erc4337PaymasterV07ABI
+	p.abi[eip2535.ABIDiamond] = &eip2535DiamondABI
 
 	return nil
 }
@@ -96,33 +96,119 @@ func (p *Module) parseEIP1967Proxy(ctx *dCtx.Context, tx *storage.Tx) {
 		return
 	}
 
-	eip1967Contracts := getEIP1967Proxy(tx.Logs)
-	for proxyAddress, implementationAddress := range eip1967Contracts {
-		storageImplementationAddress := storage.Address{
-			Hash:       implementationAddress,
-			LastHeight: ctx.Block.Height,
-			IsContract: true,
-			Balance:    storage.EmptyBalance(),
+	for i := range tx.Logs {
+		upgrade := getProxyUpgrade(tx.Logs[i].Topics, tx.Logs[i].Data)
+		if upgrade == nil {
+			continue
 		}
-
-		ctx.AddProxyContract(&storage.ProxyContract{
-			Height: ctx.Block.Height,
-			Type:   types.EIP1967,
-			Status: types.Resolved,
-			Contract: storage.Contract{
-				Address: storage.Address{
-					Hash: pkgTypes.MustDecodeHex(proxyAddress),
+		upgrade.Height = ctx.Block.Height
+		upgrade.Time = ctx.Block.Time
+		upgrade.Proxy = storage.Address{
+			Hash: tx.Logs[i].Address.Hash,
+		}
+		setProxyUpgradeAddresses(ctx, upgrade)
+		tx.ProxyUpgrades = append(tx.ProxyUpgrades, upgrade)
+
+		switch upgrade.Type {
+		case types.Upgraded:
+			ctx.SetProxyContract(&storage.ProxyContract{
+				Height: ctx.Block.Height,
+				Type:   types.EIP1967,
+				Status: types.Resolved,
+				Contract: storage.Contract{
+					Address: upgrade.Proxy,
 				},
+				Implementation: &storage.Contract{
+					Address: *upgrade.Implementation,
+				},
+			})
+		case types.BeaconUpgraded:
+			// implementation is resolved from the beacon
+			ctx.SetProxyContract(&storage.ProxyContract{
+				Height: ctx.Block.Height,
+				Type:   types.EIP1967,
+				Status: types.New,
+				Contract: storage.Contract{
+					Address: upgrade.Proxy,
+				},
+				Beacon: upgrade.Beacon,
+			})
+		}
+	}
+}
+
+// getProxyUpgrade - recognizes EIP-1967 Upgraded, BeaconUpgraded and AdminChanged events. Returns nil for any other log.
+func getProxyUpgrade(topics []pkgTypes.Hex, data pkgTypes.Hex) *storage.ProxyUpgrade {
+	if len(topics) == 0 {
+		return nil
+	}
+
+	switch topics[0].Hex() {
+	case eip1967.EventUpgradedSignature:
+		if len(topics) != 2 || !isAddressWord(topics[1]) {
+			return nil
+		}
+		return &storage.ProxyUpgrade{
+			Type: types.Upgraded,
+			Implementation: &storage.Address{
+				Hash: topics[1][uint256BytesLength-AddressBytesLength:],
 			},
-			Implementation: &storage.Contract{
-				Address: storageImplementationAddress,
+		}
+	case eip1967.EventBeaconUpgradedSignature:
+		if len(topics) != 2 || !isAddressWord(topics[1]) {
+			return nil
+		}
+		return &storage.ProxyUpgrade{
+			Type: types.BeaconUpgraded,
+			Beacon: &storage.Address{
+				Hash: topics[1][uint256BytesLength-AddressBytesLength:],
 			},
-		})
+		}
+	case eip1967.EventAdminChangedSignature:
+		if len(topics) != 1 || len(data) != 2*uint256BytesLength ||
+			!isAddressWord(data[:uint256BytesLength]) || !isAddressWord(data[uint256BytesLength:]) {
+			return nil
+		}
+		return &storage.ProxyUpgrade{
+			Type: types.AdminChanged,
+			PreviousAdmin: &storage.Address{
+				Hash: data[uint256BytesLength-AddressBytesLength : uint256BytesLength],
+			},
+			Admin: &storage.Address{
+				Hash: data[2*uint256BytesLength-AddressBytesLength:],
+			},
+		}
+	}
+
+	return nil
+}
 
-		ctx.AddAddress(&storageImplementationAddress)
+func setProxyUpgradeAddresses(ctx *dCtx.Context, upgrade *storage.ProxyUpgrade) {
+	for _, address := range []*storage.Address{upgrade.Implementation, upgrade.Beacon} {
+		if address == nil {
+			continue
+		}
+		address.FirstHeight = ctx.Block.Height
+		address.LastHeight = ctx.Block.Height
+		address.IsContract = true
+		address.Balance = storage.EmptyBalance()
+		ctx.AddAddress(address)
+	}
+	for _, address := range []*storage.Address{upgrade.PreviousAdmin, upgrade.Admin} {
+		if address == nil {
+			continue
+		}
+		address.FirstHeight = ctx.Block.Height
+		address.LastHeight = ctx.Block.Height
+		address.Balance = storage.EmptyBalance()
+		ctx.AddAddress(address)
 	}
 }
 
+func isAddressWord(data pkgTypes.Hex) bool {
+	return len(data) == uint256BytesLength && isAddress(data)
+}
+
 func getProxyType(contract *storage.Contract) types.ProxyType {
 	if isEIP1167(contract) {
 		return types.EIP1167
@@ -241,29 +327,3 @@ func isCloneWithImmutableArgs(contract *storage.Contract) bool {
 		bytes.Equal(contract.Code[clone.ThirdStart:clone.ThirdEnd], clone.Third) &&
 		bytes.Equal(contract.Code[clone.FifthStart:clone.FifthEnd], clone.Fifth)
 }
-
-func getEIP1967Proxy(logs []*storage.Log) map[string]pkgTypes.Hex {
-	result := make(map[string]pkgTypes.Hex)
-	if len(logs) == 0 {
-		return result
-	}
-
-	for i := range logs {
-		if len(logs[i].Topics) == 0 {
-			continue
-		}
-
-		for range logs[i].Topics {
-			if len(logs[i].Topics) > 1 && (logs[i].Topics[0].Hex() == eip1967.EventUpgradedSignature ||
-				logs[i].Topics[0].Hex() == eip1967.EventBeaconUpgradedSignature) {
-				implementationAddress := logs[i].Topics[1]
-				if len(implementationAddress) > AddressBytesLength {
-					implementationAddress = implementationAddress[len(implementationAddress)-AddressBytesLength:]
-				}
-				result[logs[i].Address.String()] = implementationAddress
-			}
-		}
-	}
-
-	return result
-}
@@ -0,0 +1,132 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/NobleScope/noble-indexer/internal/storage"
+	"github.com/NobleScope/noble-indexer/internal/storage/types"
+	"github.com/NobleScope/noble-indexer/pkg/indexer/parser/types/eip1967"
+	pkgTypes "github.com/NobleScope/noble-indexer/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	upgradedTopic       = pkgTypes.MustDecodeHex(eip1967.EventUpgradedSignature)
+	beaconUpgradedTopic = pkgTypes.MustDecodeHex(eip1967.EventBeaconUpgradedSignature)
+	adminChangedTopic   = pkgTypes.MustDecodeHex(eip1967.EventAdminChangedSignature)
+)
+
+func TestParseEIP1967Proxy_Upgraded(t *testing.T) {
+	module := createTestModule(t, nil)
+
+	ctx := createApprovalContext(types.TxStatusSuccess, &storage.Log{
+		Address: storage.Address{Hash: contractAddressBytes},
+		Topics:  []pkgTypes.Hex{upgradedTopic, toAddressPadded},
+	})
+
+	module.parseEIP1967Proxy(ctx, ctx.Block.Txs[0])
+
+	upgrades := ctx.Block.Txs[0].ProxyUpgrades
+	require.Len(t, upgrades, 1)
+	require.Equal(t, types.Upgraded, upgrades[0].Type)
+	require.EqualValues(t, 100, upgrades[0].Height)
+	require.EqualValues(t, contractAddressBytes, upgrades[0].Proxy.Hash)
+	require.NotNil(t, upgrades[0].Implementation)
+	require.EqualValues(t, toAddressPadded[12:], upgrades[0].Implementation.Hash)
+	require.Nil(t, upgrades[0].Beacon)
+
+	proxies := ctx.GetProxyContracts()
+	require.Len(t, proxies, 1)
+	require.Equal(t, types.EIP1967, proxies[0].Type)
+	require.Equal(t, types.Resolved, proxies[0].Status)
+	require.NotNil(t, proxies[0].Implementation)
+	require.EqualValues(t, toAddressPadded[12:], proxies[0].Implementation.Address.Hash)
+}
+
+func TestParseEIP1967Proxy_LatestUpgradeWins(t *testing.T) {
+	module := createTestModule(t, nil)
+
+	ctx := createApprovalContext(types.TxStatusSuccess,
+		&storage.Log{
+			Address: storage.Address{Hash: contractAddressBytes},
+			Topics:  []pkgTypes.Hex{upgradedTopic, toAddressPadded},
+		},
+		&storage.Log{
+			Address: storage.Address{Hash: contractAddressBytes},
+			Topics:  []pkgTypes.Hex{beaconUpgradedTopic, fromAddressPadded},
+		},
+	)
+
+	module.parseEIP1967Proxy(ctx, ctx.Block.Txs[0])
+
+	upgrades := ctx.Block.Txs[0].ProxyUpgrades
+	require.Len(t, upgrades, 2)
+	require.Equal(t, types.BeaconUpgraded, upgrades[1].Type)
+	require.NotNil(t, upgrades[1].Beacon)
+	require.EqualValues(t, fromAddressPadded[12:], upgrades[1].Beacon.Hash)
+
+	proxies := ctx.GetProxyContracts()
+	require.Len(t, proxies, 1)
+	require.Equal(t, types.New, proxies[0].Status)
+	require.Nil(t, proxies[0].Implementation)
+	require.NotNil(t, proxies[0].Beacon)
+	require.EqualValues(t, fromAddressPadded[12:], proxies[0].Beacon.Hash)
+}
+
+func TestParseEIP1967Proxy_AdminChanged(t *testing.T) {
+	module := createTestModule(t, nil)
+
+	data := append(append(pkgTypes.Hex{}, fromAddressPadded...), toAddressPadded...)
+	ctx := createApprovalContext(types.TxStatusSuccess, &storage.Log{
+		Address: storage.Address{Hash: contractAddressBytes},
+		Topics:  []pkgTypes.Hex{adminChangedTopic},
+		Data:    data,
+	})
+
+	module.parseEIP1967Proxy(ctx, ctx.Block.Txs[0])
+
+	upgrades := ctx.Block.Txs[0].ProxyUpgrades
+	require.Len(t, upgrades, 1)
+	require.Equal(t, types.AdminChanged, upgrades[0].Type)
+	require.NotNil(t, upgrades[0].PreviousAdmin)
+	require.EqualValues(t, fromAddressPadded[12:], upgrades[0].PreviousAdmin.Hash)
+	require.NotNil(t, upgrades[0].Admin)
+	require.EqualValues(t, toAddressPadded[12:], upgrades[0].Admin.Hash)
+
+	require.Len(t, ctx.GetProxyContracts(), 0)
+	require.Len(t, ctx.GetAddresses(), 2)
+}
+
+func TestParseEIP1967Proxy_Reverted(t *testing.T) {
+	module := createTestModule(t, nil)
+
+	ctx := createApprovalContext(types.TxStatusRevert, &storage.Log{
+		Address: storage.Address{Hash: contractAddressBytes},
+		Topics:  []pkgTypes.Hex{upgradedTopic, toAddressPadded},
+	})
+
+	module.parseEIP1967Proxy(ctx, ctx.Block.Txs[0])
+
+	require.Len(t, ctx.Block.Txs[0].ProxyUpgrades, 0)
+	require.Len(t, ctx.GetProxyContracts(), 0)
+}
+
+func TestGetProxyUpgrade_Invalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		topics []pkgTypes.Hex
+		data   pkgTypes.Hex
+	}{
+		{"no topics", nil, nil},
+		{"upgraded without implementation", []pkgTypes.Hex{upgradedTopic}, nil},
+		{"upgraded with short topic", []pkgTypes.Hex{upgradedTopic, toAddressPadded[12:]}, nil},
+		{"admin changed with short data", []pkgTypes.Hex{adminChangedTopic}, fromAddressPadded},
+		{"unknown event", []pkgTypes.Hex{approvalTopic, fromAddressPadded, toAddressPadded}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Nil(t, getProxyUpgrade(tt.topics, tt.data))
+		})
+	}
+}
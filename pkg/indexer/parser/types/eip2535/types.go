@@ -0,0 +1,29 @@
+package eip2535
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const ABIDiamond = "EIP2535_diamond"
+
+const EventDiamondCutSignature = "0x8faa70878671ccd212d20771b795c50af8fd3ff6cf27f4bde57e5d4de0aeb673"
+
+// FacetCutAction values of IDiamondCut.FacetCutAction enum
+const (
+	FacetCutActionAdd uint8 = iota
+	FacetCutActionReplace
+	FacetCutActionRemove
+)
+
+type FacetCut struct {
+	FacetAddress      common.Address
+	Action            uint8
+	FunctionSelectors [][4]byte
+}
+
+// DiamondCutEvent - data of DiamondCut(FacetCut[] _diamondCut, address _init, bytes _calldata) event
+type DiamondCutEvent struct {
+	DiamondCut []FacetCut
+	Init       common.Address
+	Calldata   []byte
+}
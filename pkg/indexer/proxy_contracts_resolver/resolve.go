@@ -11,23 +11,60 @@ import (
 )
 
 func (p *Module) resolveProxyContracts(ctx context.Context, contracts []*storage.ProxyContract) (int, error) {
-	storageRequest := make([]pkgTypes.StorageRequest, len(contracts))
+	storageRequest := make([]pkgTypes.StorageRequest, 0, len(contracts))
+	callRequest := make([]pkgTypes.CallRequest, 0)
 	resolved := 0
 
 	for i := range contracts {
-		storageRequest[i].BlockNumber = contracts[i].Height
-		storageRequest[i].StorageSlot = getStorageSlot(contracts[i].Type)
-		storageRequest[i].ContractAddress = contracts[i].Contract.Address.Hash.Hex()
+		if contracts[i].Beacon != nil {
+			callRequest = append(callRequest, pkgTypes.CallRequest{
+				To:          contracts[i].Beacon.Hash.Hex(),
+				Data:        beaconImplementationSelector,
+				BlockNumber: contracts[i].Height,
+			})
+			continue
+		}
+		storageRequest = append(storageRequest, pkgTypes.StorageRequest{
+			ContractAddress: contracts[i].Contract.Address.Hash.Hex(),
+			StorageSlot:     getStorageSlot(contracts[i].Type),
+			BlockNumber:     contracts[i].Height,
+		})
+	}
+
+	var storageValues []pkgTypes.Hex
+	if len(storageRequest) > 0 {
+		values, err := p.api.Storage(ctx, storageRequest)
+		if err != nil {
+			p.handleError(contracts)
+			return resolved, errors.Wrap(err, "fetching contracts storage")
+		}
+		storageValues = values
+	}
+	if len(storageValues) != len(storageRequest) {
+		p.handleError(contracts)
+		return resolved, errors.New("unexpected number of storage values")
 	}
 
-	storageValues, err := p.api.Storage(ctx, storageRequest)
+	callValues, err := p.api.Call(ctx, callRequest)
 	if err != nil {
 		p.handleError(contracts)
-		return resolved, errors.Wrap(err, "fetching contracts storage")
+		return resolved, errors.Wrap(err, "calling beacon contracts")
 	}
-	if len(storageValues) != len(contracts) {
+	if len(callValues) != len(callRequest) {
 		p.handleError(contracts)
-		return resolved, errors.New("unexpected number of storage values")
+		return resolved, errors.New("unexpected number of beacon call results")
+	}
+
+	values := make([]pkgTypes.Hex, len(contracts))
+	var storageIdx, callIdx int
+	for i := range contracts {
+		if contracts[i].Beacon != nil {
+			values[i] = callValues[callIdx]
+			callIdx++
+			continue
+		}
+		values[i] = storageValues[storageIdx]
+		storageIdx++
 	}
 
 	for i := range contracts {
@@ -37,7 +74,7 @@ func (p *Module) resolveProxyContracts(ctx context.Context, contracts []*storage
 			continue
 		}
 
-		implementationAddress := storageValues[i]
+		implementationAddress := values[i]
 		if implementationAddress == nil {
 			continue
 		}
@@ -56,6 +93,9 @@ func (p *Module) resolveProxyContracts(ctx context.Context, contracts []*storage
 	return resolved, nil
 }
 
+// beaconImplementationSelector - selector of `implementation()` of the beacon contract (IBeacon)
+const beaconImplementationSelector = "0x5c60da1b"
+
 func getStorageSlot(proxyType types.ProxyType) string {
 	switch proxyType {
 	case types.EIP7760:
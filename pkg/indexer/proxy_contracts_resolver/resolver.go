@@ -6,7 +6,7 @@ import (
 	"github.com/NobleScope/noble-indexer/internal/storage"
 	"github.com/NobleScope/noble-indexer/internal/storage/postgres"
 	"github.com/NobleScope/noble-indexer/pkg/indexer/config"
-	"github.com/NobleScope/noble-indexer/pkg/node/rpc"
+	"github.com/NobleScope/noble-indexer/pkg/node"
 	"github.com/dipdup-io/workerpool"
 	"github.com/dipdup-net/indexer-sdk/pkg/modules"
 	sdkSync "github.com/dipdup-net/indexer-sdk/pkg/sync"
@@ -16,7 +16,7 @@ type Module struct {
 	modules.BaseModule
 
 	cfg       config.Indexer
-	api       *rpc.API
+	api       node.Api
 	pg        *postgres.Storage
 	pool      *workerpool.TimedPool[[]*storage.ProxyContract]
 	taskQueue *sdkSync.Map[string, struct{}]
@@ -29,7 +29,7 @@ const (
 	StopOutput = "stop"
 )
 
-func NewModule(cfg config.Indexer, api *rpc.API, pg *postgres.Storage) Module {
+func NewModule(cfg config.Indexer, api node.Api, pg *postgres.Storage) Module {
 	m := Module{
 		BaseModule: modules.New("proxy_contracts_resolver"),
 		cfg:        cfg,
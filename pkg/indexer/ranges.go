@@ -0,0 +1,171 @@
+package indexer
+
+import (
+	"bytes"
+	"context"
+
+	internalStorage "github.com/NobleScope/noble-indexer/internal/storage"
+	"github.com/NobleScope/noble-indexer/internal/storage/postgres"
+	"github.com/NobleScope/noble-indexer/pkg/indexer/config"
+	"github.com/NobleScope/noble-indexer/pkg/types"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// createRangeState creates the state of the range indexer which doesn't start from genesis.
+// The range starts from the block after the last height of the state.
+func createRangeState(ctx context.Context, pg postgres.Storage, cfg config.Indexer) (*internalStorage.State, error) {
+	state := internalStorage.State{
+		Name:        cfg.StateName(),
+		StartHeight: types.Level(cfg.StartLevel),
+		EndHeight:   types.Level(cfg.EndLevel),
+		LastHeight:  types.Level(cfg.StartLevel - 1),
+	}
+	if err := pg.State.Save(ctx, &state); err != nil {
+		return nil, err
+	}
+	return &state, nil
+}
+
+// mergeableRanges returns fully indexed ranges which continue the canonical state without gaps.
+// If the canonical state doesn't exist, the first range has to start from genesis.
+func mergeableRanges(canonical *internalStorage.State, ranges []internalStorage.State) []internalStorage.State {
+	var next types.Level
+	if canonical != nil {
+		next = canonical.LastHeight + 1
+	}
+
+	for i := range ranges {
+		if ranges[i].StartHeight != next || !ranges[i].IsRangeIndexed() {
+			return ranges[:i]
+		}
+		next = ranges[i].EndHeight + 1
+	}
+	return ranges
+}
+
+// mergeRanges stitches states of indexed ranges into the canonical state of the indexer. Counters of ranges are summed,
+// the first block time of the range and balance histories are recalculated since ranges were indexed concurrently.
+// Heights of addresses and tokens, allowances, delegations and diamond facets don't need merging, their upserts keep
+// the value of the highest block, so they are saved independently of the order of blocks.
+// The canonical indexer can't start while some ranges are not merged, otherwise it would index their blocks again.
+func mergeRanges(ctx context.Context, pg postgres.Storage, name string) error {
+	ranges, err := pg.State.Ranges(ctx, name)
+	if err != nil {
+		return errors.Wrap(err, "receiving range states")
+	}
+	if len(ranges) == 0 {
+		return nil
+	}
+
+	canonical, err := loadState(pg, ctx, name)
+	if err != nil {
+		return errors.Wrap(err, "loading canonical state")
+	}
+
+	merged := mergeableRanges(canonical, ranges)
+	seam, err := brokenSeam(ctx, pg.Blocks, canonical, merged)
+	if err != nil {
+		return errors.Wrap(err, "checking seams of ranges")
+	}
+	if seam > 0 {
+		if err := saveMergedRanges(ctx, pg, name, canonical, merged[:seam]); err != nil {
+			return errors.Wrap(err, "merging ranges")
+		}
+	}
+
+	if seam < len(merged) {
+		return errors.Errorf(
+			"range %s can't be merged: parent hash of block %d differs from hash of the previous block",
+			merged[seam].Name, merged[seam].StartHeight,
+		)
+	}
+	if len(merged) < len(ranges) {
+		pending := ranges[len(merged)]
+		return errors.Errorf(
+			"range %s can't be merged: it's indexed up to %d of %d or there is a gap before it",
+			pending.Name, pending.LastHeight, pending.EndHeight,
+		)
+	}
+	return nil
+}
+
+// brokenSeam returns the index of the first range which first block is not the child of the last block
+// of the previous range or of the canonical state. It returns the number of ranges if all seams are valid.
+func brokenSeam(ctx context.Context, blocks internalStorage.IBlock, canonical *internalStorage.State, ranges []internalStorage.State) (int, error) {
+	var lastHash []byte
+	if canonical != nil {
+		lastHash = canonical.LastHash
+	}
+
+	for i := range ranges {
+		// the range from genesis has no previous block
+		if i > 0 || canonical != nil {
+			first, err := blocks.ByHeight(ctx, ranges[i].StartHeight, false)
+			if err != nil {
+				return 0, errors.Wrapf(err, "receiving the first block of range %s", ranges[i].Name)
+			}
+			if !bytes.Equal(first.ParentHashHash, lastHash) {
+				return i, nil
+			}
+		}
+		lastHash = ranges[i].LastHash
+	}
+	return len(ranges), nil
+}
+
+func saveMergedRanges(ctx context.Context, pg postgres.Storage, name string, canonical *internalStorage.State, ranges []internalStorage.State) error {
+	tx, err := postgres.BeginTransaction(ctx, pg.Transactable)
+	if err != nil {
+		return err
+	}
+	defer tx.Close(ctx)
+
+	for i := range ranges {
+		r := ranges[i]
+
+		if err := tx.RecalculateBalanceHistory(ctx, r.StartHeight, r.EndHeight); err != nil {
+			return tx.HandleError(ctx, err)
+		}
+		if err := tx.RecalculateTokenBalanceHistory(ctx, r.StartHeight, r.EndHeight); err != nil {
+			return tx.HandleError(ctx, err)
+		}
+
+		if canonical == nil {
+			// the range from genesis becomes the canonical state
+			state := r
+			state.Name = name
+			state.StartHeight = 0
+			state.EndHeight = 0
+			canonical = &state
+		} else {
+			if err := tx.DeleteState(ctx, r.Name); err != nil {
+				return tx.HandleError(ctx, err)
+			}
+			if err := tx.UpdateBlockTime(ctx, r.StartHeight); err != nil {
+				return tx.HandleError(ctx, err)
+			}
+
+			canonical.LastHeight = r.LastHeight
+			canonical.LastHash = r.LastHash
+			canonical.LastTime = r.LastTime
+			canonical.TotalTx += r.TotalTx
+			canonical.TotalAccounts += r.TotalAccounts
+			canonical.TotalContracts += r.TotalContracts
+			canonical.TotalVerifiedContracts += r.TotalVerifiedContracts
+			canonical.TotalTokens += r.TotalTokens
+			canonical.SafeHeight = max(canonical.SafeHeight, r.SafeHeight)
+			canonical.FinalizedHeight = max(canonical.FinalizedHeight, r.FinalizedHeight)
+		}
+
+		log.Info().
+			Str("range", r.Name).
+			Uint64("last_height", uint64(canonical.LastHeight)).
+			Msg("range is merged to the canonical state")
+	}
+
+	if err := tx.Update(ctx, canonical); err != nil {
+		return tx.HandleError(ctx, err)
+	}
+	return tx.Flush(ctx)
+}
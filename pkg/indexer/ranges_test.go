@@ -0,0 +1,95 @@
+package indexer
+
+import (
+	"context"
+	"testing"
+
+	internalStorage "github.com/NobleScope/noble-indexer/internal/storage"
+	"github.com/NobleScope/noble-indexer/internal/storage/mock"
+	"github.com/NobleScope/noble-indexer/pkg/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func rangeState(start, end, last types.Level) internalStorage.State {
+	return internalStorage.State{
+		Name:        internalStorage.RangeStateName("indexer", start, end),
+		StartHeight: start,
+		EndHeight:   end,
+		LastHeight:  last,
+	}
+}
+
+func TestMergeableRanges(t *testing.T) {
+	tests := []struct {
+		name      string
+		canonical *internalStorage.State
+		ranges    []internalStorage.State
+		want      int
+	}{
+		{
+			name:   "all ranges from genesis are indexed",
+			ranges: []internalStorage.State{rangeState(0, 100, 100), rangeState(101, 200, 200), rangeState(201, 300, 300)},
+			want:   3,
+		}, {
+			name:   "middle range is in progress",
+			ranges: []internalStorage.State{rangeState(0, 100, 100), rangeState(101, 200, 150), rangeState(201, 300, 300)},
+			want:   1,
+		}, {
+			name:   "genesis range is absent",
+			ranges: []internalStorage.State{rangeState(101, 200, 200)},
+			want:   0,
+		}, {
+			name:      "ranges continue the canonical state",
+			canonical: &internalStorage.State{Name: "indexer", LastHeight: 100},
+			ranges:    []internalStorage.State{rangeState(101, 200, 200), rangeState(201, 300, 300)},
+			want:      2,
+		}, {
+			name:      "gap after the canonical state",
+			canonical: &internalStorage.State{Name: "indexer", LastHeight: 90},
+			ranges:    []internalStorage.State{rangeState(101, 200, 200)},
+			want:      0,
+		}, {
+			name:   "gap between ranges",
+			ranges: []internalStorage.State{rangeState(0, 100, 100), rangeState(111, 200, 200)},
+			want:   1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := mergeableRanges(tt.canonical, tt.ranges)
+			require.Len(t, got, tt.want)
+		})
+	}
+}
+
+func TestBrokenSeam(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	blocks := mock.NewMockIBlock(ctrl)
+
+	ranges := []internalStorage.State{rangeState(101, 200, 200), rangeState(201, 300, 300)}
+	ranges[0].LastHash = []byte{0x02}
+	ranges[1].LastHash = []byte{0x03}
+	canonical := &internalStorage.State{Name: "indexer", LastHeight: 100, LastHash: []byte{0x01}}
+
+	blocks.EXPECT().
+		ByHeight(gomock.Any(), types.Level(101), false).
+		Return(internalStorage.Block{Height: 101, ParentHashHash: []byte{0x01}}, nil).
+		Times(2)
+	blocks.EXPECT().
+		ByHeight(gomock.Any(), types.Level(201), false).
+		Return(internalStorage.Block{Height: 201, ParentHashHash: []byte{0x02}}, nil)
+
+	seam, err := brokenSeam(context.Background(), blocks, canonical, ranges)
+	require.NoError(t, err)
+	require.Equal(t, 2, seam)
+
+	blocks.EXPECT().
+		ByHeight(gomock.Any(), types.Level(201), false).
+		Return(internalStorage.Block{Height: 201, ParentHashHash: []byte{0xff}}, nil)
+
+	seam, err = brokenSeam(context.Background(), blocks, canonical, ranges)
+	require.NoError(t, err)
+	require.Equal(t, 1, seam)
+}
@@ -0,0 +1,43 @@
+package receiver
+
+import (
+	"context"
+	"time"
+
+	"github.com/NobleScope/noble-indexer/pkg/types"
+)
+
+// finality requests the safe and finalized blocks of the node periodically and passes them to the storage.
+// They are passed on every tick since the storage may catch up the finality in several steps.
+// Nodes which don't support block tags only produce warnings.
+func (r *Module) finality(ctx context.Context) {
+	ticker := time.NewTicker(time.Second * time.Duration(r.cfg.FinalityPeriod))
+	defer ticker.Stop()
+
+	var last types.Finality
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			finality, err := r.api.Finality(ctx)
+			if err != nil {
+				if ctx.Err() == nil {
+					r.Log.Warn().Err(err).Msg("receiving safe and finalized blocks")
+				}
+				continue
+			}
+
+			finality.Safe = r.limitHead(finality.Safe)
+			finality.Finalized = r.limitHead(finality.Finalized)
+			if finality != last {
+				last = finality
+				r.Log.Debug().
+					Uint64("safe", uint64(finality.Safe)).
+					Uint64("finalized", uint64(finality.Finalized)).
+					Msg("finality is updated")
+			}
+			r.MustOutput(FinalityOutput).Push(finality)
+		}
+	}
+}
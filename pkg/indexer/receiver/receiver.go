@@ -20,6 +20,7 @@ const (
 	GenesisOutput    = "genesis"
 	GenesisDoneInput = "genesis_done"
 	StopOutput       = "stop"
+	FinalityOutput   = "finality"
 )
 
 type Module struct {
@@ -69,6 +70,7 @@ func NewModule(cfg config.Indexer, api node.Api, ws *websocket.Conn, state *stor
 	receiver.CreateOutput(RollbackOutput)
 	receiver.CreateOutput(GenesisOutput)
 	receiver.CreateOutput(StopOutput)
+	receiver.CreateOutput(FinalityOutput)
 
 	return receiver
 }
@@ -86,6 +88,10 @@ func (r *Module) Start(ctx context.Context) {
 	r.G.GoCtx(ctx, r.sequencer)
 	r.G.GoCtx(ctx, r.sync)
 	r.G.GoCtx(ctx, r.rollback)
+
+	if r.cfg.FinalityPeriod > 0 {
+		r.G.GoCtx(ctx, r.finality)
+	}
 }
 
 func (r *Module) Level() (types.Level, []byte) {
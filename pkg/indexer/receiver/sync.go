@@ -104,7 +104,7 @@ func (r *Module) live(ctx context.Context) error {
 			}
 
 			r.Log.Info().Uint64("height", height).Msg("ws subscription received")
-			r.passBlocks(ctx, pkgTypes.Level(height))
+			r.passBlocks(ctx, r.limitHead(pkgTypes.Level(height)))
 		}
 	}
 }
@@ -123,6 +123,9 @@ func (r *Module) readBlocks(ctx context.Context) error {
 		r.w.SetLiveMode(isLiveMode)
 
 		if level, _ := r.Level(); level == headLevel {
+			if r.cfg.IsRange() && level == pkgTypes.Level(r.cfg.EndLevel) {
+				return nil
+			}
 			time.Sleep(time.Millisecond * 300)
 			continue
 		}
@@ -136,6 +139,19 @@ func (r *Module) passBlocks(ctx context.Context, head pkgTypes.Level) {
 	level, _ := r.Level()
 	level += 1
 
+	// far from the head ranges of blocks are requested concurrently until the head is closer than the single round
+	if workers := r.cfg.Backfill.Workers; workers > 1 && len(r.w.queue) == 0 {
+		round := pkgTypes.Level(workers * r.w.capacity)
+		for ; level+round <= head+1; level += round {
+			select {
+			case <-ctx.Done():
+				return
+			default:
+				r.w.Backfill(ctx, level, workers)
+			}
+		}
+	}
+
 	for ; level <= head; level++ {
 		select {
 		case <-ctx.Done():
@@ -151,5 +167,13 @@ func (r *Module) headLevel(ctx context.Context) (pkgTypes.Level, error) {
 	if err != nil {
 		return 0, err
 	}
-	return head, nil
+	return r.limitHead(head), nil
+}
+
+// limitHead returns the end of the range for the range indexer, so it doesn't request blocks after the range.
+func (r *Module) limitHead(head pkgTypes.Level) pkgTypes.Level {
+	if r.cfg.IsRange() {
+		return min(head, pkgTypes.Level(r.cfg.EndLevel))
+	}
+	return head
 }
@@ -69,37 +69,76 @@ func (worker *Worker) Do(ctx context.Context, level types.Level) {
 
 	start := time.Now()
 
-	var result []types.BlockData
+	result := worker.request(ctx, worker.queue)
+	if ctx.Err() != nil {
+		return
+	}
+	worker.push(ctx, result, start)
+
+	worker.queue = worker.queue[:0]
+	clear(worker.m)
+}
+
+// Backfill requests `workers` bulks of blocks starting from the level concurrently and passes them in order.
+// Blocks of the single round are kept in memory only, so the memory is bounded by workers * capacity blocks.
+func (worker *Worker) Backfill(ctx context.Context, from types.Level, workers int) {
+	start := time.Now()
+
+	results := make([][]types.BlockData, workers)
+	var wg sync.WaitGroup
+	for i := range workers {
+		levels := make([]types.Level, worker.capacity)
+		for j := range levels {
+			levels[j] = from + types.Level(i*worker.capacity+j)
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = worker.request(ctx, levels)
+		}()
+	}
+	wg.Wait()
+
+	if ctx.Err() != nil {
+		return
+	}
+	for i := range results {
+		worker.push(ctx, results[i], start)
+	}
+}
+
+// request requests the bulk of blocks until success or the context cancellation
+func (worker *Worker) request(ctx context.Context, levels []types.Level) []types.BlockData {
 	for {
 		select {
 		case <-ctx.Done():
-			return
+			return nil
 		default:
 		}
 
 		requestTimeout, cancel := context.WithTimeout(ctx, time.Minute)
-		blocks, err := worker.api.BlockBulk(requestTimeout, worker.queue...)
-		if err != nil {
-			cancel()
+		blocks, err := worker.api.BlockBulk(requestTimeout, levels...)
+		cancel()
+		if err == nil {
+			return blocks
+		}
 
-			if errors.Is(err, context.Canceled) {
-				return
-			}
+		if errors.Is(err, context.Canceled) {
+			return nil
+		}
 
-			worker.log.Err(err).
-				Uint64("height", uint64(level)).
-				Msg("while getting block data")
+		worker.log.Err(err).
+			Uint64("height", uint64(levels[len(levels)-1])).
+			Msg("while getting block data")
 
-			time.Sleep(time.Second)
-			continue
-		}
-		result = blocks
-		cancel()
-		break
+		time.Sleep(time.Second)
 	}
+}
 
-	for i := range result {
-		height, err := result[i].Number.Uint64()
+func (worker *Worker) push(ctx context.Context, blocks []types.BlockData, start time.Time) {
+	for i := range blocks {
+		height, err := blocks[i].Number.Uint64()
 		if err != nil {
 			worker.log.Err(err).Msg("can't read block number")
 		}
@@ -108,9 +147,11 @@ func (worker *Worker) Do(ctx context.Context, level types.Level) {
 			Uint64("height", height).
 			Int64("ms", time.Since(start).Milliseconds()).
 			Msg("received block")
-		worker.blocks <- result[i]
-	}
 
-	worker.queue = worker.queue[:0]
-	clear(worker.m)
+		select {
+		case <-ctx.Done():
+			return
+		case worker.blocks <- blocks[i]:
+		}
+	}
 }
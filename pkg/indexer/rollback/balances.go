@@ -31,6 +31,10 @@ func (module *Module) rollbackBalances(
 		return err
 	}
 
+	if err := tx.RollbackBalanceHistory(ctx, block.Height); err != nil {
+		return err
+	}
+
 	if len(deletedTxs) == 0 {
 		return nil
 	}
@@ -40,8 +44,7 @@ func (module *Module) rollbackBalances(
 		return err
 	}
 
-	err = tx.SaveBalances(ctx, updates...)
-	if err != nil {
+	if _, err = tx.SaveBalances(ctx, updates...); err != nil {
 		return err
 	}
 
@@ -90,7 +93,11 @@ func getBalanceUpdates(
 		}
 
 		if _, ok := deletedAddressIds[t.FromAddressId]; !ok {
-			updates[t.FromAddressId] = t.Amount.Add(t.Fee)
+			spent := t.Amount.Add(t.Fee)
+			if t.BlobFee != nil {
+				spent = spent.Add(*t.BlobFee)
+			}
+			updates[t.FromAddressId] = spent
 		}
 
 		if t.ToAddressId != nil {
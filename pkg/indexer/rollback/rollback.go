@@ -6,6 +6,7 @@ import (
 
 	"github.com/NobleScope/noble-indexer/internal/storage"
 	"github.com/NobleScope/noble-indexer/internal/storage/postgres"
+	"github.com/NobleScope/noble-indexer/internal/storage/types"
 	"github.com/NobleScope/noble-indexer/pkg/indexer/config"
 	"github.com/NobleScope/noble-indexer/pkg/node"
 	"github.com/dipdup-net/indexer-sdk/pkg/modules"
@@ -44,7 +45,7 @@ func NewModule(
 		state:      state,
 		blocks:     blocks,
 		node:       node,
-		indexName:  cfg.Name,
+		indexName:  cfg.StateName(),
 	}
 
 	module.CreateInput(InputName)
@@ -94,7 +95,12 @@ func (module *Module) rollback(ctx context.Context) error {
 		case <-ctx.Done():
 			return nil
 		default:
-			lastBlock, err := module.blocks.Last(ctx)
+			// range indexers write blocks concurrently, so only blocks of the own range are compared
+			state, err := module.state.ByName(ctx, module.indexName)
+			if err != nil {
+				return errors.Wrap(err, "receive state from database")
+			}
+			lastBlock, err := module.blocks.LastInRange(ctx, state.StartHeight, state.EndHeight)
 			if err != nil {
 				return errors.Wrap(err, "receive last block from database")
 			}
@@ -114,6 +120,10 @@ func (module *Module) rollback(ctx context.Context) error {
 				return module.finish(ctx)
 			}
 
+			if lastBlock.Finality == types.Finalized {
+				return errors.Errorf("finalized block %d differs from the node one, it can't be rolled back", lastBlock.Height)
+			}
+
 			log.Warn().
 				Uint64("height", uint64(lastBlock.Height)).
 				Hex("db_block_hash", lastBlock.Hash).
@@ -177,6 +187,30 @@ func (module *Module) rollbackBlock(ctx context.Context, block storage.Block) er
 		return tx.HandleError(ctx, err)
 	}
 
+	if err := tx.RollbackTxAccessLists(ctx, height); err != nil {
+		return tx.HandleError(ctx, err)
+	}
+
+	if err := tx.RollbackTxAuthorizations(ctx, height); err != nil {
+		return tx.HandleError(ctx, err)
+	}
+
+	if err := tx.RollbackDelegations(ctx, height); err != nil {
+		return tx.HandleError(ctx, err)
+	}
+
+	if err := tx.RollbackBlobs(ctx, height); err != nil {
+		return tx.HandleError(ctx, err)
+	}
+
+	if err := tx.RollbackProxyUpgrades(ctx, height); err != nil {
+		return tx.HandleError(ctx, err)
+	}
+
+	if err := tx.RollbackDiamondCuts(ctx, height); err != nil {
+		return tx.HandleError(ctx, err)
+	}
+
 	traces, err := tx.RollbackTraces(ctx, height)
 	if err != nil {
 		return tx.HandleError(ctx, err)
@@ -187,6 +221,18 @@ func (module *Module) rollbackBlock(ctx context.Context, block storage.Block) er
 		return tx.HandleError(ctx, err)
 	}
 
+	if err := tx.RollbackTokenBalanceHistory(ctx, height); err != nil {
+		return tx.HandleError(ctx, err)
+	}
+
+	if err := tx.RollbackApprovals(ctx, height); err != nil {
+		return tx.HandleError(ctx, err)
+	}
+
+	if err := tx.RollbackAllowances(ctx, height); err != nil {
+		return tx.HandleError(ctx, err)
+	}
+
 	tokens, err := tx.RollbackTokens(ctx, height)
 	if err != nil {
 		return tx.HandleError(ctx, err)
@@ -205,11 +251,11 @@ func (module *Module) rollbackBlock(ctx context.Context, block storage.Block) er
 		return tx.HandleError(ctx, err)
 	}
 
-	newBlock, err := tx.LastBlock(ctx)
+	state, err := tx.State(ctx, module.indexName)
 	if err != nil {
 		return tx.HandleError(ctx, err)
 	}
-	state, err := tx.State(ctx, module.indexName)
+	newBlock, err := tx.LastBlockInRange(ctx, state.StartHeight, state.EndHeight)
 	if err != nil {
 		return tx.HandleError(ctx, err)
 	}
@@ -9,6 +9,7 @@ import (
 func saveAddresses(
 	ctx context.Context,
 	tx storage.Transaction,
+	block *storage.Block,
 	addresses []*storage.Address,
 ) (map[string]uint64, int64, error) {
 	if len(addresses) == 0 {
@@ -27,9 +28,50 @@ func saveAddresses(
 		addresses[i].Balance.Id = addresses[i].Id
 		balances[i] = addresses[i].Balance
 	}
-	if len(balances) > 0 {
-		err = tx.SaveBalances(ctx, balances...)
+
+	updated, err := tx.SaveBalances(ctx, balances...)
+	if err != nil {
+		return nil, 0, err
 	}
 
+	err = saveBalanceHistory(ctx, tx, block, balances, updated)
 	return addrToId, totalAccounts, err
 }
+
+func saveBalanceHistory(
+	ctx context.Context,
+	tx storage.Transaction,
+	block *storage.Block,
+	deltas []*storage.Balance,
+	updated []storage.Balance,
+) error {
+	// addresses saved outside of block processing (e.g. proxy implementations) don't change balances
+	if block == nil {
+		return nil
+	}
+
+	values := make(map[uint64]storage.Balance, len(updated))
+	for i := range updated {
+		values[updated[i].Id] = updated[i]
+	}
+
+	history := make([]*storage.BalanceHistory, 0, len(deltas))
+	for i := range deltas {
+		if deltas[i].Value.IsZero() {
+			continue
+		}
+		balance, ok := values[deltas[i].Id]
+		if !ok {
+			continue
+		}
+		history = append(history, &storage.BalanceHistory{
+			Height:    block.Height,
+			Time:      block.Time,
+			AddressId: deltas[i].Id,
+			Value:     balance.Value,
+			Delta:     deltas[i].Value,
+		})
+	}
+
+	return tx.SaveBalanceHistory(ctx, history...)
+}
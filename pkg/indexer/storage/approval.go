@@ -0,0 +1,87 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/NobleScope/noble-indexer/internal/storage"
+	"github.com/NobleScope/noble-indexer/internal/storage/types"
+	"github.com/pkg/errors"
+)
+
+func saveApprovals(
+	ctx context.Context,
+	tx storage.Transaction,
+	approvals []*storage.Approval,
+	addresses map[string]uint64,
+) error {
+	if len(approvals) == 0 {
+		return nil
+	}
+
+	for i := range approvals {
+		contractID, ok := addresses[approvals[i].Contract.Address.String()]
+		if !ok {
+			return errors.Errorf("can't find contract key: %s", approvals[i].Contract.Address.String())
+		}
+		approvals[i].ContractId = contractID
+
+		ownerID, ok := addresses[approvals[i].Owner.String()]
+		if !ok {
+			return errors.Errorf("can't find owner key: %s", approvals[i].Owner.String())
+		}
+		approvals[i].OwnerId = ownerID
+
+		spenderID, ok := addresses[approvals[i].Spender.String()]
+		if !ok {
+			return errors.Errorf("can't find spender key: %s", approvals[i].Spender.String())
+		}
+		approvals[i].SpenderId = spenderID
+	}
+
+	if err := tx.SaveApprovals(ctx, approvals...); err != nil {
+		return err
+	}
+
+	return tx.SaveAllowances(ctx, getAllowances(approvals)...)
+}
+
+// getAllowances - collapses approval events of the block into the final state of allowances. Events must be in the block order.
+func getAllowances(approvals []*storage.Approval) []*storage.Allowance {
+	var (
+		allowances = make([]*storage.Allowance, 0, len(approvals))
+		index      = make(map[string]int, len(approvals))
+	)
+
+	for _, approval := range approvals {
+		allowance := &storage.Allowance{
+			Height:     approval.Height,
+			LastHeight: approval.Height,
+			Type:       approval.Type,
+			ContractId: approval.ContractId,
+			OwnerId:    approval.OwnerId,
+			SpenderId:  approval.SpenderId,
+			TokenID:    approval.TokenID,
+			Amount:     approval.Amount,
+			Approved:   approval.Approved,
+		}
+
+		key := allowanceKey(allowance)
+		if idx, ok := index[key]; ok {
+			allowances[idx] = allowance
+		} else {
+			index[key] = len(allowances)
+			allowances = append(allowances, allowance)
+		}
+	}
+
+	return allowances
+}
+
+func allowanceKey(allowance *storage.Allowance) string {
+	// NFT has only one approved address, so the latest approval of the token wins regardless of spender
+	if allowance.Type == types.TokenApproval {
+		return fmt.Sprintf("%s:%d:%d:%s", allowance.Type, allowance.ContractId, allowance.OwnerId, allowance.TokenID.String())
+	}
+	return fmt.Sprintf("%s:%d:%d:%d:%s", allowance.Type, allowance.ContractId, allowance.OwnerId, allowance.SpenderId, allowance.TokenID.String())
+}
@@ -5,6 +5,8 @@ import (
 	"fmt"
 
 	"github.com/NobleScope/noble-indexer/internal/storage"
+	"github.com/NobleScope/noble-indexer/internal/storage/types"
+	pkgTypes "github.com/NobleScope/noble-indexer/pkg/types"
 )
 
 func saveBlock(
@@ -20,3 +22,15 @@ func saveBlock(
 
 	return fmt.Errorf("miner address %s not found in block", block.Miner.Hash.String())
 }
+
+// blockFinality returns the finality of the block by the last safe and finalized heights known to the state.
+func blockFinality(state *storage.State, height pkgTypes.Level) types.Finality {
+	switch {
+	case height <= state.FinalizedHeight:
+		return types.Finalized
+	case height <= state.SafeHeight:
+		return types.Safe
+	default:
+		return types.Latest
+	}
+}
@@ -0,0 +1,77 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/NobleScope/noble-indexer/internal/storage"
+	"github.com/NobleScope/noble-indexer/internal/storage/types"
+	"github.com/pkg/errors"
+)
+
+func saveDiamondCuts(
+	ctx context.Context,
+	tx storage.Transaction,
+	cuts []*storage.DiamondCut,
+	addresses map[string]uint64,
+) error {
+	if len(cuts) == 0 {
+		return nil
+	}
+
+	// the last cut of the selector in the block defines its facet
+	type facetKey struct {
+		diamondId uint64
+		selector  string
+	}
+	last := make(map[facetKey]*storage.DiamondCut, len(cuts))
+	keys := make([]facetKey, 0, len(cuts))
+
+	for i := range cuts {
+		diamondID, ok := addresses[cuts[i].Diamond.String()]
+		if !ok {
+			return errors.Errorf("can't find diamond key: %s", cuts[i].Diamond.String())
+		}
+		cuts[i].DiamondId = diamondID
+
+		var err error
+		if cuts[i].FacetId, err = optionalAddressId(addresses, cuts[i].Facet); err != nil {
+			return err
+		}
+
+		key := facetKey{diamondID, cuts[i].Selector.String()}
+		if _, ok := last[key]; !ok {
+			keys = append(keys, key)
+		}
+		last[key] = cuts[i]
+	}
+
+	if err := tx.SaveDiamondCuts(ctx, cuts...); err != nil {
+		return errors.Wrap(err, "saving diamond cuts")
+	}
+
+	saved := make([]*storage.DiamondFacet, 0, len(keys))
+	removed := make([]*storage.DiamondFacet, 0)
+	for _, key := range keys {
+		cut := last[key]
+		facet := &storage.DiamondFacet{
+			DiamondId: cut.DiamondId,
+			Selector:  cut.Selector,
+			Height:    cut.Height,
+		}
+		if cut.Action == types.Remove || cut.FacetId == nil {
+			removed = append(removed, facet)
+			continue
+		}
+		facet.FacetId = *cut.FacetId
+		saved = append(saved, facet)
+	}
+
+	if err := tx.SaveDiamondFacets(ctx, saved...); err != nil {
+		return errors.Wrap(err, "saving diamond facets")
+	}
+	if err := tx.DeleteDiamondFacets(ctx, removed...); err != nil {
+		return errors.Wrap(err, "deleting diamond facets")
+	}
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/NobleScope/noble-indexer/internal/storage"
+	"github.com/NobleScope/noble-indexer/internal/storage/postgres"
+	storageTypes "github.com/NobleScope/noble-indexer/internal/storage/types"
+	"github.com/NobleScope/noble-indexer/pkg/types"
+	"github.com/goccy/go-json"
+)
+
+// finalityUpdateLimit - max count of blocks which finality is updated at once. The first update after enabling
+// of the finality tracking catches up the whole history, so it is spread over several ticks.
+const finalityUpdateLimit types.Level = 100_000
+
+// saveFinality marks blocks which became safe or finalized since the previous update and saves the heights to the state.
+// Heights never go back: the node pool may switch to the node which lags behind.
+func (module *Module) saveFinality(ctx context.Context, finality types.Finality) error {
+	tx, err := postgres.BeginTransaction(ctx, module.storage)
+	if err != nil {
+		return err
+	}
+	defer tx.Close(ctx)
+
+	state, err := module.pg.State.ByName(ctx, module.indexerName)
+	if err != nil {
+		return tx.HandleError(ctx, err)
+	}
+
+	finalized := min(max(state.FinalizedHeight, finality.Finalized), state.FinalizedHeight+finalityUpdateLimit)
+	safe := min(max(state.SafeHeight, finality.Safe, finalized), state.SafeHeight+finalityUpdateLimit)
+	if finalized == state.FinalizedHeight && safe == state.SafeHeight {
+		return nil
+	}
+
+	// finalized blocks are marked after safe ones since they may be in both ranges
+	if err := tx.UpdateBlockFinality(ctx, storageTypes.Safe, state.SafeHeight, safe); err != nil {
+		return tx.HandleError(ctx, err)
+	}
+	if err := tx.UpdateBlockFinality(ctx, storageTypes.Finalized, state.FinalizedHeight, finalized); err != nil {
+		return tx.HandleError(ctx, err)
+	}
+
+	state.SafeHeight = safe
+	state.FinalizedHeight = finalized
+	if err := tx.Update(ctx, &state); err != nil {
+		return tx.HandleError(ctx, err)
+	}
+	if err := tx.Flush(ctx); err != nil {
+		return tx.HandleError(ctx, err)
+	}
+
+	module.Log.Info().
+		Uint64("safe", uint64(safe)).
+		Uint64("finalized", uint64(finalized)).
+		Msg("finality saved")
+
+	rawState, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+	return module.notificator.Notify(ctx, storage.ChannelHead, string(rawState))
+}
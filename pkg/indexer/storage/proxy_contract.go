@@ -5,6 +5,7 @@ import (
 
 	"github.com/NobleScope/noble-indexer/internal/storage"
 	"github.com/NobleScope/noble-indexer/internal/storage/postgres"
+	"github.com/NobleScope/noble-indexer/internal/storage/types"
 	"github.com/pkg/errors"
 )
 
@@ -56,6 +57,14 @@ func saveProxyContracts(
 		}
 		proxyContracts[i].Id = contractID
 
+		if proxyContracts[i].Beacon != nil {
+			beaconID, ok := addresses[proxyContracts[i].Beacon.String()]
+			if !ok {
+				return errors.Errorf("can't find beacon key: %s", proxyContracts[i].Beacon.String())
+			}
+			proxyContracts[i].BeaconId = &beaconID
+		}
+
 		if proxyContracts[i].Implementation == nil {
 			continue
 		}
@@ -91,6 +100,15 @@ func (module *Module) updateProxyContracts(ctx context.Context, proxyContracts [
 				Balance:    storage.EmptyBalance(),
 			}
 		}
+		if beacon := proxyContracts[i].Beacon; beacon != nil {
+			if _, ok := addressesMap[beacon.String()]; !ok {
+				addressesMap[beacon.String()] = &storage.Address{
+					Hash:       beacon.Hash,
+					IsContract: true,
+					Balance:    storage.EmptyBalance(),
+				}
+			}
+		}
 		if proxyContracts[i].Implementation == nil {
 			continue
 		}
@@ -109,7 +127,7 @@ func (module *Module) updateProxyContracts(ctx context.Context, proxyContracts [
 		addresses = append(addresses, address)
 	}
 
-	addrToId, _, err := saveAddresses(ctx, tx, addresses)
+	addrToId, _, err := saveAddresses(ctx, tx, nil, addresses)
 	if err != nil {
 		return errors.Wrap(err, "saving proxy contracts addresses")
 	}
@@ -123,3 +141,66 @@ func (module *Module) updateProxyContracts(ctx context.Context, proxyContracts [
 
 	return nil
 }
+
+func saveProxyUpgrades(
+	ctx context.Context,
+	tx storage.Transaction,
+	upgrades []*storage.ProxyUpgrade,
+	addresses map[string]uint64,
+) error {
+	if len(upgrades) == 0 {
+		return nil
+	}
+
+	beaconIds := make([]uint64, 0)
+	for i := range upgrades {
+		proxyID, ok := addresses[upgrades[i].Proxy.String()]
+		if !ok {
+			return errors.Errorf("can't find proxy key: %s", upgrades[i].Proxy.String())
+		}
+		upgrades[i].ProxyId = proxyID
+
+		var err error
+		if upgrades[i].ImplementationId, err = optionalAddressId(addresses, upgrades[i].Implementation); err != nil {
+			return err
+		}
+		if upgrades[i].BeaconId, err = optionalAddressId(addresses, upgrades[i].Beacon); err != nil {
+			return err
+		}
+		if upgrades[i].PreviousAdminId, err = optionalAddressId(addresses, upgrades[i].PreviousAdmin); err != nil {
+			return err
+		}
+		if upgrades[i].AdminId, err = optionalAddressId(addresses, upgrades[i].Admin); err != nil {
+			return err
+		}
+
+		switch upgrades[i].Type {
+		case types.Upgraded:
+			// the upgraded contract may be a beacon of other proxies
+			beaconIds = append(beaconIds, proxyID)
+		case types.BeaconUpgraded:
+			beaconIds = append(beaconIds, *upgrades[i].BeaconId)
+		}
+	}
+
+	if err := tx.SaveProxyUpgrades(ctx, upgrades...); err != nil {
+		return errors.Wrap(err, "saving proxy upgrades")
+	}
+
+	if err := tx.UpdateBeaconProxies(ctx, beaconIds...); err != nil {
+		return errors.Wrap(err, "updating beacon proxies")
+	}
+
+	return nil
+}
+
+func optionalAddressId(addresses map[string]uint64, address *storage.Address) (*uint64, error) {
+	if address == nil {
+		return nil, nil
+	}
+	id, ok := addresses[address.String()]
+	if !ok {
+		return nil, errors.Errorf("can't find address key: %s", address.String())
+	}
+	return &id, nil
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/NobleScope/noble-indexer/internal/storage/postgres"
 	"github.com/NobleScope/noble-indexer/pkg/indexer/config"
 	decodeContext "github.com/NobleScope/noble-indexer/pkg/indexer/decode/context"
+	"github.com/NobleScope/noble-indexer/pkg/types"
 	"github.com/dipdup-net/indexer-sdk/pkg/modules"
 	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
 	"github.com/goccy/go-json"
@@ -17,15 +18,24 @@ import (
 const (
 	InputName           = "data"
 	ProxyContractsInput = "proxy_contracts"
+	FinalityInput       = "finality"
 	StopOutput          = "stop"
 )
 
+const (
+	// liveBlockAge - blocks newer than the age are indexed near the head and aren't waited for to fill the batch
+	liveBlockAge = time.Minute
+	// batchTimeout - max time of waiting for blocks of the batch during the backfill
+	batchTimeout = time.Second
+)
+
 type Module struct {
 	modules.BaseModule
 	pg          postgres.Storage
 	storage     sdk.Transactable
 	notificator storage.Notificator
 	indexerName string
+	batchSize   int
 }
 
 var _ modules.Module = (*Module)(nil)
@@ -40,11 +50,13 @@ func NewModule(
 		pg:          pg,
 		storage:     pg.Transactable,
 		notificator: notificator,
-		indexerName: cfg.Name,
+		indexerName: cfg.StateName(),
+		batchSize:   max(cfg.Backfill.BatchSize, 1),
 	}
 
 	m.CreateInputWithCapacity(InputName, 128)
 	m.CreateInputWithCapacity(ProxyContractsInput, 128)
+	m.CreateInputWithCapacity(FinalityInput, 16)
 	m.CreateOutput(StopOutput)
 
 	return m
@@ -58,11 +70,30 @@ func (module *Module) Start(ctx context.Context) {
 func (module *Module) listen(ctx context.Context) {
 	module.Log.Info().Msg("module started")
 	input := module.MustInput(InputName)
+	finalityInput := module.MustInput(FinalityInput)
 
 	for {
 		select {
 		case <-ctx.Done():
 			return
+		case msg, ok := <-finalityInput.Listen():
+			if !ok {
+				module.Log.Warn().Msg("can't read message from finality input")
+				continue
+			}
+
+			finality, ok := msg.(types.Finality)
+			if !ok {
+				module.Log.Warn().Msgf("invalid message type: %T", msg)
+				continue
+			}
+
+			if err := module.saveFinality(ctx, finality); err != nil {
+				module.Log.Err(err).
+					Uint64("safe", uint64(finality.Safe)).
+					Uint64("finalized", uint64(finality.Finalized)).
+					Msg("finality saving error")
+			}
 		case msg, ok := <-input.Listen():
 			if !ok {
 				module.Log.Warn().Msg("can't read message from input")
@@ -76,23 +107,77 @@ func (module *Module) listen(ctx context.Context) {
 				continue
 			}
 
-			state, err := module.saveBlock(ctx, decodedContext)
+			blocks := module.batch(ctx, input.Listen(), decodedContext)
+			states, err := module.saveBlocks(ctx, blocks)
 			if err != nil {
 				module.Log.Err(err).
-					Uint64("height", uint64(decodedContext.Block.Height)).
-					Any("state", state).
+					Uint64("height", uint64(blocks[0].Block.Height)).
+					Int("blocks", len(blocks)).
 					Msg("block saving error")
 				module.MustOutput(StopOutput).Push(struct{}{})
 				continue
 			}
 
-			if err := module.notify(ctx, state, *decodedContext.Block); err != nil {
-				module.Log.Err(err).Msg("block notification error")
+			for i := range blocks {
+				if err := module.notify(ctx, states[i], *blocks[i].Block); err != nil {
+					module.Log.Err(err).Msg("block notification error")
+				}
 			}
 		}
 	}
 }
 
+// batch appends next blocks of the input to the first one up to the batch size. During the backfill the batch waits
+// for blocks up to the batch timeout. Near the head blocks arrive one by one, so only blocks already waiting
+// in the input are appended and blocks are mostly saved one by one.
+func (module *Module) batch(ctx context.Context, input <-chan any, first *decodeContext.Context) []*decodeContext.Context {
+	blocks := []*decodeContext.Context{first}
+	if module.batchSize == 1 {
+		return blocks
+	}
+
+	// nil channel never fires, so the live batch doesn't wait
+	var timeout <-chan time.Time
+	if time.Since(first.Block.Time) > liveBlockAge {
+		timer := time.NewTimer(batchTimeout)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+
+	for len(blocks) < module.batchSize {
+		var (
+			msg any
+			ok  bool
+		)
+		if timeout == nil {
+			select {
+			case msg, ok = <-input:
+			default:
+				return blocks
+			}
+		} else {
+			select {
+			case msg, ok = <-input:
+			case <-timeout:
+				return blocks
+			case <-ctx.Done():
+				return blocks
+			}
+		}
+		if !ok {
+			return blocks
+		}
+
+		decodedContext, ok := msg.(*decodeContext.Context)
+		if !ok {
+			module.Log.Warn().Msgf("invalid message type: %T", msg)
+			continue
+		}
+		blocks = append(blocks, decodedContext)
+	}
+	return blocks
+}
+
 // Close -
 func (module *Module) Close() error {
 	module.Log.Info().Msg("closing module...")
@@ -100,70 +185,142 @@ func (module *Module) Close() error {
 	return nil
 }
 
-func (module *Module) saveBlock(ctx context.Context, dCtx *decodeContext.Context) (storage.State, error) {
+// saveBlocks saves blocks in a single transaction and returns the state after every block.
+func (module *Module) saveBlocks(ctx context.Context, blocks []*decodeContext.Context) ([]storage.State, error) {
 	start := time.Now()
-	module.Log.Info().Uint64("height", uint64(dCtx.Block.Height)).Msg("saving block...")
+	first, last := blocks[0].Block, blocks[len(blocks)-1].Block
+	module.Log.Info().
+		Uint64("height", uint64(first.Height)).
+		Int("blocks", len(blocks)).
+		Msg("saving blocks...")
+
 	tx, err := postgres.BeginTransaction(ctx, module.storage)
 	if err != nil {
-		return storage.State{}, err
+		return nil, err
 	}
 	defer tx.Close(ctx)
 
-	state, err := module.processBlockInTransaction(ctx, tx, dCtx)
+	state, err := module.pg.State.ByName(ctx, module.indexerName)
 	if err != nil {
-		return state, tx.HandleError(ctx, err)
+		return nil, tx.HandleError(ctx, err)
+	}
+
+	batch := newCopyBatch()
+	defer batch.release()
+
+	states := make([]storage.State, len(blocks))
+	var txCount int
+	for i := range blocks {
+		if err := module.processBlockInTransaction(ctx, tx, blocks[i], &state, batch); err != nil {
+			return nil, tx.HandleError(ctx, err)
+		}
+		states[i] = state
+		txCount += len(blocks[i].Block.Txs)
+	}
+
+	if err := batch.save(ctx, tx); err != nil {
+		return nil, tx.HandleError(ctx, err)
+	}
+
+	if err := tx.Update(ctx, &state); err != nil {
+		return nil, tx.HandleError(ctx, err)
 	}
 
 	if err := tx.Flush(ctx); err != nil {
-		return state, tx.HandleError(ctx, err)
+		return nil, tx.HandleError(ctx, err)
 	}
 	module.Log.Info().
-		Uint64("height", uint64(dCtx.Block.Height)).
-		Time("block_time", dCtx.Block.Time).
+		Uint64("height", uint64(last.Height)).
+		Time("block_time", last.Time).
 		Int64("ms", time.Since(start).Milliseconds()).
-		Int("tx_count", len(dCtx.Block.Txs)).
-		Msg("block saved")
-	return state, nil
+		Int("blocks", len(blocks)).
+		Int("tx_count", txCount).
+		Msg("blocks saved")
+
+	if state.IsRangeIndexed() {
+		module.Log.Info().
+			Uint64("start", uint64(state.StartHeight)).
+			Uint64("end", uint64(state.EndHeight)).
+			Msg("range is indexed, it will be merged to the canonical state by the indexer without end level")
+	}
+	return states, nil
 }
 
 var transfersPool = pool.New(func() []*storage.Transfer {
 	return make([]*storage.Transfer, 0, 100)
 })
 
+var logsPool = pool.New(func() []*storage.Log {
+	return make([]*storage.Log, 0, 1024)
+})
+
+// copyBatch - logs and traces of all blocks of the transaction. Their identities aren't needed after saving,
+// so they are saved by a single COPY per table after the blocks.
+type copyBatch struct {
+	logs   []*storage.Log
+	traces []*storage.Trace
+}
+
+func newCopyBatch() *copyBatch {
+	return &copyBatch{
+		logs:   logsPool.Get(),
+		traces: make([]*storage.Trace, 0),
+	}
+}
+
+func (b *copyBatch) save(ctx context.Context, tx storage.Transaction) error {
+	if err := tx.SaveLogs(ctx, b.logs...); err != nil {
+		return err
+	}
+	return tx.SaveTraces(ctx, b.traces...)
+}
+
+func (b *copyBatch) release() {
+	for i := range b.logs {
+		b.logs[i] = nil
+	}
+	logsPool.Put(b.logs[:0])
+	b.logs = nil
+}
+
 func (module *Module) processBlockInTransaction(
 	ctx context.Context,
 	tx storage.Transaction,
 	dCtx *decodeContext.Context,
-) (storage.State, error) {
+	state *storage.State,
+	batch *copyBatch,
+) error {
 	block := dCtx.Block
-	state, err := module.pg.State.ByName(ctx, module.indexerName)
-	if err != nil {
-		return state, err
-	}
-	if state.LastHeight > 0 {
+	block.Finality = blockFinality(state, block.Height)
+
+	// the time of the block before the range start is unknown, it's set when ranges are merged
+	if state.LastHeight > 0 && !state.LastTime.IsZero() {
 		block.Stats.BlockTime = uint64(block.Time.Sub(state.LastTime).Milliseconds())
 	}
 
-	addrToId, totalAccounts, err := saveAddresses(ctx, tx, dCtx.GetAddresses())
+	addrToId, totalAccounts, err := saveAddresses(ctx, tx, block, dCtx.GetAddresses())
 	if err != nil {
-		return state, err
+		return err
 	}
 
 	err = saveBlock(ctx, tx, block, addrToId)
 	if err != nil {
-		return state, err
+		return err
 	}
 
 	if err := tx.Add(ctx, block.Stats); err != nil {
-		return state, err
+		return err
 	}
 
 	err = saveTransactions(ctx, tx, block.Txs, addrToId)
 	if err != nil {
-		return state, err
+		return err
 	}
 
 	txHashToId := make(map[string]uint64, len(block.Txs))
+	approvals := make([]*storage.Approval, 0)
+	proxyUpgrades := make([]*storage.ProxyUpgrade, 0)
+	diamondCuts := make([]*storage.DiamondCut, 0)
 	transfers := transfersPool.Get()
 	defer func() {
 		for i := range transfers {
@@ -180,59 +337,93 @@ func (module *Module) processBlockInTransaction(
 			block.Txs[i].Transfers[j].TxID = block.Txs[i].Id
 		}
 		transfers = append(transfers, block.Txs[i].Transfers...)
+
+		for j := range block.Txs[i].Approvals {
+			block.Txs[i].Approvals[j].TxID = block.Txs[i].Id
+		}
+		approvals = append(approvals, block.Txs[i].Approvals...)
+
+		for j := range block.Txs[i].ProxyUpgrades {
+			block.Txs[i].ProxyUpgrades[j].TxId = block.Txs[i].Id
+		}
+		proxyUpgrades = append(proxyUpgrades, block.Txs[i].ProxyUpgrades...)
+
+		for j := range block.Txs[i].DiamondCuts {
+			block.Txs[i].DiamondCuts[j].TxId = block.Txs[i].Id
+		}
+		diamondCuts = append(diamondCuts, block.Txs[i].DiamondCuts...)
 	}
 
 	totalContracts, err := saveContracts(ctx, tx, dCtx.GetContracts(), txHashToId, addrToId)
 	if err != nil {
-		return state, err
+		return err
 	}
 
-	err = saveTraces(ctx, tx, dCtx.GetTraces(), txHashToId, addrToId)
-	if err != nil {
-		return state, err
+	traces := dCtx.GetTraces()
+	if err := resolveTraces(traces, txHashToId, addrToId); err != nil {
+		return err
 	}
+	batch.traces = append(batch.traces, traces...)
 
-	err = saveLogs(ctx, tx, block.Txs, addrToId)
+	batch.logs, err = collectLogs(batch.logs, block.Txs, addrToId)
 	if err != nil {
-		return state, err
+		return err
+	}
+
+	if err := saveTxAccessLists(ctx, tx, block.Txs); err != nil {
+		return err
+	}
+
+	if err := saveTxAuthorizations(ctx, tx, block.Txs, addrToId); err != nil {
+		return err
+	}
+
+	if err := saveBlobs(ctx, tx, block.Txs); err != nil {
+		return err
 	}
 
 	err = saveTransfers(ctx, tx, transfers, addrToId)
 	if err != nil {
-		return state, err
+		return err
 	}
 
 	totalTokens, err := saveTokens(ctx, tx, dCtx.GetTokens(), addrToId)
 	if err != nil {
-		return state, err
+		return err
 	}
 
-	err = saveTokenBalances(ctx, tx, dCtx.GetTokenBalances(), addrToId)
+	err = saveTokenBalances(ctx, tx, block, dCtx.GetTokenBalances(), addrToId)
 	if err != nil {
-		return state, err
+		return err
+	}
+
+	if err := saveApprovals(ctx, tx, approvals, addrToId); err != nil {
+		return err
 	}
 
 	err = saveProxyContracts(ctx, tx, dCtx.GetProxyContracts(), addrToId)
 	if err != nil {
-		return state, err
+		return err
 	}
 
-	err = saveERC4337UserOps(ctx, tx, dCtx.GetUserOps(), txHashToId, addrToId)
-	if err != nil {
-		return state, err
+	if err := saveProxyUpgrades(ctx, tx, proxyUpgrades, addrToId); err != nil {
+		return err
 	}
 
-	if err := saveBeaconWithdrawals(ctx, tx, dCtx.Block.Withdrawals, addrToId); err != nil {
-		return state, err
+	if err := saveDiamondCuts(ctx, tx, diamondCuts, addrToId); err != nil {
+		return err
 	}
 
-	if err := updateState(block, totalAccounts, int64(len(block.Txs)), totalContracts, 0, totalTokens, &state); err != nil {
-		return state, err
+	err = saveERC4337UserOps(ctx, tx, dCtx.GetUserOps(), txHashToId, addrToId)
+	if err != nil {
+		return err
 	}
 
-	err = tx.Update(ctx, &state)
+	if err := saveBeaconWithdrawals(ctx, tx, dCtx.Block.Withdrawals, addrToId); err != nil {
+		return err
+	}
 
-	return state, err
+	return updateState(block, totalAccounts, int64(len(block.Txs)), totalContracts, 0, totalTokens, state)
 }
 
 func (module *Module) notify(ctx context.Context, state storage.State, block storage.Block) error {
@@ -2,6 +2,7 @@ package storage
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/NobleScope/noble-indexer/internal/storage"
 	"github.com/pkg/errors"
@@ -10,6 +11,7 @@ import (
 func saveTokenBalances(
 	ctx context.Context,
 	tx storage.Transaction,
+	block *storage.Block,
 	tokenBalances []*storage.TokenBalance,
 	addresses map[string]uint64,
 ) error {
@@ -31,6 +33,49 @@ func saveTokenBalances(
 		tokenBalances[i].AddressID = addressID
 	}
 
-	_, err := tx.SaveTokenBalances(ctx, tokenBalances...)
-	return err
+	updated, err := tx.SaveTokenBalances(ctx, tokenBalances...)
+	if err != nil {
+		return err
+	}
+
+	return saveTokenBalanceHistory(ctx, tx, block, tokenBalances, updated)
+}
+
+func saveTokenBalanceHistory(
+	ctx context.Context,
+	tx storage.Transaction,
+	block *storage.Block,
+	deltas []*storage.TokenBalance,
+	updated []storage.TokenBalance,
+) error {
+	balances := make(map[string]storage.TokenBalance, len(updated))
+	for i := range updated {
+		balances[tokenBalanceKey(updated[i])] = updated[i]
+	}
+
+	history := make([]*storage.TokenBalanceHistory, 0, len(deltas))
+	for i := range deltas {
+		if deltas[i].Balance.IsZero() {
+			continue
+		}
+		balance, ok := balances[tokenBalanceKey(*deltas[i])]
+		if !ok {
+			return errors.Errorf("can't find updated token balance: %s", tokenBalanceKey(*deltas[i]))
+		}
+		history = append(history, &storage.TokenBalanceHistory{
+			Height:     block.Height,
+			Time:       block.Time,
+			TokenID:    deltas[i].TokenID,
+			ContractID: deltas[i].ContractID,
+			AddressID:  deltas[i].AddressID,
+			Balance:    balance.Balance,
+			Delta:      deltas[i].Balance,
+		})
+	}
+
+	return tx.SaveTokenBalanceHistory(ctx, history...)
+}
+
+func tokenBalanceKey(tb storage.TokenBalance) string {
+	return fmt.Sprintf("%d:%s:%d", tb.ContractID, tb.TokenID.String(), tb.AddressID)
 }
@@ -1,22 +1,16 @@
 package storage
 
 import (
-	"context"
-
 	"github.com/NobleScope/noble-indexer/internal/storage"
 	"github.com/pkg/errors"
 )
 
-func saveTraces(
-	ctx context.Context,
-	tx storage.Transaction,
+// resolveTraces sets identities of transactions and addresses to the traces
+func resolveTraces(
 	traces []*storage.Trace,
 	txHashes map[string]uint64,
 	addresses map[string]uint64,
 ) error {
-	if len(traces) == 0 {
-		return nil
-	}
 	for i := range traces {
 		if traces[i].Tx != nil {
 			id, ok := txHashes[traces[i].Tx.Hash.String()]
@@ -51,7 +45,5 @@ func saveTraces(
 			traces[i].ContractId = &id
 		}
 	}
-
-	err := tx.SaveTraces(ctx, traces...)
-	return err
+	return nil
 }
@@ -0,0 +1,113 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/NobleScope/noble-indexer/internal/storage"
+	"github.com/pkg/errors"
+)
+
+func saveTxAccessLists(
+	ctx context.Context,
+	tx storage.Transaction,
+	transactions []*storage.Tx,
+) error {
+	accessLists := make([]*storage.TxAccessList, 0)
+	for i := range transactions {
+		for j := range transactions[i].AccessList {
+			transactions[i].AccessList[j].TxId = transactions[i].Id
+		}
+		accessLists = append(accessLists, transactions[i].AccessList...)
+	}
+
+	return tx.SaveTxAccessLists(ctx, accessLists...)
+}
+
+func saveTxAuthorizations(
+	ctx context.Context,
+	tx storage.Transaction,
+	transactions []*storage.Tx,
+	addresses map[string]uint64,
+) error {
+	authorizations := make([]*storage.TxAuthorization, 0)
+	for i := range transactions {
+		for j := range transactions[i].Authorizations {
+			authorization := transactions[i].Authorizations[j]
+			authorization.TxId = transactions[i].Id
+
+			if authorization.Authority != nil {
+				authorityId, ok := addresses[authorization.Authority.String()]
+				if !ok {
+					return errors.Errorf("can't find authority key: %s", authorization.Authority.String())
+				}
+				authorization.AuthorityId = &authorityId
+			}
+			if authorization.Delegate != nil {
+				delegateId, ok := addresses[authorization.Delegate.String()]
+				if !ok {
+					return errors.Errorf("can't find delegate key: %s", authorization.Delegate.String())
+				}
+				authorization.DelegateId = &delegateId
+			}
+		}
+		authorizations = append(authorizations, transactions[i].Authorizations...)
+	}
+
+	if err := tx.SaveTxAuthorizations(ctx, authorizations...); err != nil {
+		return err
+	}
+
+	return tx.SaveDelegations(ctx, getDelegations(authorizations)...)
+}
+
+// getDelegations - collapses valid authorizations of the block into the final delegation state of authorities. Authorizations must be in the block order.
+func getDelegations(authorizations []*storage.TxAuthorization) []*storage.Delegation {
+	var (
+		delegations = make([]*storage.Delegation, 0, len(authorizations))
+		index       = make(map[uint64]int)
+	)
+
+	for _, authorization := range authorizations {
+		if !authorization.Valid || authorization.AuthorityId == nil {
+			continue
+		}
+
+		delegation := &storage.Delegation{
+			Id:         *authorization.AuthorityId,
+			Height:     authorization.Height,
+			LastHeight: authorization.Height,
+			DelegateId: authorization.DelegateId,
+			TxId:       authorization.TxId,
+		}
+
+		if idx, ok := index[delegation.Id]; ok {
+			delegations[idx] = delegation
+			continue
+		}
+		index[delegation.Id] = len(delegations)
+		delegations = append(delegations, delegation)
+	}
+	return delegations
+}
+
+func saveBlobs(
+	ctx context.Context,
+	tx storage.Transaction,
+	transactions []*storage.Tx,
+) error {
+	blobs := make([]*storage.Blob, 0)
+	for i := range transactions {
+		for j := range transactions[i].BlobVersionedHashes {
+			blobs = append(blobs, &storage.Blob{
+				Height:        transactions[i].Height,
+				Time:          transactions[i].Time,
+				TxId:          transactions[i].Id,
+				SenderId:      transactions[i].FromAddressId,
+				Index:         int64(j),
+				VersionedHash: transactions[i].BlobVersionedHashes[j],
+			})
+		}
+	}
+
+	return tx.SaveBlobs(ctx, blobs...)
+}
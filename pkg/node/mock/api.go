@@ -124,6 +124,84 @@ func (c *MockApiBlockBulkCall) DoAndReturn(f func(context.Context, ...types.Leve
 	return c
 }
 
+// Call mocks base method.
+func (m *MockApi) Call(ctx context.Context, requestData []types.CallRequest) ([]types.Hex, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Call", ctx, requestData)
+	ret0, _ := ret[0].([]types.Hex)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Call indicates an expected call of Call.
+func (mr *MockApiMockRecorder) Call(ctx, requestData any) *MockApiCallCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Call", reflect.TypeOf((*MockApi)(nil).Call), ctx, requestData)
+	return &MockApiCallCall{Call: call}
+}
+
+// MockApiCallCall wrap *gomock.Call
+type MockApiCallCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApiCallCall) Return(arg0 []types.Hex, arg1 error) *MockApiCallCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApiCallCall) Do(f func(context.Context, []types.CallRequest) ([]types.Hex, error)) *MockApiCallCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApiCallCall) DoAndReturn(f func(context.Context, []types.CallRequest) ([]types.Hex, error)) *MockApiCallCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Finality mocks base method.
+func (m *MockApi) Finality(ctx context.Context) (types.Finality, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Finality", ctx)
+	ret0, _ := ret[0].(types.Finality)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Finality indicates an expected call of Finality.
+func (mr *MockApiMockRecorder) Finality(ctx any) *MockApiFinalityCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Finality", reflect.TypeOf((*MockApi)(nil).Finality), ctx)
+	return &MockApiFinalityCall{Call: call}
+}
+
+// MockApiFinalityCall wrap *gomock.Call
+type MockApiFinalityCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApiFinalityCall) Return(arg0 types.Finality, arg1 error) *MockApiFinalityCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApiFinalityCall) Do(f func(context.Context) (types.Finality, error)) *MockApiFinalityCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApiFinalityCall) DoAndReturn(f func(context.Context) (types.Finality, error)) *MockApiFinalityCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Head mocks base method.
 func (m *MockApi) Head(ctx context.Context) (types.Level, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,100 @@
+package pool
+
+import (
+	"sync"
+	"time"
+
+	"github.com/NobleScope/noble-indexer/pkg/node"
+	pkgTypes "github.com/NobleScope/noble-indexer/pkg/types"
+)
+
+// smoothing factor of moving averages: the last 10 requests contribute most of the value
+const ewmaAlpha = 0.2
+
+// Endpoint is the named node API of the pool.
+type Endpoint struct {
+	Name string
+	Api  node.Api
+}
+
+type endpoint struct {
+	Endpoint
+
+	mx        *sync.RWMutex
+	head      pkgTypes.Level
+	down      bool
+	errorRate float64
+	latency   time.Duration
+}
+
+func newEndpoint(e Endpoint) *endpoint {
+	return &endpoint{
+		Endpoint: e,
+		mx:       new(sync.RWMutex),
+	}
+}
+
+// observe updates moving averages of the error rate and the latency with the request result
+func (e *endpoint) observe(method string, duration time.Duration, err error) {
+	status := "success"
+	failure := 0.0
+	if err != nil {
+		status = "error"
+		failure = 1
+	}
+	nodeRequests.WithLabelValues(e.Name, method, status).Inc()
+	nodeRequestDuration.WithLabelValues(e.Name, method).Observe(duration.Seconds())
+
+	e.mx.Lock()
+	defer e.mx.Unlock()
+
+	e.errorRate += ewmaAlpha * (failure - e.errorRate)
+	if err == nil {
+		if e.latency == 0 {
+			e.latency = duration
+		} else {
+			e.latency += time.Duration(ewmaAlpha * float64(duration-e.latency))
+		}
+	}
+	nodeErrorRate.WithLabelValues(e.Name).Set(e.errorRate)
+	nodeLatency.WithLabelValues(e.Name).Set(e.latency.Seconds())
+}
+
+// setHead saves the result of the health check
+func (e *endpoint) setHead(head pkgTypes.Level, err error) {
+	e.mx.Lock()
+	defer e.mx.Unlock()
+
+	e.down = err != nil
+	if err == nil {
+		e.head = head
+		nodeHead.WithLabelValues(e.Name).Set(float64(head))
+	}
+}
+
+type endpointState struct {
+	*endpoint
+
+	head      pkgTypes.Level
+	down      bool
+	errorRate float64
+	latency   time.Duration
+}
+
+func (e *endpoint) state() endpointState {
+	e.mx.RLock()
+	defer e.mx.RUnlock()
+
+	return endpointState{
+		endpoint:  e,
+		head:      e.head,
+		down:      e.down,
+		errorRate: e.errorRate,
+		latency:   e.latency,
+	}
+}
+
+// cost is the expected time of the successful request: the latency divided by the probability of success
+func (s endpointState) cost() float64 {
+	return float64(s.latency) / (1 - min(s.errorRate, 0.99))
+}
@@ -0,0 +1,49 @@
+package pool
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	nodeRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "node_rpc_requests_total",
+		Help: "Total number of requests to node RPC endpoints",
+	}, []string{"endpoint", "method", "status"}) // status: success, error
+
+	nodeRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "node_rpc_request_duration_seconds",
+		Help:    "Duration of requests to node RPC endpoints",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"endpoint", "method"})
+
+	nodeFailovers = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "node_rpc_failovers_total",
+		Help: "Total number of requests retried on another endpoint",
+	}, []string{"endpoint", "method"})
+
+	nodeHead = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "node_rpc_head",
+		Help: "Last head level reported by the endpoint",
+	}, []string{"endpoint"})
+
+	nodeHeadLag = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "node_rpc_head_lag",
+		Help: "Number of blocks the endpoint lags behind the highest head of the pool",
+	}, []string{"endpoint"})
+
+	nodeErrorRate = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "node_rpc_error_rate",
+		Help: "Moving average of the endpoint error rate",
+	}, []string{"endpoint"})
+
+	nodeLatency = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "node_rpc_latency_seconds",
+		Help: "Moving average of the endpoint latency",
+	}, []string{"endpoint"})
+
+	nodeHealthy = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "node_rpc_healthy",
+		Help: "Whether the endpoint is healthy (1) or not (0)",
+	}, []string{"endpoint"})
+)
@@ -0,0 +1,35 @@
+package pool
+
+import "time"
+
+type Option func(pool *Pool)
+
+// WithMaxHeadLag sets the number of blocks an endpoint may lag behind the highest head and stay healthy.
+func WithMaxHeadLag(lag uint64) Option {
+	return func(pool *Pool) {
+		pool.maxHeadLag = lag
+	}
+}
+
+// WithMaxErrorRate sets the error rate from 0 to 1 above which an endpoint is unhealthy.
+func WithMaxErrorRate(rate float64) Option {
+	return func(pool *Pool) {
+		pool.maxErrorRate = rate
+	}
+}
+
+func WithHealthCheckPeriod(period time.Duration) Option {
+	return func(pool *Pool) {
+		pool.checkPeriod = period
+	}
+}
+
+// WithRetry sets the number of retries of requests failed on all endpoints and the delay before the first retry.
+// The delay is doubled on every next retry. Endpoints shouldn't retry requests themselves, so the pool fails over
+// to the next endpoint at once.
+func WithRetry(count int, delay time.Duration) Option {
+	return func(pool *Pool) {
+		pool.retryCount = count
+		pool.retryDelay = delay
+	}
+}
@@ -0,0 +1,302 @@
+package pool
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/NobleScope/noble-indexer/pkg/node"
+	pkgTypes "github.com/NobleScope/noble-indexer/pkg/types"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+const maxRetryDelay = time.Second * 30
+
+// Pool is the node API over several RPC endpoints. Requests are routed to the healthiest endpoint
+// and retried on the next one if the endpoint fails. Requests failed on all endpoints are retried with backoff.
+// An endpoint is healthy if its last health check succeeded, it doesn't lag behind the highest head of the pool
+// and its error rate is below the limit.
+type Pool struct {
+	endpoints    []*endpoint
+	maxHeadLag   uint64
+	maxErrorRate float64
+	checkPeriod  time.Duration
+	retryCount   int
+	retryDelay   time.Duration
+	log          zerolog.Logger
+	wg           *sync.WaitGroup
+}
+
+var _ node.Api = (*Pool)(nil)
+
+func NewPool(endpoints []Endpoint, opts ...Option) (*Pool, error) {
+	if len(endpoints) == 0 {
+		return nil, errors.New("node pool requires at least one endpoint")
+	}
+
+	pool := &Pool{
+		endpoints:    make([]*endpoint, len(endpoints)),
+		maxHeadLag:   5,
+		maxErrorRate: 0.5,
+		checkPeriod:  time.Second * 10,
+		log:          log.With().Str("module", "node pool").Logger(),
+		wg:           new(sync.WaitGroup),
+	}
+	for i := range endpoints {
+		pool.endpoints[i] = newEndpoint(endpoints[i])
+	}
+
+	for i := range opts {
+		opts[i](pool)
+	}
+
+	return pool, nil
+}
+
+// Start runs periodic health checks of endpoints.
+func (p *Pool) Start(ctx context.Context) {
+	p.wg.Add(1)
+	go p.healthCheck(ctx)
+}
+
+func (p *Pool) Close() error {
+	p.wg.Wait()
+	return nil
+}
+
+func (p *Pool) healthCheck(ctx context.Context) {
+	defer p.wg.Done()
+
+	ticker := time.NewTicker(p.checkPeriod)
+	defer ticker.Stop()
+
+	for {
+		if _, err := p.checkHeads(ctx); err != nil && ctx.Err() == nil {
+			p.log.Err(err).Msg("health check")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// checkHeads requests heads of all endpoints concurrently and returns the highest one.
+func (p *Pool) checkHeads(ctx context.Context) (pkgTypes.Level, error) {
+	var (
+		wg   sync.WaitGroup
+		errs = make([]error, len(p.endpoints))
+	)
+	for i, e := range p.endpoints {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			requestCtx, cancel := context.WithTimeout(ctx, p.checkPeriod)
+			defer cancel()
+
+			start := time.Now()
+			head, err := e.Api.Head(requestCtx)
+			if ctx.Err() != nil {
+				errs[i] = ctx.Err()
+				return
+			}
+			e.observe("head", time.Since(start), err)
+			e.setHead(head, err)
+			if err != nil {
+				p.log.Warn().Err(err).Str("endpoint", e.Name).Msg("receiving head")
+			}
+			errs[i] = err
+		}()
+	}
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	states := p.states()
+	best := highestHead(states)
+	for i := range states {
+		if !states[i].down {
+			nodeHeadLag.WithLabelValues(states[i].Name).Set(float64(best - states[i].head))
+		}
+		healthy := 0.0
+		if p.healthy(states[i], best) {
+			healthy = 1
+		}
+		nodeHealthy.WithLabelValues(states[i].Name).Set(healthy)
+	}
+
+	for i := range errs {
+		if errs[i] == nil {
+			return best, nil
+		}
+	}
+	return 0, errors.Wrapf(errs[0], "head is unavailable on all %d endpoints", len(errs))
+}
+
+func (p *Pool) states() []endpointState {
+	states := make([]endpointState, len(p.endpoints))
+	for i := range p.endpoints {
+		states[i] = p.endpoints[i].state()
+	}
+	return states
+}
+
+func highestHead(states []endpointState) pkgTypes.Level {
+	var head pkgTypes.Level
+	for i := range states {
+		if !states[i].down {
+			head = max(head, states[i].head)
+		}
+	}
+	return head
+}
+
+func (p *Pool) healthy(s endpointState, best pkgTypes.Level) bool {
+	return !s.down && s.errorRate <= p.maxErrorRate && uint64(best-min(best, s.head)) <= p.maxHeadLag
+}
+
+// route returns endpoints in the order of requesting: healthy endpoints having the requested level,
+// other healthy endpoints and unhealthy ones. Endpoints of the same group are ordered by the expected request time.
+func (p *Pool) route(level pkgTypes.Level) []endpointState {
+	states := p.states()
+	best := highestHead(states)
+
+	rank := func(s endpointState) int {
+		switch {
+		case !p.healthy(s, best):
+			return 2
+		case s.head < level:
+			return 1
+		default:
+			return 0
+		}
+	}
+
+	slices.SortStableFunc(states, func(a, b endpointState) int {
+		if c := cmp.Compare(rank(a), rank(b)); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.cost(), b.cost())
+	})
+	return states
+}
+
+// request sends the request to endpoints one by one until one of them succeeds. If all endpoints fail,
+// the round is repeated after the backoff delay until the retry count is reached.
+func request[T any](ctx context.Context, p *Pool, method string, level pkgTypes.Level, fn func(ctx context.Context, api node.Api) (T, error)) (T, error) {
+	var (
+		result T
+		err    error
+	)
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 {
+			delay := p.backoff(attempt - 1)
+			p.log.Warn().
+				Err(err).
+				Str("method", method).
+				Int("attempt", attempt).
+				Dur("delay", delay).
+				Msg("retrying request failed on all endpoints")
+
+			if sleepErr := sleep(ctx, delay); sleepErr != nil {
+				return result, sleepErr
+			}
+		}
+
+		for i, e := range p.route(level) {
+			if i > 0 {
+				nodeFailovers.WithLabelValues(e.Name, method).Inc()
+			}
+
+			start := time.Now()
+			result, err = fn(ctx, e.Api)
+			if ctx.Err() != nil {
+				return result, err
+			}
+			e.observe(method, time.Since(start), err)
+			if err == nil {
+				return result, nil
+			}
+
+			p.log.Warn().Err(err).Str("endpoint", e.Name).Str("method", method).Msg("request failed")
+		}
+
+		if attempt >= p.retryCount {
+			return result, errors.Wrapf(err, "%s failed on all endpoints", method)
+		}
+	}
+}
+
+// backoff returns the delay before the retry. The delay is doubled on every attempt.
+func (p *Pool) backoff(attempt int) time.Duration {
+	delay := p.retryDelay << attempt
+	if delay <= 0 || delay > maxRetryDelay {
+		return maxRetryDelay
+	}
+	return delay
+}
+
+func sleep(ctx context.Context, delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// Head returns the highest head of endpoints. It also serves as the health check.
+func (p *Pool) Head(ctx context.Context) (pkgTypes.Level, error) {
+	return p.checkHeads(ctx)
+}
+
+func (p *Pool) Finality(ctx context.Context) (pkgTypes.Finality, error) {
+	return request(ctx, p, "finality", 0, func(ctx context.Context, api node.Api) (pkgTypes.Finality, error) {
+		return api.Finality(ctx)
+	})
+}
+
+func (p *Pool) Block(ctx context.Context, level pkgTypes.Level) (pkgTypes.Block, error) {
+	return request(ctx, p, "block", level, func(ctx context.Context, api node.Api) (pkgTypes.Block, error) {
+		return api.Block(ctx, level)
+	})
+}
+
+func (p *Pool) BlockBulk(ctx context.Context, levels ...pkgTypes.Level) ([]pkgTypes.BlockData, error) {
+	if len(levels) == 0 {
+		return nil, nil
+	}
+	return request(ctx, p, "block_bulk", slices.Max(levels), func(ctx context.Context, api node.Api) ([]pkgTypes.BlockData, error) {
+		return api.BlockBulk(ctx, levels...)
+	})
+}
+
+func (p *Pool) TokenMetadataBulk(ctx context.Context, requests []pkgTypes.TokenMetadataRequest) (map[uint64]pkgTypes.TokenMetadata, error) {
+	return request(ctx, p, "token_metadata_bulk", 0, func(ctx context.Context, api node.Api) (map[uint64]pkgTypes.TokenMetadata, error) {
+		return api.TokenMetadataBulk(ctx, requests)
+	})
+}
+
+func (p *Pool) Storage(ctx context.Context, requests []pkgTypes.StorageRequest) ([]pkgTypes.Hex, error) {
+	return request(ctx, p, "storage", 0, func(ctx context.Context, api node.Api) ([]pkgTypes.Hex, error) {
+		return api.Storage(ctx, requests)
+	})
+}
+
+func (p *Pool) Call(ctx context.Context, requests []pkgTypes.CallRequest) ([]pkgTypes.Hex, error) {
+	return request(ctx, p, "call", 0, func(ctx context.Context, api node.Api) ([]pkgTypes.Hex, error) {
+		return api.Call(ctx, requests)
+	})
+}
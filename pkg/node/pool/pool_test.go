@@ -0,0 +1,141 @@
+package pool
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/NobleScope/noble-indexer/pkg/node/mock"
+	pkgTypes "github.com/NobleScope/noble-indexer/pkg/types"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func newTestPool(t *testing.T, names ...string) (*Pool, map[string]*mock.MockApi) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+
+	apis := make(map[string]*mock.MockApi, len(names))
+	endpoints := make([]Endpoint, len(names))
+	for i, name := range names {
+		apis[name] = mock.NewMockApi(ctrl)
+		endpoints[i] = Endpoint{Name: name, Api: apis[name]}
+	}
+
+	pool, err := NewPool(endpoints, WithMaxHeadLag(5))
+	require.NoError(t, err)
+	return pool, apis
+}
+
+func TestNewPoolWithoutEndpoints(t *testing.T) {
+	_, err := NewPool(nil)
+	require.Error(t, err)
+}
+
+func TestPoolHead(t *testing.T) {
+	pool, apis := newTestPool(t, "main", "fallback")
+	ctx := context.Background()
+
+	apis["main"].EXPECT().Head(gomock.Any()).Return(pkgTypes.Level(100), nil)
+	apis["fallback"].EXPECT().Head(gomock.Any()).Return(pkgTypes.Level(102), nil)
+
+	head, err := pool.Head(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, 102, head)
+
+	apis["main"].EXPECT().Head(gomock.Any()).Return(pkgTypes.Level(0), errors.New("connection refused"))
+	apis["fallback"].EXPECT().Head(gomock.Any()).Return(pkgTypes.Level(103), nil)
+
+	head, err = pool.Head(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, 103, head)
+
+	apis["main"].EXPECT().Head(gomock.Any()).Return(pkgTypes.Level(0), errors.New("connection refused"))
+	apis["fallback"].EXPECT().Head(gomock.Any()).Return(pkgTypes.Level(0), errors.New("too many requests"))
+
+	_, err = pool.Head(ctx)
+	require.ErrorContains(t, err, "head is unavailable on all 2 endpoints")
+}
+
+func TestPoolFailover(t *testing.T) {
+	pool, apis := newTestPool(t, "main", "fallback")
+	ctx := context.Background()
+
+	levels := []pkgTypes.Level{10, 11}
+	blocks := []pkgTypes.BlockData{{}, {}}
+
+	gomock.InOrder(
+		apis["main"].EXPECT().BlockBulk(gomock.Any(), levels).Return(nil, errors.New("header not found")),
+		apis["fallback"].EXPECT().BlockBulk(gomock.Any(), levels).Return(blocks, nil),
+	)
+
+	result, err := pool.BlockBulk(ctx, levels...)
+	require.NoError(t, err)
+	require.Len(t, result, 2)
+
+	require.Greater(t, pool.endpoints[0].state().errorRate, 0.0)
+	require.Zero(t, pool.endpoints[1].state().errorRate)
+}
+
+func TestPoolAllEndpointsFailed(t *testing.T) {
+	pool, apis := newTestPool(t, "main", "fallback")
+
+	apis["main"].EXPECT().Storage(gomock.Any(), gomock.Any()).Return(nil, errors.New("timeout"))
+	apis["fallback"].EXPECT().Storage(gomock.Any(), gomock.Any()).Return(nil, errors.New("rate limit"))
+
+	_, err := pool.Storage(context.Background(), []pkgTypes.StorageRequest{})
+	require.ErrorContains(t, err, "storage failed on all endpoints")
+}
+
+func TestPoolRetry(t *testing.T) {
+	pool, apis := newTestPool(t, "main", "fallback")
+	pool.retryCount = 1
+	pool.retryDelay = time.Millisecond
+
+	gomock.InOrder(
+		apis["main"].EXPECT().Storage(gomock.Any(), gomock.Any()).Return(nil, errors.New("timeout")),
+		apis["fallback"].EXPECT().Storage(gomock.Any(), gomock.Any()).Return(nil, errors.New("rate limit")),
+	)
+	apis["main"].EXPECT().Storage(gomock.Any(), gomock.Any()).Return(nil, errors.New("timeout")).MaxTimes(1)
+	apis["fallback"].EXPECT().Storage(gomock.Any(), gomock.Any()).Return([]pkgTypes.Hex{{}}, nil).MaxTimes(1)
+
+	result, err := pool.Storage(context.Background(), []pkgTypes.StorageRequest{{}})
+	require.NoError(t, err)
+	require.Len(t, result, 1)
+}
+
+func TestPoolRoute(t *testing.T) {
+	pool, apis := newTestPool(t, "main", "second", "fallback")
+	ctx := context.Background()
+
+	apis["main"].EXPECT().Head(gomock.Any()).Return(pkgTypes.Level(90), nil)
+	apis["second"].EXPECT().Head(gomock.Any()).Return(pkgTypes.Level(98), nil)
+	apis["fallback"].EXPECT().Head(gomock.Any()).Return(pkgTypes.Level(100), nil)
+	_, err := pool.Head(ctx)
+	require.NoError(t, err)
+
+	// self-hosted nodes respond faster than the fallback
+	for i, latency := range []time.Duration{10, 20, 50} {
+		pool.endpoints[i].latency = latency * time.Millisecond
+	}
+
+	names := func(level pkgTypes.Level) []string {
+		states := pool.route(level)
+		result := make([]string, len(states))
+		for i := range states {
+			result[i] = states[i].Name
+		}
+		return result
+	}
+
+	// main lags behind for 10 blocks, second doesn't have the requested level yet
+	require.Equal(t, []string{"second", "fallback", "main"}, names(98))
+	require.Equal(t, []string{"fallback", "second", "main"}, names(99))
+
+	// failed requests increase the error rate of the endpoint above the limit
+	for range 5 {
+		pool.endpoints[1].observe("block", 0, errors.New("too many requests"))
+	}
+	require.Equal(t, []string{"fallback", "main", "second"}, names(98))
+}
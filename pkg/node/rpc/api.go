@@ -24,6 +24,8 @@ type API struct {
 	cfg           config.DataSource
 	rateLimit     *rate.Limiter
 	timeout       time.Duration
+	retryCount    int
+	retryDelay    time.Duration
 	traceProvider trace_provider.ITraceProvider
 	log           zerolog.Logger
 }
@@ -39,6 +41,8 @@ func NewApi(cfg config.DataSource, opts ...APIOption) API {
 		client:        fastshot.NewClient(nodeURL.Scheme + "://" + nodeURL.Host).Build(),
 		rateLimit:     rate.NewLimiter(rate.Every(time.Second/time.Duration(10)), 10),
 		timeout:       time.Second * 30,
+		retryCount:    5,
+		retryDelay:    time.Millisecond * 500,
 		traceProvider: &trace_provider.ParityTraceProvider{},
 		log:           log.With().Str("module", "node rpc").Logger(),
 	}
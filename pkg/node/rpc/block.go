@@ -2,7 +2,6 @@ package rpc
 
 import (
 	"context"
-	stdjson "encoding/json"
 	"net/url"
 	"sort"
 
@@ -67,24 +66,18 @@ func (api *API) Block(ctx context.Context, level pkgTypes.Level) (pkgTypes.Block
 	return block.Result, err
 }
 
+// BlockBulk requests blocks with receipts and traces in the single batch. Sub-requests failed with transient errors
+// are retried, see API.batch.
 func (api *API) BlockBulk(ctx context.Context, levels ...pkgTypes.Level) ([]pkgTypes.BlockData, error) {
 	if len(levels) == 0 {
 		return nil, nil
 	}
-	if api.rateLimit != nil {
-		if err := api.rateLimit.Wait(ctx); err != nil {
-			return nil, err
-		}
-	}
 
 	u, err := url.Parse(api.cfg.URL)
 	if err != nil {
 		return []pkgTypes.BlockData{}, err
 	}
 
-	requestCtx, cancel := context.WithTimeout(ctx, api.timeout)
-	defer cancel()
-
 	requests := make([]types.Request, len(levels)*3)
 
 	for i := range levels {
@@ -115,61 +108,38 @@ func (api *API) BlockBulk(ctx context.Context, levels ...pkgTypes.Level) ([]pkgT
 		}
 	}
 
-	resp, err := api.client.POST(u.Path).
-		Context().
-		Set(requestCtx).
-		Header().
-		AddAll(map[header.Type]string{
-			header.ContentType: "application/json",
-			header.UserAgent:   userAgent}).
-		Body().AsJSON(&requests).Send()
-
-	if err != nil {
-		return []pkgTypes.BlockData{}, err
-	}
-	defer resp.Raw().Body.Close()
-
-	if resp.Status().IsError() {
-		return []pkgTypes.BlockData{}, errors.Errorf("invalid status: %d", resp.Status().Code())
-	}
-
-	var rawResponses []types.Response[stdjson.RawMessage]
-	err = json.NewDecoder(resp.Raw().Body).Decode(&rawResponses)
+	results, err := api.batch(ctx, u.Path, requests)
 	if err != nil {
-		return []pkgTypes.BlockData{}, err
+		return nil, err
 	}
 
 	var blockData = make([]pkgTypes.BlockData, len(levels))
-	for i := range rawResponses {
-		if rawResponses[i].Error != nil {
-			return nil, errors.Wrapf(types.ErrRequest, "request error: %s", rawResponses[i].Error.Error())
-		}
-
-		blockIdx := rawResponses[i].Id / 3
-		switch rawResponses[i].Id % 3 {
+	for i := range results {
+		blockIdx := i / 3
+		switch i % 3 {
 		case 0:
 			var block pkgTypes.Block
-			if err := json.Unmarshal(rawResponses[i].Result, &block); err != nil {
+			if err := json.Unmarshal(results[i], &block); err != nil {
 				return nil, errors.Wrap(err, "failed to unmarshal block")
 			}
 			sortTransactionsByIndex(block.Transactions)
 			blockData[blockIdx].Block = block
 		case 1:
-			if len(rawResponses[i].Result) == 0 {
+			if len(results[i]) == 0 {
 				blockData[blockIdx].Receipts = []pkgTypes.Receipt{}
 				continue
 			}
 			var receipts []pkgTypes.Receipt
-			if err := json.Unmarshal(rawResponses[i].Result, &receipts); err != nil {
+			if err := json.Unmarshal(results[i], &receipts); err != nil {
 				return nil, errors.Wrap(err, "failed to unmarshal receipts")
 			}
 			blockData[blockIdx].Receipts = receipts
 		case 2:
-			if len(rawResponses[i].Result) == 0 {
+			if len(results[i]) == 0 {
 				blockData[blockIdx].Traces = []pkgTypes.Trace{}
 				continue
 			}
-			traces, err := api.traceProvider.ParseTraces(rawResponses[i].Result)
+			traces, err := api.traceProvider.ParseTraces(results[i])
 			if err != nil {
 				return nil, errors.Wrap(err, "failed to parse traces")
 			}
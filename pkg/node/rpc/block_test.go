@@ -0,0 +1,140 @@
+package rpc
+
+import (
+	"context"
+	stdjson "encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/NobleScope/noble-indexer/pkg/node/types"
+	pkgTypes "github.com/NobleScope/noble-indexer/pkg/types"
+	"github.com/dipdup-net/go-lib/config"
+	"github.com/stretchr/testify/require"
+)
+
+// testNode serves batch requests with the handler and records sizes of received batches
+type testNode struct {
+	mx      sync.Mutex
+	batches []int
+	handler func(attempt int, request types.Request) (any, *types.Error)
+}
+
+func (n *testNode) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var requests []types.Request
+	if err := stdjson.NewDecoder(r.Body).Decode(&requests); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	n.mx.Lock()
+	attempt := len(n.batches)
+	n.batches = append(n.batches, len(requests))
+	n.mx.Unlock()
+
+	responses := make([]map[string]any, len(requests))
+	for i := range requests {
+		result, err := n.handler(attempt, requests[i])
+		responses[i] = map[string]any{"jsonrpc": "2.0", "id": requests[i].Id}
+		if err != nil {
+			responses[i]["error"] = err
+		} else {
+			responses[i]["result"] = result
+		}
+	}
+	_ = stdjson.NewEncoder(w).Encode(responses)
+}
+
+func newTestAPI(t *testing.T, node *testNode) API {
+	t.Helper()
+	server := httptest.NewServer(node)
+	t.Cleanup(server.Close)
+
+	return NewApi(config.DataSource{URL: server.URL}, WithRateLimit(1000), WithRetry(3, time.Millisecond))
+}
+
+func blockResult(request types.Request) any {
+	switch request.Method {
+	case pathBlock:
+		return map[string]any{"number": request.Params[0], "transactions": []any{}}
+	default:
+		return []any{}
+	}
+}
+
+func TestBlockBulkRetryFailedRequests(t *testing.T) {
+	node := &testNode{
+		handler: func(attempt int, request types.Request) (any, *types.Error) {
+			switch {
+			case attempt == 0 && request.Id == 1:
+				return nil, &types.Error{Code: types.CodeLimitExceeded, Message: "request limit reached"}
+			case attempt < 2 && request.Id == 3:
+				return nil, &types.Error{Code: -32000, Message: "header not found"}
+			default:
+				return blockResult(request), nil
+			}
+		},
+	}
+	api := newTestAPI(t, node)
+
+	blocks, err := api.BlockBulk(context.Background(), 10, 11)
+	require.NoError(t, err)
+	require.Len(t, blocks, 2)
+	require.Equal(t, pkgTypes.Hex{0x0a}, blocks[0].Number)
+	require.Equal(t, pkgTypes.Hex{0x0b}, blocks[1].Number)
+	require.NotNil(t, blocks[0].Receipts)
+
+	// only failed sub-requests are requested again
+	require.Equal(t, []int{6, 2, 1}, node.batches)
+}
+
+func TestBlockBulkMethodNotFound(t *testing.T) {
+	node := &testNode{
+		handler: func(_ int, request types.Request) (any, *types.Error) {
+			if request.Method == "trace_block" {
+				return nil, &types.Error{Code: types.CodeMethodNotFound, Message: "the method trace_block does not exist/is not available"}
+			}
+			return blockResult(request), nil
+		},
+	}
+	api := newTestAPI(t, node)
+
+	_, err := api.BlockBulk(context.Background(), 10)
+	require.ErrorIs(t, err, types.ErrMethodNotFound)
+	require.ErrorContains(t, err, "trace_block is not supported by the node")
+	require.Equal(t, []int{3}, node.batches)
+}
+
+func TestBlockBulkRetriesExceeded(t *testing.T) {
+	node := &testNode{
+		handler: func(_ int, request types.Request) (any, *types.Error) {
+			if request.Id == 2 {
+				return nil, &types.Error{Code: types.CodeLimitExceeded, Message: "request limit reached"}
+			}
+			return blockResult(request), nil
+		},
+	}
+	api := newTestAPI(t, node)
+
+	_, err := api.BlockBulk(context.Background(), 10)
+	require.ErrorIs(t, err, types.ErrRequest)
+	require.ErrorContains(t, err, "1 requests failed after 3 retries")
+	require.Equal(t, []int{3, 1, 1, 1}, node.batches)
+}
+
+func TestBackoff(t *testing.T) {
+	api := API{retryDelay: time.Second}
+	require.Equal(t, time.Second, api.backoff(0))
+	require.Equal(t, 4*time.Second, api.backoff(2))
+	require.Equal(t, maxRetryDelay, api.backoff(10))
+	require.Equal(t, maxRetryDelay, api.backoff(100))
+}
+
+func TestTransientError(t *testing.T) {
+	require.True(t, transientError(statusError(http.StatusTooManyRequests)))
+	require.True(t, transientError(statusError(http.StatusBadGateway)))
+	require.False(t, transientError(statusError(http.StatusUnauthorized)))
+	require.True(t, transientError(context.DeadlineExceeded))
+}
@@ -0,0 +1,57 @@
+package rpc
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/NobleScope/noble-indexer/pkg/node/types"
+	pkgTypes "github.com/NobleScope/noble-indexer/pkg/types"
+)
+
+// Call executes read-only calls of contracts in the single batch. Results are returned in the order of requests,
+// the result of the reverted call is empty.
+func (api *API) Call(ctx context.Context, requestData []pkgTypes.CallRequest) ([]pkgTypes.Hex, error) {
+	if len(requestData) == 0 {
+		return nil, nil
+	}
+
+	u, err := url.Parse(api.cfg.URL)
+	if err != nil {
+		return nil, err
+	}
+
+	requests := make([]types.Request, len(requestData))
+	for i := range requestData {
+		requests[i] = types.Request{
+			Method:  pathEthCall,
+			JsonRpc: "2.0",
+			Id:      int64(i),
+			Params: []any{
+				map[string]string{
+					"to":   requestData[i].To,
+					"data": requestData[i].Data,
+				},
+				requestData[i].BlockNumber.Hex(),
+			},
+		}
+	}
+
+	responses, err := api.sendBatch(ctx, u.Path, requests)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]pkgTypes.Hex, len(requests))
+	for i := range responses {
+		id := responses[i].Id
+		if id < 0 || id >= int64(len(result)) || responses[i].Error != nil || len(responses[i].Result) == 0 {
+			continue
+		}
+		var value pkgTypes.Hex
+		if err := json.Unmarshal(responses[i].Result, &value); err != nil {
+			continue
+		}
+		result[id] = value
+	}
+	return result, nil
+}
@@ -0,0 +1,37 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NobleScope/noble-indexer/pkg/node/types"
+	pkgTypes "github.com/NobleScope/noble-indexer/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCall(t *testing.T) {
+	node := &testNode{
+		handler: func(_ int, request types.Request) (any, *types.Error) {
+			call, ok := request.Params[0].(map[string]any)
+			if !ok || request.Method != pathEthCall || request.Params[1] != "0x64" {
+				return nil, &types.Error{Code: -32602, Message: "invalid params"}
+			}
+			if call["to"] == "0x0000000000000000000000000000000000000002" {
+				return nil, &types.Error{Code: 3, Message: "execution reverted"}
+			}
+			return "0x000000000000000000000000000000000000000000000000000000000000abcd", nil
+		},
+	}
+	api := newTestAPI(t, node)
+
+	result, err := api.Call(context.Background(), []pkgTypes.CallRequest{
+		{To: "0x0000000000000000000000000000000000000001", Data: "0x5c60da1b", BlockNumber: 100},
+		{To: "0x0000000000000000000000000000000000000002", Data: "0x5c60da1b", BlockNumber: 100},
+	})
+	require.NoError(t, err)
+	require.Len(t, result, 2)
+	require.Len(t, result[0], 32)
+	require.EqualValues(t, 0xab, result[0][30])
+	require.Nil(t, result[1])
+	require.Equal(t, []int{2}, node.batches)
+}
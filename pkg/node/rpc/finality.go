@@ -0,0 +1,67 @@
+package rpc
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/NobleScope/noble-indexer/pkg/node/types"
+	pkgTypes "github.com/NobleScope/noble-indexer/pkg/types"
+	"github.com/pkg/errors"
+)
+
+const (
+	tagSafe      = "safe"
+	tagFinalized = "finalized"
+)
+
+// Finality requests the safe and finalized blocks by their tags in the single batch. The node returns null
+// instead of the block until the chain reaches the finality, the height is zero in that case.
+func (api *API) Finality(ctx context.Context) (pkgTypes.Finality, error) {
+	u, err := url.Parse(api.cfg.URL)
+	if err != nil {
+		return pkgTypes.Finality{}, err
+	}
+
+	tags := []string{tagSafe, tagFinalized}
+	requests := make([]types.Request, len(tags))
+	for i := range tags {
+		requests[i] = types.Request{
+			Method:  pathBlock,
+			JsonRpc: "2.0",
+			Id:      int64(i),
+			Params: []any{
+				tags[i],
+				false,
+			},
+		}
+	}
+
+	results, err := api.batch(ctx, u.Path, requests)
+	if err != nil {
+		return pkgTypes.Finality{}, err
+	}
+
+	levels := make([]pkgTypes.Level, len(tags))
+	for i := range results {
+		if len(results[i]) == 0 {
+			continue
+		}
+		var header *pkgTypes.Result
+		if err := json.Unmarshal(results[i], &header); err != nil {
+			return pkgTypes.Finality{}, errors.Wrapf(err, "failed to unmarshal %s block", tags[i])
+		}
+		if header == nil {
+			continue
+		}
+		level, err := header.Number.Uint64()
+		if err != nil {
+			return pkgTypes.Finality{}, errors.Wrapf(err, "converting %s block number", tags[i])
+		}
+		levels[i] = pkgTypes.Level(level)
+	}
+
+	return pkgTypes.Finality{
+		Safe:      levels[0],
+		Finalized: levels[1],
+	}, nil
+}
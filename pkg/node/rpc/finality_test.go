@@ -0,0 +1,47 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NobleScope/noble-indexer/pkg/node/types"
+	pkgTypes "github.com/NobleScope/noble-indexer/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFinality(t *testing.T) {
+	node := &testNode{
+		handler: func(_ int, request types.Request) (any, *types.Error) {
+			switch request.Params[0] {
+			case tagSafe:
+				return map[string]any{"number": "0x64"}, nil
+			case tagFinalized:
+				return map[string]any{"number": "0x40"}, nil
+			default:
+				return nil, &types.Error{Code: -32602, Message: "invalid block tag"}
+			}
+		},
+	}
+	api := newTestAPI(t, node)
+
+	finality, err := api.Finality(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, pkgTypes.Finality{Safe: 100, Finalized: 64}, finality)
+	require.Equal(t, []int{2}, node.batches)
+}
+
+func TestFinalityNotReached(t *testing.T) {
+	node := &testNode{
+		handler: func(_ int, request types.Request) (any, *types.Error) {
+			if request.Params[0] == tagSafe {
+				return map[string]any{"number": "0x10"}, nil
+			}
+			return nil, nil
+		},
+	}
+	api := newTestAPI(t, node)
+
+	finality, err := api.Finality(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, pkgTypes.Finality{Safe: 16}, finality)
+}
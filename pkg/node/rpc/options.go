@@ -21,6 +21,15 @@ func WithTimeout(timeout time.Duration) APIOption {
 	}
 }
 
+// WithRetry sets the number of retries of failed batch requests and the delay before the first retry.
+// The delay is doubled on every next retry.
+func WithRetry(count int, delay time.Duration) APIOption {
+	return func(api *API) {
+		api.retryCount = count
+		api.retryDelay = delay
+	}
+}
+
 func WithTraceMethod(method string) APIOption {
 	return func(api *API) {
 		switch method {
@@ -0,0 +1,165 @@
+package rpc
+
+import (
+	"context"
+	stdjson "encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/NobleScope/noble-indexer/pkg/node/types"
+	"github.com/opus-domini/fast-shot/constant/header"
+	"github.com/pkg/errors"
+)
+
+const maxRetryDelay = time.Second * 30
+
+type statusError int
+
+func (e statusError) Error() string {
+	return fmt.Sprintf("invalid status: %d", int(e))
+}
+
+// transientError returns true if the failed batch may succeed on retry. Network errors, timeouts and broken responses
+// are transient, so only client errors of HTTP except rate limiting are permanent.
+func transientError(err error) bool {
+	var status statusError
+	if errors.As(err, &status) {
+		return status == http.StatusTooManyRequests || status >= http.StatusInternalServerError
+	}
+	return true
+}
+
+// requestError converts the permanent error of the sub-request.
+func requestError(method string, err types.Error) error {
+	if err.Code == types.CodeMethodNotFound {
+		return errors.Wrapf(types.ErrMethodNotFound, "%s is not supported by the node", method)
+	}
+	return errors.Wrapf(types.ErrRequest, "%s: %s", method, err.Error())
+}
+
+// backoff returns the delay before the retry. The delay is doubled on every attempt.
+func (api *API) backoff(attempt int) time.Duration {
+	delay := api.retryDelay << attempt
+	if delay <= 0 || delay > maxRetryDelay {
+		return maxRetryDelay
+	}
+	return delay
+}
+
+func sleep(ctx context.Context, delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// batch sends the batch request and re-sends only failed sub-requests with exponential backoff until all of them
+// succeed or the retry count is reached. Request ids have to be positions of requests in the batch,
+// results are returned in the same order.
+func (api *API) batch(ctx context.Context, path string, requests []types.Request) ([]stdjson.RawMessage, error) {
+	var (
+		results = make([]stdjson.RawMessage, len(requests))
+		pending = requests
+		lastErr error
+	)
+
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 {
+			delay := api.backoff(attempt - 1)
+			api.log.Warn().
+				Err(lastErr).
+				Int("attempt", attempt).
+				Int("requests", len(pending)).
+				Dur("delay", delay).
+				Msg("retrying failed requests")
+
+			if err := sleep(ctx, delay); err != nil {
+				return nil, err
+			}
+		}
+
+		responses, err := api.sendBatch(ctx, path, pending)
+		switch {
+		case err != nil && (ctx.Err() != nil || !transientError(err)):
+			return nil, err
+		case err != nil:
+			lastErr = err
+		default:
+			lastErr = nil
+			received := make(map[int64]struct{}, len(responses))
+			for i := range responses {
+				id := responses[i].Id
+				if id < 0 || id >= int64(len(requests)) {
+					continue
+				}
+				if responses[i].Error != nil {
+					if !responses[i].Error.Transient() {
+						return nil, requestError(requests[id].Method, *responses[i].Error)
+					}
+					lastErr = errors.Wrapf(types.ErrRequest, "%s: %s", requests[id].Method, responses[i].Error.Error())
+					continue
+				}
+				results[id] = responses[i].Result
+				received[id] = struct{}{}
+			}
+
+			failed := make([]types.Request, 0)
+			for i := range pending {
+				if _, ok := received[pending[i].Id]; !ok {
+					failed = append(failed, pending[i])
+				}
+			}
+			if len(failed) == 0 {
+				return results, nil
+			}
+			if lastErr == nil {
+				lastErr = errors.Errorf("no response to %d requests", len(failed))
+			}
+			pending = failed
+		}
+
+		if attempt >= api.retryCount {
+			return nil, errors.Wrapf(lastErr, "%d requests failed after %d retries", len(pending), api.retryCount)
+		}
+	}
+}
+
+func (api *API) sendBatch(ctx context.Context, path string, requests []types.Request) ([]types.Response[stdjson.RawMessage], error) {
+	if api.rateLimit != nil {
+		if err := api.rateLimit.Wait(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	requestCtx, cancel := context.WithTimeout(ctx, api.timeout)
+	defer cancel()
+
+	resp, err := api.client.POST(path).
+		Context().
+		Set(requestCtx).
+		Header().
+		AddAll(map[header.Type]string{
+			header.ContentType: "application/json",
+			header.UserAgent:   userAgent}).
+		Body().AsJSON(&requests).Send()
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Raw().Body.Close()
+
+	if resp.Status().IsError() {
+		return nil, statusError(resp.Status().Code())
+	}
+
+	var responses []types.Response[stdjson.RawMessage]
+	if err := json.NewDecoder(resp.Raw().Body).Decode(&responses); err != nil {
+		return nil, errors.Wrap(err, "decoding response body")
+	}
+	return responses, nil
+}
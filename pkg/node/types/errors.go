@@ -3,5 +3,6 @@ package types
 import "errors"
 
 var (
-	ErrRequest = errors.New("request error")
+	ErrRequest        = errors.New("request error")
+	ErrMethodNotFound = errors.New("method not found")
 )
@@ -3,8 +3,32 @@ package types
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 )
 
+// JSON-RPC error codes, see EIP-1474
+const (
+	CodeInvalidRequest      = -32600
+	CodeMethodNotFound      = -32601
+	CodeInvalidParams       = -32602
+	CodeInternal            = -32603
+	CodeResourceUnavailable = -32002
+	CodeLimitExceeded       = -32005
+)
+
+// messages of server errors (-32000) which may disappear on retry
+var transientMessages = []string{
+	"header not found",
+	"block not found",
+	"unknown block",
+	"timeout",
+	"timed out",
+	"rate limit",
+	"too many requests",
+	"busy",
+	"try again",
+}
+
 type Request struct {
 	Method  string `json:"method"`
 	Params  []any  `json:"params"`
@@ -32,3 +56,22 @@ type Error struct {
 func (e Error) Error() string {
 	return fmt.Sprintf("code=%d message=%s data=%s", e.Code, e.Message, string(e.Data))
 }
+
+// Transient returns true if the request may succeed on retry: the node is overloaded, rate-limited
+// or has not processed the requested block yet.
+func (e Error) Transient() bool {
+	switch e.Code {
+	case CodeLimitExceeded, CodeResourceUnavailable, CodeInternal:
+		return true
+	case CodeMethodNotFound, CodeInvalidRequest, CodeInvalidParams:
+		return false
+	}
+
+	message := strings.ToLower(e.Message)
+	for i := range transientMessages {
+		if strings.Contains(message, transientMessages[i]) {
+			return true
+		}
+	}
+	return false
+}
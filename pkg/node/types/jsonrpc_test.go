@@ -0,0 +1,28 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestErrorTransient(t *testing.T) {
+	for _, e := range []Error{
+		{Code: CodeLimitExceeded, Message: "request limit reached"},
+		{Code: CodeResourceUnavailable, Message: "resource unavailable"},
+		{Code: CodeInternal, Message: "internal error"},
+		{Code: -32000, Message: "header not found"},
+		{Code: -32000, Message: "Request timed out"},
+		{Code: 429, Message: "Too Many Requests"},
+	} {
+		require.True(t, e.Transient(), e.Error())
+	}
+
+	for _, e := range []Error{
+		{Code: CodeMethodNotFound, Message: "the method trace_block does not exist/is not available"},
+		{Code: CodeInvalidParams, Message: "invalid argument 0: hex string without 0x prefix"},
+		{Code: -32000, Message: "execution reverted"},
+	} {
+		require.False(t, e.Transient(), e.Error())
+	}
+}
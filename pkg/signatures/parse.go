@@ -0,0 +1,199 @@
+package signatures
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/NobleScope/noble-indexer/internal/storage"
+	"github.com/NobleScope/noble-indexer/internal/storage/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/pkg/errors"
+)
+
+// FromABI - returns signatures of all functions and non-anonymous events of the JSON ABI.
+// Each signature keeps its own ABI entry to decode calls and logs without the whole contract ABI.
+func FromABI(data json.RawMessage) ([]*storage.Signature, error) {
+	var entries []json.RawMessage
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, errors.Wrap(err, "unmarshal ABI")
+	}
+
+	signatures := make([]*storage.Signature, 0, len(entries))
+	for i := range entries {
+		var entry bytes.Buffer
+		if err := json.Compact(&entry, entries[i]); err != nil {
+			return nil, errors.Wrap(err, "compact ABI entry")
+		}
+
+		parsed, err := abi.JSON(strings.NewReader("[" + entry.String() + "]"))
+		if err != nil {
+			return nil, errors.Wrap(err, "parse ABI entry")
+		}
+
+		for _, method := range parsed.Methods {
+			signatures = append(signatures, &storage.Signature{
+				Selector:  method.ID,
+				Signature: method.Sig,
+				Type:      types.Function,
+				ABI:       entry.Bytes(),
+			})
+		}
+		for _, event := range parsed.Events {
+			if event.Anonymous {
+				continue
+			}
+			signatures = append(signatures, &storage.Signature{
+				Selector:  event.ID.Bytes(),
+				Signature: event.Sig,
+				Type:      types.Event,
+				ABI:       entry.Bytes(),
+			})
+		}
+	}
+
+	return signatures, nil
+}
+
+type abiArgument struct {
+	Name       string        `json:"name"`
+	Type       string        `json:"type"`
+	Components []abiArgument `json:"components,omitempty"`
+	Indexed    bool          `json:"indexed,omitempty"`
+}
+
+type abiEntry struct {
+	Type            string        `json:"type"`
+	Name            string        `json:"name"`
+	Inputs          []abiArgument `json:"inputs"`
+	StateMutability string        `json:"stateMutability,omitempty"`
+}
+
+// FromText - returns the signature of the text declaration like `transfer(address,uint256)`.
+// The declaration is treated as a function unless it is prefixed with `event`.
+// Text signatures usually have no argument names and indexed flags, so arguments are named by position.
+func FromText(text string) (*storage.Signature, error) {
+	text = strings.TrimSpace(text)
+
+	entry := abiEntry{
+		Type:            "function",
+		StateMutability: "nonpayable",
+	}
+	switch {
+	case strings.HasPrefix(text, "event "):
+		entry.Type = "event"
+		entry.StateMutability = ""
+		text = strings.TrimSpace(strings.TrimPrefix(text, "event "))
+	case strings.HasPrefix(text, "function "):
+		text = strings.TrimSpace(strings.TrimPrefix(text, "function "))
+	}
+
+	open := strings.Index(text, "(")
+	if open <= 0 || !strings.HasSuffix(text, ")") {
+		return nil, errors.Errorf("invalid text signature: %s", text)
+	}
+	entry.Name = text[:open]
+
+	inputs, err := parseArguments(text[open+1 : len(text)-1])
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid text signature: %s", text)
+	}
+	entry.Inputs = inputs
+
+	data, err := json.Marshal([]abiEntry{entry})
+	if err != nil {
+		return nil, err
+	}
+
+	signatures, err := FromABI(data)
+	if err != nil {
+		return nil, err
+	}
+	if len(signatures) != 1 {
+		return nil, errors.Errorf("invalid text signature: %s", text)
+	}
+	return signatures[0], nil
+}
+
+func parseArguments(list string) ([]abiArgument, error) {
+	parts, err := splitArguments(list)
+	if err != nil {
+		return nil, err
+	}
+
+	args := make([]abiArgument, 0, len(parts))
+	for i, part := range parts {
+		arg := abiArgument{
+			Name: fmt.Sprintf("arg%d", i),
+		}
+
+		// declarations may contain argument names and modifiers: `address indexed from`
+		var modifiers []string
+		if strings.HasPrefix(part, "(") {
+			closing := strings.LastIndex(part, ")")
+			components, err := parseArguments(part[1:closing])
+			if err != nil {
+				return nil, err
+			}
+			arg.Type = "tuple"
+			arg.Components = components
+
+			modifiers = strings.Fields(part[closing+1:])
+			if len(modifiers) > 0 && strings.HasPrefix(modifiers[0], "[") {
+				arg.Type += modifiers[0]
+				modifiers = modifiers[1:]
+			}
+		} else {
+			fields := strings.Fields(part)
+			arg.Type = fields[0]
+			modifiers = fields[1:]
+		}
+		arg.Indexed = slices.Contains(modifiers, "indexed")
+
+		args = append(args, arg)
+	}
+	return args, nil
+}
+
+// splitArguments splits the argument list by commas which are not nested into tuples.
+func splitArguments(list string) ([]string, error) {
+	list = strings.TrimSpace(list)
+	if list == "" {
+		return nil, nil
+	}
+
+	var (
+		parts = make([]string, 0)
+		depth int
+		start int
+	)
+	for i, r := range list {
+		switch r {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth < 0 {
+				return nil, errors.New("unbalanced parentheses")
+			}
+		case ',':
+			if depth == 0 {
+				parts = append(parts, strings.TrimSpace(list[start:i]))
+				start = i + 1
+			}
+		}
+	}
+	if depth != 0 {
+		return nil, errors.New("unbalanced parentheses")
+	}
+	parts = append(parts, strings.TrimSpace(list[start:]))
+
+	for i := range parts {
+		if parts[i] == "" {
+			return nil, errors.New("empty argument type")
+		}
+	}
+	return parts, nil
+}
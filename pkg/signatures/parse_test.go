@@ -0,0 +1,136 @@
+package signatures
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/NobleScope/noble-indexer/internal/storage/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFromABI(t *testing.T) {
+	t.Run("functions and events", func(t *testing.T) {
+		data := []byte(`[
+			{"inputs":[{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],"name":"transfer","outputs":[{"name":"","type":"bool"}],"stateMutability":"nonpayable","type":"function"},
+			{"anonymous":false,"inputs":[{"indexed":true,"name":"from","type":"address"},{"indexed":true,"name":"to","type":"address"},{"indexed":false,"name":"value","type":"uint256"}],"name":"Transfer","type":"event"},
+			{"anonymous":true,"inputs":[],"name":"Anonymous","type":"event"},
+			{"stateMutability":"payable","type":"fallback"}
+		]`)
+
+		signatures, err := FromABI(data)
+		require.NoError(t, err)
+		require.Len(t, signatures, 2)
+
+		require.Equal(t, "0xa9059cbb", signatures[0].Selector.Hex())
+		require.Equal(t, "transfer(address,uint256)", signatures[0].Signature)
+		require.Equal(t, types.Function, signatures[0].Type)
+		require.JSONEq(t, `{"inputs":[{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],"name":"transfer","outputs":[{"name":"","type":"bool"}],"stateMutability":"nonpayable","type":"function"}`, string(signatures[0].ABI))
+
+		require.Equal(t, "0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef", signatures[1].Selector.Hex())
+		require.Equal(t, "Transfer(address,address,uint256)", signatures[1].Signature)
+		require.Equal(t, types.Event, signatures[1].Type)
+	})
+
+	t.Run("tuple arguments", func(t *testing.T) {
+		data := []byte(`[{"inputs":[{"components":[{"name":"target","type":"address"},{"name":"callData","type":"bytes"}],"name":"calls","type":"tuple[]"}],"name":"aggregate","outputs":[],"stateMutability":"payable","type":"function"}]`)
+
+		signatures, err := FromABI(data)
+		require.NoError(t, err)
+		require.Len(t, signatures, 1)
+		require.Equal(t, "aggregate((address,bytes)[])", signatures[0].Signature)
+		require.Equal(t, "0x252dba42", signatures[0].Selector.Hex())
+	})
+
+	t.Run("invalid JSON", func(t *testing.T) {
+		_, err := FromABI([]byte(`{}`))
+		require.Error(t, err)
+	})
+
+	t.Run("invalid type", func(t *testing.T) {
+		_, err := FromABI([]byte(`[{"inputs":[{"name":"a","type":"unknown"}],"name":"f","type":"function"}]`))
+		require.Error(t, err)
+	})
+}
+
+func TestFromText(t *testing.T) {
+	t.Run("function", func(t *testing.T) {
+		signature, err := FromText("transfer(address,uint256)")
+		require.NoError(t, err)
+		require.Equal(t, "0xa9059cbb", signature.Selector.Hex())
+		require.Equal(t, "transfer(address,uint256)", signature.Signature)
+		require.Equal(t, types.Function, signature.Type)
+		require.JSONEq(t, `{"type":"function","name":"transfer","inputs":[{"name":"arg0","type":"address"},{"name":"arg1","type":"uint256"}],"stateMutability":"nonpayable"}`, string(signature.ABI))
+	})
+
+	t.Run("function with keyword and without arguments", func(t *testing.T) {
+		signature, err := FromText("function totalSupply()")
+		require.NoError(t, err)
+		require.Equal(t, "0x18160ddd", signature.Selector.Hex())
+		require.Equal(t, "totalSupply()", signature.Signature)
+	})
+
+	t.Run("event with indexed arguments", func(t *testing.T) {
+		signature, err := FromText("event Transfer(address indexed from, address indexed to, uint256 value)")
+		require.NoError(t, err)
+		require.Equal(t, "0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef", signature.Selector.Hex())
+		require.Equal(t, "Transfer(address,address,uint256)", signature.Signature)
+		require.Equal(t, types.Event, signature.Type)
+		require.JSONEq(t, `{"type":"event","name":"Transfer","inputs":[{"name":"arg0","type":"address","indexed":true},{"name":"arg1","type":"address","indexed":true},{"name":"arg2","type":"uint256"}]}`, string(signature.ABI))
+	})
+
+	t.Run("tuple", func(t *testing.T) {
+		signature, err := FromText("aggregate((address,bytes)[])")
+		require.NoError(t, err)
+		require.Equal(t, "0x252dba42", signature.Selector.Hex())
+		require.Equal(t, "aggregate((address,bytes)[])", signature.Signature)
+	})
+
+	for _, text := range []string{
+		"",
+		"transfer",
+		"(address)",
+		"transfer(address,",
+		"transfer(address,,uint256)",
+		"transfer((address,uint256)",
+		"transfer(unknown)",
+	} {
+		t.Run("invalid "+text, func(t *testing.T) {
+			_, err := FromText(text)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestLoadDump(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "signatures.txt")
+	require.NoError(t, os.WriteFile(path, []byte(`# known signatures
+transfer(address,uint256)
+
+event Approval(address indexed,address indexed,uint256)
+`), 0o600))
+
+	signatures, err := LoadDump(path)
+	require.NoError(t, err)
+	require.Len(t, signatures, 2)
+	require.Equal(t, "transfer(address,uint256)", signatures[0].Signature)
+	require.Equal(t, "Approval(address,address,uint256)", signatures[1].Signature)
+	require.Equal(t, "0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925", signatures[1].Selector.Hex())
+
+	require.NoError(t, os.WriteFile(path, []byte("transfer(address\n"), 0o600))
+	_, err = LoadDump(path)
+	require.ErrorContains(t, err, "line 1")
+}
+
+func TestLoadDir(t *testing.T) {
+	signatures, err := LoadDir("../../assets/abi")
+	require.NoError(t, err)
+
+	known := make(map[string]struct{}, len(signatures))
+	for _, signature := range signatures {
+		known[signature.Signature] = struct{}{}
+	}
+	require.Contains(t, known, "transfer(address,uint256)")
+	require.Contains(t, known, "Transfer(address,address,uint256)")
+	require.Contains(t, known, "TransferSingle(address,address,address,uint256,uint256)")
+}
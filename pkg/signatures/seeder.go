@@ -0,0 +1,192 @@
+package signatures
+
+import (
+	"bufio"
+	"context"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/NobleScope/noble-indexer/internal/storage"
+	"github.com/NobleScope/noble-indexer/internal/storage/postgres"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+const batchSize = 100
+
+// Seeder fills the signature registry from ABIs of known interfaces, ABIs of contracts and the signatures dump file.
+type Seeder struct {
+	pg  postgres.Storage
+	log zerolog.Logger
+}
+
+func NewSeeder(pg postgres.Storage, log zerolog.Logger) *Seeder {
+	return &Seeder{
+		pg:  pg,
+		log: log,
+	}
+}
+
+// Seed - saves signatures of all ABIs in the directory, of all contracts with ABI and of the dump file if it's set
+func (s *Seeder) Seed(ctx context.Context, abiDir, dumpFile string) error {
+	if abiDir != "" {
+		signatures, err := LoadDir(abiDir)
+		if err != nil {
+			return errors.Wrap(err, "load ABI directory")
+		}
+		if err := s.save(ctx, signatures); err != nil {
+			return errors.Wrap(err, "save ABI directory signatures")
+		}
+		s.log.Info().Int("count", len(signatures)).Str("dir", abiDir).Msg("signatures of known interfaces saved")
+	}
+
+	if err := s.seedContracts(ctx); err != nil {
+		return errors.Wrap(err, "seed contracts signatures")
+	}
+
+	if dumpFile != "" {
+		signatures, err := LoadDump(dumpFile)
+		if err != nil {
+			return errors.Wrap(err, "load signatures dump")
+		}
+		if err := s.save(ctx, signatures); err != nil {
+			return errors.Wrap(err, "save dump signatures")
+		}
+		s.log.Info().Int("count", len(signatures)).Str("file", dumpFile).Msg("signatures dump imported")
+	}
+
+	return nil
+}
+
+func (s *Seeder) seedContracts(ctx context.Context) error {
+	var (
+		cursor uint64
+		count  int
+	)
+	for {
+		contracts, err := s.pg.Contracts.ListWithABI(ctx, cursor, batchSize)
+		if err != nil {
+			return errors.Wrap(err, "list contracts with ABI")
+		}
+		if len(contracts) == 0 {
+			break
+		}
+
+		signatures := make([]*storage.Signature, 0)
+		for i := range contracts {
+			contractSignatures, err := FromABI(contracts[i].ABI)
+			if err != nil {
+				s.log.Warn().Err(err).Uint64("contract_id", contracts[i].Id).Msg("invalid contract ABI")
+				continue
+			}
+			signatures = append(signatures, contractSignatures...)
+		}
+		if err := s.save(ctx, signatures); err != nil {
+			return err
+		}
+
+		cursor = contracts[len(contracts)-1].Id
+		count += len(contracts)
+	}
+
+	s.log.Info().Int("contracts", count).Msg("signatures of contracts saved")
+	return nil
+}
+
+func (s *Seeder) save(ctx context.Context, signatures []*storage.Signature) error {
+	signatures = unique(signatures)
+	if len(signatures) == 0 {
+		return nil
+	}
+
+	tx, err := postgres.BeginTransaction(ctx, s.pg.Transactable)
+	if err != nil {
+		return err
+	}
+	defer tx.Close(ctx)
+
+	if err := tx.SaveSignatures(ctx, signatures...); err != nil {
+		return tx.HandleError(ctx, err)
+	}
+	if err := tx.ResetUnknownLogs(ctx, signatures...); err != nil {
+		return tx.HandleError(ctx, err)
+	}
+
+	if err := tx.Flush(ctx); err != nil {
+		return tx.HandleError(ctx, err)
+	}
+	return nil
+}
+
+// LoadDir - returns signatures of all JSON ABIs in the directory and its subdirectories
+func LoadDir(dir string) ([]*storage.Signature, error) {
+	signatures := make([]*storage.Signature, 0)
+
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || filepath.Ext(path) != ".json" {
+			return nil
+		}
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return errors.Wrapf(err, "read file %s", path)
+		}
+
+		abiSignatures, err := FromABI(data)
+		if err != nil {
+			return errors.Wrapf(err, "parse ABI %s", path)
+		}
+		signatures = append(signatures, abiSignatures...)
+		return nil
+	})
+
+	return signatures, err
+}
+
+// LoadDump - returns signatures of the dump file. The file contains a text signature per line,
+// e.g. `transfer(address,uint256)` or `event Transfer(address indexed,address indexed,uint256)`.
+// Empty lines and lines started with `#` are skipped.
+func LoadDump(path string) ([]*storage.Signature, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	signatures := make([]*storage.Signature, 0)
+
+	scanner := bufio.NewScanner(file)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		signature, err := FromText(text)
+		if err != nil {
+			return nil, errors.Wrapf(err, "line %d", line)
+		}
+		signatures = append(signatures, signature)
+	}
+
+	return signatures, scanner.Err()
+}
+
+func unique(signatures []*storage.Signature) []*storage.Signature {
+	result := make([]*storage.Signature, 0, len(signatures))
+	seen := make(map[string]struct{}, len(signatures))
+	for _, signature := range signatures {
+		key := signature.Selector.String() + signature.Signature
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		result = append(result, signature)
+	}
+	return result
+}
@@ -23,6 +23,8 @@ type Block struct {
 	TransactionsRoot Hex          `json:"transactionsRoot"`
 	Uncles           []Hex        `json:"uncles"`
 	Withdrawals      []Withdrawal `json:"withdrawals,omitempty"`
+	BlobGasUsed      *Hex         `json:"blobGasUsed"`
+	ExcessBlobGas    *Hex         `json:"excessBlobGas"`
 }
 
 type Withdrawal struct {
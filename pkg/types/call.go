@@ -0,0 +1,8 @@
+package types
+
+// CallRequest - read-only call of the contract at the block
+type CallRequest struct {
+	To          string
+	Data        string
+	BlockNumber Level
+}
@@ -0,0 +1,8 @@
+package types
+
+// Finality contains heights of the last safe and finalized blocks of the node. Zero height means the node
+// doesn't know such block yet.
+type Finality struct {
+	Safe      Level
+	Finalized Level
+}
@@ -16,6 +16,8 @@ type Receipt struct {
 	TransactionHash   Hex   `json:"transactionHash"`
 	TransactionIndex  Hex   `json:"transactionIndex"`
 	Type              Hex   `json:"type"`
+	BlobGasUsed       *Hex  `json:"blobGasUsed"`
+	BlobGasPrice      *Hex  `json:"blobGasPrice"`
 }
 
 type Log struct {
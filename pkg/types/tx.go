@@ -1,19 +1,42 @@
 package types
 
 type Tx struct {
-	BlockHash        Hex  `json:"blockHash"`
-	BlockNumber      Hex  `json:"blockNumber"`
-	From             Hex  `json:"from"`
-	Gas              Hex  `json:"gas"`
-	GasPrice         Hex  `json:"gasPrice"`
-	Hash             Hex  `json:"hash"`
-	Input            Hex  `json:"input"`
-	Nonce            Hex  `json:"nonce"`
-	To               *Hex `json:"to"`
-	TransactionIndex Hex  `json:"transactionIndex"`
-	Value            Hex  `json:"value"`
-	Type             Hex  `json:"type"`
-	V                Hex  `json:"v"`
-	R                Hex  `json:"r"`
-	S                Hex  `json:"s"`
+	BlockHash            Hex             `json:"blockHash"`
+	BlockNumber          Hex             `json:"blockNumber"`
+	From                 Hex             `json:"from"`
+	Gas                  Hex             `json:"gas"`
+	GasPrice             Hex             `json:"gasPrice"`
+	MaxFeePerGas         *Hex            `json:"maxFeePerGas"`
+	MaxPriorityFeePerGas *Hex            `json:"maxPriorityFeePerGas"`
+	MaxFeePerBlobGas     *Hex            `json:"maxFeePerBlobGas"`
+	Hash                 Hex             `json:"hash"`
+	Input                Hex             `json:"input"`
+	Nonce                Hex             `json:"nonce"`
+	To                   *Hex            `json:"to"`
+	TransactionIndex     Hex             `json:"transactionIndex"`
+	Value                Hex             `json:"value"`
+	Type                 Hex             `json:"type"`
+	ChainId              *Hex            `json:"chainId"`
+	AccessList           []AccessTuple   `json:"accessList"`
+	BlobVersionedHashes  []Hex           `json:"blobVersionedHashes"`
+	AuthorizationList    []Authorization `json:"authorizationList"`
+	V                    Hex             `json:"v"`
+	R                    Hex             `json:"r"`
+	S                    Hex             `json:"s"`
+}
+
+// AccessTuple - EIP-2930 access list entry
+type AccessTuple struct {
+	Address     Hex   `json:"address"`
+	StorageKeys []Hex `json:"storageKeys"`
+}
+
+// Authorization - EIP-7702 set code authorization tuple
+type Authorization struct {
+	ChainId Hex `json:"chainId"`
+	Address Hex `json:"address"`
+	Nonce   Hex `json:"nonce"`
+	YParity Hex `json:"yParity"`
+	R       Hex `json:"r"`
+	S       Hex `json:"s"`
 }